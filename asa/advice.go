@@ -0,0 +1,34 @@
+package asa
+
+import "context"
+
+// Advice is an ASA request with an advice status. It notifies the program of an
+// authorization that was already decided on its behalf, for example by the card
+// network during stand-in processing, so it cannot be declined. Its amount
+// must not be counted as another authorization of the transaction.
+type Advice struct {
+	Request
+}
+
+// AdviceFunc is called with the advice messages that a Handler receives.
+type AdviceFunc func(ctx context.Context, advice *Advice)
+
+// IsAdvice reports whether requests with the status are advice messages.
+func (s Status) IsAdvice() bool {
+	switch s {
+	case StatusAuthorizationAdvice, StatusCreditAuthorizationAdvice, StatusFinancialAdvice, StatusFinancialCreditAdvice:
+		return true
+	}
+	return false
+}
+
+// Financial reports whether the advice is for a financial authorization, which
+// settles without a clearing.
+func (a *Advice) Financial() bool {
+	return a.Status == StatusFinancialAdvice || a.Status == StatusFinancialCreditAdvice
+}
+
+// Credit reports whether the advice is for a refund or credit to the card.
+func (a *Advice) Credit() bool {
+	return a.Status == StatusCreditAuthorizationAdvice || a.Status == StatusFinancialCreditAdvice
+}
@@ -0,0 +1,46 @@
+package asa
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestHandlerAdvice(t *testing.T) {
+	advice := strings.Replace(payload, `"status":"AUTHORIZATION"`, `"status":"FINANCIAL_CREDIT_ADVICE"`, 1)
+	var got *Advice
+	var record Record
+	h := NewHandler(secret, DeclineReasonUnauthorizedMerchant, func(ctx context.Context, req *Request) (*Response, error) {
+		t.Fatal("advice was decided")
+		return nil, nil
+	})
+	h.OnAdvice = func(ctx context.Context, advice *Advice) { got = advice }
+	h.Audit = AuditSinkFunc(func(ctx context.Context, r Record) { record = r })
+
+	_, res := serve(t, h, advice, sign([]byte(advice), secret))
+	if res == nil || res.Token != "tx" || res.Result != ResultApproved {
+		t.Fatalf("expected an acknowledgement, got %+v", res)
+	}
+	if got == nil || got.Amount != 1500 || !got.Financial() || !got.Credit() {
+		t.Fatalf("unexpected advice %+v", got)
+	}
+	if record.Outcome != OutcomeAdvice {
+		t.Fatalf("unexpected record %+v", record)
+	}
+}
+
+func TestStatusIsAdvice(t *testing.T) {
+	for status, want := range map[Status]bool{
+		StatusAuthorization:             false,
+		StatusFinancialAuthorization:    false,
+		StatusBalanceInquiry:            false,
+		StatusAuthorizationAdvice:       true,
+		StatusCreditAuthorizationAdvice: true,
+		StatusFinancialAdvice:           true,
+		StatusFinancialCreditAdvice:     true,
+	} {
+		if status.IsAdvice() != want {
+			t.Errorf("expected IsAdvice of %s to be %v", status, want)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package asa
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/lithic-com/lithic-go/redact"
+)
+
+// Outcome describes how the response to an ASA request was decided.
+type Outcome string
+
+const (
+	// The response was decided by the decision function.
+	OutcomeDecided Outcome = "DECIDED"
+	// The decision function failed, timed out or returned an invalid response,
+	// and the handler's fallback was returned.
+	OutcomeFallback Outcome = "FALLBACK"
+	// The decision function failed and the handler had no fallback, so the
+	// authorization was left to stand-in processing.
+	OutcomeStandIn Outcome = "STAND_IN"
+	// The request was an advice, which was acknowledged without a decision.
+	OutcomeAdvice Outcome = "ADVICE"
+)
+
+// Record is the audit record of a decision on an ASA request.
+type Record struct {
+	// The request as it was received. It is encoded as Payload.
+	Request Request `json:"-"`
+	// The body of the request, exactly as it was signed by Lithic.
+	Payload json.RawMessage `json:"request"`
+	// The response that was sent, which is nil for OutcomeStandIn.
+	Response *Response `json:"response"`
+	Outcome  Outcome   `json:"outcome"`
+	// The error of the decision function, for outcomes other than
+	// OutcomeDecided.
+	Error string `json:"error,omitempty"`
+	// The rules that the decision function reported with RecordRuleHit, in the
+	// order they were reported.
+	RuleHits []string `json:"rule_hits"`
+	// The time from receiving the request to sending the response.
+	Latency time.Duration `json:"latency_ns"`
+	At      time.Time     `json:"at"`
+}
+
+// AuditSink receives the audit record of every decision made by a Handler. It
+// is called after the response has been written and flushed, so it does not
+// delay the response, but it holds on to the request's connection and should
+// not block for long.
+type AuditSink interface {
+	Audit(ctx context.Context, record Record)
+}
+
+// AuditSinkFunc adapts a function to an AuditSink.
+type AuditSinkFunc func(ctx context.Context, record Record)
+
+func (f AuditSinkFunc) Audit(ctx context.Context, record Record) {
+	f(ctx, record)
+}
+
+// JSONAuditSink writes every record as a line of JSON, for example to a file
+// that is shipped to a data warehouse.
+type JSONAuditSink struct {
+	// The policy that the request payloads are redacted with before they are
+	// written. Defaults to redact.Default().
+	Policy *redact.Policy
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewJSONAuditSink(w io.Writer) *JSONAuditSink {
+	return &JSONAuditSink{w: w}
+}
+
+func (s *JSONAuditSink) Audit(ctx context.Context, record Record) {
+	policy := s.Policy
+	if policy == nil {
+		policy = redact.Default()
+	}
+	if len(record.Payload) != 0 {
+		record.Payload = policy.JSON(record.Payload)
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.w.Write(append(data, '\n'))
+}
+
+type ruleHitsKey struct{}
+
+type ruleHits struct {
+	mu    sync.Mutex
+	rules []string
+}
+
+func (h *ruleHits) list() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]string(nil), h.rules...)
+}
+
+// RecordRuleHit records that a rule, such as "high_risk_mcc", matched the
+// request that is being decided with ctx, so that it appears in the request's
+// audit record. It has no effect outside of a Handler.
+func RecordRuleHit(ctx context.Context, rule string) {
+	hits, ok := ctx.Value(ruleHitsKey{}).(*ruleHits)
+	if !ok {
+		return
+	}
+	hits.mu.Lock()
+	defer hits.mu.Unlock()
+	hits.rules = append(hits.rules, rule)
+}
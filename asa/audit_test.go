@@ -0,0 +1,138 @@
+package asa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandlerAudits(t *testing.T) {
+	var records []Record
+	h := NewHandler(secret, DeclineReasonUnauthorizedMerchant, func(ctx context.Context, req *Request) (*Response, error) {
+		RecordRuleHit(ctx, "coffee_shop")
+		RecordRuleHit(ctx, "small_amount")
+		req.Amount = 0
+		return Approve().For(req)
+	})
+	h.Audit = AuditSinkFunc(func(ctx context.Context, record Record) {
+		records = append(records, record)
+	})
+	serve(t, h, payload, sign([]byte(payload), secret))
+
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	record := records[0]
+	if record.Outcome != OutcomeDecided || record.Error != "" || record.Response.Result != ResultApproved {
+		t.Fatalf("unexpected record %+v", record)
+	}
+	if record.Request.Amount != 1500 || string(record.Payload) != payload {
+		t.Fatalf("expected a snapshot of the request, got %+v", record.Request)
+	}
+	if !reflect.DeepEqual(record.RuleHits, []string{"coffee_shop", "small_amount"}) {
+		t.Fatalf("unexpected rule hits %v", record.RuleHits)
+	}
+	if record.Latency <= 0 || record.At.IsZero() {
+		t.Fatalf("expected latency and time, got %v at %v", record.Latency, record.At)
+	}
+}
+
+func TestHandlerAuditsFallbacks(t *testing.T) {
+	decide := func(ctx context.Context, req *Request) (*Response, error) {
+		return nil, errors.New("rules unavailable")
+	}
+	tests := map[string]struct {
+		fallback Result
+		outcome  Outcome
+	}{
+		"fallback": {DeclineReasonUnauthorizedMerchant, OutcomeFallback},
+		"stand-in": {"", OutcomeStandIn},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var record Record
+			h := NewHandler(secret, test.fallback, decide)
+			h.Audit = AuditSinkFunc(func(ctx context.Context, r Record) { record = r })
+			serve(t, h, payload, sign([]byte(payload), secret))
+			if record.Outcome != test.outcome || record.Error != "rules unavailable" {
+				t.Fatalf("unexpected record %+v", record)
+			}
+			if test.fallback == "" && record.Response != nil {
+				t.Fatalf("expected no response, got %+v", record.Response)
+			}
+			if test.fallback != "" && record.Response.Result != test.fallback {
+				t.Fatalf("expected the fallback, got %+v", record.Response)
+			}
+		})
+	}
+}
+
+func TestHandlerAuditsAfterResponding(t *testing.T) {
+	release := make(chan struct{})
+	h := NewHandler(secret, DeclineReasonUnauthorizedMerchant, func(ctx context.Context, req *Request) (*Response, error) {
+		return Approve().For(req)
+	})
+	h.Audit = AuditSinkFunc(func(ctx context.Context, r Record) { <-release })
+	server := httptest.NewServer(h)
+	defer server.Close()
+	defer close(release)
+
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(payload))
+	req.Header.Set(SignatureHeader, sign([]byte(payload), secret))
+	client := &http.Client{Timeout: time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("expected the response before the audit sink returns, got %v", err)
+	}
+	defer resp.Body.Close()
+	res := &Response{}
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil || res.Result != ResultApproved {
+		t.Fatalf("unexpected response %+v %v", res, err)
+	}
+}
+
+func TestHandlerDoesNotAuditRejectedRequests(t *testing.T) {
+	audited := false
+	h := NewHandler(secret, ResultApproved, func(ctx context.Context, req *Request) (*Response, error) {
+		return Approve().For(req)
+	})
+	h.Audit = AuditSinkFunc(func(ctx context.Context, r Record) { audited = true })
+	w, _ := serve(t, h, payload, sign([]byte(payload), "other"))
+	if w.Code != http.StatusUnauthorized || audited {
+		t.Fatalf("expected an unaudited rejection, got %d", w.Code)
+	}
+}
+
+func TestJSONAuditSink(t *testing.T) {
+	buf := &bytes.Buffer{}
+	sink := NewJSONAuditSink(buf)
+	sink.Audit(context.Background(), Record{Payload: json.RawMessage(payload), Outcome: OutcomeStandIn, Error: "timeout"})
+	sink.Audit(context.Background(), Record{Payload: json.RawMessage(payload), Outcome: OutcomeDecided, RuleHits: []string{"mcc"}})
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", buf.String())
+	}
+	var line struct {
+		Request  Request  `json:"request"`
+		Outcome  Outcome  `json:"outcome"`
+		RuleHits []string `json:"rule_hits"`
+	}
+	if err := json.Unmarshal(lines[1], &line); err != nil {
+		t.Fatal(err)
+	}
+	if line.Request.Token != "tx" || line.Outcome != OutcomeDecided || line.RuleHits[0] != "mcc" {
+		t.Fatalf("unexpected line %s", lines[1])
+	}
+}
+
+func TestRecordRuleHitOutsideHandler(t *testing.T) {
+	RecordRuleHit(context.Background(), "ignored")
+}
@@ -0,0 +1,89 @@
+package asa
+
+// ResponseBuilder builds an ASA response fluently:
+//
+//	return asa.Approve().WithAvs(true, false).For(req)
+type ResponseBuilder struct {
+	res Response
+	// Whether the response was started with Decline, whose reason must then not
+	// approve the authorization.
+	decline bool
+}
+
+// Approve starts building a response that approves the authorization.
+func Approve() *ResponseBuilder {
+	return &ResponseBuilder{res: Response{Result: ResultApproved}}
+}
+
+// Decline starts building a response that declines the authorization with
+// reason. For rejects a reason that is not a decline reason, as NewDecline does.
+func Decline(reason Result) *ResponseBuilder {
+	return &ResponseBuilder{res: Response{Result: reason}, decline: true}
+}
+
+// WithToken sets the token of the transaction that the response is for. For
+// fills it in from the request.
+func (b *ResponseBuilder) WithToken(token string) *ResponseBuilder {
+	b.res.Token = token
+	return b
+}
+
+// WithApprovedAmount approves only amount (in cents) of the requested amount.
+func (b *ResponseBuilder) WithApprovedAmount(amount int64) *ResponseBuilder {
+	b.res.ApprovedAmount = amount
+	return b
+}
+
+// WithBalance reports the balance of the account, in cents.
+func (b *ResponseBuilder) WithBalance(amount int64, available int64) *ResponseBuilder {
+	b.res.Balance = &Balance{Amount: amount, Available: available}
+	return b
+}
+
+// WithAvsResult sets the outcome of the address verification.
+func (b *ResponseBuilder) WithAvsResult(result AvsResult) *ResponseBuilder {
+	b.res.AvsResult = result
+	return b
+}
+
+// WithAvs sets the outcome of the address verification from whether the street
+// address and the zip code sent by the merchant matched.
+func (b *ResponseBuilder) WithAvs(addressMatch bool, zipMatch bool) *ResponseBuilder {
+	switch {
+	case addressMatch && zipMatch:
+		b.res.AvsResult = AvsResultMatch
+	case addressMatch:
+		b.res.AvsResult = AvsResultMatchAddressOnly
+	case zipMatch:
+		b.res.AvsResult = AvsResultMatchZipOnly
+	default:
+		b.res.AvsResult = AvsResultFail
+	}
+	return b
+}
+
+// Response returns the response without validating it.
+func (b *ResponseBuilder) Response() *Response {
+	res := b.res
+	if b.res.Balance != nil {
+		balance := *b.res.Balance
+		res.Balance = &balance
+	}
+	return &res
+}
+
+// For returns the response to req, with the token of req unless one was set,
+// and validates it with Response.Validate.
+func (b *ResponseBuilder) For(req *Request) (*Response, error) {
+	if b.decline && b.res.Result.Approved() {
+		return nil, invalid("%s is not a decline reason", b.res.Result)
+	}
+	res := b.Response()
+	if res.Token == "" {
+		res.Token = req.Token
+	}
+	if err := res.Validate(req); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
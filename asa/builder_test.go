@@ -0,0 +1,36 @@
+package asa
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestResponseBuilder(t *testing.T) {
+	req := &Request{Token: "tx", Status: StatusAuthorization, Amount: 1000, Avs: Avs{Address: "1 Main St", Zipcode: "10001"}}
+	req.Pos.Terminal.PartialApprovalCapable = true
+
+	res, err := Approve().WithAvs(true, false).WithApprovedAmount(400).For(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(res)
+	if string(data) != `{"token":"tx","result":"APPROVED","avs_result":"MATCH_ADDRESS_ONLY","approved_amount":400}` {
+		t.Fatalf("unexpected response %s", data)
+	}
+
+	res, err = Decline(DeclineReasonInsufficientFunds).WithBalance(100, 50).For(req)
+	if err != nil || res.Balance.Available != 50 || res.Result != DeclineReasonInsufficientFunds {
+		t.Fatalf("unexpected response %+v %v", res, err)
+	}
+
+	if _, err := Decline(DeclineReasonCardPaused).WithToken("other").For(req); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected a mismatched token to be invalid, got %v", err)
+	}
+	if _, err := Decline(ResultApproved).For(req); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected declining with an approval to be invalid, got %v", err)
+	}
+	if res := Decline("NOPE").WithToken("tx").Response(); res.Result != "NOPE" {
+		t.Fatalf("expected Response not to validate, got %+v", res)
+	}
+}
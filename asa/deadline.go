@@ -0,0 +1,89 @@
+package asa
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DeadlineMargin is the time that WithDeadlineFallback keeps before the deadline
+// of a decision to send the fallback response.
+const DeadlineMargin = 50 * time.Millisecond
+
+// Timeout describes a decision that did not complete before its deadline.
+type Timeout struct {
+	Request  *Request
+	Fallback Result
+	// The time the decision function was given.
+	Budget time.Duration
+	At     time.Time
+}
+
+// WithDeadlineFallback wraps decide so that it returns fallback, rather than an
+// error, when decide has not returned shortly before the deadline of its
+// context, which the Handler sets to its Timeout. Contexts without a deadline
+// are given DefaultTimeout. Every timeout is passed to record, which may be
+// nil, for example TimeoutLog.Record.
+func WithDeadlineFallback(fallback Result, decide DecisionFunc, record func(Timeout)) DecisionFunc {
+	return func(ctx context.Context, req *Request) (*Response, error) {
+		start := time.Now()
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			deadline = start.Add(DefaultTimeout)
+		}
+		deadline = deadline.Add(-DeadlineMargin)
+		budgetCtx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+
+		res, err := run(budgetCtx, decide, req)
+		if !errors.Is(err, ErrTimeout) || ctx.Err() != nil {
+			return res, err
+		}
+		if record != nil {
+			record(Timeout{Request: req, Fallback: fallback, Budget: deadline.Sub(start), At: time.Now()})
+		}
+		return &Response{Token: req.Token, Result: fallback}, nil
+	}
+}
+
+// TimeoutLog keeps the most recent timeouts of decision functions, for example
+// to find the merchants or rules that are slow to decide.
+type TimeoutLog struct {
+	// The number of timeouts kept. Defaults to 100.
+	Size int
+
+	mu       sync.Mutex
+	timeouts []Timeout
+	total    int
+}
+
+// Record adds a timeout to the log, dropping the oldest one if it is full.
+func (l *TimeoutLog) Record(t Timeout) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	size := l.Size
+	if size <= 0 {
+		size = 100
+	}
+	l.total += 1
+	l.timeouts = append(l.timeouts, t)
+	if len(l.timeouts) > size {
+		l.timeouts = append(l.timeouts[:0:0], l.timeouts[len(l.timeouts)-size:]...)
+	}
+}
+
+// Timeouts returns the timeouts in the log, oldest first.
+func (l *TimeoutLog) Timeouts() []Timeout {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Timeout(nil), l.timeouts...)
+}
+
+// Total returns the number of timeouts recorded, including those that have
+// been dropped from the log.
+func (l *TimeoutLog) Total() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.total
+}
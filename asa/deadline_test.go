@@ -0,0 +1,56 @@
+package asa
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWithDeadlineFallback(t *testing.T) {
+	log := &TimeoutLog{Size: 1}
+	slow := func(ctx context.Context, req *Request) (*Response, error) {
+		if req.Amount > 100 {
+			time.Sleep(500 * time.Millisecond)
+		}
+		return &Response{Result: ResultApproved}, nil
+	}
+	decide := WithDeadlineFallback(DeclineReasonVelocityExceeded, slow, log.Record)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	res, err := decide(ctx, &Request{Token: "fast", Amount: 1})
+	if err != nil || res.Result != ResultApproved {
+		t.Fatalf("expected the decision, got %+v %v", res, err)
+	}
+	for _, token := range []string{"slow-1", "slow-2"} {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		res, err = decide(ctx, &Request{Token: token, Amount: 1000})
+		cancel()
+		if err != nil || res.Result != DeclineReasonVelocityExceeded || res.Token != token {
+			t.Fatalf("expected the fallback, got %+v %v", res, err)
+		}
+	}
+
+	timeouts := log.Timeouts()
+	if log.Total() != 2 || len(timeouts) != 1 || timeouts[0].Request.Token != "slow-2" {
+		t.Fatalf("unexpected timeouts %d %+v", log.Total(), timeouts)
+	}
+	if timeouts[0].Budget <= 0 || timeouts[0].Budget > 100*time.Millisecond-DeadlineMargin {
+		t.Fatalf("unexpected budget %s", timeouts[0].Budget)
+	}
+}
+
+func TestHandlerWithDeadlineFallback(t *testing.T) {
+	log := &TimeoutLog{}
+	var reported error
+	h := NewHandler(secret, DeclineReasonCardClosed, WithDeadlineFallback(DeclineReasonInsufficientFunds, func(ctx context.Context, req *Request) (*Response, error) {
+		time.Sleep(300 * time.Millisecond)
+		return &Response{Result: ResultApproved}, nil
+	}, log.Record))
+	h.Timeout = 100 * time.Millisecond
+	h.OnError = func(req *Request, err error) { reported = err }
+	_, res := serve(t, h, payload, sign([]byte(payload), secret))
+	if res == nil || res.Result != DeclineReasonInsufficientFunds || reported != nil || log.Total() != 1 {
+		t.Fatalf("expected the deadline fallback, got %+v %v %d", res, reported, log.Total())
+	}
+}
@@ -0,0 +1,260 @@
+package asa
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// The header that carries the HMAC signature of an ASA request.
+const SignatureHeader = "X-Lithic-Hmac"
+
+// DefaultTimeout is the time a decision function is given by default, which
+// leaves room within the response time budget of the card networks.
+const DefaultTimeout = 2 * time.Second
+
+// ErrInvalidSignature is returned by VerifySignature for requests that were not
+// signed with the ASA secret.
+var ErrInvalidSignature = errors.New("asa: invalid signature")
+
+// ErrTimeout is reported to Handler.OnError when a decision function did not
+// return within the timeout.
+var ErrTimeout = errors.New("asa: decision timed out")
+
+// DecisionFunc decides on an ASA request. The context is cancelled once the
+// handler's timeout expires.
+type DecisionFunc func(ctx context.Context, req *Request) (*Response, error)
+
+// VerifySignature validates that an ASA request body was signed by Lithic with
+// secret, the ASA HMAC secret returned by AuthStreamEnrollmentService.GetSecret.
+func VerifySignature(payload []byte, headers http.Header, secret string) error {
+	signature, err := base64.StdEncoding.DecodeString(headers.Get(SignatureHeader))
+	if err != nil || len(signature) == 0 {
+		return fmt.Errorf("%w: missing or malformed %s header", ErrInvalidSignature, SignatureHeader)
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Handler is an http.Handler that responds to ASA requests. It verifies the
+// signature of every request, decodes it and responds with the decision of
+// Decide, or with Fallback if Decide fails, does not return within Timeout, or
+// returns a response that does not pass Response.Validate.
+type Handler struct {
+	// ASA HMAC secrets that requests may be signed with. Several secrets can be
+	// given while a secret is being rotated. Requests are not verified if there
+	// are none.
+	Secrets []string
+	Decide  DecisionFunc
+	// The time Decide is given to return. Defaults to DefaultTimeout.
+	Timeout time.Duration
+	// The result that is returned when Decide fails or times out. If it is
+	// empty, or not a valid response to the request, such as AVS_INVALID for a
+	// request without AVS data, the handler responds with a 503 instead, and the
+	// authorization is decided by the program's stand-in configuration.
+	Fallback Result
+	// Called when a request is rejected or the fallback is used.
+	OnError func(req *Request, err error)
+	// If Audit is not nil, it receives a record of every decision.
+	Audit AuditSink
+	// Shadow is an optional decision function, such as a new version of the
+	// authorization rules, that is run on every request alongside Decide. Its
+	// decisions are never sent, but reported to OnShadow together with the
+	// response that was sent. Shadow is only run if OnShadow is set.
+	Shadow   DecisionFunc
+	OnShadow func(record ShadowRecord)
+	// Advice messages are passed to OnAdvice rather than Decide, and are
+	// acknowledged with an approval.
+	OnAdvice AdviceFunc
+}
+
+func NewHandler(secret string, fallback Result, decide DecisionFunc) *Handler {
+	return &Handler{Secrets: []string{secret}, Fallback: fallback, Decide: decide}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.reject(w, nil, http.StatusBadRequest, err)
+		return
+	}
+	if err := h.verify(payload, r.Header); err != nil {
+		h.reject(w, nil, http.StatusUnauthorized, err)
+		return
+	}
+	req := &Request{}
+	if err := json.Unmarshal(payload, req); err != nil {
+		h.reject(w, nil, http.StatusBadRequest, fmt.Errorf("asa: failed to parse request: %w", err))
+		return
+	}
+
+	if req.Status.IsAdvice() {
+		h.advise(r.Context(), w, req, payload, start)
+		return
+	}
+
+	// The decision function may modify req, so the audit record is given a copy.
+	snapshot := *req
+	var sent chan *Response
+	if h.Shadow != nil && h.OnShadow != nil {
+		sent = make(chan *Response, 1)
+		go h.shadow(snapshot, payload, sent)
+	}
+	hits := &ruleHits{}
+	res, err := h.decide(context.WithValue(r.Context(), ruleHitsKey{}, hits), req)
+	if err == nil {
+		if res.Token == "" {
+			res.Token = req.Token
+		}
+		err = res.Validate(req)
+	}
+	outcome := OutcomeDecided
+	if err != nil {
+		h.report(req, err)
+		res, outcome = h.fallback(&snapshot), OutcomeFallback
+		if res == nil {
+			outcome = OutcomeStandIn
+		}
+	}
+	respond(w, res)
+	if sent != nil {
+		sent <- res
+	}
+
+	if h.Audit != nil {
+		record := Record{Request: snapshot, Payload: payload, Response: res, Outcome: outcome, RuleHits: hits.list(), Latency: time.Since(start), At: start}
+		if err != nil {
+			record.Error = err.Error()
+		}
+		h.Audit.Audit(r.Context(), record)
+	}
+}
+
+// fallback returns the fallback response to req, or nil if there is no fallback
+// or it is not a valid response to req.
+func (h *Handler) fallback(req *Request) *Response {
+	if h.Fallback == "" {
+		return nil
+	}
+	res := &Response{Token: req.Token, Result: h.Fallback}
+	if err := res.Validate(req); err != nil {
+		h.report(req, fmt.Errorf("asa: cannot fall back: %w", err))
+		return nil
+	}
+	return res
+}
+
+func (h *Handler) advise(ctx context.Context, w http.ResponseWriter, req *Request, payload []byte, start time.Time) {
+	snapshot := *req
+	if h.OnAdvice != nil {
+		h.OnAdvice(ctx, &Advice{*req})
+	}
+	res := &Response{Token: req.Token, Result: ResultApproved}
+	respond(w, res)
+	if h.Audit != nil {
+		h.Audit.Audit(ctx, Record{Request: snapshot, Payload: payload, Response: res, Outcome: OutcomeAdvice, Latency: time.Since(start), At: start})
+	}
+}
+
+// respond sends res, or a 503 if it is nil, and flushes it, so that the
+// response is complete before the audit sink is called. net/http would
+// otherwise hold back the buffered response until ServeHTTP returns.
+func respond(w http.ResponseWriter, res *Response) {
+	if res == nil {
+		w.Header().Set("Content-Length", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		data, _ := json.Marshal(res)
+		data = append(data, '\n')
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Write(data)
+	}
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (h *Handler) verify(payload []byte, headers http.Header) error {
+	if len(h.Secrets) == 0 {
+		return nil
+	}
+	var err error
+	for _, secret := range h.Secrets {
+		if err = VerifySignature(payload, headers, secret); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// decide runs Decide within the timeout.
+func (h *Handler) decide(ctx context.Context, req *Request) (*Response, error) {
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return run(ctx, h.Decide, req)
+}
+
+// run calls decide and waits for its decision until ctx is done. A decision
+// that arrives later is discarded.
+func run(ctx context.Context, decide DecisionFunc, req *Request) (*Response, error) {
+	type decision struct {
+		res *Response
+		err error
+	}
+	done := make(chan decision, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				done <- decision{nil, fmt.Errorf("asa: decision panicked: %v", p)}
+			}
+		}()
+		res, err := decide(ctx, req)
+		if err == nil && res == nil {
+			err = errors.New("asa: decision function returned no response")
+		}
+		done <- decision{res, err}
+	}()
+
+	select {
+	case d := <-done:
+		return d.res, d.err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, ErrTimeout
+		}
+		return nil, ctx.Err()
+	}
+}
+
+func (h *Handler) reject(w http.ResponseWriter, req *Request, status int, err error) {
+	h.report(req, err)
+	w.WriteHeader(status)
+}
+
+func (h *Handler) report(req *Request, err error) {
+	if h.OnError != nil {
+		h.OnError(req, err)
+	}
+}
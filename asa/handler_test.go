@@ -0,0 +1,145 @@
+package asa
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const secret = "asa-secret"
+
+func sign(payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func serve(t *testing.T, h http.Handler, payload string, signature string) (*httptest.ResponseRecorder, *Response) {
+	t.Helper()
+	r := httptest.NewRequest("POST", "/asa", bytes.NewBufferString(payload))
+	r.Header.Set(SignatureHeader, signature)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		return w, nil
+	}
+	res := &Response{}
+	if err := json.Unmarshal(w.Body.Bytes(), res); err != nil {
+		t.Fatal(err)
+	}
+	return w, res
+}
+
+const payload = `{"token":"tx","status":"AUTHORIZATION","amount":1500,"network":"VISA","card":{"token":"card","state":"OPEN"},"merchant":{"descriptor":"COFFEE","mcc":"5814"},"pos":{"terminal":{"partial_approval_capable":true}}}`
+
+func TestHandlerDecides(t *testing.T) {
+	var got *Request
+	h := NewHandler(secret, DeclineReasonUnauthorizedMerchant, func(ctx context.Context, req *Request) (*Response, error) {
+		got = req
+		return &Response{Result: DeclineReasonInsufficientFunds}, nil
+	})
+	_, res := serve(t, h, payload, sign([]byte(payload), secret))
+	if res == nil || res.Token != "tx" || res.Result != DeclineReasonInsufficientFunds {
+		t.Fatalf("unexpected response %+v", res)
+	}
+	if got.Amount != 1500 || got.Card.Token != "card" || got.Merchant.Mcc != "5814" || !got.Pos.Terminal.PartialApprovalCapable {
+		t.Fatalf("unexpected request %+v", got)
+	}
+}
+
+func TestHandlerRejectsInvalidSignature(t *testing.T) {
+	var reported error
+	h := NewHandler(secret, ResultApproved, func(ctx context.Context, req *Request) (*Response, error) {
+		t.Fatal("decision should not be called")
+		return nil, nil
+	})
+	h.OnError = func(req *Request, err error) { reported = err }
+	w, _ := serve(t, h, payload, sign([]byte(payload), "other"))
+	if w.Code != http.StatusUnauthorized || !errors.Is(reported, ErrInvalidSignature) {
+		t.Fatalf("expected the request to be rejected, got %d %v", w.Code, reported)
+	}
+
+	h.Secrets = append(h.Secrets, "other")
+	h.Decide = func(ctx context.Context, req *Request) (*Response, error) {
+		return &Response{Result: ResultApproved}, nil
+	}
+	if _, res := serve(t, h, payload, sign([]byte(payload), "other")); res == nil {
+		t.Fatal("expected the rotated secret to be accepted")
+	}
+}
+
+func TestHandlerFallsBack(t *testing.T) {
+	var reported []error
+	h := NewHandler(secret, DeclineReasonVelocityExceeded, func(ctx context.Context, req *Request) (*Response, error) {
+		time.Sleep(200 * time.Millisecond)
+		return &Response{Result: ResultApproved}, nil
+	})
+	h.Timeout = 10 * time.Millisecond
+	h.OnError = func(req *Request, err error) { reported = append(reported, err) }
+	_, res := serve(t, h, payload, sign([]byte(payload), secret))
+	if res == nil || res.Result != DeclineReasonVelocityExceeded || res.Token != "tx" {
+		t.Fatalf("expected the fallback on timeout, got %+v", res)
+	}
+
+	h.Decide = func(ctx context.Context, req *Request) (*Response, error) {
+		panic("boom")
+	}
+	if _, res := serve(t, h, payload, sign([]byte(payload), secret)); res == nil || res.Result != DeclineReasonVelocityExceeded {
+		t.Fatalf("expected the fallback on panic, got %+v", res)
+	}
+	if len(reported) != 2 || !errors.Is(reported[0], ErrTimeout) {
+		t.Fatalf("unexpected errors %v", reported)
+	}
+
+	h.Fallback = ""
+	if w, _ := serve(t, h, payload, sign([]byte(payload), secret)); w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected a 503 without a fallback, got %d", w.Code)
+	}
+}
+
+func TestHandlerFallsBackOnInvalidResponse(t *testing.T) {
+	var reported error
+	h := NewHandler(secret, DeclineReasonCardPaused, func(ctx context.Context, req *Request) (*Response, error) {
+		return &Response{Result: ResultApproved, ApprovedAmount: 5000}, nil
+	})
+	h.OnError = func(req *Request, err error) { reported = err }
+	_, res := serve(t, h, payload, sign([]byte(payload), secret))
+	if res == nil || res.Result != DeclineReasonCardPaused || !errors.Is(reported, ErrInvalidResponse) {
+		t.Fatalf("expected the fallback for an invalid response, got %+v %v", res, reported)
+	}
+}
+
+func TestHandlerStandsInForInvalidFallback(t *testing.T) {
+	var reported []error
+	fail := func(ctx context.Context, req *Request) (*Response, error) {
+		return nil, errors.New("unavailable")
+	}
+	for _, c := range []struct {
+		fallback Result
+		payload  string
+	}{
+		// The request has no AVS data.
+		{DeclineReasonAvsInvalid, payload},
+		{DeclineReasonDriverNumberInvalid, `{"token":"tx","status":"AUTHORIZATION","amount":1500,"network":"MAESTRO"}`},
+		// A balance inquiry must be approved with a balance.
+		{ResultApproved, `{"token":"tx","status":"BALANCE_INQUIRY","network":"VISA"}`},
+	} {
+		reported = nil
+		h := NewHandler(secret, c.fallback, fail)
+		h.OnError = func(req *Request, err error) { reported = append(reported, err) }
+		if w, res := serve(t, h, c.payload, sign([]byte(c.payload), secret)); w.Code != http.StatusServiceUnavailable {
+			t.Fatalf("expected a 503 for the invalid fallback %s, got %d %+v", c.fallback, w.Code, res)
+		}
+		if len(reported) != 2 || !errors.Is(reported[1], ErrInvalidResponse) {
+			t.Fatalf("expected the invalid fallback %s to be reported, got %v", c.fallback, reported)
+		}
+	}
+}
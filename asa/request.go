@@ -0,0 +1,121 @@
+// Package asa implements responders for Auth Stream Access (ASA), which lets a
+// program approve or decline every authorization on its cards through an HTTP
+// endpoint that Lithic calls during the authorization.
+//
+// A responder is an http.Handler built around a decision function:
+//
+//	handler := asa.NewHandler(secret, asa.DeclineReasonUnauthorizedMerchant, func(ctx context.Context, req *asa.Request) (*asa.Response, error) {
+//		if req.Amount > 100000 {
+//			return asa.Decline(asa.DeclineReasonVelocityExceeded).For(req)
+//		}
+//		return asa.Approve().For(req)
+//	})
+//	http.Handle("/asa", handler)
+package asa
+
+import (
+	"time"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// Status is the kind of authorization that an ASA request is made for.
+type Status string
+
+const (
+	StatusAuthorization                Status = "AUTHORIZATION"
+	StatusCreditAuthorization          Status = "CREDIT_AUTHORIZATION"
+	StatusFinancialAuthorization       Status = "FINANCIAL_AUTHORIZATION"
+	StatusFinancialCreditAuthorization Status = "FINANCIAL_CREDIT_AUTHORIZATION"
+	StatusBalanceInquiry               Status = "BALANCE_INQUIRY"
+	// Advice statuses, see Advice.
+	StatusAuthorizationAdvice       Status = "AUTHORIZATION_ADVICE"
+	StatusCreditAuthorizationAdvice Status = "CREDIT_AUTHORIZATION_ADVICE"
+	StatusFinancialAdvice           Status = "FINANCIAL_ADVICE"
+	StatusFinancialCreditAdvice     Status = "FINANCIAL_CREDIT_ADVICE"
+)
+
+// Request is an authorization that Lithic asks the responder to decide on. It
+// follows the Lithic transaction schema, with additional fields for
+// decisioning.
+type Request struct {
+	// Globally unique identifier of the transaction, which the response must
+	// echo.
+	Token  string `json:"token"`
+	Status Status `json:"status"`
+	// Amount (in cents) of the authorization, including any acquirer fee.
+	Amount int64 `json:"amount"`
+	// Amount (in cents) authorized so far, for incremental authorizations.
+	AuthorizationAmount int64 `json:"authorization_amount"`
+	SettledAmount       int64 `json:"settled_amount"`
+	// Fee (in cents) assessed by the merchant and paid for by the cardholder.
+	AcquirerFee int64 `json:"acquirer_fee"`
+	// Amount (in cents) of cash back requested by the cardholder.
+	CashAmount int64 `json:"cash_amount"`
+	// Amount in the merchant's currency (smallest unit).
+	MerchantAmount int64 `json:"merchant_amount"`
+	// 3-digit alphabetic ISO 4217 code for the merchant's currency.
+	MerchantCurrency string `json:"merchant_currency"`
+	// Rate used to convert the merchant amount into the amount.
+	ConversionRate float64   `json:"conversion_rate"`
+	Created        time.Time `json:"created"`
+	// Card network of the authorization.
+	Network                  responses.TransactionNetwork        `json:"network"`
+	Card                     Card                                `json:"card"`
+	Merchant                 responses.Merchant                  `json:"merchant"`
+	Pos                      Pos                                 `json:"pos"`
+	Avs                      Avs                                 `json:"avs"`
+	CardholderAuthentication *responses.CardholderAuthentication `json:"cardholder_authentication"`
+	// Earlier events of the transaction, for incremental authorizations.
+	Events []responses.TransactionEvent `json:"events"`
+}
+
+// Card is the card that an ASA request is made for.
+type Card struct {
+	Token    string `json:"token"`
+	LastFour string `json:"last_four"`
+	Memo     string `json:"memo"`
+	Hostname string `json:"hostname"`
+	// Spend limit (in cents) of the card, where 0 means no limit.
+	SpendLimit         int64                        `json:"spend_limit"`
+	SpendLimitDuration responses.SpendLimitDuration `json:"spend_limit_duration"`
+	State              responses.CardState          `json:"state"`
+	Type               responses.CardType           `json:"type"`
+}
+
+// Pos describes the point of sale of an authorization.
+type Pos struct {
+	EntryMode PosEntryMode `json:"entry_mode"`
+	Terminal  PosTerminal  `json:"terminal"`
+}
+
+type PosEntryMode struct {
+	// How the card was presented, such as "PRESENT" or "NOT_PRESENT".
+	Card string `json:"card"`
+	// How the cardholder was present, such as "PRESENT" or "ECOMMERCE".
+	Cardholder string `json:"cardholder"`
+	// How the card number was entered, such as "CHIP" or "KEY_ENTERED".
+	Pan        string `json:"pan"`
+	PinEntered bool   `json:"pin_entered"`
+}
+
+type PosTerminal struct {
+	Attended             bool `json:"attended"`
+	CardRetentionCapable bool `json:"card_retention_capable"`
+	OnPremise            bool `json:"on_premise"`
+	// Who operates the terminal, such as "CARDHOLDER" or "ADMINISTRATIVE".
+	Operator string `json:"operator"`
+	// Whether the terminal can accept an approval for less than the requested
+	// amount.
+	PartialApprovalCapable bool `json:"partial_approval_capable"`
+	// Whether the terminal can accept PINs, such as "CAPABLE" or "NOT_CAPABLE".
+	PinCapability string `json:"pin_capability"`
+	// Type of the terminal, such as "POS" or "ATM".
+	Type string `json:"type"`
+}
+
+// Avs holds the address verification data sent by the merchant, if any.
+type Avs struct {
+	Address string `json:"address"`
+	Zipcode string `json:"zipcode"`
+}
@@ -0,0 +1,56 @@
+package asa
+
+// Result is the decision of an ASA response: ResultApproved or one of the
+// decline reasons.
+type Result string
+
+const (
+	ResultApproved Result = "APPROVED"
+
+	DeclineReasonAccountInactive      Result = "ACCOUNT_INACTIVE"
+	DeclineReasonAvsInvalid           Result = "AVS_INVALID"
+	DeclineReasonCardClosed           Result = "CARD_CLOSED"
+	DeclineReasonCardPaused           Result = "CARD_PAUSED"
+	DeclineReasonInsufficientFunds    Result = "INSUFFICIENT_FUNDS"
+	DeclineReasonUnauthorizedMerchant Result = "UNAUTHORIZED_MERCHANT"
+	DeclineReasonVelocityExceeded     Result = "VELOCITY_EXCEEDED"
+	DeclineReasonDriverNumberInvalid  Result = "DRIVER_NUMBER_INVALID"
+	DeclineReasonVehicleNumberInvalid Result = "VEHICLE_NUMBER_INVALID"
+)
+
+// Approved reports whether the result approves the authorization.
+func (r Result) Approved() bool {
+	return r == ResultApproved
+}
+
+// AvsResult is the outcome of the address verification of an authorization.
+type AvsResult string
+
+const (
+	AvsResultMatch            AvsResult = "MATCH"
+	AvsResultMatchAddressOnly AvsResult = "MATCH_ADDRESS_ONLY"
+	AvsResultMatchZipOnly     AvsResult = "MATCH_ZIP_ONLY"
+	AvsResultFail             AvsResult = "FAIL"
+)
+
+// Response is the decision of the responder on an ASA request.
+type Response struct {
+	// Token of the transaction that the response is for. The handler fills it in
+	// from the request if it is empty.
+	Token  string `json:"token"`
+	Result Result `json:"result"`
+	// Outcome of the address verification, if the responder performed it.
+	AvsResult AvsResult `json:"avs_result,omitempty"`
+	// Amount (in cents) approved, for a partial approval of less than the
+	// requested amount.
+	ApprovedAmount int64 `json:"approved_amount,omitempty"`
+	// Balance of the account, which is returned to balance inquiries and to
+	// terminals that display it.
+	Balance *Balance `json:"balance,omitempty"`
+}
+
+// Balance is the balance reported in an ASA response, in cents.
+type Balance struct {
+	Amount    int64 `json:"amount"`
+	Available int64 `json:"available"`
+}
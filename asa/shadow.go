@@ -0,0 +1,60 @@
+package asa
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ShadowRecord compares the decision of a Handler's Shadow function with the
+// response that was sent for the same request.
+type ShadowRecord struct {
+	// The request as it was received. It is encoded as Payload.
+	Request Request         `json:"-"`
+	Payload json.RawMessage `json:"request"`
+	// The response that was sent, which is nil if the request was left to
+	// stand-in processing.
+	Response *Response `json:"response"`
+	// The decision of the shadow function, which is nil if it failed, timed out
+	// or returned an invalid response.
+	Shadow *Response `json:"shadow"`
+	Error  string    `json:"error,omitempty"`
+	// The rules that the shadow function reported with RecordRuleHit.
+	RuleHits []string      `json:"rule_hits"`
+	Latency  time.Duration `json:"latency_ns"`
+	// Whether the shadow function reached the same result as the response.
+	Agrees bool `json:"agrees"`
+}
+
+// shadow decides on req with h.Shadow, waits for the response that is sent and
+// reports both to h.OnShadow. It runs independently of the request, so that it
+// never delays the response.
+func (h *Handler) shadow(req Request, payload []byte, sent <-chan *Response) {
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	hits := &ruleHits{}
+	shadowReq := req
+	res, err := run(context.WithValue(ctx, ruleHitsKey{}, hits), h.Shadow, &shadowReq)
+	if err == nil {
+		if res.Token == "" {
+			res.Token = req.Token
+		}
+		err = res.Validate(&req)
+	}
+	record := ShadowRecord{Request: req, Payload: payload, RuleHits: hits.list(), Latency: time.Since(start)}
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.Shadow = res
+	}
+
+	record.Response = <-sent
+	record.Agrees = record.Response != nil && record.Shadow != nil && record.Response.Result == record.Shadow.Result
+	h.OnShadow(record)
+}
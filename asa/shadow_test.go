@@ -0,0 +1,74 @@
+package asa
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHandlerShadow(t *testing.T) {
+	records := make(chan ShadowRecord, 1)
+	h := NewHandler(secret, DeclineReasonUnauthorizedMerchant, func(ctx context.Context, req *Request) (*Response, error) {
+		return Approve().For(req)
+	})
+	h.Shadow = func(ctx context.Context, req *Request) (*Response, error) {
+		RecordRuleHit(ctx, "max_amount")
+		return Decline(DeclineReasonVelocityExceeded).For(req)
+	}
+	h.OnShadow = func(record ShadowRecord) { records <- record }
+
+	_, res := serve(t, h, payload, sign([]byte(payload), secret))
+	if res == nil || res.Result != ResultApproved {
+		t.Fatalf("expected the decision of Decide, got %+v", res)
+	}
+	record := <-records
+	if record.Response.Result != ResultApproved || record.Shadow.Result != DeclineReasonVelocityExceeded || record.Agrees {
+		t.Fatalf("unexpected record %+v", record)
+	}
+	if record.Shadow.Token != "tx" || record.Request.Token != "tx" || len(record.RuleHits) != 1 {
+		t.Fatalf("unexpected record %+v", record)
+	}
+}
+
+func TestHandlerShadowDoesNotDelayResponse(t *testing.T) {
+	records := make(chan ShadowRecord, 1)
+	h := NewHandler(secret, DeclineReasonUnauthorizedMerchant, func(ctx context.Context, req *Request) (*Response, error) {
+		return Approve().For(req)
+	})
+	h.Timeout = 50 * time.Millisecond
+	h.Shadow = func(ctx context.Context, req *Request) (*Response, error) {
+		time.Sleep(100 * time.Millisecond)
+		return Approve().For(req)
+	}
+	h.OnShadow = func(record ShadowRecord) { records <- record }
+
+	start := time.Now()
+	serve(t, h, payload, sign([]byte(payload), secret))
+	if elapsed := time.Since(start); elapsed >= 50*time.Millisecond {
+		t.Fatalf("response took %v", elapsed)
+	}
+	record := <-records
+	if record.Shadow != nil || record.Error != ErrTimeout.Error() || record.Agrees {
+		t.Fatalf("expected a timed out shadow, got %+v", record)
+	}
+}
+
+func TestHandlerShadowFailureDoesNotAffectResponse(t *testing.T) {
+	records := make(chan ShadowRecord, 1)
+	h := NewHandler(secret, DeclineReasonUnauthorizedMerchant, func(ctx context.Context, req *Request) (*Response, error) {
+		return Approve().For(req)
+	})
+	h.Shadow = func(ctx context.Context, req *Request) (*Response, error) {
+		panic(errors.New("bug in new rules"))
+	}
+	h.OnShadow = func(record ShadowRecord) { records <- record }
+
+	_, res := serve(t, h, payload, sign([]byte(payload), secret))
+	if res == nil || res.Result != ResultApproved {
+		t.Fatalf("expected the decision of Decide, got %+v", res)
+	}
+	if record := <-records; record.Shadow != nil || record.Error == "" {
+		t.Fatalf("expected a failed shadow, got %+v", record)
+	}
+}
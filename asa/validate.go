@@ -0,0 +1,133 @@
+package asa
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// ErrInvalidResponse is returned, wrapped, for responses that the card network
+// would not accept for a request. Such responses make the network fall back to
+// stand-in processing.
+var ErrInvalidResponse = errors.New("asa: invalid response")
+
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{ErrInvalidResponse}, args...)...)
+}
+
+// Decline reasons that only apply to some networks. Networks that are not listed
+// accept every decline reason.
+var networkDeclineReasons = map[responses.TransactionNetwork]map[Result]bool{
+	// PIN debit networks do not carry address verification or fleet data.
+	responses.TransactionNetworkInterlink: {
+		DeclineReasonAccountInactive:      true,
+		DeclineReasonCardClosed:           true,
+		DeclineReasonCardPaused:           true,
+		DeclineReasonInsufficientFunds:    true,
+		DeclineReasonUnauthorizedMerchant: true,
+		DeclineReasonVelocityExceeded:     true,
+	},
+	responses.TransactionNetworkMaestro: {
+		DeclineReasonAccountInactive:      true,
+		DeclineReasonCardClosed:           true,
+		DeclineReasonCardPaused:           true,
+		DeclineReasonInsufficientFunds:    true,
+		DeclineReasonUnauthorizedMerchant: true,
+		DeclineReasonVelocityExceeded:     true,
+	},
+}
+
+var declineReasons = map[Result]bool{
+	DeclineReasonAccountInactive:      true,
+	DeclineReasonAvsInvalid:           true,
+	DeclineReasonCardClosed:           true,
+	DeclineReasonCardPaused:           true,
+	DeclineReasonInsufficientFunds:    true,
+	DeclineReasonUnauthorizedMerchant: true,
+	DeclineReasonVelocityExceeded:     true,
+	DeclineReasonDriverNumberInvalid:  true,
+	DeclineReasonVehicleNumberInvalid: true,
+}
+
+// Validate checks that the response can be sent for req:
+//
+//   - the result is approved or a decline reason that the request's network
+//     supports, and AVS_INVALID is only used when the merchant sent AVS data;
+//   - partial approvals are only made to terminals that accept them, for
+//     authorizations, and for less than the requested amount;
+//   - approved balance inquiries carry a balance, and balances are not negative.
+func (r *Response) Validate(req *Request) error {
+	if r.Token != "" && req.Token != "" && r.Token != req.Token {
+		return invalid("token %q does not match the request %q", r.Token, req.Token)
+	}
+	if !r.Result.Approved() {
+		if !declineReasons[r.Result] {
+			return invalid("unknown result %q", r.Result)
+		}
+		if allowed, ok := networkDeclineReasons[req.Network]; ok && !allowed[r.Result] {
+			return invalid("%s does not support the decline reason %s", req.Network, r.Result)
+		}
+		if r.Result == DeclineReasonAvsInvalid && req.Avs == (Avs{}) {
+			return invalid("AVS_INVALID requires the merchant to send AVS data")
+		}
+		if r.ApprovedAmount != 0 {
+			return invalid("a decline cannot have an approved amount")
+		}
+	}
+	if r.ApprovedAmount != 0 {
+		if req.Status != StatusAuthorization && req.Status != StatusFinancialAuthorization {
+			return invalid("partial approvals are not supported for %s", req.Status)
+		}
+		if !req.Pos.Terminal.PartialApprovalCapable {
+			return invalid("the terminal does not accept partial approvals")
+		}
+		if r.ApprovedAmount < 0 || r.ApprovedAmount >= req.Amount {
+			return invalid("approved amount %d must be between 0 and the requested amount %d", r.ApprovedAmount, req.Amount)
+		}
+	}
+	if r.AvsResult != "" && req.Avs == (Avs{}) {
+		return invalid("an AVS result requires the merchant to send AVS data")
+	}
+	if r.Balance != nil && (r.Balance.Amount < 0 || r.Balance.Available < 0) {
+		return invalid("balance amounts cannot be negative")
+	}
+	if req.Status == StatusBalanceInquiry && r.Result.Approved() && r.Balance == nil {
+		return invalid("an approved balance inquiry requires a balance")
+	}
+	return nil
+}
+
+func build(req *Request, res *Response) (*Response, error) {
+	res.Token = req.Token
+	if err := res.Validate(req); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// NewApproval approves req in full.
+func NewApproval(req *Request) (*Response, error) {
+	return build(req, &Response{Result: ResultApproved})
+}
+
+// NewPartialApproval approves amount (in cents) of req, which must be less than
+// the requested amount, for terminals that accept partial approvals.
+func NewPartialApproval(req *Request, amount int64) (*Response, error) {
+	return build(req, &Response{Result: ResultApproved, ApprovedAmount: amount})
+}
+
+// NewBalanceApproval approves req and reports the balance of the account, as
+// balance inquiries require.
+func NewBalanceApproval(req *Request, balance Balance) (*Response, error) {
+	return build(req, &Response{Result: ResultApproved, Balance: &balance})
+}
+
+// NewDecline declines req with reason, which must be supported by the network
+// of req.
+func NewDecline(req *Request, reason Result) (*Response, error) {
+	if reason.Approved() {
+		return nil, invalid("%s is not a decline reason", reason)
+	}
+	return build(req, &Response{Result: reason})
+}
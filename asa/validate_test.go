@@ -0,0 +1,48 @@
+package asa
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestResponseValidate(t *testing.T) {
+	auth := &Request{Token: "tx", Status: StatusAuthorization, Amount: 1000, Network: responses.TransactionNetworkVisa}
+	partial := *auth
+	partial.Pos.Terminal.PartialApprovalCapable = true
+	pin := *auth
+	pin.Network = responses.TransactionNetworkInterlink
+	withAvs := *auth
+	withAvs.Avs = Avs{Zipcode: "10001"}
+	inquiry := &Request{Token: "tx", Status: StatusBalanceInquiry}
+
+	cases := []struct {
+		name  string
+		build func() (*Response, error)
+		ok    bool
+	}{
+		{"approval", func() (*Response, error) { return NewApproval(auth) }, true},
+		{"decline", func() (*Response, error) { return NewDecline(auth, DeclineReasonInsufficientFunds) }, true},
+		{"decline with approval", func() (*Response, error) { return NewDecline(auth, ResultApproved) }, false},
+		{"unknown reason", func() (*Response, error) { return NewDecline(auth, "NOPE") }, false},
+		{"fleet reason on pin debit", func() (*Response, error) { return NewDecline(&pin, DeclineReasonDriverNumberInvalid) }, false},
+		{"avs decline without avs", func() (*Response, error) { return NewDecline(auth, DeclineReasonAvsInvalid) }, false},
+		{"avs decline", func() (*Response, error) { return NewDecline(&withAvs, DeclineReasonAvsInvalid) }, true},
+		{"partial", func() (*Response, error) { return NewPartialApproval(&partial, 600) }, true},
+		{"partial not capable", func() (*Response, error) { return NewPartialApproval(auth, 600) }, false},
+		{"partial over amount", func() (*Response, error) { return NewPartialApproval(&partial, 1000) }, false},
+		{"inquiry without balance", func() (*Response, error) { return NewApproval(inquiry) }, false},
+		{"inquiry", func() (*Response, error) { return NewBalanceApproval(inquiry, Balance{Amount: 500, Available: 400}) }, true},
+		{"negative balance", func() (*Response, error) { return NewBalanceApproval(inquiry, Balance{Amount: -1}) }, false},
+	}
+	for _, c := range cases {
+		res, err := c.build()
+		if c.ok && (err != nil || res.Token != "tx") {
+			t.Errorf("%s: expected a response, got %v", c.name, err)
+		}
+		if !c.ok && !errors.Is(err, ErrInvalidResponse) {
+			t.Errorf("%s: expected ErrInvalidResponse, got %v", c.name, err)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Package benchmarks measures the serialization hot paths of the SDK. The
+// benchmarks can be run with `go test -bench . -benchmem ./benchmarks`, or
+// programmatically through Run so that results from different SDK versions can
+// be compared side by side.
+package benchmarks
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// A representative transaction with a handful of events, as returned by
+// `GET /transactions/{transaction_token}`.
+var transactionJSON = []byte(`{
+	"acquirer_reference_number": "12345678901234567890123",
+	"amount": 1800,
+	"authorization_amount": 1800,
+	"cardholder_authentication": {
+		"3ds_version": "2",
+		"acquirer_exemption": "NONE",
+		"liability_shift": "3DS_AUTHENTICATED",
+		"verification_attempted": "BIOMETRIC",
+		"verification_result": "SUCCESS"
+	},
+	"merchant_amount": 1800,
+	"merchant_authorization_amount": 1800,
+	"merchant_currency": "USD",
+	"authorization_code": "123456",
+	"card_token": "19c22c47-7a75-43ee-9891-595419830f7e",
+	"created": "2023-05-09T16:01:12Z",
+	"events": [
+		{"amount": 1800, "created": "2023-05-09T16:01:12Z", "result": "APPROVED", "token": "0c2adae9-f535-4505-8c35-421dad9bd0b6", "type": "AUTHORIZATION"},
+		{"amount": 1800, "created": "2023-05-10T09:30:00Z", "result": "APPROVED", "token": "4f3cd5ba-6d27-4f43-8c9e-e2d0d5a4c2e7", "type": "CLEARING"}
+	],
+	"merchant": {
+		"acceptor_id": "333301802529120",
+		"city": "NEW YORK",
+		"country": "USA",
+		"descriptor": "COFFEE SHOP",
+		"mcc": "5814",
+		"state": "NY"
+	},
+	"network": "MASTERCARD",
+	"result": "APPROVED",
+	"settled_amount": 1800,
+	"status": "SETTLED",
+	"token": "c30c2182-1e69-4e0e-b40f-eec0d2c19123"
+}`)
+
+func cardNewParams() *requests.CardNewParams {
+	return &requests.CardNewParams{
+		AccountToken:       fields.F("f8b4f9e5-1b6a-4b86-9a52-6f1b0c2a0f6e"),
+		Type:               fields.F(requests.CardNewParamsTypePhysical),
+		Memo:               fields.F("Travel card"),
+		SpendLimit:         fields.F(int64(50000)),
+		SpendLimitDuration: fields.F(requests.SpendLimitDurationMonthly),
+		State:              fields.F(requests.CardNewParamsStateOpen),
+		ShippingMethod:     fields.F(requests.CardNewParamsShippingMethodStandardWithTracking),
+		ShippingAddress: fields.F(requests.ShippingAddress{
+			FirstName:  fields.F("Janet"),
+			LastName:   fields.F("Yellen"),
+			Address1:   fields.F("1600 Pennsylvania Ave NW"),
+			City:       fields.F("Washington"),
+			State:      fields.F("DC"),
+			PostalCode: fields.F("20500"),
+			Country:    fields.F("USA"),
+		}),
+	}
+}
+
+func transactionListParams() *requests.TransactionListParams {
+	return &requests.TransactionListParams{
+		AccountToken: fields.F("f8b4f9e5-1b6a-4b86-9a52-6f1b0c2a0f6e"),
+		CardToken:    fields.F("19c22c47-7a75-43ee-9891-595419830f7e"),
+		Result:       fields.F(requests.TransactionListParamsResultApproved),
+		Begin:        fields.F(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		End:          fields.F(time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)),
+		Page:         fields.F(int64(3)),
+		PageSize:     fields.F(int64(100)),
+	}
+}
+
+// MarshalCardNewParams benchmarks the JSON serialization of a fully populated
+// CardNewParams.
+func MarshalCardNewParams(b *testing.B) {
+	params := cardNewParams()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := pjson.MarshalRoot(params); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// UnmarshalTransaction benchmarks the JSON deserialization of a Transaction.
+func UnmarshalTransaction(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(transactionJSON)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var transaction responses.Transaction
+		if err := transaction.UnmarshalJSON(transactionJSON); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// MarshalTransactionListParams benchmarks the query string serialization of a
+// fully populated TransactionListParams.
+func MarshalTransactionListParams(b *testing.B) {
+	params := transactionListParams()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = query.Marshal(params).Encode()
+	}
+}
+
+// All of the benchmarks in this package, in the order they are reported.
+var Benchmarks = []struct {
+	Name string
+	F    func(*testing.B)
+}{
+	{"MarshalCardNewParams", MarshalCardNewParams},
+	{"UnmarshalTransaction", UnmarshalTransaction},
+	{"MarshalTransactionListParams", MarshalTransactionListParams},
+}
+
+type BenchmarkResult struct {
+	Name        string
+	N           int
+	NsPerOp     int64
+	AllocsPerOp int64
+	BytesPerOp  int64
+}
+
+// BenchmarkReport holds the results of one run of every benchmark in this
+// package.
+type BenchmarkReport struct {
+	Results []BenchmarkResult
+}
+
+// Run executes every benchmark in this package and collects the results.
+func Run() BenchmarkReport {
+	report := BenchmarkReport{}
+	for _, bench := range Benchmarks {
+		result := testing.Benchmark(bench.F)
+		report.Results = append(report.Results, BenchmarkResult{
+			Name:        bench.Name,
+			N:           result.N,
+			NsPerOp:     result.NsPerOp(),
+			AllocsPerOp: result.AllocsPerOp(),
+			BytesPerOp:  result.AllocedBytesPerOp(),
+		})
+	}
+	return report
+}
+
+// Get returns the result of the benchmark with the given name.
+func (r BenchmarkReport) Get(name string) (BenchmarkResult, bool) {
+	for _, result := range r.Results {
+		if result.Name == name {
+			return result, true
+		}
+	}
+	return BenchmarkResult{}, false
+}
+
+// String formats the report in the same layout as `go test -bench`.
+func (r BenchmarkReport) String() string {
+	sb := strings.Builder{}
+	for _, result := range r.Results {
+		sb.WriteString(fmt.Sprintf("%-32s %10d %12d ns/op %10d B/op %8d allocs/op\n", result.Name, result.N, result.NsPerOp, result.BytesPerOp, result.AllocsPerOp))
+	}
+	return sb.String()
+}
@@ -0,0 +1,21 @@
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func BenchmarkMarshalCardNewParams(b *testing.B)         { MarshalCardNewParams(b) }
+func BenchmarkUnmarshalTransaction(b *testing.B)         { UnmarshalTransaction(b) }
+func BenchmarkMarshalTransactionListParams(b *testing.B) { MarshalTransactionListParams(b) }
+
+func TestTransactionFixture(t *testing.T) {
+	var transaction responses.Transaction
+	if err := transaction.UnmarshalJSON(transactionJSON); err != nil {
+		t.Fatal(err)
+	}
+	if len(transaction.Events) != 2 || transaction.Merchant.Mcc != "5814" {
+		t.Fatalf("fixture did not decode as expected: %+v", transaction)
+	}
+}
@@ -0,0 +1,82 @@
+package lithic
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/redact"
+)
+
+// CanonicalHash returns a stable, hex encoded SHA-256 hash of the serialized form
+// of a request params struct, such as requests.CardUpdateParams. The fields
+// matched by redact.Default() are redacted and object keys are sorted before
+// hashing, so two params that serialize to the same request always produce the
+// same hash and audit systems can record which request was sent without storing
+// its payload.
+//
+// It returns an error if req cannot be serialized, for example update params
+// whose fields do not match their field mask.
+func CanonicalHash(req any) (string, error) {
+	canonical, err := canonicalize(req)
+	if err != nil {
+		return "", fmt.Errorf("lithic: cannot canonicalize %T: %w", req, err)
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func canonicalize(req any) ([]byte, error) {
+	policy := redact.Default()
+	// Params serialize through pointer receivers, so hash values through a copy.
+	if v := reflect.ValueOf(req); v.IsValid() && v.Kind() != reflect.Pointer {
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		req = ptr.Interface()
+	}
+	var out bytes.Buffer
+	if q, ok := req.(query.Queryer); ok {
+		values := q.URLQuery()
+		for key, inner := range values {
+			if policy.Matches(key) {
+				for i, value := range inner {
+					inner[i] = policy.Value(value)
+				}
+			}
+		}
+		// Encode sorts the values by key.
+		out.WriteString("query:")
+		out.WriteString(values.Encode())
+	}
+	if m, ok := req.(json.Marshaler); ok {
+		raw, err := m.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		var body interface{}
+		if err := decoder.Decode(&body); err != nil {
+			return nil, err
+		}
+		// encoding/json sorts map keys, so the re-encoded body is canonical.
+		raw, err = json.Marshal(policy.Decoded(body))
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString("body:")
+		out.Write(raw)
+	}
+	if out.Len() == 0 {
+		raw, err := json.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+		return canonicalize(json.RawMessage(raw))
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,72 @@
+package lithic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/redact"
+	"github.com/lithic-com/lithic-go/requests"
+)
+
+func hash(t *testing.T, req any) string {
+	t.Helper()
+	h, err := CanonicalHash(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestCanonicalHashIsStable(t *testing.T) {
+	a := requests.CardUpdateParams{Memo: fields.F("groceries"), SpendLimit: fields.F(int64(100))}
+	b := requests.CardUpdateParams{SpendLimit: fields.F(int64(100)), Memo: fields.F("groceries")}
+	if hash(t, a) != hash(t, b) {
+		t.Fatal("expected equal params to hash equally")
+	}
+	c := requests.CardUpdateParams{Memo: fields.F("groceries"), SpendLimit: fields.F(int64(200))}
+	if hash(t, a) == hash(t, c) {
+		t.Fatal("expected different params to hash differently")
+	}
+}
+
+func TestCanonicalHashRedactsSensitiveFields(t *testing.T) {
+	a := requests.CardUpdateParams{Memo: fields.F("groceries"), Pin: fields.F("encrypted-pin-1")}
+	b := requests.CardUpdateParams{Memo: fields.F("groceries"), Pin: fields.F("encrypted-pin-2")}
+	if hash(t, a) != hash(t, b) {
+		t.Fatal("expected the pin to be redacted before hashing")
+	}
+}
+
+func TestCanonicalHashRedactsPolicyFields(t *testing.T) {
+	redact.SetDefault(redact.Default().With("memo"))
+	defer redact.SetDefault(nil)
+	a := requests.CardUpdateParams{Memo: fields.F("rent"), SpendLimit: fields.F(int64(100))}
+	b := requests.CardUpdateParams{Memo: fields.F("groceries"), SpendLimit: fields.F(int64(100))}
+	if hash(t, a) != hash(t, b) {
+		t.Fatal("expected the fields of the redaction policy to be redacted before hashing")
+	}
+}
+
+func TestCanonicalHashQuery(t *testing.T) {
+	a := requests.CardListParams{PageSize: fields.F(int64(10)), Page: fields.F(int64(2))}
+	b := requests.CardListParams{Page: fields.F(int64(2)), PageSize: fields.F(int64(10))}
+	if hash(t, a) != hash(t, b) {
+		t.Fatal("expected equal query params to hash equally")
+	}
+}
+
+func TestCanonicalHashPointerAndValue(t *testing.T) {
+	params := requests.CardUpdateParams{Memo: fields.F("groceries")}
+	if hash(t, params) != hash(t, &params) {
+		t.Fatal("expected a value and a pointer to hash equally")
+	}
+}
+
+func TestCanonicalHashFieldMaskMismatch(t *testing.T) {
+	params := requests.CardUpdateParams{Memo: fields.F("groceries"), FieldMask: fields.Mask("state")}
+	var maskErr *fields.FieldMaskError
+	if _, err := CanonicalHash(params); !errors.As(err, &maskErr) {
+		t.Fatalf("expected a field mask error, got %v", err)
+	}
+}
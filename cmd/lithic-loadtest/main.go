@@ -0,0 +1,265 @@
+// Command lithic-loadtest drives a configurable mix of card creations, card
+// reads, and simulated authorizations against the Lithic sandbox at a target
+// rate, and reports latency histograms per operation. It is meant to validate
+// rate-limit budgets and client tuning before launch.
+//
+//	LITHIC_API_KEY=... go run ./cmd/lithic-loadtest -qps 20 -duration 1m -mix create=1,get=8,auth=1
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+)
+
+type operation func(ctx context.Context) error
+
+type weighted struct {
+	name   string
+	weight int
+	run    operation
+}
+
+// Upper bounds of the latency histogram buckets.
+var buckets = []time.Duration{
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+type stats struct {
+	mu        sync.Mutex
+	latencies map[string][]time.Duration
+	errors    map[string]int
+}
+
+func (s *stats) record(name string, latency time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latencies[name] = append(s.latencies[name], latency)
+	if err != nil {
+		s.errors[name] += 1
+	}
+}
+
+func (s *stats) print(elapsed time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, 0, len(s.latencies))
+	for name := range s.latencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		latencies := s.latencies[name]
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		fmt.Printf("\n%s: %d requests (%.1f/s), %d errors\n", name, len(latencies), float64(len(latencies))/elapsed.Seconds(), s.errors[name])
+		fmt.Printf("  p50=%s p90=%s p99=%s max=%s\n", percentile(latencies, 0.5), percentile(latencies, 0.9), percentile(latencies, 0.99), latencies[len(latencies)-1])
+
+		counts := make([]int, len(buckets)+1)
+		for _, latency := range latencies {
+			i := sort.Search(len(buckets), func(i int) bool { return latency <= buckets[i] })
+			counts[i] += 1
+		}
+		for i, count := range counts {
+			label := "+Inf"
+			if i < len(buckets) {
+				label = buckets[i].String()
+			}
+			bar := strings.Repeat("#", count*50/len(latencies))
+			fmt.Printf("  <= %-8s %6d %s\n", label, count, bar)
+		}
+	}
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	return sorted[int(float64(len(sorted)-1)*p)]
+}
+
+// The names of the operations that the mix can weight.
+var operations = []string{"create", "get", "auth"}
+
+func parseMix(mix string) (map[string]int, error) {
+	weights := map[string]int{}
+	for _, part := range strings.Split(mix, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid mix entry %q, expected name=weight", part)
+		}
+		known := false
+		for _, op := range operations {
+			known = known || op == name
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown operation %q in mix, expected one of %s", name, strings.Join(operations, ", "))
+		}
+		weight, err := strconv.Atoi(value)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid weight for %q", name)
+		}
+		weights[name] = weight
+	}
+	return weights, nil
+}
+
+// usageError reports an invalid flag along with the usage of the command, and
+// exits.
+func usageError(msg string) {
+	fmt.Fprintln(os.Stderr, msg)
+	flag.Usage()
+	os.Exit(2)
+}
+
+func main() {
+	qps := flag.Float64("qps", 10, "target requests per second")
+	duration := flag.Duration("duration", 30*time.Second, "how long to run the test for")
+	workers := flag.Int("workers", 16, "maximum number of requests in flight")
+	mix := flag.String("mix", "create=1,get=8,auth=1", "relative weights of the create, get and auth operations")
+	baseURL := flag.String("base-url", "", "override the sandbox base URL")
+	flag.Parse()
+
+	interval := time.Duration(float64(time.Second) / *qps)
+	if *qps <= 0 || interval <= 0 {
+		usageError(fmt.Sprintf("invalid -qps %v, expected a positive rate of at most %d", *qps, int(time.Second)))
+	}
+	if *duration <= 0 {
+		usageError(fmt.Sprintf("invalid -duration %s, expected a positive duration", *duration))
+	}
+	if *workers <= 0 {
+		usageError(fmt.Sprintf("invalid -workers %d, expected at least 1", *workers))
+	}
+	weights, err := parseMix(*mix)
+	if err != nil {
+		usageError(err.Error())
+	}
+
+	opts := []options.RequestOption{options.WithEnvironmentSandbox(), options.WithMaxRetries(0)}
+	if *baseURL != "" {
+		opts = append(opts, options.WithBaseURL(*baseURL))
+	}
+	client := lithic.NewLithic(opts...)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	// A card is needed up front so that reads and simulated authorizations have
+	// something to target.
+	card, err := client.Cards.New(ctx, &requests.CardNewParams{
+		Type: fields.F(requests.CardNewParamsTypeVirtual),
+		Memo: fields.F("lithic-loadtest"),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create the seed card: %s\n", err)
+		os.Exit(1)
+	}
+
+	ops := []weighted{
+		{"create", weights["create"], func(ctx context.Context) error {
+			_, err := client.Cards.New(ctx, &requests.CardNewParams{
+				Type: fields.F(requests.CardNewParamsTypeVirtual),
+				Memo: fields.F("lithic-loadtest"),
+			})
+			return err
+		}},
+		{"get", weights["get"], func(ctx context.Context) error {
+			_, err := client.Cards.Get(ctx, card.Token)
+			return err
+		}},
+		{"auth", weights["auth"], func(ctx context.Context) error {
+			_, err := client.Transactions.SimulateAuthorization(ctx, &requests.TransactionSimulateAuthorizationParams{
+				Amount:     fields.F(int64(100)),
+				Descriptor: fields.F("LOADTEST"),
+				Pan:        fields.F(card.Pan),
+			})
+			return err
+		}},
+	}
+	total := 0
+	for _, op := range ops {
+		total += op.weight
+	}
+	if total == 0 {
+		usageError("at least one operation needs a positive weight")
+	}
+	pick := func() weighted {
+		n := rand.Intn(total)
+		for _, op := range ops {
+			if n < op.weight {
+				return op
+			}
+			n -= op.weight
+		}
+		return ops[len(ops)-1]
+	}
+
+	results := &stats{latencies: map[string][]time.Duration{}, errors: map[string]int{}}
+	jobs := make(chan weighted)
+	wg := sync.WaitGroup{}
+	for i := 0; i < *workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for op := range jobs {
+				start := time.Now()
+				err := op.run(ctx)
+				results.record(op.name, time.Since(start), err)
+			}
+		}()
+	}
+
+	start := time.Now()
+	deadline := time.After(*duration)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	dropped := 0
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		case <-deadline:
+			break loop
+		case <-ticker.C:
+			select {
+			case jobs <- pick():
+			default:
+				// All workers are busy, so the target rate can't be reached.
+				dropped += 1
+			}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	elapsed := time.Since(start)
+	fmt.Printf("ran for %s at a target of %.1f req/s", elapsed.Round(time.Millisecond), *qps)
+	if dropped > 0 {
+		fmt.Printf(", %d requests skipped because all %d workers were busy", dropped, *workers)
+	}
+	fmt.Println()
+	results.print(elapsed)
+}
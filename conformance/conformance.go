@@ -0,0 +1,517 @@
+// Package conformance checks this version of the SDK against Lithic's OpenAPI
+// document, so that drift between the two, such as a response field the SDK
+// does not decode or a request the API would reject, is flagged in CI.
+//
+// The check is usually embedded in a test:
+//
+//	func TestConformance(t *testing.T) {
+//		spec, err := conformance.Load(context.Background(), os.Getenv("LITHIC_OPENAPI_SPEC"))
+//		if err != nil {
+//			t.Fatal(err)
+//		}
+//		for _, diff := range conformance.Check(spec) {
+//			t.Error(diff)
+//		}
+//	}
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/core/query"
+)
+
+// Kinds of differences between the SDK and the spec.
+const (
+	// An endpoint bound by the SDK is not in the spec.
+	KindMissingEndpoint = "missing_endpoint"
+	// A parameter sent by the SDK is not in the spec.
+	KindUnknownParam = "unknown_param"
+	// A parameter in the spec cannot be sent with the SDK.
+	KindMissingParam = "missing_param"
+	// A parameter is required by one of the SDK and the spec but not the other.
+	KindRequiredMismatch = "required_mismatch"
+	// A request serialized by the SDK does not validate against the spec.
+	KindInvalidRequest = "invalid_request"
+	// A field in the spec is not decoded by the SDK.
+	KindMissingResponseField = "missing_response_field"
+	// A field decoded by the SDK is not in the spec.
+	KindUnknownResponseField = "unknown_response_field"
+	// A field is decoded by the SDK into a type that does not match the spec.
+	KindResponseType = "response_type"
+)
+
+// Diff is a difference between the SDK and the spec.
+type Diff struct {
+	Kind     string
+	Endpoint lithic.Endpoint
+	// The path to the parameter or field, such as "spend_limit" or
+	// "data[].funding.token", if the diff is about one.
+	Field  string
+	Detail string
+}
+
+func (d Diff) String() string {
+	s := d.Endpoint.HTTPMethod + " " + d.Endpoint.Path + ": " + d.Kind
+	if d.Field != "" {
+		s += " " + d.Field
+	}
+	if d.Detail != "" {
+		s += ": " + d.Detail
+	}
+	return s
+}
+
+// Check compares every endpoint bound by the SDK with the spec: its parameters,
+// the requests the SDK serializes for it, and the fields the SDK decodes from
+// its response.
+func Check(spec *Spec) []Diff {
+	var diffs []Diff
+	client := reflect.TypeOf(lithic.Lithic{})
+	for _, e := range lithic.APISurface() {
+		c := &checker{spec: spec, endpoint: e}
+		c.check(client)
+		diffs = append(diffs, c.diffs...)
+	}
+	return diffs
+}
+
+type checker struct {
+	spec     *Spec
+	endpoint lithic.Endpoint
+	diffs    []Diff
+}
+
+func (c *checker) add(kind string, field string, detail string, args ...interface{}) {
+	c.diffs = append(c.diffs, Diff{Kind: kind, Endpoint: c.endpoint, Field: field, Detail: fmt.Sprintf(detail, args...)})
+}
+
+func (c *checker) check(client reflect.Type) {
+	op, item := c.spec.operation(c.endpoint.HTTPMethod, c.endpoint.Path)
+	if op == nil {
+		c.add(KindMissingEndpoint, "", "not in the spec")
+		return
+	}
+	c.checkParams(op, item)
+
+	method, ok := serviceMethod(client, c.endpoint.Service, c.endpoint.Method)
+	if !ok {
+		return
+	}
+	for i := 0; i < method.Type.NumIn(); i++ {
+		if params := method.Type.In(i); params.Kind() == reflect.Pointer && params.Elem().Kind() == reflect.Struct {
+			c.checkRequest(params, op, item)
+		}
+	}
+	if method.Type.NumOut() > 1 {
+		if schema := c.spec.responseSchema(op); schema != nil {
+			c.checkResponse("", responseType(method.Type.Out(0)), schema)
+		}
+	}
+}
+
+func (c *checker) checkParams(op *Operation, item *PathItem) {
+	specParams := map[string]map[string]bool{}
+	for name, p := range c.spec.parameters(op, item, "query") {
+		specParams["query"] = setDefault(specParams["query"])
+		specParams["query"][name] = p.Required
+	}
+	body := c.spec.requestSchema(op)
+	if body != nil && len(body.OneOf) == 0 && len(body.AnyOf) == 0 {
+		specParams["body"] = map[string]bool{}
+		for name := range body.Properties {
+			specParams["body"][name] = body.requires(name)
+		}
+	}
+
+	sent := map[string]map[string]bool{}
+	for _, p := range c.endpoint.Params {
+		if p.In == "path" {
+			continue
+		}
+		if p.In == "body" && body != nil && (len(body.OneOf) > 0 || len(body.AnyOf) > 0) {
+			// Variants of a union are checked by validating serialized requests.
+			continue
+		}
+		sent[p.In] = setDefault(sent[p.In])
+		sent[p.In][p.Name] = true
+		required, ok := specParams[p.In][p.Name]
+		switch {
+		case !ok:
+			c.add(KindUnknownParam, p.Name, "%s parameter is not in the spec", p.In)
+		case required != p.Required:
+			c.add(KindRequiredMismatch, p.Name, "required is %v in the SDK and %v in the spec", p.Required, required)
+		}
+	}
+	for _, in := range []string{"query", "body"} {
+		for _, name := range sortedKeys(specParams[in]) {
+			if !sent[in][name] {
+				c.add(KindMissingParam, name, "%s parameter cannot be sent with the SDK", in)
+			}
+		}
+	}
+}
+
+var queryer = reflect.TypeOf((*query.Queryer)(nil)).Elem()
+
+var marshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// checkRequest serializes a sample of params with every field set and
+// validates it against the spec.
+func (c *checker) checkRequest(params reflect.Type, op *Operation, item *PathItem) {
+	switch {
+	case params.Implements(queryer):
+		known := c.spec.parameters(op, item, "query")
+		schema := &Schema{Properties: map[string]*Schema{}}
+		for name, p := range known {
+			schema.Properties[name] = p.Schema
+		}
+		sample := newSampler(c.spec).params(params, schema)
+		for key, values := range sample.Interface().(query.Queryer).URLQuery() {
+			name := key
+			if i := strings.IndexByte(name, '['); i >= 0 {
+				name = name[:i]
+			}
+			p, ok := known[name]
+			if !ok {
+				continue // Reported by checkParams.
+			}
+			for _, value := range values {
+				for _, problem := range validateQuery(c.spec, value, p.Schema) {
+					c.add(KindInvalidRequest, key, "%s", problem)
+				}
+			}
+		}
+	case params.Implements(marshaler):
+		body := c.spec.requestSchema(op)
+		if body == nil {
+			return
+		}
+		for _, sample := range newSampler(c.spec).variants(params, body) {
+			data, err := sample.Interface().(json.Marshaler).MarshalJSON()
+			if err != nil {
+				c.add(KindInvalidRequest, "", "failed to serialize a sample request: %s", err)
+				continue
+			}
+			var value interface{}
+			if err := json.Unmarshal(data, &value); err != nil {
+				c.add(KindInvalidRequest, "", "serialized request is not JSON: %s", err)
+				continue
+			}
+			for _, problem := range validate(c.spec, "", value, body) {
+				c.add(KindInvalidRequest, problem.path, "%s", problem.message)
+			}
+		}
+	}
+}
+
+// checkResponse compares the fields that a response type decodes with the
+// properties of its schema.
+func (c *checker) checkResponse(path string, t reflect.Type, schema *Schema) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	schema = c.spec.schema(schema)
+	if schema == nil || len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 || t.Kind() == reflect.Interface {
+		return
+	}
+	if !compatible(t, schema) {
+		c.add(KindResponseType, path, "decoded into %s but is %s in the spec", t, describe(schema))
+		return
+	}
+	switch t.Kind() {
+	case reflect.Slice:
+		if schema.Items != nil {
+			c.checkResponse(path+"[]", t.Elem(), schema.Items)
+		}
+	case reflect.Struct:
+		if t == timeType || len(schema.Properties) == 0 {
+			return
+		}
+		decoded := map[string]bool{}
+		for _, f := range taggedFields(t) {
+			decoded[f.name] = true
+			property, ok := schema.Properties[f.name]
+			if !ok {
+				c.add(KindUnknownResponseField, join(path, f.name), "decoded by the SDK but not in the spec")
+				continue
+			}
+			c.checkResponse(join(path, f.name), f.typ, property)
+		}
+		for _, name := range sortedKeys(schema.Properties) {
+			if !decoded[name] {
+				c.add(KindMissingResponseField, join(path, name), "in the spec but not decoded by the SDK")
+			}
+		}
+	}
+}
+
+// responseType returns the type that a response is decoded into, which for
+// pages, such as responses.CardsPage, is the type of the page's response rather
+// than the page itself.
+func responseType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return t
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		page := field.Type
+		if !field.Anonymous || page.Kind() != reflect.Pointer || page.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		if res, ok := page.Elem().FieldByName("res"); ok {
+			return res.Type
+		}
+	}
+	return t
+}
+
+func serviceMethod(client reflect.Type, service string, name string) (reflect.Method, bool) {
+	t := client
+	for _, field := range strings.Split(service, ".") {
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		f, ok := t.FieldByName(field)
+		if !ok {
+			return reflect.Method{}, false
+		}
+		t = f.Type
+	}
+	return t.MethodByName(name)
+}
+
+type taggedField struct {
+	name  string
+	typ   reflect.Type
+	index int
+}
+
+// taggedFields returns the exported fields of a struct that have a JSON or, for
+// query params, a query name.
+func taggedFields(t reflect.Type) []taggedField {
+	var fields []taggedField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup("json")
+		if !ok {
+			tag, ok = field.Tag.Lookup("query")
+		}
+		if !field.IsExported() || !ok {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		fields = append(fields, taggedField{name, field.Type, i})
+	}
+	return fields
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// compatible reports whether values of a schema can be decoded into t.
+func compatible(t reflect.Type, schema *Schema) bool {
+	if len(schema.Type) == 0 {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return schema.Type.has("string")
+	case reflect.Bool:
+		return schema.Type.has("boolean")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return schema.Type.has("integer")
+	case reflect.Float32, reflect.Float64:
+		return schema.Type.has("number") || schema.Type.has("integer")
+	case reflect.Slice:
+		return schema.Type.has("array")
+	case reflect.Map:
+		return schema.Type.has("object")
+	case reflect.Struct:
+		if t == timeType {
+			return schema.Type.has("string")
+		}
+		return schema.Type.has("object")
+	}
+	return true
+}
+
+func describe(schema *Schema) string {
+	s := strings.Join(schema.Type, " or ")
+	if schema.Format != "" {
+		s += " (" + schema.Format + ")"
+	}
+	return s
+}
+
+type problem struct {
+	path    string
+	message string
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// validate validates a decoded JSON value against a schema.
+func validate(spec *Spec, path string, value interface{}, schema *Schema) []problem {
+	schema = spec.schema(schema)
+	if schema == nil {
+		return nil
+	}
+	if alternatives := append(append([]*Schema{}, schema.OneOf...), schema.AnyOf...); len(alternatives) > 0 {
+		for _, alternative := range alternatives {
+			if len(validate(spec, path, value, alternative)) == 0 {
+				return nil
+			}
+		}
+		return []problem{{path, "matches none of the alternatives in the spec"}}
+	}
+	if value == nil {
+		if schema.Nullable || schema.Type.has("null") || len(schema.Type) == 0 {
+			return nil
+		}
+		return []problem{{path, "is null but is not nullable in the spec"}}
+	}
+	if len(schema.Enum) > 0 {
+		found := false
+		for _, allowed := range schema.Enum {
+			found = found || fmt.Sprint(allowed) == fmt.Sprint(value)
+		}
+		if !found {
+			return []problem{{path, fmt.Sprintf("%v is not one of %v", value, schema.Enum)}}
+		}
+	}
+	mismatch := []problem{{path, fmt.Sprintf("is %T but is %s in the spec", value, describe(schema))}}
+	switch v := value.(type) {
+	case string:
+		if len(schema.Type) > 0 && !schema.Type.has("string") {
+			return mismatch
+		}
+		return validateFormat(path, v, schema.Format)
+	case bool:
+		if len(schema.Type) > 0 && !schema.Type.has("boolean") {
+			return mismatch
+		}
+	case float64:
+		if len(schema.Type) > 0 && !schema.Type.has("number") && !(schema.Type.has("integer") && v == math.Trunc(v)) {
+			return mismatch
+		}
+	case []interface{}:
+		if len(schema.Type) > 0 && !schema.Type.has("array") {
+			return mismatch
+		}
+		var problems []problem
+		for _, item := range v {
+			problems = append(problems, validate(spec, path+"[]", item, schema.Items)...)
+		}
+		return problems
+	case map[string]interface{}:
+		if len(schema.Type) > 0 && !schema.Type.has("object") {
+			return mismatch
+		}
+		var problems []problem
+		for _, name := range schema.Required {
+			if _, ok := v[name]; !ok {
+				problems = append(problems, problem{join(path, name), "is required by the spec but was not sent"})
+			}
+		}
+		for _, name := range sortedKeys(v) {
+			property, ok := schema.Properties[name]
+			if !ok {
+				if len(schema.Properties) > 0 {
+					problems = append(problems, problem{join(path, name), "is not in the spec"})
+				}
+				continue
+			}
+			problems = append(problems, validate(spec, join(path, name), v[name], property)...)
+		}
+		return problems
+	}
+	return nil
+}
+
+func validateQuery(spec *Spec, value string, schema *Schema) []string {
+	schema = spec.schema(schema)
+	if schema == nil {
+		return nil
+	}
+	if schema.Type.has("array") {
+		schema = spec.schema(schema.Items)
+		if schema == nil {
+			return nil
+		}
+	}
+	var decoded interface{} = value
+	switch {
+	case schema.Type.has("integer"), schema.Type.has("number"):
+		var n float64
+		if _, err := fmt.Sscan(value, &n); err != nil {
+			return []string{fmt.Sprintf("%q is not a number", value)}
+		}
+		decoded = n
+	case schema.Type.has("boolean"):
+		if value != "true" && value != "false" {
+			return []string{fmt.Sprintf("%q is not a boolean", value)}
+		}
+		decoded = value == "true"
+	}
+	var messages []string
+	for _, p := range validate(spec, "", decoded, schema) {
+		messages = append(messages, p.message)
+	}
+	return messages
+}
+
+func validateFormat(path string, value string, format string) []problem {
+	var err error
+	switch format {
+	case "date-time":
+		_, err = time.Parse(time.RFC3339, value)
+	case "date":
+		_, err = time.Parse("2006-01-02", value)
+	case "uuid":
+		if !uuidPattern.MatchString(value) {
+			err = fmt.Errorf("not a UUID")
+		}
+	}
+	if err != nil {
+		return []problem{{path, fmt.Sprintf("%q is not a valid %s", value, format)}}
+	}
+	return nil
+}
+
+func join(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func setDefault(m map[string]bool) map[string]bool {
+	if m == nil {
+		return map[string]bool{}
+	}
+	return m
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
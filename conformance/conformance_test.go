@@ -0,0 +1,114 @@
+package conformance
+
+import (
+	"context"
+	"os"
+	"testing"
+)
+
+const fixture = `{
+  "paths": {
+    "/cards/{card_token}/reissue": {
+      "post": {
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "properties": {
+                  "shipping_address": {"type": "object"},
+                  "shipping_method": {"type": "string", "enum": ["STANDARD", "EXPEDITED"]},
+                  "product_id": {"type": "integer"},
+                  "carrier": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {
+            "content": {
+              "application/json": {"schema": {"$ref": "#/components/schemas/Card"}}
+            }
+          }
+        }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Card": {
+        "type": "object",
+        "properties": {
+          "token": {"type": "string", "format": "uuid"},
+          "created": {"type": "string", "format": "date-time"},
+          "last_four": {"type": "integer"},
+          "nickname": {"type": "string"}
+        }
+      }
+    }
+  }
+}`
+
+func TestCheck(t *testing.T) {
+	spec, err := Parse([]byte(fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := map[string]bool{}
+	for _, diff := range Check(spec) {
+		if diff.Endpoint.Path != "cards/{card_token}/reissue" {
+			continue
+		}
+		found[diff.Kind+" "+diff.Field] = true
+		if diff.Kind == KindUnknownParam {
+			t.Errorf("unexpected diff %s", diff)
+		}
+	}
+	for _, want := range []string{
+		KindMissingParam + " carrier",
+		KindInvalidRequest + " product_id",
+		KindResponseType + " last_four",
+		KindMissingResponseField + " nickname",
+		KindUnknownResponseField + " state",
+	} {
+		if !found[want] {
+			t.Errorf("expected a %s diff, got %v", want, found)
+		}
+	}
+	if found[KindInvalidRequest+" shipping_method"] {
+		t.Errorf("expected shipping_method to validate")
+	}
+}
+
+func TestCheckMissingEndpoint(t *testing.T) {
+	spec, err := Parse([]byte(fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diff := range Check(spec) {
+		if diff.Endpoint.Path == "cards/{card_token}" && diff.Endpoint.HTTPMethod == "GET" {
+			if diff.Kind != KindMissingEndpoint {
+				t.Fatalf("unexpected diff %s", diff)
+			}
+			return
+		}
+	}
+	t.Fatal("expected a missing_endpoint diff for GET cards/{card_token}")
+}
+
+// TestPublishedSpec checks the SDK against the spec at LITHIC_OPENAPI_SPEC,
+// a file or URL, when it is set.
+func TestPublishedSpec(t *testing.T) {
+	location := os.Getenv("LITHIC_OPENAPI_SPEC")
+	if location == "" {
+		t.Skip("LITHIC_OPENAPI_SPEC is not set")
+	}
+	spec, err := Load(context.Background(), location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diff := range Check(spec) {
+		t.Error(diff)
+	}
+}
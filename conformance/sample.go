@@ -0,0 +1,152 @@
+package conformance
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// sampler builds sample params with every field set, using the schemas of the
+// spec to pick valid values such as enum members.
+type sampler struct {
+	spec  *Spec
+	depth int
+}
+
+func newSampler(spec *Spec) *sampler {
+	return &sampler{spec: spec}
+}
+
+// params returns a pointer to a sample of the params type t, which is a
+// pointer to a struct.
+func (s *sampler) params(t reflect.Type, schema *Schema) reflect.Value {
+	v := reflect.New(t.Elem())
+	s.fill(v.Elem(), s.spec.schema(schema))
+	return v
+}
+
+// variants returns samples of params that are a union, such as
+// AccountHolderNewParams, with one sample per variant. Other params have a
+// single sample.
+func (s *sampler) variants(t reflect.Type, schema *Schema) []reflect.Value {
+	schema = s.spec.schema(schema)
+	var variants []int
+	for i := 0; i < t.Elem().NumField(); i++ {
+		field := t.Elem().Field(i)
+		if _, tagged := field.Tag.Lookup("json"); !tagged && field.IsExported() && field.Type.Kind() == reflect.Pointer && field.Type.Elem().Kind() == reflect.Struct {
+			variants = append(variants, i)
+		}
+	}
+	if len(variants) == 0 {
+		return []reflect.Value{s.params(t, schema)}
+	}
+	var samples []reflect.Value
+	for _, i := range variants {
+		v := reflect.New(t.Elem())
+		variant := reflect.New(t.Elem().Field(i).Type.Elem())
+		s.fill(variant.Elem(), s.alternative(variant.Elem().Type(), schema))
+		v.Elem().Field(i).Set(variant)
+		samples = append(samples, v)
+	}
+	return samples
+}
+
+// alternative returns the alternative of a union schema whose properties best
+// match the fields of t.
+func (s *sampler) alternative(t reflect.Type, schema *Schema) *Schema {
+	if schema == nil {
+		return nil
+	}
+	best, score := schema, -1
+	for _, alternative := range append(append([]*Schema{}, schema.OneOf...), schema.AnyOf...) {
+		alternative = s.spec.schema(alternative)
+		if alternative == nil {
+			continue
+		}
+		n := 0
+		for _, f := range taggedFields(t) {
+			if _, ok := alternative.Properties[f.name]; ok {
+				n += 1
+			}
+		}
+		if n > score {
+			best, score = alternative, n
+		}
+	}
+	return best
+}
+
+func (s *sampler) fill(v reflect.Value, schema *Schema) {
+	if s.depth > 8 {
+		return
+	}
+	s.depth += 1
+	defer func() { s.depth -= 1 }()
+
+	t := v.Type()
+	if t.Kind() == reflect.Struct && strings.HasPrefix(t.Name(), "Field[") {
+		s.fill(v.FieldByName("Value"), schema)
+		v.FieldByName("Present").SetBool(true)
+		return
+	}
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(sampleString(schema))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	case reflect.Slice:
+		var items *Schema
+		if schema != nil {
+			items = s.spec.schema(schema.Items)
+		}
+		item := reflect.New(t.Elem()).Elem()
+		s.fill(item, items)
+		v.Set(reflect.Append(reflect.MakeSlice(t, 0, 1), item))
+	case reflect.Pointer:
+		if t.Elem().Kind() == reflect.Struct {
+			ptr := reflect.New(t.Elem())
+			s.fill(ptr.Elem(), schema)
+			v.Set(ptr)
+		}
+	case reflect.Struct:
+		if t == timeType {
+			v.Set(reflect.ValueOf(time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)))
+			return
+		}
+		for _, f := range taggedFields(t) {
+			var property *Schema
+			if schema != nil {
+				property = s.spec.schema(schema.Properties[f.name])
+			}
+			s.fill(v.Field(f.index), property)
+		}
+	}
+}
+
+func sampleString(schema *Schema) string {
+	if schema == nil {
+		return "string"
+	}
+	for _, value := range schema.Enum {
+		if s, ok := value.(string); ok {
+			return s
+		}
+	}
+	switch schema.Format {
+	case "uuid":
+		return "7ef7d65c-9023-4da3-b113-3b8583fd7951"
+	case "date":
+		return "2023-03-01"
+	case "date-time":
+		return "2023-03-01T12:00:00Z"
+	case "email":
+		return "user@example.com"
+	case "uri", "url":
+		return "https://example.com"
+	}
+	return "string"
+}
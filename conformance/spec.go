@@ -0,0 +1,290 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Spec is the subset of an OpenAPI 3 document that the SDK is checked against.
+type Spec struct {
+	Paths      map[string]*PathItem `json:"paths"`
+	Components struct {
+		Schemas       map[string]*Schema      `json:"schemas"`
+		Parameters    map[string]*Parameter   `json:"parameters"`
+		RequestBodies map[string]*RequestBody `json:"requestBodies"`
+		Responses     map[string]*Response    `json:"responses"`
+	} `json:"components"`
+}
+
+type PathItem struct {
+	Parameters []*Parameter `json:"parameters"`
+	Get        *Operation   `json:"get"`
+	Post       *Operation   `json:"post"`
+	Put        *Operation   `json:"put"`
+	Patch      *Operation   `json:"patch"`
+	Delete     *Operation   `json:"delete"`
+}
+
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Parameters  []*Parameter         `json:"parameters"`
+	RequestBody *RequestBody         `json:"requestBody"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Parameter struct {
+	Ref      string  `json:"$ref"`
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Ref      string               `json:"$ref"`
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Ref     string               `json:"$ref"`
+	Content map[string]MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is a JSON schema, as used by OpenAPI 3.0 and 3.1.
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 schemaType         `json:"type"`
+	Format               string             `json:"format"`
+	Enum                 []interface{}      `json:"enum"`
+	Nullable             bool               `json:"nullable"`
+	Properties           map[string]*Schema `json:"properties"`
+	Required             []string           `json:"required"`
+	Items                *Schema            `json:"items"`
+	AllOf                []*Schema          `json:"allOf"`
+	OneOf                []*Schema          `json:"oneOf"`
+	AnyOf                []*Schema          `json:"anyOf"`
+	AdditionalProperties interface{}        `json:"additionalProperties"`
+}
+
+// schemaType is the type of a schema, which OpenAPI 3.1 allows to be a list
+// such as ["string", "null"].
+type schemaType []string
+
+func (t *schemaType) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*t = schemaType{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+func (t schemaType) has(name string) bool {
+	for _, s := range t {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Load reads an OpenAPI document in JSON from a file or, if location is an
+// http or https URL, downloads it.
+func Load(ctx context.Context, location string) (*Spec, error) {
+	var data []byte
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		req, err := http.NewRequestWithContext(ctx, "GET", location, nil)
+		if err != nil {
+			return nil, err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("conformance: failed to download the spec: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("conformance: failed to download the spec: %s", res.Status)
+		}
+		if data, err = io.ReadAll(res.Body); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if data, err = os.ReadFile(location); err != nil {
+			return nil, err
+		}
+	}
+	return Parse(data)
+}
+
+// Parse parses an OpenAPI document in JSON. YAML documents must be converted to
+// JSON first.
+func Parse(data []byte) (*Spec, error) {
+	spec := &Spec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, fmt.Errorf("conformance: failed to parse the spec as JSON: %w", err)
+	}
+	return spec, nil
+}
+
+var pathParam = regexp.MustCompile(`\{[^}]*\}`)
+
+// operation returns the operation for a method and a path relative to the API's
+// base URL, ignoring the names of path parameters.
+func (s *Spec) operation(method string, path string) (*Operation, *PathItem) {
+	want := pathParam.ReplaceAllString(strings.Trim(path, "/"), "{}")
+	for p, item := range s.Paths {
+		p = pathParam.ReplaceAllString(strings.Trim(p, "/"), "{}")
+		if p != want && p != "v1/"+want {
+			continue
+		}
+		var op *Operation
+		switch method {
+		case "GET":
+			op = item.Get
+		case "POST":
+			op = item.Post
+		case "PUT":
+			op = item.Put
+		case "PATCH":
+			op = item.Patch
+		case "DELETE":
+			op = item.Delete
+		}
+		if op != nil {
+			return op, item
+		}
+	}
+	return nil, nil
+}
+
+func refName(ref string, kind string) (string, bool) {
+	prefix := "#/components/" + kind + "/"
+	if !strings.HasPrefix(ref, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(ref, prefix), true
+}
+
+// schema resolves the references of a schema and merges its allOf members.
+func (s *Spec) schema(schema *Schema) *Schema {
+	for depth := 0; schema != nil && schema.Ref != "" && depth < 32; depth++ {
+		name, ok := refName(schema.Ref, "schemas")
+		if !ok {
+			return nil
+		}
+		schema = s.Components.Schemas[name]
+	}
+	if schema == nil || len(schema.AllOf) == 0 {
+		return schema
+	}
+	merged := *schema
+	merged.AllOf = nil
+	merged.Properties = map[string]*Schema{}
+	for name, property := range schema.Properties {
+		merged.Properties[name] = property
+	}
+	for _, member := range schema.AllOf {
+		member = s.schema(member)
+		if member == nil {
+			continue
+		}
+		for name, property := range member.Properties {
+			merged.Properties[name] = property
+		}
+		merged.Required = append(merged.Required, member.Required...)
+		if len(merged.Type) == 0 {
+			merged.Type = member.Type
+		}
+		merged.OneOf = append(merged.OneOf, member.OneOf...)
+		merged.AnyOf = append(merged.AnyOf, member.AnyOf...)
+	}
+	return &merged
+}
+
+func (s *Spec) parameter(p *Parameter) *Parameter {
+	if name, ok := refName(p.Ref, "parameters"); ok {
+		if resolved := s.Components.Parameters[name]; resolved != nil {
+			return resolved
+		}
+	}
+	return p
+}
+
+// parameters returns the parameters of an operation in the given location,
+// including the ones shared by its path.
+func (s *Spec) parameters(op *Operation, item *PathItem, in string) map[string]*Parameter {
+	params := map[string]*Parameter{}
+	for _, p := range append(append([]*Parameter{}, item.Parameters...), op.Parameters...) {
+		if p = s.parameter(p); p.In == in {
+			params[p.Name] = p
+		}
+	}
+	return params
+}
+
+// requestSchema returns the JSON schema of the body of an operation.
+func (s *Spec) requestSchema(op *Operation) *Schema {
+	body := op.RequestBody
+	if body == nil {
+		return nil
+	}
+	if name, ok := refName(body.Ref, "requestBodies"); ok {
+		if body = s.Components.RequestBodies[name]; body == nil {
+			return nil
+		}
+	}
+	return s.schema(jsonSchema(body.Content))
+}
+
+// responseSchema returns the JSON schema of the successful response of an
+// operation.
+func (s *Spec) responseSchema(op *Operation) *Schema {
+	for _, status := range []string{"200", "201", "202", "2XX", "default"} {
+		res := op.Responses[status]
+		if res == nil {
+			continue
+		}
+		if name, ok := refName(res.Ref, "responses"); ok {
+			if res = s.Components.Responses[name]; res == nil {
+				return nil
+			}
+		}
+		return s.schema(jsonSchema(res.Content))
+	}
+	return nil
+}
+
+func jsonSchema(content map[string]MediaType) *Schema {
+	for contentType, media := range content {
+		if strings.Contains(contentType, "json") {
+			return media.Schema
+		}
+	}
+	return nil
+}
+
+func (s *Schema) requires(name string) bool {
+	for _, required := range s.Required {
+		if required == name {
+			return true
+		}
+	}
+	return false
+}
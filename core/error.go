@@ -2,10 +2,16 @@ package core
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tidwall/gjson"
 )
 
 type RequestError struct {
@@ -29,6 +35,10 @@ func (e RequestError) URL() *url.URL {
 	return req.URL
 }
 
+func (e RequestError) Unwrap() error {
+	return e.Cause
+}
+
 func (e RequestError) Error() string {
 	return fmt.Errorf("%s: %d: %w", e.URL(), e.StatusCode(), e.Cause).Error()
 }
@@ -41,6 +51,7 @@ type APIError struct {
 	errorBodyJSON *string
 	message       string
 	headers       http.Header
+	err           *Error
 }
 
 func (e APIError) Request() *http.Request {
@@ -106,8 +117,17 @@ func (e APIError) Error() string {
 	return fmt.Sprintf("api_error: %s %s: %d\n%s", e.Method(), e.URL(), e.status, e.errorjSON())
 }
 
+// Unwrap returns the structured *Error of the response, so that API errors can
+// be matched with errors.As(err, &lithicErr) and errors.Is(err, ErrNotFound).
+func (e APIError) Unwrap() error {
+	if e.err == nil {
+		return nil
+	}
+	return e.err
+}
+
 func NewAPIError(req *http.Request, res *http.Response, status int, err error, message string, headers http.Header) APIError {
-	return APIError{req, res, status, err, nil, message, headers}
+	return APIError{req, res, status, err, nil, message, headers, NewError(req, res, status, []byte(message))}
 }
 
 func NewAPIErrorFromResponse(req *http.Request, res *http.Response) APIError {
@@ -118,3 +138,146 @@ func NewAPIErrorFromResponse(req *http.Request, res *http.Response) APIError {
 
 	return NewAPIError(req, res, res.StatusCode, nil, message, res.Header)
 }
+
+// Sentinel errors that API errors match with errors.Is, by their status code.
+var (
+	ErrBadRequest          = errors.New("lithic: bad request")
+	ErrUnauthorized        = errors.New("lithic: unauthorized")
+	ErrForbidden           = errors.New("lithic: forbidden")
+	ErrNotFound            = errors.New("lithic: not found")
+	ErrConflict            = errors.New("lithic: conflict")
+	ErrUnprocessableEntity = errors.New("lithic: unprocessable entity")
+	ErrRateLimited         = errors.New("lithic: rate limited")
+	ErrServer              = errors.New("lithic: server error")
+)
+
+// Error is an error response of the API.
+type Error struct {
+	StatusCode int
+	// The message of the error body.
+	Message string
+	// The ID that Lithic support uses to look up the request.
+	DebuggingRequestID string
+	// The raw error body.
+	Body     []byte
+	Request  *http.Request
+	Response *http.Response
+}
+
+// NewError returns the Error of a response with the given status and body.
+func NewError(req *http.Request, res *http.Response, status int, body []byte) *Error {
+	e := &Error{StatusCode: status, Body: body, Request: req, Response: res}
+	if gjson.ValidBytes(body) {
+		e.Message = gjson.GetBytes(body, "message").String()
+		e.DebuggingRequestID = gjson.GetBytes(body, "debugging_request_id").String()
+	}
+	return e
+}
+
+func (e *Error) Error() string {
+	s := fmt.Sprintf("lithic: %d", e.StatusCode)
+	if e.Request != nil && e.Request.URL != nil {
+		s = fmt.Sprintf("lithic: %s %s: %d", e.Request.Method, e.Request.URL, e.StatusCode)
+	}
+	if e.Message != "" {
+		s += ": " + e.Message
+	}
+	if e.DebuggingRequestID != "" {
+		s += " (debugging request ID " + e.DebuggingRequestID + ")"
+	}
+	return s
+}
+
+// Is reports whether target is the sentinel error for the status code of e.
+func (e *Error) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		return target == ErrBadRequest
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusConflict:
+		return target == ErrConflict
+	case http.StatusUnprocessableEntity:
+		return target == ErrUnprocessableEntity
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	}
+	return e.StatusCode >= 500 && target == ErrServer
+}
+
+// The maximum number of bytes of a non-JSON body that is kept on a
+// TransportError.
+const transportErrorSnippetLength = 512
+
+// TransportError is returned when a response body is not JSON even though the
+// SDK expected it to be, which usually means that a proxy or CDN in front of the
+// API answered the request with an HTML error page.
+type TransportError struct {
+	Request     *http.Request
+	Response    *http.Response
+	StatusCode  int
+	ContentType string
+	// The beginning of the response body, truncated to a few hundred bytes.
+	Snippet string
+}
+
+func NewTransportError(req *http.Request, res *http.Response, body []byte) TransportError {
+	snippet := body
+	truncated := false
+	if len(snippet) > transportErrorSnippetLength {
+		snippet = snippet[:transportErrorSnippetLength]
+		truncated = true
+		for len(snippet) > 0 && !utf8.Valid(snippet) {
+			snippet = snippet[:len(snippet)-1]
+		}
+	}
+	s := strings.TrimSpace(string(snippet))
+	if truncated {
+		s += "..."
+	}
+	return TransportError{
+		Request:     req,
+		Response:    res,
+		StatusCode:  res.StatusCode,
+		ContentType: res.Header.Get("Content-Type"),
+		Snippet:     s,
+	}
+}
+
+func (e TransportError) Error() string {
+	method, u := "<nil>", "<nil>"
+	if e.Request != nil {
+		method = e.Request.Method
+		if e.Request.URL != nil {
+			u = e.Request.URL.String()
+		}
+	}
+	contentType := e.ContentType
+	if contentType == "" {
+		contentType = "<none>"
+	}
+	return fmt.Sprintf("transport_error: %s %s: %d: unexpected content-type %s\n%s", method, u, e.StatusCode, contentType, e.Snippet)
+}
+
+// Is reports whether target is the sentinel error for the status code of an
+// error response, such as ErrServer for an HTML 502 page.
+func (e TransportError) Is(target error) bool {
+	return e.StatusCode > 299 && (&Error{StatusCode: e.StatusCode}).Is(target)
+}
+
+// IsJSONContentType reports whether the given Content-Type header value
+// describes a JSON body. Parameters such as `charset` are ignored, and structured
+// syntax suffixes like `application/problem+json` are accepted.
+func IsJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		// Some proxies rewrite the header into something that doesn't parse, so
+		// fall back to looking for a JSON media type anywhere in the value.
+		return strings.Contains(strings.ToLower(contentType), "json")
+	}
+	return mediaType == "application/json" || mediaType == "text/json" || strings.HasSuffix(mediaType, "+json")
+}
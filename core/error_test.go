@@ -0,0 +1,58 @@
+package core
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIsJSONContentType(t *testing.T) {
+	tests := map[string]bool{
+		"application/json":                  true,
+		"application/json; charset=utf-8":   true,
+		"Application/JSON;charset=UTF-8":    true,
+		"application/json;;charset=\"utf":   true,
+		"application/problem+json":          true,
+		"text/json":                         true,
+		"text/html":                         false,
+		"text/html; charset=iso-8859-1":     false,
+		"text/plain":                        false,
+		"":                                  false,
+		"application/x-www-form-urlencoded": false,
+	}
+	for contentType, expected := range tests {
+		if actual := IsJSONContentType(contentType); actual != expected {
+			t.Errorf("expected IsJSONContentType(%q) to be %v", contentType, expected)
+		}
+	}
+}
+
+func TestAPIErrorUnwrapsToError(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://api.lithic.com/v1/cards/card_token", nil)
+	res := &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{"message":"Card not found","debugging_request_id":"req_123"}`))}
+	var err error = RequestError{Cause: NewAPIErrorFromResponse(req, res), Request: req, Response: res}
+
+	if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrConflict) {
+		t.Fatalf("expected the error to only match ErrNotFound")
+	}
+	var lithicErr *Error
+	if !errors.As(err, &lithicErr) {
+		t.Fatalf("expected an *Error, got %#v", err)
+	}
+	if lithicErr.Message != "Card not found" || lithicErr.DebuggingRequestID != "req_123" || string(lithicErr.Body) == "" {
+		t.Fatalf("expected the error body to be parsed, got %+v", lithicErr)
+	}
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.Status() != http.StatusNotFound {
+		t.Fatalf("expected the error to still be an APIError")
+	}
+}
+
+func TestTransportErrorMatchesSentinels(t *testing.T) {
+	res := &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}}
+	if err := NewTransportError(nil, res, []byte("<html></html>")); !errors.Is(err, ErrServer) {
+		t.Fatalf("expected a 502 to match ErrServer")
+	}
+}
@@ -1,5 +1,49 @@
 package form
 
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+)
+
 type Marshaler interface {
-	MarshalMultipart() ([]byte, error)
+	// MarshalMultipart returns the encoded form along with its content type, which
+	// carries the boundary of the parts.
+	MarshalMultipart() (data []byte, contentType string, err error)
+}
+
+// File is a file part of a multipart form.
+type File struct {
+	// Name of the form field.
+	Field string
+	// Name of the file, sent as the filename of the part.
+	Name   string
+	Reader io.Reader
+}
+
+// Encode encodes the given fields and files as a multipart form, returning the
+// body and its content type.
+func Encode(fields map[string]string, files ...File) ([]byte, string, error) {
+	body := bytes.NewBuffer(nil)
+	writer := multipart.NewWriter(body)
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, "", err
+		}
+	}
+	for _, file := range files {
+		part, err := writer.CreateFormFile(file.Field, file.Name)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := io.Copy(part, file.Reader); err != nil {
+			return nil, "", err
+		}
+	}
+	// Closing the writer writes the final boundary, so it must happen before the
+	// body is read.
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+	return body.Bytes(), writer.FormDataContentType(), nil
 }
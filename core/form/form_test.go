@@ -0,0 +1,36 @@
+package form
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	data, contentType, err := Encode(map[string]string{"document_type": "drivers_license"}, File{Field: "file", Name: "license.png", Reader: strings.NewReader("image")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Fatalf("unexpected content type %q", contentType)
+	}
+	form, err := multipart.NewReader(bytes.NewReader(data), params["boundary"]).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatalf("expected a complete multipart body: %s", err)
+	}
+	if form.Value["document_type"][0] != "drivers_license" {
+		t.Fatalf("unexpected fields %v", form.Value)
+	}
+	file, err := form.File["file"][0].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	contents, _ := io.ReadAll(file)
+	if form.File["file"][0].Filename != "license.png" || string(contents) != "image" {
+		t.Fatalf("unexpected file %q", contents)
+	}
+}
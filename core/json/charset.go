@@ -0,0 +1,46 @@
+package json
+
+import (
+	"bytes"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+var (
+	bomUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+	bomUTF16LE = []byte{0xFF, 0xFE}
+)
+
+// Normalize strips a leading byte order mark from raw and, if the BOM indicates
+// a UTF-16 encoding, transcodes the remainder to UTF-8. Input without a BOM is
+// returned unchanged. Some proxies prepend a BOM to bodies they rewrite, which
+// would otherwise make the payload invalid JSON.
+func Normalize(raw []byte) []byte {
+	switch {
+	case bytes.HasPrefix(raw, bomUTF8):
+		return raw[len(bomUTF8):]
+	case bytes.HasPrefix(raw, bomUTF16BE):
+		return decodeUTF16(raw[len(bomUTF16BE):], true)
+	case bytes.HasPrefix(raw, bomUTF16LE):
+		return decodeUTF16(raw[len(bomUTF16LE):], false)
+	default:
+		return raw
+	}
+}
+
+func decodeUTF16(raw []byte, bigEndian bool) []byte {
+	units := make([]uint16, 0, len(raw)/2)
+	for i := 0; i+1 < len(raw); i += 2 {
+		if bigEndian {
+			units = append(units, uint16(raw[i])<<8|uint16(raw[i+1]))
+		} else {
+			units = append(units, uint16(raw[i+1])<<8|uint16(raw[i]))
+		}
+	}
+	out := make([]byte, 0, len(units))
+	for _, r := range utf16.Decode(units) {
+		out = utf8.AppendRune(out, r)
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+package json
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]struct {
+		raw      []byte
+		expected string
+	}{
+		"no_bom":     {[]byte(`{"a":true}`), `{"a":true}`},
+		"utf8_bom":   {append([]byte{0xEF, 0xBB, 0xBF}, `{"a":true}`...), `{"a":true}`},
+		"utf16_be":   {[]byte{0xFE, 0xFF, 0x00, '{', 0x00, '}'}, `{}`},
+		"utf16_le":   {[]byte{0xFF, 0xFE, '{', 0x00, '}', 0x00}, `{}`},
+		"utf16_le_é": {[]byte{0xFF, 0xFE, '"', 0x00, 0xE9, 0x00, '"', 0x00}, `"é"`},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := string(Normalize(test.raw)); actual != test.expected {
+				t.Fatalf("expected %q but got %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestUnmarshalWithBOM(t *testing.T) {
+	raw := append([]byte{0xEF, 0xBB, 0xBF}, `{"name":"Robert","child":{"name":"Alex"}}`...)
+	var result Recursive
+	if err := Unmarshal(raw, &result); err != nil {
+		t.Fatalf("deserialization failed with error %v", err)
+	}
+	expected := Recursive{Name: "Robert", Child: &Recursive{Name: "Alex"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("expected %#v but got %#v", expected, result)
+	}
+}
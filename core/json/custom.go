@@ -0,0 +1,79 @@
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/tidwall/gjson"
+)
+
+var (
+	customEncoders sync.Map // map[reflect.Type]encoderFunc
+	customDecoders sync.Map // map[reflect.Type]decoderFunc
+)
+
+// RegisterEncoder registers how values of type T, such as an application's own
+// Money or ULID type, are encoded wherever they appear in a request body,
+// including inside fields.Field[T]. encode must return valid JSON. It takes
+// precedence over a MarshalJSON method of T.
+//
+// Encoders should be registered before any request is made, for example in an
+// init function, as registering one resets the encoders that were built for
+// other types.
+func RegisterEncoder[T any](encode func(value T) ([]byte, error)) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	customEncoders.Store(t, encoderFunc(func(v reflect.Value) ([]byte, error) {
+		raw, err := encode(v.Interface().(T))
+		if err != nil {
+			return nil, err
+		}
+		if !json.Valid(raw) {
+			return nil, fmt.Errorf("json: encoder for %s returned invalid JSON %q", t, raw)
+		}
+		return raw, nil
+	}))
+	// Encoders of the types that contain T were built without it.
+	encoders.Range(func(key, _ any) bool {
+		encoders.Delete(key)
+		return true
+	})
+}
+
+// RegisterDecoder registers how values of type T are decoded wherever they
+// appear in a response body, from the raw JSON of the value. A value that fails
+// to decode is reported as invalid in the metadata of its field. It takes
+// precedence over an UnmarshalJSON method of T, and is subject to the same
+// ordering as RegisterEncoder.
+func RegisterDecoder[T any](decode func(raw []byte) (T, error)) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	customDecoders.Store(t, decoderFunc(func(node gjson.Result, v reflect.Value) error {
+		value, err := decode([]byte(node.Raw))
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(&value).Elem())
+		return nil
+	}))
+	decoders.Range(func(key, _ any) bool {
+		decoders.Delete(key)
+		return true
+	})
+}
+
+func customEncoder(t reflect.Type) (encoderFunc, bool) {
+	fn, ok := customEncoders.Load(t)
+	if !ok {
+		return nil, false
+	}
+	return fn.(encoderFunc), true
+}
+
+func customDecoder(t reflect.Type) (decoderFunc, bool) {
+	fn, ok := customDecoders.Load(t)
+	if !ok {
+		return nil, false
+	}
+	return fn.(decoderFunc), true
+}
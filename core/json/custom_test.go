@@ -0,0 +1,86 @@
+package json
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+// Money is encoded as a decimal string of dollars, and would be encoded as an
+// object of its fields otherwise.
+type Money struct {
+	Cents int64
+}
+
+type MoneyParams struct {
+	Amount fields.Field[Money] `json:"amount"`
+	Limits []Money             `json:"limits"`
+}
+
+type MoneyResponse struct {
+	Amount Money `json:"amount"`
+	JSON   struct {
+		Amount Metadata
+		Raw    []byte
+		Extras map[string]Metadata
+	}
+}
+
+func init() {
+	RegisterEncoder(func(m Money) ([]byte, error) {
+		return []byte(fmt.Sprintf(`"%d.%02d"`, m.Cents/100, m.Cents%100)), nil
+	})
+	RegisterDecoder(func(raw []byte) (Money, error) {
+		s, err := strconv.Unquote(string(raw))
+		if err != nil {
+			return Money{}, err
+		}
+		dollars, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return Money{}, errors.New("invalid amount")
+		}
+		return Money{Cents: int64(dollars*100 + 0.5)}, nil
+	})
+}
+
+func TestRegisterEncoder(t *testing.T) {
+	raw, err := MarshalRoot(MoneyParams{Amount: fields.F(Money{1234}), Limits: []Money{{5}, {100000}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != `{"amount":"12.34","limits":["0.05","1000.00"]}` {
+		t.Fatalf("unexpected encoding %s", raw)
+	}
+}
+
+func TestRegisterEncoderInvalidJSON(t *testing.T) {
+	type Bad struct{ v string }
+	type BadParams struct {
+		Value Bad `json:"value"`
+	}
+	RegisterEncoder(func(b Bad) ([]byte, error) { return []byte(b.v), nil })
+	if _, err := MarshalRoot(BadParams{Bad{"not json"}}); err == nil {
+		t.Fatal("expected invalid JSON to be rejected")
+	}
+}
+
+func TestRegisterDecoder(t *testing.T) {
+	var res MoneyResponse
+	if err := UnmarshalRoot([]byte(`{"amount":"12.34"}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Amount.Cents != 1234 || res.JSON.Amount.IsInvalid() {
+		t.Fatalf("unexpected decoding %+v", res)
+	}
+
+	res = MoneyResponse{}
+	if err := UnmarshalRoot([]byte(`{"amount":"twelve"}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if !res.JSON.Amount.IsInvalid() {
+		t.Fatalf("expected the amount to be invalid, got %+v", res)
+	}
+}
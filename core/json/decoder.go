@@ -44,7 +44,7 @@ type decoderEntry struct {
 
 func (d *decoder) unmarshal(raw []byte, to any) error {
 	value := reflect.ValueOf(to).Elem()
-	result := gjson.ParseBytes(raw)
+	result := gjson.ParseBytes(Normalize(raw))
 	if !value.IsValid() {
 		return fmt.Errorf("json: cannot marshal into invalid value")
 	}
@@ -90,6 +90,9 @@ func unmarshalerDecoder(n gjson.Result, v reflect.Value) error {
 }
 
 func (d *decoder) newTypeDecoder(t reflect.Type) decoderFunc {
+	if fn, ok := customDecoder(t); ok {
+		return fn
+	}
 	if !d.root && t != reflect.TypeOf(time.Time{}) && t.Implements(reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()) {
 		return unmarshalerDecoder
 	}
@@ -0,0 +1,171 @@
+package json
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DriftKind is a way in which a decoded value differs from the JSON it was
+// decoded from.
+type DriftKind string
+
+const (
+	// The field is not part of the struct and was kept in its extras.
+	DriftUnknownField DriftKind = "unknown_field"
+	// The JSON type of the field was converted to the Go type of the field, such
+	// as a numeric string into an int64.
+	DriftCoerced DriftKind = "coerced"
+	// The field could not be decoded and was left as its zero value.
+	DriftInvalid DriftKind = "invalid"
+	// The field is null but not nullable, and was left as its zero value.
+	DriftNullToZero DriftKind = "null_to_zero"
+)
+
+// Drift is a field of a decoded value that differs from its JSON.
+type Drift struct {
+	Kind DriftKind
+	// The dotted path of the field, such as `data.0.spend_limit`.
+	Path string
+	// The Go type of the field, which is empty for unknown fields.
+	Type string
+	Raw  string
+}
+
+// Drifts walks a value decoded by this package and reports the fields that
+// differ from the JSON that they were decoded from, as recorded in the JSON
+// metadata of every struct.
+func Drifts(v interface{}) (drifts []Drift) {
+	walkDrifts(reflect.ValueOf(v), "", &drifts)
+	return drifts
+}
+
+func walkDrifts(v reflect.Value, path string, drifts *[]Drift) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			walkDrifts(v.Elem(), path, drifts)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			walkDrifts(v.Index(i), joinPath(path, strconv.Itoa(i)), drifts)
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			walkDrifts(iter.Value(), joinPath(path, iter.Key().String()), drifts)
+		}
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			return
+		}
+		walkStructDrifts(v, path, drifts)
+	}
+}
+
+func walkStructDrifts(v reflect.Value, path string, drifts *[]Drift) {
+	meta := v.FieldByName("JSON")
+	if meta.IsValid() && meta.Kind() == reflect.Struct {
+		if extras, ok := metadataField(meta, "Extras").(map[string]Metadata); ok {
+			names := make([]string, 0, len(extras))
+			for name := range extras {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				*drifts = append(*drifts, Drift{Kind: DriftUnknownField, Path: joinPath(path, name), Raw: string(extras[name].raw)})
+			}
+		}
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Anonymous {
+			walkDrifts(v.Field(i), path, drifts)
+			continue
+		}
+		ptag, ok := parseJSONStructTag(field)
+		if !ok || ptag.extras || ptag.metadata {
+			continue
+		}
+		fieldPath := joinPath(path, ptag.name)
+		if meta.IsValid() && meta.Kind() == reflect.Struct {
+			if m, ok := metadataField(meta, field.Name).(Metadata); ok {
+				if kind, ok := driftOf(m, field, v.Field(i)); ok {
+					*drifts = append(*drifts, Drift{Kind: kind, Path: fieldPath, Type: field.Type.String(), Raw: string(m.raw)})
+					continue
+				}
+			}
+		}
+		walkDrifts(v.Field(i), fieldPath, drifts)
+	}
+}
+
+func driftOf(m Metadata, field reflect.StructField, value reflect.Value) (DriftKind, bool) {
+	switch m.status {
+	case invalid:
+		return DriftInvalid, true
+	case null:
+		switch field.Type.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+			return "", false
+		}
+		if strings.Contains(field.Tag.Get(jsonStructTag), ",nullable") {
+			return "", false
+		}
+		return DriftNullToZero, true
+	case valid:
+		if len(m.raw) == 0 {
+			return "", false
+		}
+		isString := m.raw[0] == '"'
+		t := field.Type
+		for t.Kind() == reflect.Ptr {
+			t, value = t.Elem(), reflect.Indirect(value)
+		}
+		if t == reflect.TypeOf(time.Time{}) {
+			// Times that fail to parse are left as their zero value.
+			if isString && value.IsValid() && value.Interface().(time.Time).IsZero() {
+				return DriftInvalid, true
+			}
+			return "", false
+		}
+		switch t.Kind() {
+		case reflect.String:
+			if !isString {
+				return DriftCoerced, true
+			}
+		case reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			if isString {
+				return DriftCoerced, true
+			}
+		}
+	}
+	return "", false
+}
+
+func metadataField(meta reflect.Value, name string) interface{} {
+	field := meta.FieldByName(name)
+	if !field.IsValid() || !field.CanInterface() {
+		return nil
+	}
+	return field.Interface()
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -17,6 +17,36 @@ import (
 
 var encoders sync.Map // map[encoderEntry]encoderFunc
 
+// Scratch buffers used while encoding a single struct or array. The encoded
+// bytes are copied out before a buffer is returned to the pool.
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+func putBuffer(buf *bytes.Buffer) {
+	// Don't hold on to buffers that grew for unusually large payloads.
+	if buf.Cap() > 64*1024 {
+		return
+	}
+	bufferPool.Put(buf)
+}
+
+func copyBuffer(buf *bytes.Buffer) []byte {
+	return append([]byte(nil), buf.Bytes()...)
+}
+
+func writeKey(buf *bytes.Buffer, key string) {
+	buf.WriteByte('"')
+	buf.WriteString(key)
+	buf.WriteString(`":`)
+}
+
 func Marshal(value interface{}) ([]byte, error) {
 	e := &encoder{dateFormat: time.RFC3339}
 	return e.marshal(value)
@@ -94,6 +124,9 @@ func marshalerEncoder(v reflect.Value) ([]byte, error) {
 }
 
 func (e *encoder) newTypeEncoder(t reflect.Type) encoderFunc {
+	if fn, ok := customEncoder(t); ok {
+		return fn
+	}
 	if !e.root && t != reflect.TypeOf(time.Time{}) && t.Implements(reflect.TypeOf((*json.Marshaler)(nil)).Elem()) {
 		return marshalerEncoder
 	}
@@ -172,7 +205,10 @@ func (e *encoder) newArrayTypeEncoder(t reflect.Type) encoderFunc {
 	itemEncoder := e.typeEncoder(t.Elem())
 
 	return func(value reflect.Value) ([]byte, error) {
-		json := []byte("[]")
+		buf := getBuffer()
+		defer putBuffer(buf)
+
+		buf.WriteByte('[')
 		for i := 0; i < value.Len(); i++ {
 			var value, err = itemEncoder(value.Index(i))
 			if err != nil {
@@ -183,14 +219,14 @@ func (e *encoder) newArrayTypeEncoder(t reflect.Type) encoderFunc {
 				// will be the same length as the input array
 				value = []byte("null")
 			}
-
-			json, err = sjson.SetRawBytes(json, "-1", value)
-			if err != nil {
-				return nil, err
+			if i > 0 {
+				buf.WriteByte(',')
 			}
+			buf.Write(value)
 		}
+		buf.WriteByte(']')
 
-		return json, nil
+		return copyBuffer(buf), nil
 	}
 }
 
@@ -261,8 +297,10 @@ func (e *encoder) newStructTypeEncoder(t reflect.Type) encoderFunc {
 	})
 
 	return func(value reflect.Value) (json []byte, err error) {
-		json = []byte("{}")
+		buf := getBuffer()
+		defer putBuffer(buf)
 
+		buf.WriteByte('{')
 		for _, ef := range encoderFields {
 			field := value.FieldByIndex(ef.idx)
 			encoded, err := ef.fn(field)
@@ -272,11 +310,14 @@ func (e *encoder) newStructTypeEncoder(t reflect.Type) encoderFunc {
 			if encoded == nil {
 				continue
 			}
-			json, err = sjson.SetRawBytes(json, ef.tag.name, encoded)
-			if err != nil {
-				return nil, err
+			if buf.Len() > 1 {
+				buf.WriteByte(',')
 			}
+			writeKey(buf, ef.tag.name)
+			buf.Write(encoded)
 		}
+		buf.WriteByte('}')
+		json = copyBuffer(buf)
 
 		if extraEncoder != nil {
 			json, err = e.encodeMapEntries(json, value.FieldByIndex(extraEncoder.idx))
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/lithic-com/lithic-go/fields"
 )
@@ -13,15 +14,19 @@ import (
 var encoders sync.Map // map[reflect.Type]encoderFunc
 
 type encoder struct {
-	settings QuerySettings
+	dateFormat string
+	settings   QuerySettings
 }
 
 type encoderEntry struct {
 	reflect.Type
-	settings QuerySettings
+	dateFormat string
+	settings   QuerySettings
 }
 
-type encoderFunc func(key string, value reflect.Value) []Pair
+// encoderFunc appends the pairs for value to pairs and returns the extended
+// slice, so that a single slice can be reused across a whole struct.
+type encoderFunc func(pairs []Pair, key string, value reflect.Value) []Pair
 
 type Pair struct {
 	key   string
@@ -57,7 +62,7 @@ type structField struct {
 }
 
 func (e *encoder) typeEncoder(t reflect.Type) encoderFunc {
-	entry := encoderEntry{t, e.settings}
+	entry := encoderEntry{t, e.dateFormat, e.settings}
 	if fi, ok := encoders.Load(entry); ok {
 		return fi.(encoderFunc)
 	}
@@ -71,9 +76,9 @@ func (e *encoder) typeEncoder(t reflect.Type) encoderFunc {
 		f  encoderFunc
 	)
 	wg.Add(1)
-	fi, loaded := encoders.LoadOrStore(entry, encoderFunc(func(key string, v reflect.Value) []Pair {
+	fi, loaded := encoders.LoadOrStore(entry, encoderFunc(func(pairs []Pair, key string, v reflect.Value) []Pair {
 		wg.Wait()
-		return f(key, v)
+		return f(pairs, key, v)
 	}))
 	if loaded {
 		return fi.(encoderFunc)
@@ -87,15 +92,17 @@ func (e *encoder) typeEncoder(t reflect.Type) encoderFunc {
 }
 
 func (e *encoder) newTypeEncoder(t reflect.Type) encoderFunc {
+	if t.ConvertibleTo(reflect.TypeOf(time.Time{})) {
+		return e.newTimeTypeEncoder(t)
+	}
 	switch t.Kind() {
 	case reflect.Pointer:
 		encoder := e.typeEncoder(t.Elem())
-		return func(key string, value reflect.Value) (pairs []Pair) {
+		return func(pairs []Pair, key string, value reflect.Value) []Pair {
 			if !value.IsValid() || value.IsNil() {
-				return
+				return pairs
 			}
-			pairs = encoder(key, value.Elem())
-			return
+			return encoder(pairs, key, value.Elem())
 		}
 	case reflect.Struct:
 		return e.newStructTypeEncoder(t)
@@ -106,12 +113,12 @@ func (e *encoder) newTypeEncoder(t reflect.Type) encoderFunc {
 	case reflect.Map:
 		return e.newMapEncoder(t)
 	case reflect.Interface:
-		return func(key string, value reflect.Value) []Pair {
+		return func(pairs []Pair, key string, value reflect.Value) []Pair {
 			value = value.Elem()
 			if !value.IsValid() {
-				return nil
+				return pairs
 			}
-			return e.typeEncoder(value.Type())(key, value)
+			return e.typeEncoder(value.Type())(pairs, key, value)
 		}
 	default:
 		return e.newPrimitiveTypeEncoder(t)
@@ -143,10 +150,21 @@ func (e *encoder) newStructTypeEncoder(t reflect.Type) encoderFunc {
 		if !ok {
 			continue
 		}
+		dateFormat, ok := field.Tag.Lookup(formatStructTag)
+		oldFormat := e.dateFormat
+		if ok {
+			switch dateFormat {
+			case "date-time":
+				e.dateFormat = time.RFC3339
+			case "date":
+				e.dateFormat = "2006-01-02"
+			}
+		}
 		fieldEncoders[i] = structField{parseStructTag(tag), e.typeEncoder(field.Type)}
+		e.dateFormat = oldFormat
 	}
 
-	return func(key string, value reflect.Value) (pairs []Pair) {
+	return func(pairs []Pair, key string, value reflect.Value) []Pair {
 		for i, field := range fieldEncoders {
 			if field.omitempty {
 				if !value.IsValid() || value.IsZero() {
@@ -159,32 +177,37 @@ func (e *encoder) newStructTypeEncoder(t reflect.Type) encoderFunc {
 			} else {
 				subkey = e.renderKeyPath(key, subkey)
 			}
-			for _, pair := range field.encoderFunc(subkey, value.Field(i)) {
-				if field.omitempty && len(pair.value) == 0 {
-					continue
+			start := len(pairs)
+			pairs = field.encoderFunc(pairs, subkey, value.Field(i))
+			if field.omitempty {
+				kept := pairs[:start]
+				for _, pair := range pairs[start:] {
+					if len(pair.value) != 0 {
+						kept = append(kept, pair)
+					}
 				}
-				pairs = append(pairs, pair)
+				pairs = kept
 			}
 		}
-		return
+		return pairs
 	}
 }
 
 func (e *encoder) newMapEncoder(t reflect.Type) encoderFunc {
 	keyEncoder := e.typeEncoder(t.Key())
 	elementEncoder := e.typeEncoder(t.Elem())
-	return func(key string, value reflect.Value) (pairs []Pair) {
+	return func(pairs []Pair, key string, value reflect.Value) []Pair {
 		iter := value.MapRange()
 		for iter.Next() {
-			encodedKey := keyEncoder("", iter.Key())
+			encodedKey := keyEncoder(nil, "", iter.Key())
 			if len(encodedKey) != 1 {
 				panic("Unexpected number of parts for encoded map key. Are you using a non-primitive for this map?")
 			}
 			subkey := encodedKey[0].value
 			keyPath := e.renderKeyPath(key, subkey)
-			pairs = append(pairs, elementEncoder(keyPath, iter.Value())...)
+			pairs = elementEncoder(pairs, keyPath, iter.Value())
 		}
-		return
+		return pairs
 	}
 }
 
@@ -193,32 +216,32 @@ func (e *encoder) renderKeyPath(key string, subkey string) string {
 		return subkey
 	}
 	if e.settings.NestedFormat == NestedQueryFormatDots {
-		return fmt.Sprintf("%s.%s", key, subkey)
+		return key + "." + subkey
 	}
-	return fmt.Sprintf("%s[%s]", key, subkey)
+	return key + "[" + subkey + "]"
 }
 
 func (e *encoder) newArrayTypeEncoder(t reflect.Type) encoderFunc {
 	switch e.settings.ArrayFormat {
 	case ArrayQueryFormatComma:
 		innerEncoder := e.typeEncoder(t.Elem())
-		return func(key string, v reflect.Value) []Pair {
+		return func(pairs []Pair, key string, v reflect.Value) []Pair {
 			elements := []string{}
 			for i := 0; i < v.Len(); i++ {
-				for _, pair := range innerEncoder("", v.Index(i)) {
+				for _, pair := range innerEncoder(nil, "", v.Index(i)) {
 					elements = append(elements, pair.value)
 				}
 			}
 			if len(elements) == 0 {
-				return []Pair{}
+				return pairs
 			}
-			return []Pair{{key, strings.Join(elements, ",")}}
+			return append(pairs, Pair{key, strings.Join(elements, ",")})
 		}
 	case ArrayQueryFormatRepeat:
 		innerEncoder := e.typeEncoder(t.Elem())
-		return func(key string, value reflect.Value) (pairs []Pair) {
+		return func(pairs []Pair, key string, value reflect.Value) []Pair {
 			for i := 0; i < value.Len(); i++ {
-				pairs = append(pairs, innerEncoder(key, value.Index(i))...)
+				pairs = innerEncoder(pairs, key, value.Index(i))
 			}
 			return pairs
 		}
@@ -226,10 +249,9 @@ func (e *encoder) newArrayTypeEncoder(t reflect.Type) encoderFunc {
 		panic("The array indices format is not supported yet")
 	case ArrayQueryFormatBrackets:
 		innerEncoder := e.typeEncoder(t.Elem())
-		return func(key string, value reflect.Value) []Pair {
-			pairs := []Pair{}
+		return func(pairs []Pair, key string, value reflect.Value) []Pair {
 			for i := 0; i < value.Len(); i++ {
-				pairs = append(pairs, innerEncoder(key+"[]", value)...)
+				pairs = innerEncoder(pairs, key+"[]", value)
 			}
 			return pairs
 		}
@@ -244,68 +266,75 @@ func (e *encoder) newPrimitiveTypeEncoder(t reflect.Type) encoderFunc {
 		inner := t.Elem()
 
 		innerEncoder := e.newPrimitiveTypeEncoder(inner)
-		return func(key string, v reflect.Value) []Pair {
+		return func(pairs []Pair, key string, v reflect.Value) []Pair {
 			if !v.IsValid() || v.IsNil() {
-				return nil
+				return pairs
 			}
-			return innerEncoder(key, v.Elem())
+			return innerEncoder(pairs, key, v.Elem())
 		}
 	case reflect.String:
-		return func(key string, v reflect.Value) []Pair {
-			return []Pair{{key, v.String()}}
+		return func(pairs []Pair, key string, v reflect.Value) []Pair {
+			return append(pairs, Pair{key, v.String()})
 		}
 	case reflect.Bool:
-		return func(key string, v reflect.Value) []Pair {
+		return func(pairs []Pair, key string, v reflect.Value) []Pair {
 			if v.Bool() {
-				return []Pair{{key, "true"}}
+				return append(pairs, Pair{key, "true"})
 			}
-			return []Pair{{key, "false"}}
+			return append(pairs, Pair{key, "false"})
 		}
 	case reflect.Int, reflect.Int16, reflect.Int32, reflect.Int64:
-		return func(key string, v reflect.Value) []Pair {
-			return []Pair{{key, strconv.FormatInt(v.Int(), 10)}}
+		return func(pairs []Pair, key string, v reflect.Value) []Pair {
+			return append(pairs, Pair{key, strconv.FormatInt(v.Int(), 10)})
 		}
 	case reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return func(key string, v reflect.Value) []Pair {
-			return []Pair{{key, strconv.FormatUint(v.Uint(), 10)}}
+		return func(pairs []Pair, key string, v reflect.Value) []Pair {
+			return append(pairs, Pair{key, strconv.FormatUint(v.Uint(), 10)})
 		}
 	case reflect.Float32, reflect.Float64:
-		return func(key string, v reflect.Value) []Pair {
-			return []Pair{{key, strconv.FormatFloat(v.Float(), 'f', -1, 64)}}
+		return func(pairs []Pair, key string, v reflect.Value) []Pair {
+			return append(pairs, Pair{key, strconv.FormatFloat(v.Float(), 'f', -1, 64)})
 		}
 	case reflect.Complex64, reflect.Complex128:
 		bitSize := 64
 		if t.Kind() == reflect.Complex128 {
 			bitSize = 128
 		}
-		return func(key string, v reflect.Value) []Pair {
-			return []Pair{{key, strconv.FormatComplex(v.Complex(), 'f', -1, bitSize)}}
+		return func(pairs []Pair, key string, v reflect.Value) []Pair {
+			return append(pairs, Pair{key, strconv.FormatComplex(v.Complex(), 'f', -1, bitSize)})
 		}
 	default:
-		return func(key string, v reflect.Value) []Pair {
-			return nil
+		return func(pairs []Pair, key string, v reflect.Value) []Pair {
+			return pairs
 		}
 	}
 }
 
+func (e *encoder) newTimeTypeEncoder(t reflect.Type) encoderFunc {
+	format := e.dateFormat
+	return func(pairs []Pair, key string, value reflect.Value) []Pair {
+		return append(pairs, Pair{key, value.Convert(reflect.TypeOf(time.Time{})).Interface().(time.Time).Format(format)})
+	}
+}
+
 func (e *encoder) newFieldTypeEncoder(t reflect.Type) encoderFunc {
 	f, _ := t.FieldByName("Value")
 	enc := e.typeEncoder(f.Type)
 
-	return func(key string, value reflect.Value) []Pair {
+	return func(pairs []Pair, key string, value reflect.Value) []Pair {
 		present := value.FieldByName("Present")
 		if !present.Bool() {
-			return nil
+			return pairs
 		}
 		null := value.FieldByName("Null")
 		if null.Bool() {
 			// TODO: Error?
-			return nil
+			return pairs
 		}
 		raw := value.FieldByName("Raw")
 		if !raw.IsNil() {
-			return e.typeEncoder(raw.Type())(key, raw)
+			return e.typeEncoder(raw.Type())(pairs, key, raw)
 		}
-		return enc(key, value.FieldByName("Value"))
+		return enc(pairs, key, value.FieldByName("Value"))
 	}
 }
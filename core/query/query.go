@@ -3,22 +3,39 @@ package query
 import (
 	"net/url"
 	"reflect"
+	"sync"
+	"time"
 )
 
 const queryStructTag = "query"
 const pathParamStructTag = "pathparam"
+const formatStructTag = "format"
+
+// Pool of pair slices reused across calls to MarshalWithSettings, since the
+// pairs never outlive a single call.
+var pairsPool = sync.Pool{
+	New: func() any {
+		pairs := make([]Pair, 0, 16)
+		return &pairs
+	},
+}
 
 func MarshalWithSettings(value interface{}, settings QuerySettings) url.Values {
-	e := encoder{settings}
-	kv := url.Values{}
+	e := encoder{time.RFC3339, settings}
 	val := reflect.ValueOf(value)
 	if !val.IsValid() {
 		return nil
 	}
 	typ := val.Type()
-	for _, pair := range e.typeEncoder(typ)("", val) {
+
+	buf := pairsPool.Get().(*[]Pair)
+	pairs := e.typeEncoder(typ)((*buf)[:0], "", val)
+	kv := make(url.Values, len(pairs))
+	for _, pair := range pairs {
 		kv.Add(pair.key, pair.value)
 	}
+	*buf = pairs[:0]
+	pairsPool.Put(buf)
 	return kv
 }
 
@@ -3,8 +3,10 @@ package query
 import (
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/lithic-com/lithic-go/core/pointers"
+	"github.com/lithic-com/lithic-go/fields"
 )
 
 type EmptyTestC struct {
@@ -40,6 +42,17 @@ func TestBasic(t *testing.T) {
 	assert(t, BasicTest{A: pointers.P(1.23456)}, "a=1.23456", QuerySettings{})
 }
 
+type TimeTest struct {
+	DateTime time.Time               `query:"date_time" format:"date-time"`
+	Date     time.Time               `query:"date" format:"date"`
+	Field    fields.Field[time.Time] `query:"field" format:"date-time"`
+}
+
+func TestTime(t *testing.T) {
+	at := time.Date(2023, time.March, 1, 13, 4, 5, 0, time.UTC)
+	assert(t, TimeTest{DateTime: at, Date: at, Field: fields.F(at)}, "date=2023-03-01&date_time=2023-03-01T13:04:05Z&field=2023-03-01T13:04:05Z", QuerySettings{})
+}
+
 func serialize(v interface{}, options QuerySettings) string {
 	escaped := MarshalWithSettings(v, options).Encode()
 	unescaped, err := url.QueryUnescape(escaped)
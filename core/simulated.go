@@ -0,0 +1,5 @@
+package core
+
+// SimulatedMarker is the marker that options.WithSimulatedMarker appends to
+// the memos of resources created in the sandbox by default.
+const SimulatedMarker = "[simulated]"
@@ -0,0 +1,5 @@
+package core
+
+// PackageVersion is the version of this SDK. It is sent to the API in the
+// X-Stainless-Package-Version header and returned by lithic.Version.
+const PackageVersion = "0.1.0"
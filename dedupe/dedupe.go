@@ -0,0 +1,166 @@
+// Package dedupe filters out the events of a stream whose tokens have already
+// been seen, so that an event that is delivered more than once, by webhook
+// retries or by a backfill with EventService.List that overlaps the webhooks,
+// is processed once.
+//
+// A Filter remembers tokens for a TTL, and at most a maximum number of them, evicting the
+// oldest first, so that its memory is bounded:
+//
+//	filter := dedupe.New(dedupe.DefaultMaxKeys, dedupe.DefaultTTL)
+//	http.Handle("/webhooks", filter.Handler(webhookHandler))
+//
+//	for iter := page.Iterator(); iter.Next(ctx); {
+//		if filter.Seen(iter.Current().Token) {
+//			continue
+//		}
+//		process(iter.Current())
+//	}
+package dedupe
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+const (
+	// The default maximum number of tokens that a Filter remembers.
+	DefaultMaxKeys = 100000
+	// The default time for which a Filter remembers a token, which covers the
+	// retries of a webhook.
+	DefaultTTL = 72 * time.Hour
+)
+
+// The header of a webhook that holds the token of its event, which is the same
+// across retries.
+const webhookIDHeader = "webhook-id"
+
+// Filter remembers the tokens that it has seen. It is safe for concurrent use.
+type Filter struct {
+	maxKeys int
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu    sync.Mutex
+	seen  map[string]*list.Element
+	order *list.List
+}
+
+type entry struct {
+	token string
+	at    time.Time
+}
+
+// New returns a Filter that remembers at most maxKeys tokens, each for ttl.
+// Non-positive values are replaced by DefaultMaxKeys and DefaultTTL.
+func New(maxKeys int, ttl time.Duration) *Filter {
+	if maxKeys <= 0 {
+		maxKeys = DefaultMaxKeys
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Filter{maxKeys: maxKeys, ttl: ttl, now: time.Now, seen: map[string]*list.Element{}, order: list.New()}
+}
+
+// Seen reports whether token was seen within the TTL, and remembers it if it
+// was not. The TTL of a token starts when it is first seen.
+func (f *Filter) Seen(token string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := f.now()
+	f.evict(now)
+	if _, ok := f.seen[token]; ok {
+		return true
+	}
+	f.seen[token] = f.order.PushBack(entry{token, now})
+	if f.order.Len() > f.maxKeys {
+		f.remove(f.order.Front())
+	}
+	return false
+}
+
+// Forget removes token, so that it is processed again the next time that it is
+// seen, for example after processing it failed.
+func (f *Filter) Forget(token string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if element, ok := f.seen[token]; ok {
+		f.remove(element)
+	}
+}
+
+// Len returns the number of tokens remembered, including expired tokens that
+// have not been evicted yet.
+func (f *Filter) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.order.Len()
+}
+
+// Events returns the events whose tokens were not seen before, in order.
+func (f *Filter) Events(events []responses.Event) []responses.Event {
+	var unseen []responses.Event
+	for _, event := range events {
+		if !f.Seen(event.Token) {
+			unseen = append(unseen, event)
+		}
+	}
+	return unseen
+}
+
+// Handler returns a webhook handler that acknowledges the webhooks whose event
+// tokens, in their webhook-id header, were already seen, without passing them
+// on to next. Webhooks that next fails to handle, with a status code of 300 or
+// above or a panic, are forgotten so that their retries are handled. Webhooks
+// without a webhook-id header are always passed on.
+func (f *Filter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(webhookIDHeader)
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if f.Seen(token) {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		handled := false
+		defer func() {
+			// A handler that panics did not handle the webhook either.
+			if !handled || recorder.status >= 300 {
+				f.Forget(token)
+			}
+		}()
+		next.ServeHTTP(recorder, r)
+		handled = true
+	})
+}
+
+func (f *Filter) evict(now time.Time) {
+	for element := f.order.Front(); element != nil; element = f.order.Front() {
+		if now.Sub(element.Value.(entry).at) < f.ttl {
+			return
+		}
+		f.remove(element)
+	}
+}
+
+func (f *Filter) remove(element *list.Element) {
+	delete(f.seen, element.Value.(entry).token)
+	f.order.Remove(element)
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,77 @@
+package dedupe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestSeenExpiresAndIsBounded(t *testing.T) {
+	now := time.Unix(0, 0)
+	f := New(2, time.Hour)
+	f.now = func() time.Time { return now }
+
+	if f.Seen("a") || !f.Seen("a") {
+		t.Fatal("expected a to be seen on its second delivery only")
+	}
+	now = now.Add(30 * time.Minute)
+	f.Seen("b")
+	f.Seen("c")
+	if f.Len() != 2 || !f.Seen("b") {
+		t.Fatalf("expected the oldest token to be evicted, got %d tokens", f.Len())
+	}
+	if f.Seen("a") {
+		t.Fatal("expected a to have been evicted")
+	}
+	now = now.Add(time.Hour)
+	if f.Seen("b") {
+		t.Fatal("expected b to have expired")
+	}
+}
+
+func TestEvents(t *testing.T) {
+	f := New(0, 0)
+	f.Seen("ev_2")
+	events := f.Events([]responses.Event{{Token: "ev_1"}, {Token: "ev_2"}, {Token: "ev_1"}, {Token: "ev_3"}})
+	if len(events) != 2 || events[0].Token != "ev_1" || events[1].Token != "ev_3" {
+		t.Fatalf("unexpected events %+v", events)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	calls := 0
+	status := http.StatusInternalServerError
+	handler := New(0, 0).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls += 1
+		w.WriteHeader(status)
+	}))
+	deliver := func(id string) int {
+		req := httptest.NewRequest("POST", "/webhooks", nil)
+		if id != "" {
+			req.Header.Set("webhook-id", id)
+		}
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		return res.Code
+	}
+
+	if deliver("msg_1") != http.StatusInternalServerError {
+		t.Fatal("expected the failure to be returned")
+	}
+	status = http.StatusOK
+	// The retry of a failed webhook is handled again.
+	if deliver("msg_1") != http.StatusOK || calls != 2 {
+		t.Fatalf("expected the retry to be handled, got %d calls", calls)
+	}
+	if deliver("msg_1") != http.StatusOK || calls != 2 {
+		t.Fatalf("expected the duplicate to be acknowledged only, got %d calls", calls)
+	}
+	deliver("")
+	deliver("")
+	if calls != 4 {
+		t.Fatalf("expected webhooks without an ID to be passed on, got %d calls", calls)
+	}
+}
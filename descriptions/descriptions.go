@@ -0,0 +1,164 @@
+// Package descriptions maps API enum values, such as transaction results and
+// card states, to human-readable descriptions suitable for cardholder-facing
+// apps.
+//
+// Every description has a stable key, for example
+// "transaction_result.INSUFFICIENT_FUNDS", and an English default. Apps that
+// serve other languages plug in a Translator that looks descriptions up by key.
+package descriptions
+
+import (
+	"strings"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// Kinds of enums that descriptions are provided for. A key is the kind and the
+// enum value joined by a dot.
+const (
+	KindCardState         = "card_state"
+	KindShippingMethod    = "shipping_method"
+	KindTransactionResult = "transaction_result"
+	KindTransactionStatus = "transaction_status"
+)
+
+// Translator translates descriptions, for example into the language of the
+// cardholder. Translate returns false if it has no translation for the key, in
+// which case the English description is used.
+type Translator interface {
+	Translate(key string, english string) (string, bool)
+}
+
+// TranslatorFunc adapts a function to a Translator.
+type TranslatorFunc func(key string, english string) (string, bool)
+
+func (f TranslatorFunc) Translate(key string, english string) (string, bool) {
+	return f(key, english)
+}
+
+// Map is a Translator backed by a map from keys to translations, such as one
+// loaded from a message catalog.
+type Map map[string]string
+
+func (m Map) Translate(key string, english string) (string, bool) {
+	s, ok := m[key]
+	return s, ok
+}
+
+// Catalog describes enum values, translated with its Translator if it has one.
+type Catalog struct {
+	Translator Translator
+}
+
+// Default is the Catalog used by the package level functions, which describes
+// values in English.
+var Default = &Catalog{}
+
+func New(translator Translator) *Catalog {
+	return &Catalog{Translator: translator}
+}
+
+// Describe returns the description of value, an enum of the given kind. Values
+// without a description, such as ones added to the API after this version of
+// the SDK, are described by their name in sentence case.
+func (c *Catalog) Describe(kind string, value string) string {
+	key := kind + "." + value
+	english, ok := defaults[key]
+	if !ok {
+		english = sentenceCase(value)
+	}
+	if c != nil && c.Translator != nil {
+		if s, ok := c.Translator.Translate(key, english); ok {
+			return s
+		}
+	}
+	return english
+}
+
+func (c *Catalog) CardState(state responses.CardState) string {
+	return c.Describe(KindCardState, string(state))
+}
+
+// ShippingMethod describes any of the shipping method enums, such as
+// requests.CardNewParamsShippingMethod.
+func (c *Catalog) ShippingMethod(method string) string {
+	return c.Describe(KindShippingMethod, method)
+}
+
+// TransactionResult describes why a transaction was approved or declined.
+func (c *Catalog) TransactionResult(result responses.TransactionResult) string {
+	return c.Describe(KindTransactionResult, string(result))
+}
+
+func (c *Catalog) TransactionStatus(status responses.TransactionStatus) string {
+	return c.Describe(KindTransactionStatus, string(status))
+}
+
+func Describe(kind string, value string) string {
+	return Default.Describe(kind, value)
+}
+
+func CardState(state responses.CardState) string {
+	return Default.CardState(state)
+}
+
+func ShippingMethod(method string) string {
+	return Default.ShippingMethod(method)
+}
+
+func TransactionResult(result responses.TransactionResult) string {
+	return Default.TransactionResult(result)
+}
+
+func TransactionStatus(status responses.TransactionStatus) string {
+	return Default.TransactionStatus(status)
+}
+
+func sentenceCase(value string) string {
+	s := strings.ToLower(strings.ReplaceAll(value, "_", " "))
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+var defaults = map[string]string{
+	"card_state.CLOSED":              "Closed",
+	"card_state.OPEN":                "Active",
+	"card_state.PAUSED":              "Paused",
+	"card_state.PENDING_ACTIVATION":  "Awaiting activation",
+	"card_state.PENDING_FULFILLMENT": "Being prepared for shipment",
+
+	"shipping_method.STANDARD":               "Standard mail",
+	"shipping_method.STANDARD_WITH_TRACKING": "Standard mail with tracking",
+	"shipping_method.EXPEDITED":              "Expedited shipping",
+
+	"transaction_result.ACCOUNT_STATE_TRANSACTION": "Declined because the account is not active",
+	"transaction_result.APPROVED":                  "Approved",
+	"transaction_result.BANK_CONNECTION_ERROR":     "Declined because the funding bank account could not be reached",
+	"transaction_result.BANK_NOT_VERIFIED":         "Declined because the funding bank account is not verified",
+	"transaction_result.CARD_CLOSED":               "Declined because the card is closed",
+	"transaction_result.CARD_PAUSED":               "Declined because the card is paused",
+	"transaction_result.FRAUD_ADVICE":              "Declined as suspected fraud",
+	"transaction_result.GLOBAL_TRANSACTION_LIMIT":  "Declined because it exceeds the program's transaction limit",
+	"transaction_result.GLOBAL_WEEKLY_LIMIT":       "Declined because it exceeds the program's weekly limit",
+	"transaction_result.GLOBAL_MONTHLY_LIMIT":      "Declined because it exceeds the program's monthly limit",
+	"transaction_result.INACTIVE_ACCOUNT":          "Declined because the account is inactive",
+	"transaction_result.INCORRECT_PIN":             "Declined because the PIN was incorrect",
+	"transaction_result.INVALID_CARD_DETAILS":      "Declined because the card details were incorrect",
+	"transaction_result.INSUFFICIENT_FUNDS":        "Declined due to insufficient funds",
+	"transaction_result.MERCHANT_BLACKLIST":        "Declined because the merchant is blocked",
+	"transaction_result.SINGLE_USE_RECHARGED":      "Declined because the single-use card was already used",
+	"transaction_result.SWITCH_INOPERATIVE_ADVICE": "Declined because the card network was unavailable",
+	"transaction_result.UNAUTHORIZED_MERCHANT":     "Declined because the card is locked to another merchant",
+	"transaction_result.UNKNOWN_HOST_TIMEOUT":      "Declined because the authorization timed out",
+	"transaction_result.USER_TRANSACTION_LIMIT":    "Declined because it exceeds the card's spend limit",
+
+	"transaction_status.BOUNCED":  "Bounced",
+	"transaction_status.DECLINED": "Declined",
+	"transaction_status.EXPIRED":  "Expired",
+	"transaction_status.PENDING":  "Pending",
+	"transaction_status.SETTLED":  "Completed",
+	"transaction_status.SETTLING": "Completing",
+	"transaction_status.VOIDED":   "Voided",
+}
@@ -0,0 +1,30 @@
+package descriptions
+
+import (
+	"testing"
+
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestDescribe(t *testing.T) {
+	if s := TransactionResult(responses.TransactionResultInsufficientFunds); s != "Declined due to insufficient funds" {
+		t.Fatalf("unexpected description %q", s)
+	}
+	if s := ShippingMethod(string(requests.CardNewParamsShippingMethodExpedited)); s != "Expedited shipping" {
+		t.Fatalf("unexpected description %q", s)
+	}
+	if s := CardState("PENDING_SOMETHING_NEW"); s != "Pending something new" {
+		t.Fatalf("expected unknown values to fall back to their name, got %q", s)
+	}
+}
+
+func TestTranslator(t *testing.T) {
+	catalog := New(Map{"card_state.PAUSED": "En pause"})
+	if s := catalog.CardState(responses.CardStatePaused); s != "En pause" {
+		t.Fatalf("expected the translation, got %q", s)
+	}
+	if s := catalog.CardState(responses.CardStateOpen); s != "Active" {
+		t.Fatalf("expected missing translations to fall back to English, got %q", s)
+	}
+}
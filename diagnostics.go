@@ -0,0 +1,28 @@
+package lithic
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/lithic-com/lithic-go/options"
+)
+
+// ErrDiagnosticsDisabled is returned by DumpDiagnostics if the client was not
+// created with options.WithDiagnostics.
+var ErrDiagnosticsDisabled = errors.New("lithic: diagnostics are not enabled, see options.WithDiagnostics")
+
+// DumpDiagnostics writes the last round trips captured by
+// options.WithDiagnostics to w, as a support bundle to attach to a ticket about
+// unexpected API behavior. Credentials and sensitive fields are redacted, and
+// every round trip includes its request ID.
+func (r *Lithic) DumpDiagnostics(w io.Writer) error {
+	cfg, err := options.NewRequestConfig(context.Background(), "GET", "", nil, nil, r.Options...)
+	if err != nil {
+		return err
+	}
+	if cfg.Diagnostics == nil {
+		return ErrDiagnosticsDisabled
+	}
+	return cfg.Diagnostics.WriteBundle(w)
+}
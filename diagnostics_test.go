@@ -0,0 +1,55 @@
+package lithic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/options"
+)
+
+func TestDumpDiagnostics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_123")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"Card not found"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewLithic(options.WithBaseURL(server.URL), options.WithAPIKey("secret_key"), options.WithDiagnostics(10))
+	if _, err := client.Cards.Get(context.Background(), "card_token"); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	var dump strings.Builder
+	if err := client.DumpDiagnostics(&dump); err != nil {
+		t.Fatal(err)
+	}
+	var bundle struct {
+		Entries []options.DiagnosticsEntry `json:"entries"`
+	}
+	if err := json.Unmarshal([]byte(dump.String()), &bundle); err != nil {
+		t.Fatal(err)
+	}
+	if len(bundle.Entries) != 1 {
+		t.Fatalf("expected one entry, got %s", dump.String())
+	}
+	entry := bundle.Entries[0]
+	if entry.RequestID != "req_123" || entry.Status != http.StatusNotFound || !strings.HasSuffix(entry.URL, "/cards/card_token") {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+	if strings.Contains(dump.String(), "secret_key") {
+		t.Fatalf("expected the API key to be redacted, got %s", dump.String())
+	}
+}
+
+func TestDumpDiagnosticsDisabled(t *testing.T) {
+	client := NewLithic(options.WithAPIKey("APIKey"))
+	if err := client.DumpDiagnostics(&strings.Builder{}); err != ErrDiagnosticsDisabled {
+		t.Fatalf("expected ErrDiagnosticsDisabled, got %v", err)
+	}
+}
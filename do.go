@@ -0,0 +1,55 @@
+package lithic
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/lithic-com/lithic-go/core/form"
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/options"
+)
+
+// Do calls an endpoint that this SDK does not bind yet, with the options of the
+// client, so that authentication, retries, middleware and error handling apply
+// as they do to any other call. path is relative to the base URL, such as
+// "cards/{card_token}/new_action", and may include a query string.
+//
+// body is sent as JSON, except for url.Values, which are added to the query
+// string, readers, which are sent as is, and the params types of the requests
+// package, which are sent as they are by the services. A nil body sends none.
+//
+// out is decoded from the JSON response like it is by the services. It may be
+// any type that encoding/json can decode into, a *[]byte for the raw body, an
+// *options.ResponseMetadata, or nil to discard the body.
+func (r *Lithic) Do(ctx context.Context, method string, path string, body interface{}, out interface{}, opts ...options.RequestOption) error {
+	opts = append(r.Options[:], opts...)
+	switch b := body.(type) {
+	case nil, json.Marshaler, form.Marshaler, query.Queryer, io.Reader:
+	case url.Values:
+		body = nil
+		if len(b) != 0 {
+			separator := "?"
+			if strings.Contains(path, "?") {
+				separator = "&"
+			}
+			path += separator + b.Encode()
+		}
+	default:
+		contents, err := json.Marshal(b)
+		if err != nil {
+			return err
+		}
+		body = rawJSON(contents)
+	}
+	return options.ExecuteNewRequest(ctx, method, path, body, out, opts...)
+}
+
+// rawJSON is a JSON body that is sent as is.
+type rawJSON []byte
+
+func (r rawJSON) MarshalJSON() ([]byte, error) {
+	return r, nil
+}
@@ -0,0 +1,62 @@
+package lithic
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/options"
+)
+
+func TestDo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/cards/card_token/freeze":
+			if r.Method != "POST" || string(body) != `{"reason":"lost"}` || r.Header.Get("Content-Type") != "application/json" || r.Header.Get("Authorization") != "api_key" {
+				t.Errorf("unexpected request %s %s %s", r.Method, body, r.Header)
+			}
+			w.Write([]byte(`{"token":"card_token","frozen":true}`))
+		case "/widgets":
+			if r.URL.RawQuery != "page_size=1&state=OPEN" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"data":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	client := NewLithic(options.WithBaseURL(server.URL), options.WithAPIKey("api_key"), options.WithMaxRetries(0))
+
+	var out struct {
+		Token  string `json:"token"`
+		Frozen bool   `json:"frozen"`
+	}
+	if err := client.Do(context.Background(), "POST", "cards/card_token/freeze", map[string]string{"reason": "lost"}, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Token != "card_token" || !out.Frozen {
+		t.Fatalf("unexpected response %+v", out)
+	}
+
+	var raw []byte
+	if err := client.Do(context.Background(), "GET", "widgets?page_size=1", url.Values{"state": {"OPEN"}}, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != `{"data":[]}` {
+		t.Fatalf("expected the raw body, got %s", raw)
+	}
+
+	err := client.Do(context.Background(), "DELETE", "missing", nil, nil)
+	if !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
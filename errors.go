@@ -0,0 +1,19 @@
+package lithic
+
+import "github.com/lithic-com/lithic-go/core"
+
+// Error is an error response of the API. Errors returned by the client for
+// error responses wrap an *Error, which can be retrieved with errors.As.
+type Error = core.Error
+
+// Sentinel errors that API errors match with errors.Is, by their status code.
+var (
+	ErrBadRequest          = core.ErrBadRequest
+	ErrUnauthorized        = core.ErrUnauthorized
+	ErrForbidden           = core.ErrForbidden
+	ErrNotFound            = core.ErrNotFound
+	ErrConflict            = core.ErrConflict
+	ErrUnprocessableEntity = core.ErrUnprocessableEntity
+	ErrRateLimited         = core.ErrRateLimited
+	ErrServer              = core.ErrServer
+)
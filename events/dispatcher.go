@@ -0,0 +1,100 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// HandlerFunc handles an event, whose payload was decoded into a pointer to
+// the struct registered for its type.
+type HandlerFunc func(ctx context.Context, event *responses.Event, payload interface{}) error
+
+// Dispatcher calls the handlers registered for the type of each event. It is
+// safe for concurrent use.
+type Dispatcher struct {
+	// Called for events that have no handler, if it is not nil. Their payload
+	// is decoded if their type is registered, and is nil otherwise.
+	Unhandled HandlerFunc
+
+	mu       sync.RWMutex
+	handlers map[string][]HandlerFunc
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{handlers: map[string][]HandlerFunc{}}
+}
+
+// On registers a handler for the events of eventType. Handlers are called in
+// the order they were registered.
+func (d *Dispatcher) On(eventType responses.EventEventType, handler HandlerFunc) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.handlers == nil {
+		d.handlers = map[string][]HandlerFunc{}
+	}
+	d.handlers[string(eventType)] = append(d.handlers[string(eventType)], handler)
+}
+
+// Handle registers a handler for the events of eventType that receives their
+// payload as a *T. It panics if T is not the struct registered for eventType.
+func Handle[T any](d *Dispatcher, eventType responses.EventEventType, handler func(ctx context.Context, event *responses.Event, payload *T) error) {
+	payload, ok := New(string(eventType))
+	if !ok {
+		panic(fmt.Sprintf("lithic: unknown event type %q", eventType))
+	}
+	if _, ok := payload.(*T); !ok {
+		panic(fmt.Sprintf("lithic: the payload of %s is a %s, not a %s", eventType, reflect.TypeOf(payload), reflect.TypeOf((*T)(nil))))
+	}
+	d.On(eventType, func(ctx context.Context, event *responses.Event, payload interface{}) error {
+		return handler(ctx, event, payload.(*T))
+	})
+}
+
+// Handles reports whether Dispatch calls a handler for the events of
+// eventType, including Unhandled.
+func (d *Dispatcher) Handles(eventType responses.EventEventType) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return len(d.handlers[string(eventType)]) != 0 || d.Unhandled != nil
+}
+
+// Dispatch decodes the payload of event and calls the handlers registered for
+// its type, stopping at the first one that returns an error. Events of a type
+// without handlers are passed to Unhandled, or ignored.
+func (d *Dispatcher) Dispatch(ctx context.Context, event *responses.Event) error {
+	d.mu.RLock()
+	handled := len(d.handlers[string(event.EventType)]) != 0
+	d.mu.RUnlock()
+	if !handled && d.Unhandled == nil {
+		return nil
+	}
+	payload, err := Decode(event)
+	if err != nil && handled {
+		return err
+	}
+	return d.DispatchPayload(ctx, event, payload)
+}
+
+// DispatchPayload is like Dispatch, for an event whose payload was already
+// decoded, such as by Decode.
+func (d *Dispatcher) DispatchPayload(ctx context.Context, event *responses.Event, payload interface{}) error {
+	d.mu.RLock()
+	handlers := d.handlers[string(event.EventType)]
+	d.mu.RUnlock()
+	if len(handlers) == 0 {
+		if d.Unhandled == nil {
+			return nil
+		}
+		return d.Unhandled(ctx, event, payload)
+	}
+	for _, handler := range handlers {
+		if err := handler(ctx, event, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// Package events decodes the payloads of Lithic events into typed structs, and
+// dispatches events to handlers registered per event type.
+//
+// Every documented event type is registered with the struct that its payload
+// decodes into, such as *responses.Transaction for `card_transaction.updated`.
+// Unmarshal decodes a raw payload given its event type, and Decode the payload
+// of a responses.Event:
+//
+//	payload, err := events.Decode(event)
+//	if tx, ok := payload.(*responses.Transaction); ok {
+//		...
+//	}
+//
+// A Dispatcher calls the handler registered for the type of each event with
+// its decoded payload:
+//
+//	d := events.NewDispatcher()
+//	events.Handle(d, responses.EventEventTypeCardTransactionUpdated, func(ctx context.Context, event *responses.Event, tx *responses.Transaction) error {
+//		return ledger.Record(ctx, tx)
+//	})
+//	err := d.Dispatch(ctx, event)
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// UnknownEventTypeError is returned for events whose type is not registered.
+type UnknownEventTypeError struct {
+	EventType string
+}
+
+func (e *UnknownEventTypeError) Error() string {
+	return fmt.Sprintf("lithic: unknown event type %q", e.EventType)
+}
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]reflect.Type{}
+)
+
+func init() {
+	register(responses.EventEventTypeAccountHolderCreated, responses.AccountHolderCreatedEvent{})
+	register(responses.EventEventTypeAccountHolderUpdated, responses.AccountHolderUpdatedEvent{})
+	register(responses.EventEventTypeAccountHolderVerification, responses.AccountHolderVerificationEvent{})
+	register(responses.EventEventTypeBalanceUpdated, responses.BalanceUpdatedEvent{})
+	register(responses.EventEventTypeCardCreated, responses.CardCreatedEvent{})
+	register(responses.EventEventTypeCardRenewed, responses.CardRenewedEvent{})
+	register(responses.EventEventTypeCardShipped, responses.CardShippedEvent{})
+	register(responses.EventEventTypeCardTransactionUpdated, responses.Transaction{})
+	register(responses.EventEventTypeDigitalWalletTokenizationApprovalRequest, responses.DigitalWalletTokenizationApprovalRequestEvent{})
+	register(responses.EventEventTypeDigitalWalletTokenizationResult, responses.DigitalWalletTokenizationResultEvent{})
+	register(responses.EventEventTypeDigitalWalletTokenizationTwoFactorAuthenticationCode, responses.DigitalWalletTokenizationTwoFactorAuthenticationCodeEvent{})
+	register(responses.EventEventTypeDisputeUpdated, responses.Dispute{})
+	register(responses.EventEventTypeDisputeEvidenceUploadFailed, responses.DisputeEvidence{})
+	register(responses.EventEventTypeExternalBankAccountCreated, responses.ExternalBankAccount{})
+	register(responses.EventEventTypeExternalBankAccountUpdated, responses.ExternalBankAccount{})
+	register(responses.EventEventTypeFinancialAccountCreated, responses.FinancialAccount{})
+	register(responses.EventEventTypeSettlementReportUpdated, responses.SettlementReport{})
+	register(responses.EventEventTypeStatementsCreated, responses.Statement{})
+	register(responses.EventEventTypeThreeDSAuthenticationCreated, responses.ThreeDSAuthentication{})
+}
+
+func register(eventType responses.EventEventType, payload interface{}) {
+	registry[string(eventType)] = reflect.TypeOf(payload)
+}
+
+// Register registers the struct that the payloads of eventType decode into,
+// for event types that this version of the SDK does not know yet, or to
+// replace the struct of a known one. payload is a value of the struct, such as
+// MyEvent{}, and payloads are decoded into a new *MyEvent.
+func Register(eventType string, payload interface{}) {
+	t := reflect.TypeOf(payload)
+	if t == nil || t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("lithic: the payload of %s must be a struct, got %T", eventType, payload))
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	registry[eventType] = t
+}
+
+// Types returns the registered event types, sorted.
+func Types() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	types := make([]string, 0, len(registry))
+	for eventType := range registry {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// New returns a pointer to a new value of the struct that the payloads of
+// eventType decode into, and false if eventType is not registered.
+func New(eventType string) (interface{}, bool) {
+	mu.RLock()
+	t, ok := registry[eventType]
+	mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return reflect.New(t).Interface(), true
+}
+
+// Unmarshal decodes the payload of an event of eventType into a pointer to its
+// registered struct. It returns an *UnknownEventTypeError if eventType is not
+// registered.
+func Unmarshal(eventType string, payload []byte) (interface{}, error) {
+	dst, ok := New(eventType)
+	if !ok {
+		return nil, &UnknownEventTypeError{EventType: eventType}
+	}
+	if err := pjson.Unmarshal(payload, dst); err != nil {
+		return nil, fmt.Errorf("lithic: cannot decode %s payload: %w", eventType, err)
+	}
+	return dst, nil
+}
+
+// Decode decodes the payload of event, see Unmarshal.
+func Decode(event *responses.Event) (interface{}, error) {
+	payload := event.JSON.Payload.Raw()
+	if len(payload) == 0 && event.Payload != nil {
+		// The event was not decoded from JSON, but built in code.
+		var err error
+		if payload, err = json.Marshal(event.Payload); err != nil {
+			return nil, err
+		}
+	}
+	return Unmarshal(string(event.EventType), payload)
+}
@@ -0,0 +1,127 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func decodeEvent(t *testing.T, data string) *responses.Event {
+	t.Helper()
+	event := &responses.Event{}
+	if err := json.Unmarshal([]byte(data), event); err != nil {
+		t.Fatal(err)
+	}
+	return event
+}
+
+func TestUnmarshal(t *testing.T) {
+	payload, err := Unmarshal("card_transaction.updated", []byte(`{"token":"tx","amount":1000,"status":"PENDING"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, ok := payload.(*responses.Transaction)
+	if !ok || tx.Token != "tx" || tx.Amount != 1000 {
+		t.Fatalf("expected a transaction, got %#v", payload)
+	}
+
+	payload, err = Unmarshal("digital_wallet.tokenization_approval_request", []byte(`{"card_token":"card","issuer_decision":"APPROVED","wallet_decisioning_info":{"device_score":"5"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request, ok := payload.(*responses.DigitalWalletTokenizationApprovalRequestEvent)
+	if !ok || request.CardToken != "card" || request.WalletDecisioningInfo.DeviceScore != "5" {
+		t.Fatalf("expected an approval request, got %#v", payload)
+	}
+
+	var unknown *UnknownEventTypeError
+	if _, err := Unmarshal("card.exploded", []byte(`{}`)); !errors.As(err, &unknown) || unknown.EventType != "card.exploded" {
+		t.Fatalf("expected an unknown event type error, got %v", err)
+	}
+}
+
+func TestEveryEventTypeIsRegistered(t *testing.T) {
+	types := strings.Join(Types(), ",")
+	for _, eventType := range []responses.EventEventType{
+		responses.EventEventTypeCardCreated,
+		responses.EventEventTypeCardTransactionUpdated,
+		responses.EventEventTypeDisputeUpdated,
+		responses.EventEventTypeDigitalWalletTokenizationApprovalRequest,
+		responses.EventEventTypeStatementsCreated,
+	} {
+		if !strings.Contains(types, string(eventType)) {
+			t.Errorf("expected %s to be registered", eventType)
+		}
+		payload, ok := New(string(eventType))
+		if !ok {
+			continue
+		}
+		if _, err := Unmarshal(string(eventType), []byte(`{}`)); err != nil {
+			t.Errorf("expected an empty %s payload to decode into %T, got %v", eventType, payload, err)
+		}
+	}
+}
+
+type cardExplodedEvent struct {
+	CardToken string `json:"card_token"`
+}
+
+func TestRegister(t *testing.T) {
+	Register("card.exploded", cardExplodedEvent{})
+	defer func() {
+		mu.Lock()
+		delete(registry, "card.exploded")
+		mu.Unlock()
+	}()
+	payload, err := Unmarshal("card.exploded", []byte(`{"card_token":"card"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event, ok := payload.(*cardExplodedEvent); !ok || event.CardToken != "card" {
+		t.Fatalf("expected the registered struct, got %#v", payload)
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	d := NewDispatcher()
+	var calls []string
+	Handle(d, responses.EventEventTypeCardCreated, func(ctx context.Context, event *responses.Event, payload *responses.CardCreatedEvent) error {
+		calls = append(calls, "created:"+payload.CardToken)
+		return nil
+	})
+	d.On(responses.EventEventTypeCardCreated, func(ctx context.Context, event *responses.Event, payload interface{}) error {
+		calls = append(calls, "second")
+		return errors.New("failed")
+	})
+	d.Unhandled = func(ctx context.Context, event *responses.Event, payload interface{}) error {
+		calls = append(calls, "unhandled:"+string(event.EventType))
+		return nil
+	}
+
+	err := d.Dispatch(context.Background(), decodeEvent(t, `{"token":"e1","event_type":"card.created","payload":{"card_token":"card"}}`))
+	if err == nil || err.Error() != "failed" {
+		t.Fatalf("expected the error of the second handler, got %v", err)
+	}
+	built := &responses.Event{EventType: responses.EventEventTypeCardShipped, Payload: map[string]interface{}{"card_token": "card"}}
+	if err := d.Dispatch(context.Background(), built); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(calls, ",") != "created:card,second,unhandled:card.shipped" {
+		t.Fatalf("unexpected calls %q", calls)
+	}
+}
+
+func TestHandlePanicsOnMismatchedPayload(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic")
+		}
+	}()
+	Handle(NewDispatcher(), responses.EventEventTypeCardCreated, func(ctx context.Context, event *responses.Event, payload *responses.Transaction) error {
+		return nil
+	})
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/asa"
+	"github.com/lithic-com/lithic-go/dedupe"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+	"github.com/lithic-com/lithic-go/webhooks"
+)
+
+// Config is the configuration of an App.
+type Config struct {
+	// The secret of the event subscription that webhooks are signed with.
+	WebhookSecret string
+	// The ASA HMAC secret that ASA requests are signed with.
+	ASASecret string
+	// Authorizations above this amount, in cents, are declined.
+	MaxAuthorization int64
+	// Merchant category codes that authorizations are declined for.
+	BlockedMCCs []string
+}
+
+// Ledger stores the transactions that the app learns about from webhooks.
+type Ledger interface {
+	Record(ctx context.Context, tx *responses.Transaction) error
+}
+
+// MemoryLedger is a Ledger that keeps the latest version of every transaction
+// in memory.
+type MemoryLedger struct {
+	mu           sync.Mutex
+	transactions map[string]responses.Transaction
+}
+
+func NewMemoryLedger() *MemoryLedger {
+	return &MemoryLedger{transactions: map[string]responses.Transaction{}}
+}
+
+func (l *MemoryLedger) Record(ctx context.Context, tx *responses.Transaction) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.transactions[tx.Token] = *tx
+	return nil
+}
+
+// Transaction returns the latest version of a transaction.
+func (l *MemoryLedger) Transaction(token string) (responses.Transaction, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	tx, ok := l.transactions[token]
+	return tx, ok
+}
+
+// App is the backend of a card program. It decides on ASA requests, records
+// the transactions and cards that webhooks report, and drives the sandbox
+// simulators to exercise both.
+type App struct {
+	client   *lithic.Lithic
+	config   Config
+	ledger   Ledger
+	webhooks *webhooks.Handler
+	asa      *asa.Handler
+
+	mu    sync.Mutex
+	cards []string
+}
+
+func NewApp(client *lithic.Lithic, config Config, ledger Ledger) *App {
+	a := &App{client: client, config: config, ledger: ledger}
+
+	a.webhooks = webhooks.NewHandler(config.WebhookSecret, webhooks.Handlers{
+		OnTransactionUpdated: func(ctx context.Context, event *responses.Event, tx *responses.Transaction) error {
+			return a.ledger.Record(ctx, tx)
+		},
+		OnCardCreated: func(ctx context.Context, event *responses.Event, created *responses.CardCreatedEvent) error {
+			a.mu.Lock()
+			defer a.mu.Unlock()
+			a.cards = append(a.cards, created.CardToken)
+			return nil
+		},
+	})
+	a.webhooks.Dedupe = webhooks.NewMemoryDedupeStore(dedupe.DefaultMaxKeys, dedupe.DefaultTTL)
+
+	a.asa = asa.NewHandler(config.ASASecret, asa.ResultApproved, a.decide)
+	a.asa.OnAdvice = func(ctx context.Context, advice *asa.Advice) {}
+	return a
+}
+
+// Handler returns the HTTP handler of the app, which serves webhooks on
+// /webhooks and ASA requests on /asa.
+func (a *App) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/webhooks", a.webhooks)
+	mux.Handle("/asa", a.asa)
+	return mux
+}
+
+// Cards returns the tokens of the cards that card.created webhooks reported.
+func (a *App) Cards() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]string(nil), a.cards...)
+}
+
+// decide approves authorizations, unless they are too large or made at a
+// blocked merchant.
+func (a *App) decide(ctx context.Context, req *asa.Request) (*asa.Response, error) {
+	for _, mcc := range a.config.BlockedMCCs {
+		if req.Merchant.Mcc == mcc {
+			asa.RecordRuleHit(ctx, "blocked_mcc")
+			return asa.NewDecline(req, asa.DeclineReasonUnauthorizedMerchant)
+		}
+	}
+	if a.config.MaxAuthorization > 0 && req.Amount > a.config.MaxAuthorization {
+		asa.RecordRuleHit(ctx, "max_authorization")
+		return asa.NewDecline(req, asa.DeclineReasonInsufficientFunds)
+	}
+	return asa.NewApproval(req)
+}
+
+// Simulate creates a virtual card in the sandbox, authorizes and clears a
+// purchase of amount cents on it, and returns the resulting transaction.
+func (a *App) Simulate(ctx context.Context, amount int64, descriptor string) (*responses.Transaction, error) {
+	card, err := a.client.Cards.New(ctx, &requests.CardNewParams{
+		Type: fields.F(requests.CardNewParamsTypeVirtual),
+		Memo: fields.F("fullstack example"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating a card: %w", err)
+	}
+	authorization, err := a.client.Transactions.SimulateAuthorization(ctx, &requests.TransactionSimulateAuthorizationParams{
+		Amount:     fields.F(amount),
+		Descriptor: fields.F(descriptor),
+		Pan:        fields.F(card.Pan),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("simulating an authorization: %w", err)
+	}
+	_, err = a.client.Transactions.SimulateClearing(ctx, &requests.TransactionSimulateClearingParams{
+		Token: fields.F(authorization.Token),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("simulating a clearing: %w", err)
+	}
+	return a.client.Transactions.Get(ctx, authorization.Token)
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/asa"
+	"github.com/lithic-com/lithic-go/options"
+)
+
+const (
+	webhookSecret = "whsec_c2VjcmV0X2Zvcl90ZXN0cw=="
+	asaSecret     = "asa_secret"
+)
+
+// sandbox is a fake of the Lithic sandbox. Like the real one, it sends an ASA
+// request to the app when an authorization is simulated, and webhooks when
+// cards and transactions change.
+type sandbox struct {
+	t   *testing.T
+	app http.Handler
+
+	mu           sync.Mutex
+	transactions map[string]map[string]interface{}
+	webhooks     int
+}
+
+func (s *sandbox) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]interface{}
+	json.NewDecoder(r.Body).Decode(&body)
+	w.Header().Set("Content-Type", "application/json")
+	switch r.Method + " " + r.URL.Path {
+	case "POST /cards":
+		card := map[string]interface{}{"token": "card_token", "pan": "4111111289144142", "state": "OPEN", "type": "VIRTUAL"}
+		s.webhook("card.created", map[string]interface{}{"card_token": "card_token"})
+		json.NewEncoder(w).Encode(card)
+	case "POST /simulate/authorize":
+		tx := map[string]interface{}{"token": "tx_token", "card_token": "card_token", "amount": body["amount"], "status": "PENDING", "merchant": map[string]interface{}{"descriptor": body["descriptor"], "mcc": "5812"}}
+		res := s.asa(tx)
+		tx["result"] = res.Result
+		if !res.Result.Approved() {
+			tx["status"] = "DECLINED"
+		}
+		s.mu.Lock()
+		s.transactions["tx_token"] = tx
+		s.mu.Unlock()
+		s.webhook("card_transaction.updated", tx)
+		json.NewEncoder(w).Encode(map[string]interface{}{"token": "tx_token"})
+	case "POST /simulate/clearing":
+		s.mu.Lock()
+		tx := s.transactions[body["token"].(string)]
+		if tx["status"] == "PENDING" {
+			tx["status"] = "SETTLED"
+			tx["settled_amount"] = tx["amount"]
+		}
+		s.mu.Unlock()
+		s.webhook("card_transaction.updated", tx)
+		json.NewEncoder(w).Encode(map[string]interface{}{})
+	case "GET /transactions/tx_token":
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		json.NewEncoder(w).Encode(s.transactions["tx_token"])
+	default:
+		s.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *sandbox) asa(tx map[string]interface{}) *asa.Response {
+	payload, _ := json.Marshal(map[string]interface{}{"token": tx["token"], "status": "AUTHORIZATION", "amount": tx["amount"], "merchant": tx["merchant"], "card": map[string]interface{}{"token": tx["card_token"]}})
+	mac := hmac.New(sha256.New, []byte(asaSecret))
+	mac.Write(payload)
+	r := httptest.NewRequest("POST", "/asa", bytes.NewReader(payload))
+	r.Header.Set(asa.SignatureHeader, base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	w := httptest.NewRecorder()
+	s.app.ServeHTTP(w, r)
+	res := &asa.Response{}
+	if err := json.Unmarshal(w.Body.Bytes(), res); w.Code != http.StatusOK || err != nil {
+		s.t.Errorf("unexpected ASA response %d %s", w.Code, w.Body)
+	}
+	return res
+}
+
+func (s *sandbox) webhook(eventType string, payload map[string]interface{}) {
+	s.mu.Lock()
+	s.webhooks++
+	id := fmt.Sprintf("msg_%d", s.webhooks)
+	s.mu.Unlock()
+	body, _ := json.Marshal(map[string]interface{}{"token": id, "event_type": eventType, "payload": payload, "created": time.Now()})
+	r := httptest.NewRequest("POST", "/webhooks", bytes.NewReader(body))
+	signWebhook(r, id, body)
+	w := httptest.NewRecorder()
+	s.app.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		s.t.Errorf("unexpected webhook response %d %s", w.Code, w.Body)
+	}
+}
+
+func signWebhook(r *http.Request, id string, body []byte) {
+	secret, _ := base64.StdEncoding.DecodeString(webhookSecret[len("whsec_"):])
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(id + "." + timestamp + "."))
+	mac.Write(body)
+	r.Header.Set("webhook-id", id)
+	r.Header.Set("webhook-timestamp", timestamp)
+	r.Header.Set("webhook-signature", "v1,"+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+}
+
+func newTestApp(t *testing.T) (*App, *MemoryLedger) {
+	s := &sandbox{t: t, transactions: map[string]map[string]interface{}{}}
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+	client := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL), options.WithMaxRetries(0))
+	ledger := NewMemoryLedger()
+	app := NewApp(client, Config{WebhookSecret: webhookSecret, ASASecret: asaSecret, MaxAuthorization: 10000, BlockedMCCs: []string{"7995"}}, ledger)
+	s.app = app.Handler()
+	return app, ledger
+}
+
+func TestSimulatedPurchase(t *testing.T) {
+	app, ledger := newTestApp(t)
+	tx, err := app.Simulate(context.Background(), 1500, "coffee")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Status != "SETTLED" || tx.Result != "APPROVED" || tx.SettledAmount != 1500 {
+		t.Fatalf("expected a settled, approved transaction, got %+v", tx)
+	}
+	recorded, ok := ledger.Transaction(tx.Token)
+	if !ok || recorded.Status != "SETTLED" {
+		t.Fatalf("expected the webhooks to record the settled transaction, got %+v", recorded)
+	}
+	if cards := app.Cards(); len(cards) != 1 || cards[0] != "card_token" {
+		t.Fatalf("expected the card.created webhook to be handled, got %v", cards)
+	}
+}
+
+func TestSimulatedPurchaseDeclined(t *testing.T) {
+	app, ledger := newTestApp(t)
+	tx, err := app.Simulate(context.Background(), 50000, "television")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Status != "DECLINED" || tx.Result != "INSUFFICIENT_FUNDS" {
+		t.Fatalf("expected the ASA responder to decline the purchase, got %+v", tx)
+	}
+	if recorded, _ := ledger.Transaction(tx.Token); recorded.Status != "DECLINED" {
+		t.Fatalf("expected the declined transaction to be recorded, got %+v", recorded)
+	}
+}
+
+func TestWebhooksAreVerifiedAndDeduplicated(t *testing.T) {
+	app, _ := newTestApp(t)
+	handler := app.Handler()
+	body := []byte(`{"token":"msg_1","event_type":"card.created","payload":{"card_token":"card_token"}}`)
+
+	r := httptest.NewRequest("POST", "/webhooks", bytes.NewReader(body))
+	r.Header.Set("webhook-id", "msg_1")
+	r.Header.Set("webhook-timestamp", strconv.FormatInt(time.Now().Unix(), 10))
+	r.Header.Set("webhook-signature", "v1,"+base64.StdEncoding.EncodeToString([]byte("forged")))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected a forged webhook to be rejected, got %d", w.Code)
+	}
+
+	for i := 0; i < 2; i++ {
+		r := httptest.NewRequest("POST", "/webhooks", bytes.NewReader(body))
+		signWebhook(r, "msg_1", body)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			body, _ := io.ReadAll(w.Body)
+			t.Fatalf("expected the webhook to be acknowledged, got %d %s", w.Code, body)
+		}
+	}
+	if cards := app.Cards(); len(cards) != 1 {
+		t.Fatalf("expected the retried webhook to be handled once, got %v", cards)
+	}
+}
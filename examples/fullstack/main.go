@@ -0,0 +1,51 @@
+// Command fullstack runs the backend of a card program against the Lithic
+// sandbox: it decides on ASA requests, records transactions from webhooks, and
+// can drive the sandbox simulators to exercise both.
+//
+//	LITHIC_API_KEY=... LITHIC_WEBHOOK_SECRET=whsec_... LITHIC_ASA_SECRET=... \
+//		go run ./examples/fullstack -addr :8080 -simulate 1500
+//
+// Point an event subscription at /webhooks and the ASA responder at /asa, for
+// example through a tunnel, to receive the events of the simulated purchase.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/options"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to serve webhooks and ASA requests on")
+	simulate := flag.Int64("simulate", 0, "if positive, simulate a purchase of this many cents on a new card")
+	maxAuthorization := flag.Int64("max-authorization", 100000, "authorizations above this many cents are declined")
+	flag.Parse()
+
+	client := lithic.NewLithic(options.WithEnvironmentSandbox())
+	ledger := NewMemoryLedger()
+	app := NewApp(client, Config{
+		WebhookSecret:    os.Getenv("LITHIC_WEBHOOK_SECRET"),
+		ASASecret:        os.Getenv("LITHIC_ASA_SECRET"),
+		MaxAuthorization: *maxAuthorization,
+		BlockedMCCs:      []string{"7995"},
+	}, ledger)
+
+	if *simulate > 0 {
+		go func() {
+			tx, err := app.Simulate(context.Background(), *simulate, "fullstack example")
+			if err != nil {
+				log.Printf("simulation failed: %s", err)
+				return
+			}
+			log.Printf("simulated transaction %s: %s %s", tx.Token, tx.Status, tx.Result)
+		}()
+	}
+
+	log.Printf("serving webhooks on %s/webhooks and ASA requests on %s/asa", *addr, *addr)
+	log.Fatal(http.ListenAndServe(*addr, app.Handler()))
+}
@@ -15,13 +15,37 @@ type Field[T any] struct {
 	Raw     any
 }
 
-func F[T any](value T) Field[T]          { return Field[T]{Value: value, Present: true} }
-func NullField[T any]() Field[T]         { return Field[T]{Null: true, Present: true} }
-func RawField[T any](value any) Field[T] { return Field[T]{Raw: value, Present: true} }
+// F returns a field that is sent with value.
+func F[T any](value T) Field[T] { return Field[T]{Value: value, Present: true} }
+
+// Null returns a field that is sent as an explicit null.
+func Null[T any]() Field[T] { return Field[T]{Null: true, Present: true} }
+
+// Raw returns a field that is sent with value marshalled as is, for values that
+// the type of the field cannot represent.
+func Raw[T any](value any) Field[T] { return Field[T]{Raw: value, Present: true} }
+
+// NullField is the same as Null.
+func NullField[T any]() Field[T] { return Null[T]() }
+
+// RawField is the same as Raw.
+func RawField[T any](value any) Field[T] { return Raw[T](value) }
+
+// Int returns a field that is sent with value, for the int64 fields of params.
+func Int(value int64) Field[int64] { return F(value) }
+
+// Str returns a field that is sent with value.
+func Str(value string) Field[string] { return F(value) }
+
+// Bool returns a field that is sent with value.
+func Bool(value bool) Field[bool] { return F(value) }
 
 func (f Field[T]) field() {}
 
 func (f Field[T]) String() string {
+	if f.Null {
+		return "null"
+	}
 	if s, ok := any(f.Value).(fmt.Stringer); ok {
 		return s.String()
 	}
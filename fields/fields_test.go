@@ -0,0 +1,25 @@
+package fields
+
+import "testing"
+
+func TestConstructors(t *testing.T) {
+	if f := Str("travel card"); !f.Present || f.Null || f.Value != "travel card" {
+		t.Errorf("unexpected string field %+v", f)
+	}
+	if f := Int(12); !f.Present || f.Value != 12 {
+		t.Errorf("unexpected int field %+v", f)
+	}
+	if f := Bool(true); !f.Present || !f.Value {
+		t.Errorf("unexpected bool field %+v", f)
+	}
+	if f := Null[string](); !f.Present || !f.Null {
+		t.Errorf("unexpected null field %+v", f)
+	}
+	if f := Raw[int64]("12"); !f.Present || f.Raw != "12" {
+		t.Errorf("unexpected raw field %+v", f)
+	}
+	var unset Field[string]
+	if unset.Present {
+		t.Errorf("expected the zero field to be omitted")
+	}
+}
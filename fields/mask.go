@@ -0,0 +1,94 @@
+package fields
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FieldMask enumerates, by their JSON names, the fields that a request is meant
+// to send. Update params that have a FieldMask verify it when they are
+// marshalled, so that a PATCH never sends fields, such as nulls, that the
+// caller did not intend to send. A nil FieldMask is not verified.
+type FieldMask []string
+
+// Mask returns a FieldMask of the given JSON field names.
+func Mask(names ...string) FieldMask {
+	return append(FieldMask{}, names...)
+}
+
+// FieldMaskError is returned when the fields that are set on params do not
+// match their FieldMask.
+type FieldMaskError struct {
+	// Fields that are set but not in the mask.
+	Unexpected []string
+	// Fields that are in the mask but not set.
+	Missing []string
+	// Names in the mask that are not fields of the params.
+	Unknown []string
+}
+
+func (e *FieldMaskError) Error() string {
+	var problems []string
+	if len(e.Unexpected) > 0 {
+		problems = append(problems, "unexpected fields "+strings.Join(e.Unexpected, ", "))
+	}
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing fields "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Unknown) > 0 {
+		problems = append(problems, "unknown fields "+strings.Join(e.Unknown, ", "))
+	}
+	return fmt.Sprintf("fields: params do not match their field mask: %s", strings.Join(problems, "; "))
+}
+
+// Verify checks that exactly the fields in the mask are present in params, a
+// struct or pointer to a struct of Fields. It returns a *FieldMaskError if they
+// are not.
+func (m FieldMask) Verify(params interface{}) error {
+	if m == nil {
+		return nil
+	}
+	v := reflect.Indirect(reflect.ValueOf(params))
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("fields: cannot verify a field mask against %T", params)
+	}
+	masked := make(map[string]bool, len(m))
+	for _, name := range m {
+		masked[name] = true
+	}
+	known := map[string]bool{}
+	e := &FieldMaskError{}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if _, ok := v.Field(i).Interface().(FieldLike); !ok {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		known[name] = true
+		present := v.Field(i).FieldByName("Present").Bool()
+		if present && !masked[name] {
+			e.Unexpected = append(e.Unexpected, name)
+		}
+		if !present && masked[name] {
+			e.Missing = append(e.Missing, name)
+		}
+	}
+	for name := range masked {
+		if !known[name] {
+			e.Unknown = append(e.Unknown, name)
+		}
+	}
+	if len(e.Unexpected) == 0 && len(e.Missing) == 0 && len(e.Unknown) == 0 {
+		return nil
+	}
+	sort.Strings(e.Unknown)
+	return e
+}
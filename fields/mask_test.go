@@ -0,0 +1,41 @@
+package fields_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/requests"
+)
+
+func TestFieldMask(t *testing.T) {
+	params := &requests.CardUpdateParams{
+		Memo:      fields.F("Groceries"),
+		State:     fields.NullField[requests.CardUpdateParamsState](),
+		FieldMask: fields.Mask("memo", "spend_limit", "pan"),
+	}
+
+	_, err := params.MarshalJSON()
+	var maskErr *fields.FieldMaskError
+	if !errors.As(err, &maskErr) {
+		t.Fatalf("expected a FieldMaskError, got %v", err)
+	}
+	if len(maskErr.Unexpected) != 1 || maskErr.Unexpected[0] != "state" {
+		t.Fatalf("expected the null state to be unexpected, got %v", maskErr.Unexpected)
+	}
+	if len(maskErr.Missing) != 1 || maskErr.Missing[0] != "spend_limit" {
+		t.Fatalf("expected the spend limit to be missing, got %v", maskErr.Missing)
+	}
+	if len(maskErr.Unknown) != 1 || maskErr.Unknown[0] != "pan" {
+		t.Fatalf("expected pan to be unknown, got %v", maskErr.Unknown)
+	}
+
+	params.FieldMask = fields.Mask("memo", "state")
+	data, err := params.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"memo":"Groceries","state":null}` {
+		t.Fatalf("expected the masked fields to be sent, got %s", data)
+	}
+}
@@ -0,0 +1,151 @@
+// Package hotkeys counts the requests made by a client per endpoint and per
+// resource token, to find the cards or transactions that are being requested far
+// more often than the rest, for example by a webhook storm.
+package hotkeys
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/lithic-com/lithic-go/options"
+)
+
+var tokenPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// The default maximum number of distinct resource tokens that are tracked.
+const DefaultMaxKeys = 10000
+
+// Key is a request count for a path template, such as `GET
+// transactions/{token}`, or a resource token.
+type Key struct {
+	Name  string
+	Count int
+}
+
+// Report is a snapshot of the hottest path templates and resource tokens.
+type Report struct {
+	Paths  []Key
+	Tokens []Key
+	// Number of requests for tokens that were not tracked because MaxKeys distinct
+	// tokens had already been seen.
+	Untracked int
+}
+
+func (r Report) String() string {
+	var b strings.Builder
+	b.WriteString("paths:\n")
+	for _, key := range r.Paths {
+		fmt.Fprintf(&b, "  %6d %s\n", key.Count, key.Name)
+	}
+	b.WriteString("tokens:\n")
+	for _, key := range r.Tokens {
+		fmt.Fprintf(&b, "  %6d %s\n", key.Count, key.Name)
+	}
+	if r.Untracked > 0 {
+		fmt.Fprintf(&b, "untracked: %d\n", r.Untracked)
+	}
+	return b.String()
+}
+
+// Tracker counts requests made through its middleware. It is safe for
+// concurrent use.
+type Tracker struct {
+	// Maximum number of distinct resource tokens to track, bounding the memory
+	// used by the tracker. Defaults to DefaultMaxKeys.
+	MaxKeys int
+
+	mu        sync.Mutex
+	paths     map[string]int
+	tokens    map[string]int
+	untracked int
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{MaxKeys: DefaultMaxKeys}
+}
+
+// Middleware returns a middleware that counts every request sent by a client,
+// including retries. Add it with options.WithMiddleware.
+func (t *Tracker) Middleware() options.Middleware {
+	return func(req *http.Request, next options.MiddlewareNext) (*http.Response, error) {
+		t.record(req)
+		return next(req)
+	}
+}
+
+// Template returns the path template of a request, with every resource token
+// replaced by `{token}`, and the tokens that were replaced.
+func Template(req *http.Request) (template string, tokens []string) {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		if tokenPattern.MatchString(segment) {
+			tokens = append(tokens, segment)
+			segments[i] = "{token}"
+		}
+	}
+	return req.Method + " " + strings.Join(segments, "/"), tokens
+}
+
+func (t *Tracker) record(req *http.Request) {
+	template, tokens := Template(req)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.paths == nil {
+		t.paths = map[string]int{}
+		t.tokens = map[string]int{}
+	}
+	t.paths[template] += 1
+	max := t.MaxKeys
+	if max <= 0 {
+		max = DefaultMaxKeys
+	}
+	for _, token := range tokens {
+		if _, ok := t.tokens[token]; !ok && len(t.tokens) >= max {
+			t.untracked += 1
+			continue
+		}
+		t.tokens[token] += 1
+	}
+}
+
+// Report returns the n most requested path templates and resource tokens, in
+// descending order of requests. If n is 0 or less, every key is returned.
+func (t *Tracker) Report(n int) Report {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return Report{
+		Paths:     top(t.paths, n),
+		Tokens:    top(t.tokens, n),
+		Untracked: t.untracked,
+	}
+}
+
+// Reset clears all the counts.
+func (t *Tracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.paths = nil
+	t.tokens = nil
+	t.untracked = 0
+}
+
+func top(counts map[string]int, n int) []Key {
+	keys := make([]Key, 0, len(counts))
+	for name, count := range counts {
+		keys = append(keys, Key{name, count})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Count != keys[j].Count {
+			return keys[i].Count > keys[j].Count
+		}
+		return keys[i].Name < keys[j].Name
+	})
+	if n > 0 && len(keys) > n {
+		keys = keys[:n]
+	}
+	return keys
+}
@@ -0,0 +1,59 @@
+package hotkeys
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+func TestTrackerReportsHotTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token":"ignored"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	tracker := NewTracker()
+	transactions := services.NewTransactionService(
+		options.WithBaseURL(server.URL+"/v1/"),
+		options.WithMaxRetries(0),
+		options.WithMiddleware(tracker.Middleware()),
+	)
+	hot := "a3f1e7c2-1b2c-4d5e-8f90-123456789abc"
+	cold := "0e2d3c4b-5a69-4788-9a0b-cdef01234567"
+	for i := 0; i < 5; i++ {
+		if _, err := transactions.Get(context.Background(), hot); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := transactions.Get(context.Background(), cold); err != nil {
+		t.Fatal(err)
+	}
+
+	report := tracker.Report(1)
+	if len(report.Paths) != 1 || report.Paths[0] != (Key{"GET v1/transactions/{token}", 6}) {
+		t.Fatalf("unexpected paths %v", report.Paths)
+	}
+	if len(report.Tokens) != 1 || report.Tokens[0] != (Key{hot, 5}) {
+		t.Fatalf("unexpected tokens %v", report.Tokens)
+	}
+}
+
+func TestTrackerBoundsTokens(t *testing.T) {
+	tracker := &Tracker{MaxKeys: 1}
+	for _, path := range []string{
+		"/cards/a3f1e7c2-1b2c-4d5e-8f90-123456789abc",
+		"/cards/0e2d3c4b-5a69-4788-9a0b-cdef01234567",
+	} {
+		req, _ := http.NewRequest("GET", "http://example.com"+path, nil)
+		tracker.record(req)
+	}
+	report := tracker.Report(0)
+	if len(report.Tokens) != 1 || report.Untracked != 1 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+}
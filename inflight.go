@@ -0,0 +1,17 @@
+package lithic
+
+import "context"
+
+// Inflight returns the number of requests made by the client that haven't
+// completed yet, including requests that are waiting to be retried.
+func (r *Lithic) Inflight() int {
+	return r.inflight.Count()
+}
+
+// Drain waits until every outstanding request made by the client has completed,
+// so that a process can finish in-progress card updates before it exits. It
+// returns the context's error if ctx is done first. Drain doesn't stop new
+// requests from being made.
+func (r *Lithic) Drain(ctx context.Context) error {
+	return r.inflight.Wait(ctx)
+}
@@ -0,0 +1,51 @@
+package lithic
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go/options"
+)
+
+func TestDrainWaitsForInflightRequests(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token":"card_token"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewLithic(options.WithBaseURL(server.URL), options.WithMaxRetries(0))
+	done := make(chan error)
+	go func() {
+		_, err := client.Cards.Get(context.Background(), "card_token")
+		done <- err
+	}()
+	<-started
+
+	if n := client.Inflight(); n != 1 {
+		t.Fatalf("expected 1 inflight request, got %d", n)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := client.Drain(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected the drain to time out, got %v", err)
+	}
+
+	close(release)
+	if err := client.Drain(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if n := client.Inflight(); n != 0 {
+		t.Fatalf("expected no inflight requests, got %d", n)
+	}
+}
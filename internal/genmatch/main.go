@@ -0,0 +1,160 @@
+// Command genmatch generates exhaustive match helpers for the string enums of
+// the package in the current directory. It is run with `go generate
+// ./requests ./responses`.
+//
+// For an enum E, it generates EMatchFuncs, a struct with a function for every
+// constant of E and an Unknown function for the values that the SDK does not
+// know, and MatchE, which calls the function for a value.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const output = "match.go"
+
+type constant struct {
+	name  string
+	field string
+	value string
+}
+
+type enum struct {
+	name      string
+	constants []constant
+}
+
+func main() {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg := ""
+	enums := map[string]*enum{}
+	var consts []*ast.ValueSpec
+	for _, name := range files {
+		if name == output || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		pkg = file.Name.Name
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if ident, ok := spec.Type.(*ast.Ident); ok && ident.Name == "string" && spec.Name.IsExported() && !spec.Assign.IsValid() {
+						enums[spec.Name.Name] = &enum{name: spec.Name.Name}
+					}
+				case *ast.ValueSpec:
+					if gen.Tok == token.CONST {
+						consts = append(consts, spec)
+					}
+				}
+			}
+		}
+	}
+
+	for _, spec := range consts {
+		ident, ok := spec.Type.(*ast.Ident)
+		if !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+			continue
+		}
+		e, ok := enums[ident.Name]
+		if !ok || !spec.Names[0].IsExported() {
+			continue
+		}
+		lit, ok := spec.Values[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			log.Fatal(err)
+		}
+		e.constants = append(e.constants, constant{name: spec.Names[0].Name, value: value})
+	}
+
+	var sorted []*enum
+	for _, e := range enums {
+		if len(e.constants) == 0 {
+			continue
+		}
+		e.constants = fieldNames(e)
+		sorted = append(sorted, e)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].name < sorted[j].name })
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "// Code generated by genmatch. DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintf(buf, "package %s\n\n", pkg)
+	fmt.Fprintln(buf, "//go:generate go run ../internal/genmatch")
+	for _, e := range sorted {
+		fmt.Fprintf(buf, "\n// %[1]sMatchFuncs has a function for every value of %[1]s, see Match%[1]s.\ntype %[1]sMatchFuncs[R any] struct {\n", e.name)
+		for _, c := range e.constants {
+			fmt.Fprintf(buf, "\t%s func() R\n", c.field)
+		}
+		fmt.Fprintf(buf, "\t// Unknown is called with the values that this version of the SDK does not know.\n\tUnknown func(%s) R\n}\n", e.name)
+
+		fmt.Fprintf(buf, "\n// Match%[1]s calls the function of m for v. It panics if any function of m is\n// nil, so that a value added by a new version of the SDK cannot go unhandled.\nfunc Match%[1]s[R any](v %[1]s, m %[1]sMatchFuncs[R]) R {\n", e.name)
+		var checks []string
+		for _, c := range e.constants {
+			checks = append(checks, "m."+c.field+" == nil")
+		}
+		checks = append(checks, "m.Unknown == nil")
+		fmt.Fprintf(buf, "\tif %s {\n\t\tpanic(\"%s: Match%s needs a function for every value\")\n\t}\n", strings.Join(checks, " || "), pkg, e.name)
+		fmt.Fprintln(buf, "\tswitch v {")
+		for _, c := range e.constants {
+			fmt.Fprintf(buf, "\tcase %s:\n\t\treturn m.%s()\n", c.name, c.field)
+		}
+		fmt.Fprintln(buf, "\tdefault:\n\t\treturn m.Unknown(v)\n\t}\n}")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// fieldNames names the fields of the constants of e after their names without
+// the name of e, and drops the constants whose value is repeated.
+func fieldNames(e *enum) []constant {
+	var res []constant
+	values := map[string]bool{}
+	names := map[string]bool{"Unknown": true}
+	for _, c := range e.constants {
+		if values[c.value] {
+			continue
+		}
+		values[c.value] = true
+		c.field = strings.TrimPrefix(c.name, e.name)
+		if c.field == "" || !ast.IsExported(c.field) || names[c.field] {
+			c.field = c.name
+		}
+		names[c.field] = true
+		res = append(res, c)
+	}
+	return res
+}
@@ -3,6 +3,7 @@ package lithic
 import (
 	"context"
 	"os"
+	"sync"
 
 	"github.com/lithic-com/lithic-go/options"
 	"github.com/lithic-com/lithic-go/responses"
@@ -10,17 +11,31 @@ import (
 )
 
 type Lithic struct {
-	Options              []options.RequestOption
-	Accounts             *services.AccountService
-	AccountHolders       *services.AccountHolderService
-	AuthRules            *services.AuthRuleService
-	AuthStreamEnrollment *services.AuthStreamEnrollmentService
-	Cards                *services.CardService
-	Disputes             *services.DisputeService
-	Events               *services.EventService
-	FundingSources       *services.FundingSourceService
-	Transactions         *services.TransactionService
-	Webhooks             *services.WebhookService
+	Options                 []options.RequestOption
+	Accounts                *services.AccountService
+	AccountHolders          *services.AccountHolderService
+	AggregateBalances       *services.AggregateBalanceService
+	AuthRules               *services.AuthRuleService
+	AuthStreamEnrollment    *services.AuthStreamEnrollmentService
+	Balances                *services.BalanceService
+	BookTransfers           *services.BookTransferService
+	Cards                   *services.CardService
+	Disputes                *services.DisputeService
+	Events                  *services.EventService
+	ExternalBankAccounts    *services.ExternalBankAccountService
+	FinancialAccounts       *services.FinancialAccountService
+	FundingSources          *services.FundingSourceService
+	Reports                 *services.ReportService
+	ResponderEndpoints      *services.ResponderEndpointService
+	ThreeDS                 *services.ThreeDSService
+	TokenizationDecisioning *services.TokenizationDecisioningService
+	Tokenizations           *services.TokenizationService
+	Transactions            *services.TransactionService
+	Webhooks                *services.WebhookService
+
+	closersMu sync.Mutex
+	closers   []Closer
+	inflight  options.InflightCounter
 }
 
 // NewLithic generates a new client with the default options read from the
@@ -35,18 +50,29 @@ func NewLithic(opts ...options.RequestOption) (r *Lithic) {
 	if o, ok := os.LookupEnv("LITHIC_WEBHOOK_SECRET"); ok {
 		defaults = append(defaults, options.WithWebhookSecret(o))
 	}
+	r = &Lithic{}
+	defaults = append(defaults, options.WithInflightCounter(&r.inflight))
 	opts = append(defaults, opts...)
-
-	r = &Lithic{Options: opts}
+	r.Options = opts
 
 	r.Accounts = services.NewAccountService(opts...)
 	r.AccountHolders = services.NewAccountHolderService(opts...)
+	r.AggregateBalances = services.NewAggregateBalanceService(opts...)
 	r.AuthRules = services.NewAuthRuleService(opts...)
 	r.AuthStreamEnrollment = services.NewAuthStreamEnrollmentService(opts...)
+	r.Balances = services.NewBalanceService(opts...)
+	r.BookTransfers = services.NewBookTransferService(opts...)
 	r.Cards = services.NewCardService(opts...)
 	r.Disputes = services.NewDisputeService(opts...)
 	r.Events = services.NewEventService(opts...)
+	r.ExternalBankAccounts = services.NewExternalBankAccountService(opts...)
+	r.FinancialAccounts = services.NewFinancialAccountService(opts...)
 	r.FundingSources = services.NewFundingSourceService(opts...)
+	r.Reports = services.NewReportService(opts...)
+	r.ResponderEndpoints = services.NewResponderEndpointService(opts...)
+	r.ThreeDS = services.NewThreeDSService(opts...)
+	r.TokenizationDecisioning = services.NewTokenizationDecisioningService(opts...)
+	r.Tokenizations = services.NewTokenizationService(opts...)
 	r.Transactions = services.NewTransactionService(opts...)
 	r.Webhooks = services.NewWebhookService(opts...)
 
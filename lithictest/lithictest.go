@@ -0,0 +1,133 @@
+// Package lithictest helps tests that run against a shared Lithic sandbox
+// program. Resources created by a test are tagged with Tag, which lets Cleanup
+// remove the ones that earlier runs left behind without touching anything else
+// in the program:
+//
+//	card, err := client.Cards.New(ctx, &requests.CardNewParams{
+//		Type: fields.F(requests.CardNewParamsTypeVirtual),
+//		Memo: fields.F(lithictest.Tag(t.Name())),
+//	})
+//
+//	// In TestMain, or a scheduled job:
+//	report, err := lithictest.Cleanup(ctx, client, 24*time.Hour)
+package lithictest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// Prefix starts every memo or description returned by Tag.
+const Prefix = "lithictest/"
+
+// Tag returns a memo or description for a resource created by a test. It
+// records when the resource was created, as not every resource reports it:
+// "lithictest/1700000000 TestCards".
+func Tag(name string) string {
+	return tagAt(name, time.Now())
+}
+
+func tagAt(name string, at time.Time) string {
+	return fmt.Sprintf("%s%d %s", Prefix, at.Unix(), name)
+}
+
+// Tagged reports whether s was returned by Tag, and when.
+func Tagged(s string) (created time.Time, ok bool) {
+	if !strings.HasPrefix(s, Prefix) {
+		return time.Time{}, false
+	}
+	stamp, _, _ := strings.Cut(s[len(Prefix):], " ")
+	sec, err := strconv.ParseInt(stamp, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(sec, 0), true
+}
+
+// CleanupReport lists what Cleanup removed. Resources that failed to be
+// removed are reported in Failed, by token, and are retried by the next
+// Cleanup.
+type CleanupReport struct {
+	ClosedCards          []string
+	DeletedSubscriptions []string
+	Failed               map[string]error
+}
+
+func (r *CleanupReport) fail(token string, err error) {
+	if r.Failed == nil {
+		r.Failed = map[string]error{}
+	}
+	r.Failed[token] = err
+}
+
+// Cleanup closes the cards and deletes the event subscriptions that were
+// tagged with Tag more than olderThan ago. A short olderThan may remove the
+// resources of test runs that are still in progress.
+//
+// Responder endpoints are not removed: a program has a single endpoint of each
+// type, which is shared by whoever tests against it and does not report when it
+// was enrolled, so a stale enrollment cannot be told apart from a live one.
+//
+// An error is returned if the resources could not be listed, along with what
+// was removed before.
+func Cleanup(ctx context.Context, client *lithic.Lithic, olderThan time.Duration) (*CleanupReport, error) {
+	report := &CleanupReport{}
+	cutoff := time.Now().Add(-olderThan)
+	stale := func(tag string) bool {
+		created, ok := Tagged(tag)
+		return ok && created.Before(cutoff)
+	}
+
+	cards, err := client.Cards.List(ctx, &requests.CardListParams{End: fields.F(cutoff)})
+	if err != nil {
+		return report, err
+	}
+	iter := cards.Iterator()
+	for iter.Next(ctx) {
+		card := iter.Current()
+		if card.State == responses.CardStateClosed || !stale(card.Memo) {
+			continue
+		}
+		_, err := client.Cards.Update(ctx, card.Token, &requests.CardUpdateParams{State: fields.F(requests.CardUpdateParamsStateClosed)})
+		if err != nil {
+			report.fail(card.Token, err)
+			continue
+		}
+		report.ClosedCards = append(report.ClosedCards, card.Token)
+	}
+	if err := iter.Err(); err != nil {
+		return report, err
+	}
+
+	subscriptions, err := client.Events.Subscriptions.List(ctx, &requests.SubscriptionListParams{})
+	if err != nil {
+		return report, err
+	}
+	// Deleting while listing would shift the cursor, so collect them first.
+	var tokens []string
+	subs := subscriptions.Iterator()
+	for subs.Next(ctx) {
+		if sub := subs.Current(); stale(sub.Description) {
+			tokens = append(tokens, sub.Token)
+		}
+	}
+	if err := subs.Err(); err != nil {
+		return report, err
+	}
+	for _, token := range tokens {
+		if err := client.Events.Subscriptions.Delete(ctx, token); err != nil {
+			report.fail(token, err)
+			continue
+		}
+		report.DeletedSubscriptions = append(report.DeletedSubscriptions, token)
+	}
+	return report, nil
+}
@@ -0,0 +1,88 @@
+package lithictest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/options"
+)
+
+func TestTagged(t *testing.T) {
+	at := time.Unix(1700000000, 0)
+	created, ok := Tagged(tagAt("TestCards", at))
+	if !ok || !created.Equal(at) {
+		t.Fatalf("expected %s, got %s %v", at, created, ok)
+	}
+	for _, s := range []string{"", "memo", "lithictest/", "lithictest/abc TestCards"} {
+		if _, ok := Tagged(s); ok {
+			t.Errorf("expected %q not to be tagged", s)
+		}
+	}
+}
+
+func TestCleanup(t *testing.T) {
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now()
+	var mu sync.Mutex
+	var closed, deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/cards":
+			if r.URL.Query().Get("end") == "" {
+				t.Errorf("expected cards to be filtered by creation")
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"page": 1, "total_pages": 1, "total_entries": 4,
+				"data": []map[string]string{
+					{"token": "stale", "state": "OPEN", "memo": tagAt("TestA", old)},
+					{"token": "fresh", "state": "OPEN", "memo": tagAt("TestB", recent)},
+					{"token": "closed", "state": "CLOSED", "memo": tagAt("TestC", old)},
+					{"token": "other", "state": "OPEN", "memo": "payroll"},
+				},
+			})
+		case r.Method == "PATCH" && strings.HasPrefix(r.URL.Path, "/cards/"):
+			closed = append(closed, strings.TrimPrefix(r.URL.Path, "/cards/"))
+			w.Write([]byte(`{}`))
+		case r.Method == "GET" && r.URL.Path == "/event_subscriptions":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"has_more": false,
+				"data": []map[string]string{
+					{"token": "sub_stale", "description": tagAt("TestD", old)},
+					{"token": "sub_fresh", "description": tagAt("TestE", recent)},
+					{"token": "sub_other", "description": "alerts"},
+				},
+			})
+		case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/event_subscriptions/"):
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/event_subscriptions/"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	client := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+
+	report, err := Cleanup(context.Background(), client, 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(closed, []string{"stale"}) || !reflect.DeepEqual(report.ClosedCards, closed) {
+		t.Errorf("expected only the stale card to be closed, got %v %v", closed, report.ClosedCards)
+	}
+	if !reflect.DeepEqual(deleted, []string{"sub_stale"}) || !reflect.DeepEqual(report.DeletedSubscriptions, deleted) {
+		t.Errorf("expected only the stale subscription to be deleted, got %v %v", deleted, report.DeletedSubscriptions)
+	}
+	if len(report.Failed) != 0 {
+		t.Errorf("unexpected failures %v", report.Failed)
+	}
+}
@@ -0,0 +1,65 @@
+package options
+
+import (
+	"net/http"
+	"time"
+)
+
+// call is the state of a single API call, including all of its retries, that
+// is reported to the tracer and metrics collector when it ends.
+type call struct {
+	cfg      *RequestConfig
+	method   string
+	path     string
+	start    time.Time
+	span     Span
+	attempts int
+	res      *http.Response
+	caller   string
+}
+
+func (cfg *RequestConfig) startCall() *call {
+	c := &call{cfg: cfg}
+	if cfg.TracerProvider == nil && cfg.MetricsCollector == nil {
+		return c
+	}
+	c.method = cfg.Request.Method
+	c.path = pathTemplate(cfg.Request.URL.Path)
+	c.start = time.Now()
+	if cfg.TracerProvider != nil {
+		c.span = cfg.startSpan(c.path)
+	}
+	return c
+}
+
+func (c *call) end(err error) {
+	status := 0
+	if c.res != nil {
+		status = c.res.StatusCode
+	}
+	if c.span != nil {
+		c.span.SetAttributes(Attribute{AttributeAttempts, c.attempts})
+		if status != 0 {
+			c.span.SetAttributes(Attribute{AttributeStatusCode, status})
+		}
+		if err != nil {
+			c.span.RecordError(err)
+		}
+		c.span.End()
+	}
+	if c.cfg.MetricsCollector != nil {
+		retries := c.attempts - 1
+		if retries < 0 {
+			retries = 0
+		}
+		c.cfg.MetricsCollector.ObserveCall(CallMetrics{
+			Method:     c.method,
+			Path:       c.path,
+			StatusCode: status,
+			Retries:    retries,
+			Duration:   time.Since(c.start),
+			Err:        err,
+			Caller:     c.caller,
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package options
+
+import (
+	"context"
+	"net/http"
+)
+
+// DefaultCallerIdentityHeader is the header that WithCallerIdentity sets by
+// default.
+const DefaultCallerIdentityHeader = "X-Internal-Caller"
+
+// WithCallerIdentity sets a header, DefaultCallerIdentityHeader unless
+// WithCallerIdentityHeader is given, on every request to the identity that
+// identify returns for the request's context. Platforms that share a client
+// between internal teams can use it to attribute their Lithic usage, for
+// example in the logs of an egress proxy. The identity is also reported as
+// CallMetrics.Caller. No header is set if identify returns an empty string.
+func WithCallerIdentity(identify func(ctx context.Context) string) RequestOption {
+	return func(r *RequestConfig) error {
+		r.CallerIdentity = identify
+		return nil
+	}
+}
+
+// WithCallerIdentityHeader changes the header that WithCallerIdentity sets.
+func WithCallerIdentityHeader(name string) RequestOption {
+	return func(r *RequestConfig) error {
+		r.CallerIdentityHeader = http.CanonicalHeaderKey(name)
+		return nil
+	}
+}
+
+// identifyCaller sets the caller identity header of the request and returns the
+// identity.
+func (cfg *RequestConfig) identifyCaller() string {
+	if cfg.CallerIdentity == nil {
+		return ""
+	}
+	caller := cfg.CallerIdentity(cfg.Request.Context())
+	if caller == "" {
+		return ""
+	}
+	header := cfg.CallerIdentityHeader
+	if header == "" {
+		header = DefaultCallerIdentityHeader
+	}
+	cfg.Request.Header.Set(header, caller)
+	return caller
+}
@@ -0,0 +1,69 @@
+package options
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// trackedBody records whether any part of a response body has been read, so that
+// a request whose response was already processed is never sent again.
+type trackedBody struct {
+	io.ReadCloser
+	read bool
+}
+
+func (b *trackedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		b.read = true
+	}
+	return n, err
+}
+
+func trackBody(res *http.Response) *http.Response {
+	if res != nil && res.Body != nil && res.Body != http.NoBody {
+		res.Body = &trackedBody{ReadCloser: res.Body}
+	}
+	return res
+}
+
+// BodyConsumed reports whether any part of the response body has been read since
+// it was received. Requests whose response body was consumed, for example by a
+// middleware streaming a statement download, are not retried to avoid
+// processing the same response twice.
+func BodyConsumed(res *http.Response) bool {
+	if res == nil {
+		return false
+	}
+	body, ok := res.Body.(*trackedBody)
+	return ok && body.read
+}
+
+// bufferedBody is a response body that was read into memory and can be read
+// again from the start with rewindBody.
+type bufferedBody struct {
+	*bytes.Reader
+	contents []byte
+}
+
+func (b *bufferedBody) Close() error { return nil }
+
+func bufferBody(res *http.Response) error {
+	if res.Body == nil {
+		return nil
+	}
+	contents, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return err
+	}
+	res.Body = &bufferedBody{bytes.NewReader(contents), contents}
+	return nil
+}
+
+func rewindBody(res *http.Response) {
+	if body, ok := res.Body.(*bufferedBody); ok {
+		body.Reset(body.contents)
+	}
+}
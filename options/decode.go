@@ -0,0 +1,82 @@
+package options
+
+import (
+	"net/http"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+)
+
+// DecodeField is a field of a response that was decoded differently from how it
+// reads in the JSON, see DecodeReport.
+type DecodeField struct {
+	// The dotted path of the field in the response body, such as
+	// "data.0.spend_limit".
+	Path string
+	// The Go type that the field was decoded into, which is empty for unknown
+	// fields.
+	Type string
+	// The raw JSON of the field.
+	Raw string
+}
+
+// DecodeReport describes how the JSON body of a single response differs from
+// the types it was decoded into.
+type DecodeReport struct {
+	// The HTTP method of the call.
+	Method string
+	// The path of the endpoint, with tokens replaced by a placeholder, for
+	// example "cards/{token}".
+	Path string
+	// The X-Request-Id of the response.
+	RequestID string
+	// Fields that are not known to this version of the SDK. They are available
+	// in the JSON.Extras of their struct.
+	UnknownFields []DecodeField
+	// Fields whose JSON type was converted to their Go type, such as a numeric
+	// string into an int64.
+	CoercedFields []DecodeField
+	// Fields that could not be decoded and were left as their zero value.
+	InvalidFields []DecodeField
+	// Fields that are null in the JSON but not nullable, and were left as their
+	// zero value.
+	NullToZeroFields []DecodeField
+}
+
+// Clean reports whether the response was decoded exactly as it reads.
+func (r DecodeReport) Clean() bool {
+	return len(r.UnknownFields)+len(r.CoercedFields)+len(r.InvalidFields)+len(r.NullToZeroFields) == 0
+}
+
+// WithDecodeDiagnostics calls report with a DecodeReport for every JSON response
+// that is decoded, including clean ones, so that drift between the API and the
+// types of this SDK can be measured, for example as the share of responses of
+// an endpoint with unknown or null-to-zero fields. report is called on the
+// goroutine making the request, after the response has been decoded.
+func WithDecodeDiagnostics(report func(DecodeReport)) RequestOption {
+	return func(r *RequestConfig) error {
+		r.DecodeDiagnostics = report
+		return nil
+	}
+}
+
+func (cfg *RequestConfig) reportDecode(res *http.Response) {
+	report := DecodeReport{
+		Method:    cfg.Request.Method,
+		Path:      pathTemplate(cfg.Request.URL.Path),
+		RequestID: res.Header.Get("X-Request-Id"),
+	}
+	for _, drift := range pjson.Drifts(cfg.ResponseBodyInto) {
+		field := DecodeField{Path: drift.Path, Type: drift.Type, Raw: drift.Raw}
+		switch drift.Kind {
+		case pjson.DriftUnknownField:
+			report.UnknownFields = append(report.UnknownFields, field)
+		case pjson.DriftCoerced:
+			report.CoercedFields = append(report.CoercedFields, field)
+		case pjson.DriftInvalid:
+			report.InvalidFields = append(report.InvalidFields, field)
+		case pjson.DriftNullToZero:
+			report.NullToZeroFields = append(report.NullToZeroFields, field)
+		}
+	}
+	cfg.DecodeDiagnostics(report)
+}
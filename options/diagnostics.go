@@ -0,0 +1,162 @@
+package options
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/redact"
+)
+
+// maxDiagnosticsBody is the number of bytes of a body that are kept in a
+// diagnostics entry.
+const maxDiagnosticsBody = 64 << 10
+
+// DiagnosticsEntry is a single HTTP round trip captured by WithDiagnostics.
+// Retries are captured as separate entries.
+type DiagnosticsEntry struct {
+	Time time.Time `json:"time"`
+	// The X-Request-Id of the response, which Lithic support can look up.
+	RequestID      string        `json:"request_id,omitempty"`
+	Method         string        `json:"method"`
+	URL            string        `json:"url"`
+	RequestHeader  http.Header   `json:"request_header"`
+	RequestBody    string        `json:"request_body,omitempty"`
+	Status         int           `json:"status,omitempty"`
+	ResponseHeader http.Header   `json:"response_header,omitempty"`
+	ResponseBody   string        `json:"response_body,omitempty"`
+	Duration       time.Duration `json:"duration_ns"`
+	Error          string        `json:"error,omitempty"`
+}
+
+// Diagnostics keeps the last few round trips made with WithDiagnostics in a
+// ring buffer, with credentials and sensitive fields redacted, so that they can
+// be attached to a support ticket.
+type Diagnostics struct {
+	mu      sync.Mutex
+	entries []DiagnosticsEntry
+	next    int
+	full    bool
+}
+
+// WithDiagnostics captures the last size HTTP round trips of every request made
+// with this option, see Diagnostics. The round trips are captured as they are
+// sent on the wire, after any middleware. Lithic.DumpDiagnostics writes them as
+// a support bundle.
+func WithDiagnostics(size int) RequestOption {
+	if size < 1 {
+		size = 1
+	}
+	diagnostics := &Diagnostics{entries: make([]DiagnosticsEntry, size)}
+	return func(r *RequestConfig) error {
+		r.Diagnostics = diagnostics
+		return nil
+	}
+}
+
+func (d *Diagnostics) add(entry DiagnosticsEntry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries[d.next] = entry
+	d.next = (d.next + 1) % len(d.entries)
+	if d.next == 0 {
+		d.full = true
+	}
+}
+
+// Entries returns the captured round trips, oldest first.
+func (d *Diagnostics) Entries() []DiagnosticsEntry {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.full {
+		return append([]DiagnosticsEntry(nil), d.entries[:d.next]...)
+	}
+	return append(append([]DiagnosticsEntry(nil), d.entries[d.next:]...), d.entries[:d.next]...)
+}
+
+// WriteBundle writes the captured round trips to w as an indented JSON
+// document, along with the versions of the SDK and of Go.
+func (d *Diagnostics) WriteBundle(w io.Writer) error {
+	bundle := struct {
+		PackageVersion string             `json:"package_version"`
+		GoVersion      string             `json:"go_version"`
+		OS             string             `json:"os"`
+		Arch           string             `json:"arch"`
+		Generated      time.Time          `json:"generated"`
+		Entries        []DiagnosticsEntry `json:"entries"`
+	}{core.PackageVersion, runtime.Version(), runtime.GOOS, runtime.GOARCH, time.Now().UTC(), d.Entries()}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(bundle)
+}
+
+// captureDiagnostics wraps the handler that sends requests on the wire so that
+// every round trip is recorded.
+func (cfg *RequestConfig) captureDiagnostics(next MiddlewareNext) MiddlewareNext {
+	return cfg.captureRoundTrips(next, cfg.Diagnostics.add)
+}
+
+// captureRoundTrips wraps next so that every round trip is passed to record,
+// redacted.
+func (cfg *RequestConfig) captureRoundTrips(next MiddlewareNext, record func(DiagnosticsEntry)) MiddlewareNext {
+	policy := cfg.redactionPolicy()
+	return func(req *http.Request) (*http.Response, error) {
+		entry := DiagnosticsEntry{
+			Time:          time.Now(),
+			Method:        req.Method,
+			URL:           policy.URL(req.URL).String(),
+			RequestHeader: policy.Header(req.Header),
+			RequestBody:   diagnosticsBody(policy, req.Header, cfg.buffer),
+		}
+		res, err := next(req)
+		entry.Duration = time.Since(entry.Time)
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		if res != nil {
+			entry.Status = res.StatusCode
+			entry.RequestID = res.Header.Get("X-Request-Id")
+			entry.ResponseHeader = policy.Header(res.Header)
+			// Only JSON bodies are read, as others may be streamed downloads.
+			if res.Body != nil && core.IsJSONContentType(res.Header.Get("Content-Type")) {
+				contents, readErr := io.ReadAll(res.Body)
+				res.Body.Close()
+				res.Body = io.NopCloser(bytes.NewReader(contents))
+				entry.ResponseBody = diagnosticsBody(policy, res.Header, contents)
+				if readErr != nil {
+					entry.Error = readErr.Error()
+					res.Body = io.NopCloser(io.MultiReader(bytes.NewReader(contents), errReader{readErr}))
+				}
+			}
+		}
+		record(entry)
+		return res, err
+	}
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func diagnosticsBody(policy *redact.Policy, header http.Header, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if !core.IsJSONContentType(header.Get("Content-Type")) {
+		return "[" + http.DetectContentType(body) + " body omitted]"
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return "[invalid JSON body omitted]"
+	}
+	contents, _ := json.Marshal(policy.Decoded(value))
+	if len(contents) > maxDiagnosticsBody {
+		return string(contents[:maxDiagnosticsBody]) + "[truncated]"
+	}
+	return string(contents)
+}
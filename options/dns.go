@@ -0,0 +1,303 @@
+package options
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// DefaultDNSMaxStale is how long a cached DNS entry is served past its expiry
+// while refreshing it fails, unless TransportConfig.DNSMaxStale is set.
+const DefaultDNSMaxStale = 5 * time.Minute
+
+type dnsEntry struct {
+	addrs   []string
+	expires time.Time
+}
+
+// dnsCache resolves hosts through a cache and dials every resolved address in
+// turn until one of them accepts the connection. Entries are cached for the TTL
+// of their records, up to the TTL of the cache. Stale entries are served for up
+// to maxStale when a refresh fails, so a short DNS outage does not fail
+// requests to a host that was resolved recently.
+type dnsCache struct {
+	ttl      time.Duration
+	maxStale time.Duration
+	// lookupHost returns the addresses of host and the TTL of its records, or
+	// zero if it is not known.
+	lookupHost func(ctx context.Context, host string) ([]string, time.Duration, error)
+	dial       func(ctx context.Context, network, addr string) (net.Conn, error)
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]dnsEntry
+}
+
+func newDNSCache(ttl time.Duration, maxStale time.Duration, dialer *net.Dialer) *dnsCache {
+	if maxStale <= 0 {
+		maxStale = DefaultDNSMaxStale
+	}
+	return &dnsCache{
+		ttl:        ttl,
+		maxStale:   maxStale,
+		lookupHost: lookupHostTTL,
+		dial:       dialer.DialContext,
+		now:        time.Now,
+		entries:    map[string]dnsEntry{},
+	}
+}
+
+func (c *dnsCache) lookup(ctx context.Context, host string) ([]string, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[host]
+	c.mu.Unlock()
+	now := c.now()
+	if ok && now.Before(entry.expires) {
+		return entry.addrs, nil
+	}
+
+	addrs, ttl, err := c.lookupHost(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		if ok && now.Before(entry.expires.Add(c.maxStale)) {
+			return entry.addrs, nil
+		}
+		if err == nil {
+			err = &net.DNSError{Err: "no addresses found", Name: host, IsNotFound: true}
+		}
+		return nil, err
+	}
+
+	if ttl <= 0 || ttl > c.ttl {
+		ttl = c.ttl
+	}
+	c.mu.Lock()
+	c.entries[host] = dnsEntry{addrs: addrs, expires: now.Add(ttl)}
+	c.mu.Unlock()
+	return addrs, nil
+}
+
+func (c *dnsCache) invalidate(host string) {
+	c.mu.Lock()
+	delete(c.entries, host)
+	c.mu.Unlock()
+}
+
+func (c *dnsCache) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || net.ParseIP(host) != nil {
+		return c.dial(ctx, network, addr)
+	}
+
+	addrs, err := c.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, ip := range addrs {
+		conn, err := c.dial(ctx, network, net.JoinHostPort(ip, port))
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	// None of the cached addresses worked, so make sure that the next dial
+	// resolves the host again.
+	c.invalidate(host)
+	return nil, lastErr
+}
+
+// The files that lookupHostTTL consults, as the system resolver does.
+var (
+	hostsFile    = "/etc/hosts"
+	nsswitchFile = "/etc/nsswitch.conf"
+	resolvFile   = "/etc/resolv.conf"
+)
+
+// lookupHostTTL resolves host with the nameservers of /etc/resolv.conf, which
+// report the TTL of its records. Hosts listed in /etc/hosts resolve to their
+// addresses there, which have no TTL. It falls back to net.DefaultResolver,
+// whose results have no TTL either, where there is no resolv.conf, where
+// nsswitch.conf resolves hosts with sources other than files and DNS, for hosts
+// that are not fully qualified and when the nameservers cannot be queried.
+func lookupHostTTL(ctx context.Context, host string) ([]string, time.Duration, error) {
+	if addrs := lookupStaticHost(host); len(addrs) != 0 {
+		return addrs, 0, nil
+	}
+	servers := nameservers()
+	if len(servers) != 0 && strings.Contains(strings.TrimSuffix(host, "."), ".") && onlyFilesAndDNS() {
+		for _, server := range servers {
+			addrs, ttl, err := queryHost(ctx, server, host)
+			if err == nil && len(addrs) != 0 {
+				return addrs, ttl, nil
+			}
+		}
+	}
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	return addrs, 0, err
+}
+
+// lookupStaticHost returns the addresses of host in /etc/hosts.
+func lookupStaticHost(host string) []string {
+	f, err := os.Open(hostsFile)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	host = strings.TrimSuffix(host, ".")
+	var addrs []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) < 2 || net.ParseIP(fields[0]) == nil {
+			continue
+		}
+		for _, name := range fields[1:] {
+			if strings.EqualFold(strings.TrimSuffix(name, "."), host) {
+				addrs = append(addrs, fields[0])
+				break
+			}
+		}
+	}
+	return addrs
+}
+
+// onlyFilesAndDNS reports whether nsswitch.conf resolves hosts with /etc/hosts
+// and DNS only, as it does when it has no hosts entry.
+func onlyFilesAndDNS() bool {
+	f, err := os.Open(nsswitchFile)
+	if err != nil {
+		return true
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != "hosts:" {
+			continue
+		}
+		for _, source := range fields[1:] {
+			// Actions such as [NOTFOUND=return] do not add sources.
+			if source != "files" && source != "dns" && !strings.HasPrefix(source, "[") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// nameservers returns the addresses of the nameservers of /etc/resolv.conf.
+func nameservers() []string {
+	f, err := os.Open(resolvFile)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	var servers []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" && net.ParseIP(fields[1]) != nil {
+			servers = append(servers, net.JoinHostPort(fields[1], "53"))
+		}
+	}
+	return servers
+}
+
+// queryHost queries server for the A and AAAA records of host, concurrently,
+// and returns their addresses and the lowest TTL of the records of the
+// answers, including the CNAME records that lead to them.
+func queryHost(ctx context.Context, server string, host string) (addrs []string, ttl time.Duration, err error) {
+	name, err := dnsmessage.NewName(strings.TrimSuffix(host, ".") + ".")
+	if err != nil {
+		return nil, 0, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	qtypes := []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA}
+	answers := make([][]dnsmessage.Resource, len(qtypes))
+	errs := make([]error, len(qtypes))
+	var wg sync.WaitGroup
+	for i, qtype := range qtypes {
+		wg.Add(1)
+		go func(i int, qtype dnsmessage.Type) {
+			defer wg.Done()
+			answers[i], errs[i] = dnsQuery(ctx, server, name, qtype)
+		}(i, qtype)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	var minTTL uint32
+	first := true
+	for _, answer := range append(answers[0], answers[1]...) {
+		if first || answer.Header.TTL < minTTL {
+			minTTL, first = answer.Header.TTL, false
+		}
+		switch body := answer.Body.(type) {
+		case *dnsmessage.AResource:
+			addrs = append(addrs, net.IP(body.A[:]).String())
+		case *dnsmessage.AAAAResource:
+			addrs = append(addrs, net.IP(body.AAAA[:]).String())
+		}
+	}
+	return addrs, time.Duration(minTTL) * time.Second, nil
+}
+
+func dnsQuery(ctx context.Context, server string, name dnsmessage.Name, qtype dnsmessage.Type) ([]dnsmessage.Resource, error) {
+	id := uint16(rand.Uint32())
+	msg := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{Name: name, Type: qtype, Class: dnsmessage.ClassINET}},
+	}
+	packed, err := msg.Pack()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := (&net.Dialer{}).DialContext(ctx, "udp", server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	if _, err := conn.Write(packed); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 1232)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		var res dnsmessage.Message
+		if err := res.Unpack(buf[:n]); err != nil || res.Header.ID != id {
+			continue
+		}
+		if res.Header.Truncated {
+			return nil, errors.New("dns: truncated response")
+		}
+		if res.Header.RCode != dnsmessage.RCodeSuccess {
+			return nil, &net.DNSError{Err: res.Header.RCode.String(), Name: name.String(), Server: server, IsNotFound: res.Header.RCode == dnsmessage.RCodeNameError}
+		}
+		return res.Answers, nil
+	}
+}
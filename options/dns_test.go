@@ -0,0 +1,176 @@
+package options
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func TestDNSCacheFailsOverAcrossAddresses(t *testing.T) {
+	lookups := 0
+	dialed := []string{}
+	cache := newDNSCache(time.Minute, 0, &net.Dialer{})
+	cache.lookupHost = func(ctx context.Context, host string) ([]string, time.Duration, error) {
+		lookups += 1
+		return []string{"10.0.0.1", "10.0.0.2"}, 0, nil
+	}
+	cache.dial = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed = append(dialed, addr)
+		if addr == "10.0.0.1:443" {
+			return nil, errors.New("connection refused")
+		}
+		client, server := net.Pipe()
+		server.Close()
+		return client, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		conn, err := cache.DialContext(context.Background(), "tcp", "api.lithic.com:443")
+		if err != nil {
+			t.Fatalf("expected dial to fail over, got %s", err)
+		}
+		conn.Close()
+	}
+	if lookups != 1 {
+		t.Fatalf("expected the lookup to be cached, got %d lookups", lookups)
+	}
+	if len(dialed) != 4 || dialed[1] != "10.0.0.2:443" {
+		t.Fatalf("unexpected dial order %v", dialed)
+	}
+}
+
+func TestDNSCacheServesStaleOnLookupFailure(t *testing.T) {
+	now := time.Now()
+	fail := false
+	cache := newDNSCache(time.Minute, 0, &net.Dialer{})
+	cache.now = func() time.Time { return now }
+	cache.lookupHost = func(ctx context.Context, host string) ([]string, time.Duration, error) {
+		if fail {
+			return nil, 0, errors.New("timeout")
+		}
+		return []string{"10.0.0.1"}, 0, nil
+	}
+
+	if _, err := cache.lookup(context.Background(), "api.lithic.com"); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(2 * time.Minute)
+	fail = true
+	addrs, err := cache.lookup(context.Background(), "api.lithic.com")
+	if err != nil || len(addrs) != 1 {
+		t.Fatalf("expected stale addresses to be served, got %v %v", addrs, err)
+	}
+}
+
+func TestDNSCacheBoundsStaleEntries(t *testing.T) {
+	now := time.Now()
+	fail := false
+	cache := newDNSCache(time.Minute, 3*time.Minute, &net.Dialer{})
+	cache.now = func() time.Time { return now }
+	cache.lookupHost = func(ctx context.Context, host string) ([]string, time.Duration, error) {
+		if fail {
+			return nil, 0, errors.New("timeout")
+		}
+		return []string{"10.0.0.1"}, 0, nil
+	}
+
+	if _, err := cache.lookup(context.Background(), "api.lithic.com"); err != nil {
+		t.Fatal(err)
+	}
+	fail = true
+	now = now.Add(5 * time.Minute)
+	if addrs, err := cache.lookup(context.Background(), "api.lithic.com"); err == nil {
+		t.Fatalf("expected an entry past its stale bound not to be served, got %v", addrs)
+	}
+}
+
+func TestDNSCacheHonoursRecordTTL(t *testing.T) {
+	now := time.Now()
+	lookups := 0
+	cache := newDNSCache(time.Hour, 0, &net.Dialer{})
+	cache.now = func() time.Time { return now }
+	cache.lookupHost = func(ctx context.Context, host string) ([]string, time.Duration, error) {
+		lookups += 1
+		return []string{"10.0.0.1"}, 30 * time.Second, nil
+	}
+
+	cache.lookup(context.Background(), "api.lithic.com")
+	now = now.Add(20 * time.Second)
+	cache.lookup(context.Background(), "api.lithic.com")
+	if lookups != 1 {
+		t.Fatalf("expected the entry to be cached within its TTL, got %d lookups", lookups)
+	}
+	now = now.Add(20 * time.Second)
+	cache.lookup(context.Background(), "api.lithic.com")
+	if lookups != 2 {
+		t.Fatalf("expected the entry to expire with its record TTL, got %d lookups", lookups)
+	}
+}
+
+func TestQueryHost(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer conn.Close()
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			var msg dnsmessage.Message
+			if err := msg.Unpack(buf[:n]); err != nil {
+				continue
+			}
+			msg.Header.Response = true
+			q := msg.Questions[0]
+			if q.Type == dnsmessage.TypeA {
+				target := dnsmessage.MustNewName("edge.lithic.com.")
+				msg.Answers = []dnsmessage.Resource{
+					{Header: dnsmessage.ResourceHeader{Name: q.Name, Type: dnsmessage.TypeCNAME, Class: dnsmessage.ClassINET, TTL: 300}, Body: &dnsmessage.CNAMEResource{CNAME: target}},
+					{Header: dnsmessage.ResourceHeader{Name: target, Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 60}, Body: &dnsmessage.AResource{A: [4]byte{10, 0, 0, 1}}},
+				}
+			}
+			packed, _ := msg.Pack()
+			conn.WriteTo(packed, addr)
+		}
+	}()
+
+	addrs, ttl, err := queryHost(context.Background(), conn.LocalAddr().String(), "api.lithic.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addrs) != 1 || addrs[0] != "10.0.0.1" || ttl != time.Minute {
+		t.Fatalf("expected the address with the lowest TTL of its records, got %v %v", addrs, ttl)
+	}
+}
+
+func TestLookupHostTTLUsesHostsFile(t *testing.T) {
+	dir := t.TempDir()
+	hosts := filepath.Join(dir, "hosts")
+	os.WriteFile(hosts, []byte("127.0.0.1 localhost\n10.1.2.3 api.lithic.com proxy # local proxy\n::1 API.lithic.com.\n"), 0o644)
+	nsswitch := filepath.Join(dir, "nsswitch.conf")
+	os.WriteFile(nsswitch, []byte("hosts: files mdns4_minimal [NOTFOUND=return] dns\n"), 0o644)
+	defer func(hosts, nsswitch string) { hostsFile, nsswitchFile = hosts, nsswitch }(hostsFile, nsswitchFile)
+	hostsFile, nsswitchFile = hosts, nsswitch
+
+	addrs, ttl, err := lookupHostTTL(context.Background(), "api.lithic.com.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(addrs) != "[10.1.2.3 ::1]" || ttl != 0 {
+		t.Fatalf("expected the addresses of the hosts file, got %v %v", addrs, ttl)
+	}
+	if onlyFilesAndDNS() {
+		t.Fatal("expected mdns to make the system resolver be used")
+	}
+}
@@ -0,0 +1,69 @@
+package options
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Environment identifies a deployment of the Lithic API.
+type Environment string
+
+const (
+	EnvironmentProduction Environment = "production"
+	EnvironmentSandbox    Environment = "sandbox"
+)
+
+// Base URLs of each environment, before a region is applied.
+var environmentURLs = map[Environment]string{
+	EnvironmentProduction: "https://api.lithic.com/v1/",
+	EnvironmentSandbox:    "https://sandbox.lithic.com/v1/",
+}
+
+// ErrSimulateInProduction is returned, wrapped, when a simulate endpoint is
+// called against the production environment, see
+// WithSimulateInProductionAllowed.
+var ErrSimulateInProduction = errors.New("lithic: simulate endpoints are not available in production")
+
+// WithEnvironment sends requests to the base URL of the given environment.
+func WithEnvironment(env Environment) RequestOption {
+	base, ok := environmentURLs[env]
+	if !ok {
+		return func(r *RequestConfig) error {
+			return fmt.Errorf("unknown environment %q", env)
+		}
+	}
+	return WithBaseURL(base)
+}
+
+// WithSimulateInProductionAllowed lets simulate endpoints, such as
+// TransactionService.SimulateAuthorization, be called against the production
+// environment. By default such calls fail with ErrSimulateInProduction before
+// they are sent.
+func WithSimulateInProductionAllowed() RequestOption {
+	return func(r *RequestConfig) error {
+		r.SimulateInProductionAllowed = true
+		return nil
+	}
+}
+
+// EnvironmentOf returns the environment that a URL points at, or an empty
+// environment for URLs that don't point at a Lithic host, such as a mock server
+// or a proxy.
+func EnvironmentOf(u *url.URL) Environment {
+	if u == nil {
+		return ""
+	}
+	for env, base := range environmentURLs {
+		b, err := url.Parse(base)
+		if err != nil {
+			continue
+		}
+		for _, host := range regionalHosts[b.Host] {
+			if u.Host == host {
+				return env
+			}
+		}
+	}
+	return ""
+}
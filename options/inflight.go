@@ -0,0 +1,69 @@
+package options
+
+import (
+	"context"
+	"sync"
+)
+
+// InflightCounter counts the requests that are being executed, from the moment
+// they are sent until their response has been read, including any time spent
+// waiting between retries. The zero value is ready to use.
+type InflightCounter struct {
+	mu   sync.Mutex
+	n    int
+	idle chan struct{}
+}
+
+func (c *InflightCounter) add() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.n == 0 || c.idle == nil {
+		c.idle = make(chan struct{})
+	}
+	c.n += 1
+}
+
+func (c *InflightCounter) done() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.n -= 1
+	if c.n == 0 {
+		close(c.idle)
+	}
+}
+
+// Count returns the number of requests that are currently being executed.
+func (c *InflightCounter) Count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.n
+}
+
+// Wait blocks until no requests are being executed or ctx is done, in which case
+// the context's error is returned.
+func (c *InflightCounter) Wait(ctx context.Context) error {
+	for {
+		c.mu.Lock()
+		if c.n == 0 {
+			c.mu.Unlock()
+			return nil
+		}
+		idle := c.idle
+		c.mu.Unlock()
+
+		select {
+		case <-idle:
+			// Requests may have started since, so check the count again.
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// WithInflightCounter counts every request executed with this config in counter.
+func WithInflightCounter(counter *InflightCounter) RequestOption {
+	return func(r *RequestConfig) error {
+		r.InflightCounter = counter
+		return nil
+	}
+}
@@ -0,0 +1,54 @@
+package options
+
+import (
+	"net/http"
+	"strings"
+)
+
+// ResponseMetadata is what is known about a response without decoding its body.
+// Passing a *ResponseMetadata as the destination of ExecuteNewRequest fills it
+// in instead of decoding the body, which suits requests whose responses have
+// no body, such as HEAD and OPTIONS requests:
+//
+//	var meta options.ResponseMetadata
+//	err := options.ExecuteNewRequest(ctx, http.MethodHead, "cards/"+token, nil, &meta, opts...)
+//	if errors.Is(err, core.ErrNotFound) {
+//		// The card does not exist.
+//	}
+type ResponseMetadata struct {
+	StatusCode int
+	Header     http.Header
+	// The X-Request-Id of the response, which Lithic support can look up.
+	RequestID string
+	// The length of the body, or -1 if it is unknown. For HEAD requests, this
+	// is the length of the body that a GET request would return.
+	ContentLength int64
+}
+
+// Allow returns the methods listed in the Allow header, as returned in response
+// to OPTIONS requests.
+func (m ResponseMetadata) Allow() (methods []string) {
+	for _, value := range m.Header.Values("Allow") {
+		for _, method := range strings.Split(value, ",") {
+			if method = strings.TrimSpace(method); method != "" {
+				methods = append(methods, method)
+			}
+		}
+	}
+	return methods
+}
+
+func newResponseMetadata(res *http.Response) ResponseMetadata {
+	return ResponseMetadata{
+		StatusCode:    res.StatusCode,
+		Header:        res.Header,
+		RequestID:     res.Header.Get("X-Request-Id"),
+		ContentLength: res.ContentLength,
+	}
+}
+
+// hasNoBody reports whether the response to a request with the given method
+// cannot have a body, whatever its headers say.
+func hasNoBody(method string, res *http.Response) bool {
+	return method == http.MethodHead || res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotModified
+}
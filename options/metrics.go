@@ -0,0 +1,150 @@
+package options
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MetricsCollector is told about every API call once it has completed, for
+// example to export counters and latency histograms to Prometheus. It must be
+// safe for concurrent use.
+type MetricsCollector interface {
+	ObserveCall(call CallMetrics)
+}
+
+// CallMetrics describes a completed API call.
+type CallMetrics struct {
+	// The HTTP method of the call.
+	Method string
+	// The path of the endpoint, with tokens replaced by a placeholder so that
+	// calls to the same endpoint share it, for example "cards/{token}".
+	Path string
+	// The status code of the final response, or 0 if none was received.
+	StatusCode int
+	// The number of times the call was retried.
+	Retries int
+	// The time taken by the call, including any retries.
+	Duration time.Duration
+	// The error the call failed with, if any.
+	Err error
+	// The identity of the caller, see WithCallerIdentity.
+	Caller string
+}
+
+// Endpoint returns the method and path of the call, for example
+// "POST cards/{token}/reissue", for use as a metric label.
+func (c CallMetrics) Endpoint() string {
+	return c.Method + " " + c.Path
+}
+
+// WithMetricsCollector reports every API call to the given collector.
+func WithMetricsCollector(collector MetricsCollector) RequestOption {
+	return func(r *RequestConfig) error {
+		r.MetricsCollector = collector
+		return nil
+	}
+}
+
+// DefaultLatencyBuckets are the upper bounds of the latency histograms of an
+// ExpvarCollector.
+var DefaultLatencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// ExpvarCollector is a MetricsCollector that keeps metrics per endpoint in
+// memory. It implements expvar.Var, so it can be published with
+// expvar.Publish("lithic", collector). The zero value is ready to use.
+type ExpvarCollector struct {
+	// The upper bounds of the latency histograms. DefaultLatencyBuckets is used
+	// if it is empty. It must not be changed once calls have been observed.
+	Buckets []time.Duration
+
+	mu        sync.Mutex
+	endpoints map[string]*EndpointMetrics
+}
+
+// EndpointMetrics are the metrics of the calls to a single endpoint.
+type EndpointMetrics struct {
+	Requests int64 `json:"requests"`
+	// The number of failed calls by status code, with 0 counting calls that
+	// failed without a response.
+	Errors  map[int]int64 `json:"errors"`
+	Retries int64         `json:"retries"`
+	Latency Histogram     `json:"latency"`
+}
+
+// Histogram counts observations in buckets. Counts[i] is the number of
+// observations no greater than Buckets[i] and greater than the previous bucket,
+// and the last count is of the observations greater than all buckets.
+type Histogram struct {
+	Buckets []time.Duration `json:"buckets"`
+	Counts  []int64         `json:"counts"`
+	Sum     time.Duration   `json:"sum"`
+}
+
+func (h *Histogram) observe(d time.Duration) {
+	i := sort.Search(len(h.Buckets), func(i int) bool { return d <= h.Buckets[i] })
+	h.Counts[i] += 1
+	h.Sum += d
+}
+
+func (c *ExpvarCollector) ObserveCall(call CallMetrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.endpoints == nil {
+		c.endpoints = map[string]*EndpointMetrics{}
+	}
+	m, ok := c.endpoints[call.Endpoint()]
+	if !ok {
+		buckets := c.Buckets
+		if len(buckets) == 0 {
+			buckets = DefaultLatencyBuckets
+		}
+		m = &EndpointMetrics{
+			Errors:  map[int]int64{},
+			Latency: Histogram{Buckets: buckets, Counts: make([]int64, len(buckets)+1)},
+		}
+		c.endpoints[call.Endpoint()] = m
+	}
+	m.Requests += 1
+	m.Retries += int64(call.Retries)
+	if call.Err != nil {
+		m.Errors[call.StatusCode] += 1
+	}
+	m.Latency.observe(call.Duration)
+}
+
+// Snapshot returns a copy of the metrics, keyed by endpoint.
+func (c *ExpvarCollector) Snapshot() map[string]EndpointMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	snapshot := make(map[string]EndpointMetrics, len(c.endpoints))
+	for endpoint, m := range c.endpoints {
+		copied := *m
+		copied.Errors = make(map[int]int64, len(m.Errors))
+		for status, n := range m.Errors {
+			copied.Errors[status] = n
+		}
+		copied.Latency.Counts = append([]int64(nil), m.Latency.Counts...)
+		snapshot[endpoint] = copied
+	}
+	return snapshot
+}
+
+// String returns the metrics as JSON, as required by expvar.Var.
+func (c *ExpvarCollector) String() string {
+	b, err := json.Marshal(c.Snapshot())
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
@@ -7,19 +7,17 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"math"
-	"math/rand"
 	"net/http"
 	"net/url"
 	"runtime"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/lithic-com/lithic-go/core"
 	"github.com/lithic-com/lithic-go/core/form"
+	pjson "github.com/lithic-com/lithic-go/core/json"
 	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/redact"
 	"github.com/tidwall/sjson"
 )
 
@@ -62,7 +60,7 @@ func getNormalizedArchitecture() string {
 func getPlatformProperties() map[string]string {
 	return map[string]string{
 		"X-Stainless-Lang":            "go",
-		"X-Stainless-Package-Version": "unknown",
+		"X-Stainless-Package-Version": core.PackageVersion,
 		"X-Stainless-OS":              getNormalizedOS(),
 		"X-Stainless-Arch":            getNormalizedArchitecture(),
 		"X-Stainless-Runtime":         "go",
@@ -82,11 +80,20 @@ func NewRequestConfig(ctx context.Context, method string, u string, body interfa
 	}
 	if body, ok := body.(form.Marshaler); ok {
 		var err error
-		b, err = body.MarshalMultipart()
+		b, contentType, err = body.MarshalMultipart()
 		if err != nil {
 			return nil, err
 		}
-		contentType = "multipart/form-data"
+	}
+	// Any other reader, such as a file, is sent as is. It is read into memory so
+	// that the request can be retried.
+	if body, ok := body.(io.Reader); ok {
+		var err error
+		b, err = io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		contentType = http.DetectContentType(b)
 	}
 	if body, ok := body.(query.Queryer); ok {
 		u = u + "?" + body.URLQuery().Encode()
@@ -118,6 +125,9 @@ func NewRequestConfig(ctx context.Context, method string, u string, body interfa
 	return &cfg, nil
 }
 
+// ExecuteNewRequest sends a request with any HTTP method, including HEAD,
+// OPTIONS and custom verbs, and decodes the response into dst. Pass a
+// *ResponseMetadata as dst for responses that have no body.
 func ExecuteNewRequest(ctx context.Context, method string, u string, body interface{}, dst interface{}, opts ...RequestOption) error {
 	cfg, err := NewRequestConfig(ctx, method, u, body, dst, opts...)
 	if err != nil {
@@ -131,26 +141,164 @@ type RequestConfig struct {
 	Context    context.Context
 	Request    *http.Request
 	BaseURL    *url.URL
-	HTTPClient *http.Client
-	APIKey     string
+	// If BaseURLProvider is not nil, it is called before every request and takes
+	// precedence over BaseURL.
+	BaseURLProvider func(context.Context) string
+	// Region of the API that requests are sent to. Only applies to base URLs
+	// that point at the Lithic API.
+	Region Region
+	// If ServingRegionInto is not nil, the region that served the request is
+	// stored into it.
+	ServingRegionInto *Region
+	// Retry policies by operation class, see WithRetryPolicyFor.
+	RetryPolicies map[OperationClass]RetryPolicy
+	// If IdempotencyKey is not empty, the request is sent with it and may be
+	// retried after any connection failure, see WithIdempotencyKey.
+	IdempotencyKey string
+	HTTPClient     *http.Client
+	APIKey         string
 	// If ResponseBodyInto not nil, then we will attempt to deserialize into
 	// ResponseBodyInto. If Destination is a []byte, then it will return the body as
 	// is.
 	ResponseBodyInto interface{}
 	// ResponseInto copies the \*http.Response of the corresponding request into the
-	// given address
+	// given address, including for error responses. Its body can be read after
+	// the response has been decoded.
 	ResponseInto  **http.Response
 	WebhookSecret string
-	buffer        []byte
+	// Middlewares are run in order around every HTTP round trip, with the first
+	// middleware being the outermost.
+	Middlewares []Middleware
+	// The maximum number of items that auto-pagination helpers collect, see
+	// WithMaxPaginationItems.
+	MaxPaginationItems int
+	// The number of pages that page numbered lists fetch ahead concurrently, see
+	// WithPagePrefetch.
+	PagePrefetch int
+	// The maximum span of the time range of list requests that are validated,
+	// see WithMaxListTimeRange.
+	MaxListTimeRange time.Duration
+	// If InflightCounter is not nil, the request is counted in it while it is
+	// being executed.
+	InflightCounter *InflightCounter
+	// If TracerProvider is not nil, every API call is traced in a span.
+	TracerProvider TracerProvider
+	// If MetricsCollector is not nil, every API call is reported to it.
+	MetricsCollector MetricsCollector
+	// Preconditions that are checked against the current state of the resource
+	// before the request is sent, see WithPrecondition.
+	Preconditions []Precondition
+	// If RateLimiter is not nil, every attempt waits for it before it is sent.
+	RateLimiter *RateLimiter
+	// Whether simulate endpoints may be called against the production
+	// environment, see WithSimulateInProductionAllowed.
+	SimulateInProductionAllowed bool
+	// Fields of the request body that middlewares must not see, see
+	// WithRedactedFields.
+	RedactedFields []string
+	// If SimulatedMarker is not empty, it is appended to the memos of the
+	// resources created in the sandbox, see WithSimulatedMarker.
+	SimulatedMarker string
+	// If RedactionPolicy is not nil, it replaces redact.Default() for this
+	// request, see WithRedactionPolicy.
+	RedactionPolicy *redact.Policy
+	// If CallerIdentity is not nil, the identity it returns is sent in the
+	// CallerIdentityHeader, see WithCallerIdentity.
+	CallerIdentity       func(ctx context.Context) string
+	CallerIdentityHeader string
+	// If Diagnostics is not nil, every round trip is captured in it, see
+	// WithDiagnostics.
+	Diagnostics *Diagnostics
+	// If Sampler is not nil, a fraction of the round trips are passed to it,
+	// see WithSampler.
+	Sampler *Sampler
+	// If DecodeDiagnostics is not nil, it is called with a report of every
+	// decoded JSON response, see WithDecodeDiagnostics.
+	DecodeDiagnostics func(DecodeReport)
+	buffer            []byte
+}
+
+// MiddlewareNext sends the request on to the next middleware in the chain, or to
+// the underlying http.Client if there are none left.
+type MiddlewareNext = func(*http.Request) (*http.Response, error)
+
+// Middleware intercepts a request before it is sent and can inspect or replace
+// the response that is returned.
+type Middleware = func(*http.Request, MiddlewareNext) (*http.Response, error)
+
+func (cfg *RequestConfig) roundTrip(req *http.Request) (*http.Response, error) {
+	send := cfg.HTTPClient.Do
+	if cfg.Diagnostics != nil {
+		send = cfg.captureDiagnostics(send)
+	}
+	if cfg.Sampler != nil {
+		send = cfg.sampleRoundTrips(send)
+	}
+	handler := func(req *http.Request) (*http.Response, error) {
+		res, err := send(req)
+		return trackBody(res), err
+	}
+	if len(cfg.buffer) != 0 {
+		req, handler = cfg.redact(req, handler)
+	}
+	for i := len(cfg.Middlewares) - 1; i >= 0; i -= 1 {
+		handler = applyMiddleware(cfg.Middlewares[i], handler)
+	}
+	return handler(req)
+}
+
+func applyMiddleware(middleware Middleware, next MiddlewareNext) MiddlewareNext {
+	return func(req *http.Request) (*http.Response, error) {
+		return middleware(req, next)
+	}
+}
+
+// URL returns the absolute URL of the request, resolved against the configured
+// base URL and region.
+func (cfg *RequestConfig) URL() (*url.URL, error) {
+	base := cfg.BaseURL
+	if cfg.BaseURLProvider != nil {
+		var err error
+		base, err = url.Parse(cfg.BaseURLProvider(cfg.Context))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse BaseURL from provider: %w", err)
+		}
+	}
+	return regionalURL(base, cfg.Region).Parse(cfg.Request.URL.String())
 }
 
-func (cfg *RequestConfig) Execute() error {
-	u, err := cfg.BaseURL.Parse(cfg.Request.URL.String())
+func (cfg *RequestConfig) Execute() (err error) {
+	if cfg.InflightCounter != nil {
+		cfg.InflightCounter.add()
+		defer cfg.InflightCounter.done()
+	}
+	call := cfg.startCall()
+	call.caller = cfg.identifyCaller()
+	defer func() { call.end(err) }()
+
+	u, err := cfg.URL()
 	if err != nil {
 		return err
 	}
+	path := cfg.Request.URL.Path
 	cfg.Request.URL = u
 
+	if !cfg.SimulateInProductionAllowed && EnvironmentOf(u) == EnvironmentProduction && ClassifyRequest(cfg.Request) == OperationClassSimulate {
+		return fmt.Errorf("%w: %s %s", ErrSimulateInProduction, cfg.Request.Method, u.Path)
+	}
+
+	if cfg.SimulatedMarker != "" {
+		if err = cfg.markSimulated(path, u); err != nil {
+			return err
+		}
+	}
+
+	if len(cfg.Preconditions) != 0 {
+		if err = cfg.checkPreconditions(); err != nil {
+			return err
+		}
+	}
+
 	if len(cfg.buffer) != 0 && cfg.Request.Body == nil {
 		buf := bytes.NewReader(cfg.buffer)
 		cfg.Request.ContentLength = int64(len(cfg.buffer))
@@ -158,48 +306,78 @@ func (cfg *RequestConfig) Execute() error {
 		cfg.Request.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(cfg.buffer)), nil }
 	}
 
+	policy := cfg.retryPolicy()
 	var res *http.Response
-	for i := 0; i <= cfg.MaxRetries; i += 1 {
-		res, err = cfg.HTTPClient.Do(cfg.Request.Clone(cfg.Request.Context()))
+	ctx := cfg.Request.Context()
+	for i := 0; ; i += 1 {
+		if cfg.RateLimiter != nil {
+			if err = cfg.RateLimiter.Wait(ctx); err != nil {
+				res = nil
+				break
+			}
+		}
+		res, err = cfg.roundTrip(cfg.Request.Clone(ctx))
+		if cfg.RateLimiter != nil {
+			cfg.RateLimiter.Observe(res)
+		}
+		call.attempts, call.res = i+1, res
 
-		if i == cfg.MaxRetries || err == nil && res.StatusCode != http.StatusConflict && res.StatusCode != http.StatusTooManyRequests && res.StatusCode < http.StatusInternalServerError {
+		if i >= policy.MaxRetries || !cfg.shouldRetry(ctx, res, err) || BodyConsumed(res) {
 			break
 		}
-
-		duration := time.Duration(500) * time.Millisecond * time.Duration(math.Exp(float64(i)))
-		if res != nil {
-			if parsed, err := strconv.ParseInt(res.Header.Get("Retry-After"), 10, 64); err == nil {
-				duration = time.Duration(parsed) * time.Second
-			}
+		if res != nil && res.Body != nil {
+			res.Body.Close()
 		}
-		if duration > time.Duration(60)*time.Second {
-			duration = time.Duration(60) * time.Second
+
+		if err = sleep(ctx, policy.backoff(i, res)); err != nil {
+			res = nil
+			break
 		}
-		duration += time.Millisecond * time.Duration(-500+rand.Intn(1000))
-		time.Sleep(duration)
 	}
 
 	if err != nil {
 		return core.RequestError{Cause: err, Request: cfg.Request, Response: res}
 	}
+	if cfg.ResponseInto != nil {
+		// The body is buffered so that it can still be read by the caller once
+		// it has been decoded.
+		if err = bufferBody(res); err != nil {
+			return fmt.Errorf("error reading response body: %w", err)
+		}
+		defer rewindBody(res)
+		*cfg.ResponseInto = res
+	}
 	if res.StatusCode > 299 {
+		if contentType := res.Header.Get("Content-Type"); contentType != "" && !core.IsJSONContentType(contentType) {
+			contents, _ := io.ReadAll(res.Body)
+			return core.NewTransportError(cfg.Request, res, contents)
+		}
 		return core.NewAPIErrorFromResponse(cfg.Request, res)
 	}
 
-	if cfg.ResponseInto != nil {
-		*cfg.ResponseInto = res
+	if cfg.ServingRegionInto != nil {
+		*cfg.ServingRegionInto = ServingRegion(res)
 	}
 
 	if cfg.ResponseBodyInto == nil {
 		return nil
 	}
+	if dst, ok := cfg.ResponseBodyInto.(*ResponseMetadata); ok {
+		*dst = newResponseMetadata(res)
+		return nil
+	}
+	// Responses to HEAD requests, for example, have a JSON content type but no
+	// body to decode.
+	if hasNoBody(cfg.Request.Method, res) {
+		return nil
+	}
 	contents, err := io.ReadAll(res.Body)
 	if err != nil {
 		return fmt.Errorf("error reading response body: %w", err)
 	}
 
 	// If we are not json return plaintext
-	isJSON := strings.Contains(res.Header.Get("content-type"), "application/json")
+	isJSON := core.IsJSONContentType(res.Header.Get("content-type"))
 	if !isJSON {
 		switch dst := cfg.ResponseBodyInto.(type) {
 		case *string:
@@ -210,14 +388,22 @@ func (cfg *RequestConfig) Execute() error {
 		case *[]byte:
 			*dst = contents
 		default:
-			return fmt.Errorf("expected destination type of 'string' or '[]byte' for responses with content-type that is not 'application/json'")
+			return core.NewTransportError(cfg.Request, res, contents)
 		}
 		return nil
 	}
 
-	err = json.NewDecoder(bytes.NewReader(contents)).Decode(cfg.ResponseBodyInto)
+	if dst, ok := cfg.ResponseBodyInto.(*[]byte); ok {
+		*dst = contents
+		return nil
+	}
+
+	err = json.NewDecoder(bytes.NewReader(pjson.Normalize(contents))).Decode(cfg.ResponseBodyInto)
 	if err != nil {
-		err = fmt.Errorf("error parsing response json: %w", err)
+		return fmt.Errorf("error parsing response json: %w", err)
+	}
+	if cfg.DecodeDiagnostics != nil {
+		cfg.reportDecode(res)
 	}
 
 	return nil
@@ -228,19 +414,18 @@ func (cfg *RequestConfig) Clone(ctx context.Context) *RequestConfig {
 		return nil
 	}
 	req := cfg.Request.Clone(ctx)
-	var err error
-	req.Body, err = req.GetBody()
-	if err != nil {
-		return nil
-	}
-	new := &RequestConfig{
-		MaxRetries: cfg.MaxRetries,
-		Context:    ctx,
-		Request:    req,
-		HTTPClient: cfg.HTTPClient,
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil
+		}
+		req.Body = body
 	}
+	new := *cfg
+	new.Context = ctx
+	new.Request = req
 	new.Request.Header.Set("Idempotency-Token", "stainless-go-"+uuid.New().String())
-	return new
+	return &new
 }
 
 func (cfg *RequestConfig) Apply(opts ...RequestOption) error {
@@ -266,6 +451,20 @@ func WithBaseURL(base string) RequestOption {
 	}
 }
 
+// WithBaseURLProvider sets a function that is evaluated before every request to
+// pick the base URL, so that traffic can be moved to another endpoint, such as a
+// disaster recovery or regional gateway, by changing runtime configuration. The
+// provider takes precedence over WithBaseURL and the environment options.
+//
+// Auto-paginating list calls keep using the base URL that their first page was
+// fetched from.
+func WithBaseURLProvider(provider func(ctx context.Context) string) RequestOption {
+	return func(r *RequestConfig) error {
+		r.BaseURLProvider = provider
+		return nil
+	}
+}
+
 func WithHTTPClient(client *http.Client) RequestOption {
 	return func(r *RequestConfig) error {
 		r.HTTPClient = client
@@ -273,6 +472,15 @@ func WithHTTPClient(client *http.Client) RequestOption {
 	}
 }
 
+// WithMiddleware appends middlewares that are run around every HTTP round trip
+// made with this config.
+func WithMiddleware(middlewares ...Middleware) RequestOption {
+	return func(r *RequestConfig) error {
+		r.Middlewares = append(r.Middlewares, middlewares...)
+		return nil
+	}
+}
+
 func WithMaxRetries(retries int) RequestOption {
 	return func(r *RequestConfig) error {
 		r.MaxRetries = retries
@@ -280,6 +488,44 @@ func WithMaxRetries(retries int) RequestOption {
 	}
 }
 
+// The number of items that auto-pagination helpers collect at most by default.
+const DefaultMaxPaginationItems = 100000
+
+// WithMaxPaginationItems caps the number of items that auto-pagination helpers,
+// such as All, collect into memory before giving up with an
+// ErrResultSetTooLarge. A limit of 0 uses DefaultMaxPaginationItems and a
+// negative limit removes the cap.
+func WithMaxPaginationItems(limit int) RequestOption {
+	return func(r *RequestConfig) error {
+		r.MaxPaginationItems = limit
+		return nil
+	}
+}
+
+// WithPagePrefetch makes the iterators of page numbered lists, such as those
+// of cards and transactions, and their All, fetch up to pages of the following
+// pages concurrently, ahead of their use, which speeds up exports of long
+// lists. The requests still wait for the rate limiter of WithRateLimiter, if
+// any, which is shared by all of them. Cursor paginated lists can only be
+// fetched one page after the other and ignore it.
+func WithPagePrefetch(pages int) RequestOption {
+	return func(r *RequestConfig) error {
+		r.PagePrefetch = pages
+		return nil
+	}
+}
+
+// WithMaxListTimeRange rejects the lists of cards and transactions whose
+// Begin and End span more than max, or until now if End is not set, before they
+// are sent, to catch ranges that accidentally query the whole history. Ranges
+// with Begin after End, or set to the zero time, are always rejected.
+func WithMaxListTimeRange(max time.Duration) RequestOption {
+	return func(r *RequestConfig) error {
+		r.MaxListTimeRange = max
+		return nil
+	}
+}
+
 func WithHeader(key, value string) RequestOption {
 	return func(r *RequestConfig) error {
 		r.Request.Header[key] = []string{value}
@@ -344,6 +590,10 @@ func WithResponseBodyInto(dst any) RequestOption {
 	}
 }
 
+// WithResponseInto stores the raw response of the request into dst, so that its
+// status, headers, such as X-Request-Id or the rate-limit headers, and body can
+// be inspected alongside the decoded result. The response is stored for error
+// responses as well, and its body remains readable after it has been decoded.
 func WithResponseInto(dst **http.Response) RequestOption {
 	return func(r *RequestConfig) error {
 		r.ResponseInto = dst
@@ -359,11 +609,11 @@ func WithAPIKey(key string) RequestOption {
 }
 
 func WithEnvironmentProduction() RequestOption {
-	return WithBaseURL("https://api.lithic.com/v1/")
+	return WithEnvironment(EnvironmentProduction)
 }
 
 func WithEnvironmentSandbox() RequestOption {
-	return WithBaseURL("https://sandbox.lithic.com/v1/")
+	return WithEnvironment(EnvironmentSandbox)
 }
 
 func WithWebhookSecret(value string) RequestOption {
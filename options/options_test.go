@@ -0,0 +1,975 @@
+package options
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go/core"
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/redact"
+)
+
+type testResponse struct {
+	Token string `json:"token"`
+}
+
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestHTMLErrorBodyIsTransportError(t *testing.T) {
+	page := "<html><body>" + strings.Repeat("502 Bad Gateway ", 100) + "</body></html>"
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(page))
+	})
+
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithBaseURL(server.URL), WithMaxRetries(0))
+	var transportErr core.TransportError
+	if !errors.As(err, &transportErr) {
+		t.Fatalf("expected a TransportError, got %#v", err)
+	}
+	if transportErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected status 502, got %d", transportErr.StatusCode)
+	}
+	if len(transportErr.Snippet) >= len(page) || !strings.HasPrefix(transportErr.Snippet, "<html>") {
+		t.Fatalf("expected a truncated snippet, got %q", transportErr.Snippet)
+	}
+}
+
+func TestHTMLSuccessBodyIsTransportError(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html>maintenance</html>"))
+	})
+
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithBaseURL(server.URL), WithMaxRetries(0))
+	var transportErr core.TransportError
+	if !errors.As(err, &transportErr) {
+		t.Fatalf("expected a TransportError, got %#v", err)
+	}
+}
+
+func TestJSONErrorBodyIsAPIError(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"not found"}`))
+	})
+
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithBaseURL(server.URL), WithMaxRetries(0))
+	var apiErr core.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got %#v", err)
+	}
+}
+
+func TestJSONBodyWithBOMAndCharset(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "Application/JSON;Charset=UTF-8")
+		w.Write(append([]byte{0xEF, 0xBB, 0xBF}, `{"token":"abc"}`...))
+	})
+
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithBaseURL(server.URL), WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err)
+	}
+	if res.Token != "abc" {
+		t.Fatalf("expected token to be decoded, got %q", res.Token)
+	}
+}
+
+func TestTransportConfigHTTPVersion(t *testing.T) {
+	if transport := NewTransport(TransportConfig{HTTPVersion: HTTPVersion1}); transport.TLSNextProto == nil || transport.ForceAttemptHTTP2 {
+		t.Fatalf("expected HTTP/2 to be disabled")
+	}
+	if transport := NewTransport(TransportConfig{HTTPVersion: HTTPVersion2}); !transport.ForceAttemptHTTP2 {
+		t.Fatalf("expected HTTP/2 to be attempted")
+	}
+	transport := NewTransport(TransportConfig{IdleConnTimeout: time.Second, MaxIdleConnsPerHost: 7})
+	if transport.IdleConnTimeout != time.Second || transport.MaxIdleConnsPerHost != 7 {
+		t.Fatalf("expected connection pool settings to be applied")
+	}
+}
+
+func TestBaseURLProviderIsEvaluatedPerRequest(t *testing.T) {
+	hits := map[string]int{}
+	handler := func(name string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			hits[name] += 1
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"token":"abc"}`))
+		}
+	}
+	primary := newTestServer(t, handler("primary"))
+	secondary := newTestServer(t, handler("secondary"))
+
+	current := primary.URL
+	opts := []RequestOption{
+		WithBaseURL("http://invalid.localhost"),
+		WithBaseURLProvider(func(ctx context.Context) string { return current }),
+		WithMaxRetries(0),
+	}
+	var res testResponse
+	if err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, opts...); err != nil {
+		t.Fatal(err)
+	}
+	current = secondary.URL
+	if err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, opts...); err != nil {
+		t.Fatal(err)
+	}
+	if hits["primary"] != 1 || hits["secondary"] != 1 {
+		t.Fatalf("expected one request per base URL, got %v", hits)
+	}
+}
+
+func TestRegionalURL(t *testing.T) {
+	cases := map[string]struct {
+		base   string
+		region Region
+		want   string
+	}{
+		"production_eu":  {"https://api.lithic.com/v1/", RegionEU, "https://api.eu.lithic.com/v1/"},
+		"sandbox_eu":     {"https://sandbox.lithic.com/v1/", RegionEU, "https://sandbox.eu.lithic.com/v1/"},
+		"eu_back_to_us":  {"https://api.eu.lithic.com/v1/", RegionUS, "https://api.lithic.com/v1/"},
+		"no_region":      {"https://api.lithic.com/v1/", "", "https://api.lithic.com/v1/"},
+		"custom_base":    {"http://127.0.0.1:4010", RegionEU, "http://127.0.0.1:4010"},
+		"unknown_region": {"https://api.lithic.com/v1/", Region("ap"), "https://api.lithic.com/v1/"},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			base, _ := url.Parse(c.base)
+			if got := regionalURL(base, c.region).String(); got != c.want {
+				t.Fatalf("expected %s, got %s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestWithRegionRejectsUnknownRegions(t *testing.T) {
+	for _, region := range []Region{"ap", "EU", ""} {
+		var res testResponse
+		err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithEnvironment(EnvironmentProduction), WithRegion(region))
+		if err == nil || !strings.Contains(err.Error(), "unknown region") {
+			t.Fatalf("expected region %q to be rejected, got %v", region, err)
+		}
+	}
+}
+
+func TestServingRegionInto(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Lithic-Region", "EU")
+		w.Write([]byte(`{"token":"abc"}`))
+	})
+	var region Region
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithBaseURL(server.URL), WithRegion(RegionEU), WithServingRegionInto(&region), WithMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if region != RegionEU {
+		t.Fatalf("expected region %q, got %q", RegionEU, region)
+	}
+}
+
+func TestResponseInto(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_123")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+			return
+		}
+		w.Write([]byte(`{"token":"abc"}`))
+	})
+
+	var raw *http.Response
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithBaseURL(server.URL), WithResponseInto(&raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Token != "abc" || raw.Header.Get("X-Request-Id") != "req_123" {
+		t.Fatalf("unexpected response %+v %v", res, raw.Header)
+	}
+	if body, _ := io.ReadAll(raw.Body); string(body) != `{"token":"abc"}` {
+		t.Fatalf("expected the raw body to be readable, got %q", body)
+	}
+
+	raw = nil
+	err = ExecuteNewRequest(context.Background(), "GET", "missing", nil, &res, WithBaseURL(server.URL), WithResponseInto(&raw), WithMaxRetries(0))
+	if err == nil || raw == nil || raw.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected the error response to be stored, got %v %v", err, raw)
+	}
+	if body, _ := io.ReadAll(raw.Body); string(body) != `{"message":"not found"}` {
+		t.Fatalf("expected the raw error body to be readable, got %q", body)
+	}
+}
+
+func TestRetryPolicyFor(t *testing.T) {
+	attempts := map[string]int{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts[r.Method+" "+r.URL.Path] += 1
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"message":"oops"}`))
+	})
+	opts := []RequestOption{
+		WithBaseURL(server.URL + "/"),
+		WithMaxRetries(1),
+		WithRetryPolicyFor(OperationClassRead, RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond}),
+		WithRetryPolicyFor(OperationClassWrite, RetryPolicy{MaxRetries: 0}),
+	}
+	var res testResponse
+	ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, opts...)
+	ExecuteNewRequest(context.Background(), "POST", "cards", nil, &res, opts...)
+	ExecuteNewRequest(context.Background(), "POST", "simulate/void", nil, &res, append(opts, WithMaxRetries(0))...)
+
+	want := map[string]int{"GET /cards": 4, "POST /cards": 1, "POST /simulate/void": 1}
+	for k, v := range want {
+		if attempts[k] != v {
+			t.Fatalf("expected %d attempts for %s, got %v", v, k, attempts)
+		}
+	}
+}
+
+func TestConsumedBodyIsNotRetried(t *testing.T) {
+	attempts := 0
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts += 1
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"message":"partial"}`))
+	})
+	peek := func(req *http.Request, next MiddlewareNext) (*http.Response, error) {
+		res, err := next(req)
+		if err == nil {
+			res.Body.Read(make([]byte, 4))
+		}
+		return res, err
+	}
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "statements", nil, &res, WithBaseURL(server.URL+"/"), WithMiddleware(peek), WithRetryPolicyFor(OperationClassRead, RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond}))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected a single attempt, got %d", attempts)
+	}
+}
+
+func TestRetriesTransientFailures(t *testing.T) {
+	var attempts atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch attempts.Add(1) {
+		case 1:
+			// Drop the connection without responding.
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+		case 2:
+			w.WriteHeader(http.StatusRequestTimeout)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"token":"card_token"}`))
+		}
+	})
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithBaseURL(server.URL+"/"), WithRetryPolicyFor(OperationClassRead, RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempts.Load() != 3 || res.Token != "card_token" {
+		t.Fatalf("expected to succeed on the third attempt, got %d attempts", attempts.Load())
+	}
+}
+
+func TestWritesAreRetriedOnlyWhenNotSent(t *testing.T) {
+	var attempts atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token":"card_token"}`))
+	})
+	policy := WithRetryPolicyFor(OperationClassWrite, RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond})
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "POST", "cards", nil, &res, WithBaseURL(server.URL+"/"), policy)
+	if err == nil || attempts.Load() != 1 {
+		t.Fatalf("expected a write that may have been processed not to be retried, got %d attempts and %v", attempts.Load(), err)
+	}
+
+	attempts.Store(0)
+	err = ExecuteNewRequest(context.Background(), "POST", "cards", nil, &res, WithBaseURL(server.URL+"/"), policy, WithIdempotencyKey("card-1"))
+	if err != nil || attempts.Load() != 2 {
+		t.Fatalf("expected a write with an idempotency key to be retried, got %d attempts and %v", attempts.Load(), err)
+	}
+
+	dials := 0
+	count := func(req *http.Request, next MiddlewareNext) (*http.Response, error) {
+		dials += 1
+		return next(req)
+	}
+	err = ExecuteNewRequest(context.Background(), "POST", "cards", nil, &res, WithBaseURL("http://127.0.0.1:1/"), WithMiddleware(count), policy)
+	if err == nil || dials != 3 {
+		t.Fatalf("expected a write that could not connect to be retried, got %d attempts and %v", dials, err)
+	}
+}
+
+func TestMiddlewareErrorIsNotRetried(t *testing.T) {
+	attempts := 0
+	reject := func(req *http.Request, next MiddlewareNext) (*http.Response, error) {
+		attempts += 1
+		return nil, errors.New("rejected")
+	}
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithBaseURL("http://127.0.0.1:1/"), WithMiddleware(reject), WithMaxRetries(2))
+	if err == nil || attempts != 1 {
+		t.Fatalf("expected a single attempt, got %d and %v", attempts, err)
+	}
+}
+
+func TestRetryBackoffStopsOnCancelledContext(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	var res testResponse
+	err := ExecuteNewRequest(ctx, "GET", "cards", nil, &res, WithBaseURL(server.URL+"/"), WithMaxRetries(2))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline to be exceeded, got %v", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Fatalf("expected the backoff to be interrupted")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := parseRetryAfter("3"); !ok || d != 3*time.Second {
+		t.Fatalf("expected 3s, got %s", d)
+	}
+	if d, ok := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)); !ok || d <= 50*time.Second {
+		t.Fatalf("expected about a minute, got %s", d)
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Fatalf("expected an invalid value to be ignored")
+	}
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+type testSpan struct {
+	name  string
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+type spanKey struct{}
+
+func (t *testTracer) Tracer(name string) Tracer { return t }
+
+func (t *testTracer) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	span := &testSpan{name: spanName, attrs: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func (t *testTracer) Inject(ctx context.Context, header http.Header) {
+	header.Set("Traceparent", ctx.Value(spanKey{}).(*testSpan).name)
+}
+
+func (s *testSpan) SetAttributes(attrs ...Attribute) {
+	for _, attr := range attrs {
+		s.attrs[attr.Key] = attr.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) { s.err = err }
+func (s *testSpan) End()                  { s.ended = true }
+
+func TestTracerProvider(t *testing.T) {
+	attempts := 0
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts += 1
+		if r.Header.Get("Traceparent") != "lithic GET cards/{token}" {
+			t.Errorf("expected the trace context to be propagated, got %q", r.Header.Get("Traceparent"))
+		}
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"not found"}`))
+	})
+	tracer := &testTracer{}
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "cards/7ef7d65c-9023-4da3-b113-3b8583fd7951", nil, &res, WithBaseURL(server.URL+"/v1/"), WithTracerProvider(tracer), WithRetryPolicyFor(OperationClassRead, RetryPolicy{MaxRetries: 1, InitialBackoff: time.Millisecond}))
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if len(tracer.spans) != 1 {
+		t.Fatalf("expected a single span, got %d", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if !span.ended || span.err == nil {
+		t.Fatalf("expected the span to end with the error, got %#v", span)
+	}
+	expected := map[string]interface{}{
+		AttributeService:    "cards",
+		AttributeMethod:     "GET",
+		AttributePath:       "cards/{token}",
+		AttributeStatusCode: http.StatusNotFound,
+		AttributeAttempts:   2,
+	}
+	for key, value := range expected {
+		if span.attrs[key] != value {
+			t.Fatalf("expected %s to be %v, got %v", key, value, span.attrs[key])
+		}
+	}
+}
+
+func TestMetricsCollector(t *testing.T) {
+	attempts := 0
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts += 1
+		w.Header().Set("Content-Type", "application/json")
+		if attempts < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message":"slow down"}`))
+			return
+		}
+		w.Write([]byte(`{"token":"card_token"}`))
+	})
+	collector := &ExpvarCollector{}
+	opts := []RequestOption{WithBaseURL(server.URL), WithMetricsCollector(collector), WithRetryPolicyFor(OperationClassWrite, RetryPolicy{MaxRetries: 1, InitialBackoff: time.Millisecond})}
+
+	var res testResponse
+	if err := ExecuteNewRequest(context.Background(), "POST", "cards", nil, &res, opts...); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if err := ExecuteNewRequest(context.Background(), "POST", "cards", nil, &res, opts...); err != nil {
+		t.Fatal(err)
+	}
+
+	m := collector.Snapshot()["POST cards"]
+	if m.Requests != 2 || m.Retries != 1 || m.Errors[http.StatusTooManyRequests] != 1 {
+		t.Fatalf("expected 2 requests, 1 retry and 1 error, got %+v", m)
+	}
+	var observed int64
+	for _, n := range m.Latency.Counts {
+		observed += n
+	}
+	if observed != 2 {
+		t.Fatalf("expected 2 latency observations, got %v", m.Latency.Counts)
+	}
+	if !strings.Contains(collector.String(), `"POST cards":{"requests":2`) {
+		t.Fatalf("expected the metrics as JSON, got %s", collector.String())
+	}
+}
+
+func TestPreconditions(t *testing.T) {
+	current := `{"token":"card_token","memo":"Groceries","updated":"2023-03-01T12:00:00Z"}`
+	var requests []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(current))
+	})
+	patch := func(opts ...RequestOption) error {
+		var res testResponse
+		opts = append([]RequestOption{WithBaseURL(server.URL), WithMaxRetries(0)}, opts...)
+		return ExecuteNewRequest(context.Background(), "PATCH", "cards/card_token", nil, &res, opts...)
+	}
+
+	if err := patch(IfUnchanged([]byte(`{"memo":"Groceries","token":"card_token","updated":"2023-03-01T12:00:00Z"}`))); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(requests, ",") != "GET /cards/card_token,PATCH /cards/card_token" {
+		t.Fatalf("expected the card to be fetched before it is updated, got %v", requests)
+	}
+
+	requests = nil
+	if err := patch(IfUnchanged([]byte(`{"token":"card_token","memo":"Rent"}`))); !errors.Is(err, ErrPreconditionFailed) {
+		t.Fatalf("expected the precondition to fail, got %v", err)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("expected the update not to be sent, got %v", requests)
+	}
+
+	if err := patch(IfUnmodifiedSince(time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC))); err != nil {
+		t.Fatal(err)
+	}
+	if err := patch(IfUnmodifiedSince(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC))); !errors.Is(err, ErrPreconditionFailed) {
+		t.Fatalf("expected the precondition to fail, got %v", err)
+	}
+}
+
+func TestSimulateRefusedInProduction(t *testing.T) {
+	var attempts int
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts += 1
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(`{}`)), Request: req}, nil
+	})}
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "POST", "simulate/authorize", nil, &res, WithEnvironment(EnvironmentProduction), WithRegion(RegionEU), WithHTTPClient(client))
+	if !errors.Is(err, ErrSimulateInProduction) || attempts != 0 {
+		t.Fatalf("expected the simulation to be refused, got %v after %d attempts", err, attempts)
+	}
+
+	for _, opts := range [][]RequestOption{
+		{WithEnvironment(EnvironmentSandbox)},
+		{WithEnvironment(EnvironmentProduction), WithSimulateInProductionAllowed()},
+	} {
+		if err := ExecuteNewRequest(context.Background(), "POST", "simulate/authorize", nil, &res, append(opts, WithHTTPClient(client))...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithEnvironment(EnvironmentProduction), WithHTTPClient(client)); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 requests to be sent, got %d", attempts)
+	}
+	if err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithEnvironment("staging")); err == nil {
+		t.Fatal("expected an unknown environment to fail")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestSimulatedMarker(t *testing.T) {
+	var sent []string
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		sent = append(sent, string(body))
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(`{}`)), Request: req}, nil
+	})}
+	send := func(method, path, body string, opts ...RequestOption) {
+		var res testResponse
+		if err := ExecuteNewRequest(context.Background(), method, path, strings.NewReader(body), &res, append(opts, WithHTTPClient(client), WithHeader("Content-Type", "application/json"))...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	send("POST", "cards", `{"type":"VIRTUAL","memo":"groceries"}`, WithEnvironment(EnvironmentSandbox), WithSimulatedMarker(""))
+	send("POST", "book_transfers", `{"amount":100}`, WithEnvironment(EnvironmentSandbox), WithSimulatedMarker("[test]"))
+	send("POST", "event_subscriptions", `{"description":"hooks [simulated]"}`, WithEnvironment(EnvironmentSandbox), WithSimulatedMarker(""))
+	send("POST", "cards", `{"memo":"groceries"}`, WithEnvironment(EnvironmentProduction), WithSimulatedMarker(""))
+	send("POST", "simulate/authorize", `{"descriptor":"coffee"}`, WithEnvironment(EnvironmentSandbox), WithSimulatedMarker(""))
+	send("POST", "cards", `{"memo":"groceries"}`, WithEnvironment(EnvironmentSandbox))
+
+	expected := []string{
+		`{"type":"VIRTUAL","memo":"groceries [simulated]"}`,
+		`{"amount":100,"memo":"[test]"}`,
+		`{"description":"hooks [simulated]"}`,
+		`{"memo":"groceries"}`,
+		`{"descriptor":"coffee"}`,
+		`{"memo":"groceries"}`,
+	}
+	for i := range expected {
+		if i >= len(sent) || sent[i] != expected[i] {
+			t.Fatalf("expected request %d to send %s, got %v", i, expected[i], sent)
+		}
+	}
+}
+
+func TestRedactedFields(t *testing.T) {
+	var sent, seen string
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		sent = string(body)
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(`{}`)), Request: req}, nil
+	})}
+	peek := func(req *http.Request, next MiddlewareNext) (*http.Response, error) {
+		body, _ := req.GetBody()
+		contents, _ := io.ReadAll(body)
+		seen = string(contents)
+		return next(req)
+	}
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "POST", "cards", strings.NewReader(`{"memo":"test","pan":"4111111289144142","nested":{"cvv":"123"}}`), &res,
+		WithBaseURL("http://localhost/"), WithHTTPClient(client), WithMiddleware(peek), WithRedactedFields("pan", "nested.cvv", "missing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seen != `{"memo":"test","pan":"[REDACTED]","nested":{"cvv":"[REDACTED]"}}` {
+		t.Fatalf("expected a redacted body, got %s", seen)
+	}
+	if sent != `{"memo":"test","pan":"4111111289144142","nested":{"cvv":"123"}}` {
+		t.Fatalf("expected the original body to be sent, got %s", sent)
+	}
+}
+
+func TestDefaultRedactionPolicy(t *testing.T) {
+	redact.SetDefault(redact.Default().With("memo"))
+	defer redact.SetDefault(nil)
+	var sent, seen string
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		sent = string(body)
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(`{}`)), Request: req}, nil
+	})}
+	peek := func(req *http.Request, next MiddlewareNext) (*http.Response, error) {
+		contents, _ := io.ReadAll(req.Body)
+		seen = string(contents)
+		return next(req)
+	}
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "POST", "simulate/authorize", strings.NewReader(`{"amount":1,"pan":"4111111111111111","memo":"lunch"}`), &res,
+		WithBaseURL("http://localhost/"), WithHTTPClient(client), WithMiddleware(peek))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seen != `{"amount":1,"pan":"[REDACTED]","memo":"[REDACTED]"}` {
+		t.Fatalf("expected the body to be redacted by the default policy, got %s", seen)
+	}
+	if sent != `{"amount":1,"pan":"4111111111111111","memo":"lunch"}` {
+		t.Fatalf("expected the original body to be sent, got %s", sent)
+	}
+}
+
+func TestRedactionPolicy(t *testing.T) {
+	var seen string
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(`{"memo":"secret memo"}`)), Request: req}, nil
+	})}
+	peek := func(req *http.Request, next MiddlewareNext) (*http.Response, error) {
+		contents, _ := io.ReadAll(req.Body)
+		seen = string(contents)
+		return next(req)
+	}
+	policy := &redact.Policy{Fields: []string{"memo"}, Hash: true, HashKey: []byte("key")}
+	opts := []RequestOption{WithBaseURL("http://localhost/"), WithHTTPClient(client), WithMiddleware(peek), WithRedactionPolicy(policy), WithDiagnostics(1), WithHeader("Content-Type", "application/json")}
+	cfg, err := NewRequestConfig(context.Background(), "GET", "", nil, nil, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var res testResponse
+	if err := ExecuteNewRequest(context.Background(), "POST", "cards", strings.NewReader(`{"memo":"secret memo","pin":"1234"}`), &res, opts...); err != nil {
+		t.Fatal(err)
+	}
+	hashed := policy.Value("secret memo")
+	if seen != `{"memo":"`+hashed+`","pin":"1234"}` {
+		t.Fatalf("expected the middleware to see the body redacted by the policy, got %s", seen)
+	}
+	entry := cfg.Diagnostics.Entries()[0]
+	if entry.RequestBody != seen || entry.ResponseBody != `{"memo":"`+hashed+`"}` {
+		t.Fatalf("expected diagnostics to be redacted by the same policy, got %s and %s", entry.RequestBody, entry.ResponseBody)
+	}
+}
+
+type callerKey struct{}
+
+type metricsFunc func(call CallMetrics)
+
+func (f metricsFunc) ObserveCall(call CallMetrics) { f(call) }
+
+func TestCallerIdentity(t *testing.T) {
+	var headers []http.Header
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token":"card_token"}`))
+	})
+	var callers []string
+	identify := func(ctx context.Context) string {
+		team, _ := ctx.Value(callerKey{}).(string)
+		return team
+	}
+	opts := []RequestOption{WithBaseURL(server.URL), WithCallerIdentity(identify), WithMetricsCollector(metricsFunc(func(call CallMetrics) {
+		callers = append(callers, call.Caller)
+	}))}
+
+	var res testResponse
+	ctx := context.WithValue(context.Background(), callerKey{}, "payments")
+	if err := ExecuteNewRequest(ctx, "GET", "cards", nil, &res, opts...); err != nil {
+		t.Fatal(err)
+	}
+	if err := ExecuteNewRequest(ctx, "GET", "cards", nil, &res, append(opts, WithCallerIdentityHeader("x-team"))...); err != nil {
+		t.Fatal(err)
+	}
+	if err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, opts...); err != nil {
+		t.Fatal(err)
+	}
+
+	if headers[0].Get(DefaultCallerIdentityHeader) != "payments" {
+		t.Fatalf("expected the default header, got %v", headers[0])
+	}
+	if headers[1].Get("X-Team") != "payments" || headers[1].Get(DefaultCallerIdentityHeader) != "" {
+		t.Fatalf("expected the configured header, got %v", headers[1])
+	}
+	if _, ok := headers[2][DefaultCallerIdentityHeader]; ok {
+		t.Fatalf("expected no header without an identity, got %v", headers[2])
+	}
+	if strings.Join(callers, ",") != "payments,payments," {
+		t.Fatalf("unexpected callers in metrics %q", callers)
+	}
+}
+
+func TestDiagnostics(t *testing.T) {
+	n := 0
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		n += 1
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_"+strings.Repeat("x", n))
+		w.Write([]byte(`{"token":"card_token","pan":"4111111289144142","data":[{"cvv":"123"}]}`))
+	})
+	opts := []RequestOption{WithBaseURL(server.URL), WithAPIKey("secret_key"), WithDiagnostics(2)}
+	cfg, err := NewRequestConfig(context.Background(), "GET", "", nil, nil, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, memo := range []string{"a", "b", "c"} {
+		var res testResponse
+		body := strings.NewReader(`{"memo":"` + memo + `","pin":"1234"}`)
+		if err := ExecuteNewRequest(context.Background(), "POST", "cards", body, &res, append(opts, WithHeader("Content-Type", "application/json"))...); err != nil {
+			t.Fatal(err)
+		}
+		if res.Token != "card_token" {
+			t.Fatalf("expected the response to still be decoded, got %+v", res)
+		}
+	}
+
+	entries := cfg.Diagnostics.Entries()
+	if len(entries) != 2 || entries[0].RequestID != "req_xx" || entries[1].RequestID != "req_xxx" {
+		t.Fatalf("expected the last two round trips, oldest first, got %+v", entries)
+	}
+	entry := entries[1]
+	if entry.RequestBody != `{"memo":"c","pin":"[REDACTED]"}` {
+		t.Fatalf("expected a redacted request body, got %s", entry.RequestBody)
+	}
+	if entry.ResponseBody != `{"data":[{"cvv":"[REDACTED]"}],"pan":"[REDACTED]","token":"card_token"}` {
+		t.Fatalf("expected a redacted response body, got %s", entry.ResponseBody)
+	}
+	if entry.RequestHeader.Get("Authorization") != "[REDACTED]" || entry.Status != http.StatusOK || entry.Method != "POST" {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+
+	var bundle strings.Builder
+	if err := cfg.Diagnostics.WriteBundle(&bundle); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(bundle.String(), "secret_key") || !strings.Contains(bundle.String(), `"request_id": "req_xxx"`) {
+		t.Fatalf("unexpected bundle %s", bundle.String())
+	}
+}
+
+type driftResponse struct {
+	Token      string             `json:"token"`
+	SpendLimit int64              `json:"spend_limit"`
+	Memo       string             `json:"memo"`
+	Hostname   string             `json:"hostname,nullable"`
+	Created    time.Time          `json:"created" format:"date-time"`
+	Funding    driftResponseChild `json:"funding"`
+	JSON       struct {
+		Token      pjson.Metadata
+		SpendLimit pjson.Metadata
+		Memo       pjson.Metadata
+		Hostname   pjson.Metadata
+		Created    pjson.Metadata
+		Funding    pjson.Metadata
+		Raw        []byte
+		Extras     map[string]pjson.Metadata
+	}
+}
+
+func (r *driftResponse) UnmarshalJSON(data []byte) error { return pjson.UnmarshalRoot(data, r) }
+
+type driftResponseChild struct {
+	Type string `json:"type"`
+	JSON struct {
+		Type   pjson.Metadata
+		Raw    []byte
+		Extras map[string]pjson.Metadata
+	}
+}
+
+func (r *driftResponseChild) UnmarshalJSON(data []byte) error { return pjson.UnmarshalRoot(data, r) }
+
+func TestSampler(t *testing.T) {
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(`{"token":"card_token","pan":"4111111289144142"}`)), Request: req}, nil
+	})}
+	send := func(n int, opt RequestOption) {
+		for i := 0; i < n; i++ {
+			var res testResponse
+			body := strings.NewReader(`{"memo":"a","pin":"1234"}`)
+			if err := ExecuteNewRequest(context.Background(), "POST", "cards", body, &res, opt, WithBaseURL("http://localhost/"), WithAPIKey("secret_key"), WithHTTPClient(client), WithHeader("Content-Type", "application/json")); err != nil {
+				t.Fatal(err)
+			}
+			if res.Token != "card_token" {
+				t.Fatalf("expected the response to still be decoded, got %+v", res)
+			}
+		}
+	}
+
+	var samples []DiagnosticsEntry
+	record := func(sample DiagnosticsEntry) { samples = append(samples, sample) }
+	send(3, WithSampler(1, record))
+	if len(samples) != 3 {
+		t.Fatalf("expected every round trip to be sampled, got %d", len(samples))
+	}
+	sample := samples[0]
+	if sample.RequestBody != `{"memo":"a","pin":"[REDACTED]"}` || sample.ResponseBody != `{"pan":"[REDACTED]","token":"card_token"}` || sample.RequestHeader.Get("Authorization") != "[REDACTED]" {
+		t.Fatalf("expected a redacted sample, got %+v", sample)
+	}
+
+	samples = nil
+	send(10, WithSampler(0, record))
+	if len(samples) != 0 {
+		t.Fatalf("expected no round trip to be sampled, got %d", len(samples))
+	}
+	send(400, WithSampler(0.25, record))
+	if len(samples) < 50 || len(samples) > 150 {
+		t.Fatalf("expected about a quarter of the round trips to be sampled, got %d", len(samples))
+	}
+}
+
+func TestDecodeDiagnostics(t *testing.T) {
+	body := `{"token":"card_token","spend_limit":"1000","memo":null,"hostname":null,"created":"yesterday","funding":{"type":"DEPOSITORY_CHECKING","nickname":"x"},"pan_last_four":"4142"}`
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_drift")
+		w.Write([]byte(body))
+	})
+	var reports []DecodeReport
+	var res driftResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "cards/00000000-0000-0000-0000-000000000000", nil, &res, WithBaseURL(server.URL), WithDecodeDiagnostics(func(r DecodeReport) {
+		reports = append(reports, r)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected a single report, got %d", len(reports))
+	}
+	report := reports[0]
+	if report.Method != "GET" || report.Path != "cards/{token}" || report.RequestID != "req_drift" || report.Clean() {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	paths := func(fields []DecodeField) string {
+		var paths []string
+		for _, field := range fields {
+			paths = append(paths, field.Path)
+		}
+		return strings.Join(paths, ",")
+	}
+	if got := paths(report.UnknownFields); got != "pan_last_four,funding.nickname" {
+		t.Fatalf("unexpected unknown fields %s", got)
+	}
+	if got := paths(report.CoercedFields); got != "spend_limit" || report.CoercedFields[0].Type != "int64" || res.SpendLimit != 1000 {
+		t.Fatalf("unexpected coerced fields %+v", report.CoercedFields)
+	}
+	if got := paths(report.InvalidFields); got != "created" {
+		t.Fatalf("unexpected invalid fields %s", got)
+	}
+	if got := paths(report.NullToZeroFields); got != "memo" {
+		t.Fatalf("expected only the non-nullable null field, got %s", got)
+	}
+}
+
+func TestMethodsWithoutResponseBody(t *testing.T) {
+	var methods []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_"+r.Method)
+		switch {
+		case r.Method == http.MethodOptions:
+			w.Header().Set("Allow", "GET, HEAD,PATCH")
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/cards/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "PURGE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Content-Length", "20")
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+	opts := []RequestOption{WithBaseURL(server.URL), WithMaxRetries(0)}
+
+	var meta ResponseMetadata
+	if err := ExecuteNewRequest(context.Background(), http.MethodHead, "cards/card_token", nil, &meta, opts...); err != nil {
+		t.Fatal(err)
+	}
+	if meta.StatusCode != http.StatusOK || meta.RequestID != "req_HEAD" || meta.ContentLength != 20 {
+		t.Fatalf("unexpected metadata %+v", meta)
+	}
+	// Decoding into a struct does not fail on the missing body.
+	var res testResponse
+	if err := ExecuteNewRequest(context.Background(), http.MethodHead, "cards/card_token", nil, &res, opts...); err != nil {
+		t.Fatal(err)
+	}
+	err := ExecuteNewRequest(context.Background(), http.MethodHead, "cards/missing", nil, &meta, opts...)
+	if !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	if err := ExecuteNewRequest(context.Background(), http.MethodOptions, "cards", nil, &meta, opts...); err != nil {
+		t.Fatal(err)
+	}
+	if allow := strings.Join(meta.Allow(), " "); allow != "GET HEAD PATCH" {
+		t.Fatalf("unexpected allowed methods %s", allow)
+	}
+	if err := ExecuteNewRequest(context.Background(), "PURGE", "cards/card_token", nil, &res, opts...); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(methods, " "); got != "HEAD HEAD HEAD OPTIONS PURGE" {
+		t.Fatalf("unexpected methods %s", got)
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	req := httptest.NewRequest("POST", "http://localhost/cards", nil)
+	apiErr := func(status int) error {
+		res := &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(`{}`)), Request: req}
+		return core.RequestError{Cause: core.NewAPIErrorFromResponse(req, res), Request: req, Response: res}
+	}
+	cases := []struct {
+		err       error
+		transient bool
+	}{
+		{apiErr(http.StatusServiceUnavailable), true},
+		{apiErr(http.StatusTooManyRequests), true},
+		{apiErr(http.StatusBadRequest), false},
+		{core.RequestError{Cause: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, Request: req}, true},
+		{core.RequestError{Cause: io.ErrUnexpectedEOF, Request: req}, true},
+		{core.RequestError{Cause: errors.New("quota exceeded"), Request: req}, false},
+		{core.RequestError{Cause: context.Canceled, Request: req}, false},
+	}
+	for _, c := range cases {
+		if IsTransient(c.err) != c.transient {
+			t.Errorf("expected IsTransient(%v) to be %v", c.err, c.transient)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package options
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
+)
+
+// ErrPreconditionFailed is returned, wrapped, when a request is not sent because
+// the resource it updates changed since it was read, see IfUnmodifiedSince and
+// IfUnchanged.
+var ErrPreconditionFailed = errors.New("lithic: precondition failed")
+
+// Precondition checks the current state of a resource, as returned by a GET to
+// the path of the request, before the request is sent. If it returns an error
+// the request is not sent.
+type Precondition func(current []byte) error
+
+// WithPrecondition adds a precondition to the request. Preconditions are
+// enforced by the client, by fetching the resource before the request is sent,
+// so they narrow but do not close the window in which concurrent changes can
+// be overwritten.
+func WithPrecondition(precondition Precondition) RequestOption {
+	return func(r *RequestConfig) error {
+		r.Preconditions = append(r.Preconditions, precondition)
+		return nil
+	}
+}
+
+// IfUnmodifiedSince only sends the request if the resource, such as a financial
+// account, has not been updated after t according to its `updated` timestamp.
+// The If-Unmodified-Since header is also sent, for endpoints that support it.
+func IfUnmodifiedSince(t time.Time) RequestOption {
+	return func(r *RequestConfig) error {
+		r.Request.Header.Set("If-Unmodified-Since", t.UTC().Format(http.TimeFormat))
+		return WithPrecondition(func(current []byte) error {
+			value := gjson.GetBytes(current, "updated")
+			if !value.Exists() {
+				return fmt.Errorf("%w: resource has no updated timestamp, use IfUnchanged instead", ErrPreconditionFailed)
+			}
+			updated, err := time.Parse(time.RFC3339, value.String())
+			if err != nil {
+				return fmt.Errorf("%w: invalid updated timestamp: %s", ErrPreconditionFailed, err)
+			}
+			if updated.After(t) {
+				return fmt.Errorf("%w: resource was updated at %s", ErrPreconditionFailed, updated.Format(time.RFC3339))
+			}
+			return nil
+		})(r)
+	}
+}
+
+// IfUnchanged only sends the request if the resource is the same as when it was
+// read, for resources that have no updated timestamp such as cards. previous
+// is the raw JSON of the resource as read, for example card.JSON.Raw.
+func IfUnchanged(previous []byte) RequestOption {
+	return WithPrecondition(func(current []byte) error {
+		var before, after interface{}
+		if err := json.Unmarshal(previous, &before); err != nil {
+			return fmt.Errorf("%w: invalid previous resource: %s", ErrPreconditionFailed, err)
+		}
+		if err := json.Unmarshal(current, &after); err != nil {
+			return fmt.Errorf("%w: invalid current resource: %s", ErrPreconditionFailed, err)
+		}
+		if !reflect.DeepEqual(before, after) {
+			return fmt.Errorf("%w: resource was modified since it was read", ErrPreconditionFailed)
+		}
+		return nil
+	})
+}
+
+// checkPreconditions fetches the current state of the resource at the URL of
+// the request and checks it against the preconditions.
+func (cfg *RequestConfig) checkPreconditions() error {
+	req, err := http.NewRequestWithContext(cfg.Request.Context(), "GET", cfg.Request.URL.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header = cfg.Request.Header.Clone()
+	req.Header.Del("Content-Type")
+	req.Header.Del("If-Unmodified-Since")
+	req.Header.Set("Idempotency-Token", "stainless-go-"+uuid.New().String())
+
+	var current json.RawMessage
+	get := *cfg
+	get.Request = req
+	get.buffer = nil
+	get.ResponseBodyInto = &current
+	get.ResponseInto = nil
+	get.ServingRegionInto = nil
+	get.InflightCounter = nil
+	get.Preconditions = nil
+	if err := get.Execute(); err != nil {
+		return err
+	}
+	for _, precondition := range cfg.Preconditions {
+		if err := precondition(current); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package options
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrRateLimiterClosed is returned for requests made through a RateLimiter
+// after it has been shut down.
+var ErrRateLimiterClosed = errors.New("lithic: rate limiter is shut down")
+
+// RateLimit is the state of the API's rate limit, as reported in the
+// X-RateLimit-Limit, X-RateLimit-Remaining and X-RateLimit-Reset headers of a
+// response.
+type RateLimit struct {
+	// The number of requests allowed in the current window.
+	Limit int
+	// The number of requests left in the current window.
+	Remaining int
+	// When the current window ends.
+	Reset time.Time
+}
+
+// ParseRateLimit returns the rate limit reported by a response, if it has the
+// headers. The reset header is either a number of seconds or a Unix timestamp.
+func ParseRateLimit(res *http.Response) (RateLimit, bool) {
+	if res == nil {
+		return RateLimit{}, false
+	}
+	remaining, err := strconv.Atoi(res.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+	limit, _ := strconv.Atoi(res.Header.Get("X-RateLimit-Limit"))
+	rl := RateLimit{Limit: limit, Remaining: remaining}
+	if reset, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		if reset > 1e9 {
+			rl.Reset = time.Unix(reset, 0)
+		} else {
+			rl.Reset = time.Now().Add(time.Duration(reset) * time.Second)
+		}
+	}
+	return rl, true
+}
+
+// RateLimiter delays requests so that they stay within a rate limit, instead of
+// sending them until the API responds with 429s. It combines a client-side
+// token bucket with the limits reported by the API: once the API reports that
+// MinRemaining or fewer requests are left in the current window, or responds
+// with a 429, requests wait until the window resets.
+//
+// A RateLimiter can be shared by clients and should be registered with
+// Lithic.RegisterCloser so that it is drained on shutdown.
+type RateLimiter struct {
+	// Requests per second allowed client-side. If zero, only the limits reported
+	// by the API are enforced.
+	Rate float64
+	// The number of requests that can be sent at once. Defaults to 1.
+	Burst int
+	// The number of requests left in a window below which requests wait for the
+	// window to reset.
+	MinRemaining int
+
+	mu      sync.Mutex
+	tokens  float64
+	last    time.Time
+	server  RateLimit
+	pending sync.WaitGroup
+	closed  bool
+	now     func() time.Time
+}
+
+// NewRateLimiter returns a RateLimiter that allows rate requests per second
+// with bursts of up to burst requests.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{Rate: rate, Burst: burst}
+}
+
+// WithRateLimiter sends every request, including retries, through the given
+// limiter.
+func WithRateLimiter(limiter *RateLimiter) RequestOption {
+	return func(r *RequestConfig) error {
+		r.RateLimiter = limiter
+		return nil
+	}
+}
+
+// RateLimit returns the rate limit last reported by the API.
+func (l *RateLimiter) RateLimit() RateLimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.server
+}
+
+// Wait blocks until a request may be sent, or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return ErrRateLimiterClosed
+	}
+	l.pending.Add(1)
+	defer l.pending.Done()
+	delay, refund := l.reserve()
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	if err := sleep(ctx, delay); err != nil {
+		l.mu.Lock()
+		refund()
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a request from the client and server side budgets and returns
+// how long the request has to wait for them, along with a function that gives
+// the request back if it is not sent.
+func (l *RateLimiter) reserve() (time.Duration, func()) {
+	now := l.clock()
+	var delay time.Duration
+	refunds := []func(){}
+
+	if l.Rate > 0 {
+		burst := float64(l.Burst)
+		if burst < 1 {
+			burst = 1
+		}
+		if l.last.IsZero() {
+			l.tokens = burst
+		} else {
+			l.tokens += now.Sub(l.last).Seconds() * l.Rate
+			if l.tokens > burst {
+				l.tokens = burst
+			}
+		}
+		l.last = now
+		l.tokens -= 1
+		if l.tokens < 0 {
+			delay = time.Duration(-l.tokens / l.Rate * float64(time.Second))
+		}
+		refunds = append(refunds, func() { l.tokens += 1 })
+	}
+
+	if !l.server.Reset.IsZero() {
+		if !now.Before(l.server.Reset) {
+			l.server = RateLimit{Limit: l.server.Limit, Remaining: l.server.Limit}
+		} else if l.server.Remaining <= l.MinRemaining {
+			if wait := l.server.Reset.Sub(now); wait > delay {
+				delay = wait
+			}
+		} else {
+			l.server.Remaining -= 1
+			refunds = append(refunds, func() { l.server.Remaining += 1 })
+		}
+	}
+
+	return delay, func() {
+		for _, refund := range refunds {
+			refund()
+		}
+	}
+}
+
+// Observe updates the limiter with the rate limit reported by a response. A
+// 429 without rate limit headers pauses requests for its Retry-After, or a
+// second if it has none.
+func (l *RateLimiter) Observe(res *http.Response) {
+	if res == nil {
+		return
+	}
+	rl, ok := ParseRateLimit(res)
+	if !ok && res.StatusCode == http.StatusTooManyRequests {
+		wait, found := parseRetryAfter(res.Header.Get("Retry-After"))
+		if !found {
+			wait = time.Second
+		}
+		rl, ok = RateLimit{Reset: l.clock().Add(wait)}, true
+	}
+	if !ok {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if rl.Limit == 0 {
+		rl.Limit = l.server.Limit
+	}
+	l.server = rl
+}
+
+// Shutdown stops the limiter from accepting new requests, which fail with
+// ErrRateLimiterClosed, and waits for the requests it is delaying to be sent or
+// ctx to be done.
+func (l *RateLimiter) Shutdown(ctx context.Context) error {
+	l.mu.Lock()
+	l.closed = true
+	l.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		l.pending.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *RateLimiter) clock() time.Time {
+	if l.now != nil {
+		return l.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,100 @@
+package options
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterTokenBucket(t *testing.T) {
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	limiter := &RateLimiter{Rate: 10, Burst: 2, now: func() time.Time { return now }}
+
+	var delays []time.Duration
+	for i := 0; i < 4; i++ {
+		delay, _ := limiter.reserve()
+		delays = append(delays, delay)
+	}
+	expected := []time.Duration{0, 0, 100 * time.Millisecond, 200 * time.Millisecond}
+	for i := range expected {
+		if delays[i] != expected[i] {
+			t.Fatalf("expected delays %v, got %v", expected, delays)
+		}
+	}
+
+	now = now.Add(time.Second)
+	if delay, _ := limiter.reserve(); delay != 0 {
+		t.Fatalf("expected the bucket to refill, got a delay of %v", delay)
+	}
+}
+
+func TestRateLimiterServerLimits(t *testing.T) {
+	now := time.Now()
+	limiter := &RateLimiter{MinRemaining: 1, now: func() time.Time { return now }}
+	res := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	res.Header.Set("X-RateLimit-Limit", "100")
+	res.Header.Set("X-RateLimit-Remaining", "2")
+	res.Header.Set("X-RateLimit-Reset", strconv.FormatInt(now.Add(30*time.Second).Unix(), 10))
+	limiter.Observe(res)
+
+	if delay, _ := limiter.reserve(); delay != 0 {
+		t.Fatalf("expected a request to be allowed, got a delay of %v", delay)
+	}
+	if delay, _ := limiter.reserve(); delay <= 0 || delay > 30*time.Second {
+		t.Fatalf("expected to wait for the window to reset, got a delay of %v", delay)
+	}
+
+	now = now.Add(31 * time.Second)
+	if delay, _ := limiter.reserve(); delay != 0 {
+		t.Fatalf("expected the window to have reset, got a delay of %v", delay)
+	}
+	if rl := limiter.RateLimit(); rl.Limit != 100 || rl.Remaining != 100 {
+		t.Fatalf("expected a fresh window, got %+v", rl)
+	}
+}
+
+func TestRateLimiterPausesOn429(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	limiter := NewRateLimiter(0, 0)
+	var res testResponse
+	err := ExecuteNewRequest(context.Background(), "GET", "cards", nil, &res, WithBaseURL(server.URL), WithRateLimiter(limiter), WithMaxRetries(0))
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if delay, _ := limiter.reserve(); delay < 4*time.Second {
+		t.Fatalf("expected the 429 to pause requests for its Retry-After, got a delay of %v", delay)
+	}
+}
+
+func TestRateLimiterShutdown(t *testing.T) {
+	limiter := NewRateLimiter(20, 1)
+	limiter.Wait(context.Background())
+
+	waited := make(chan error)
+	go func() { waited <- limiter.Wait(context.Background()) }()
+	for {
+		limiter.mu.Lock()
+		tokens := limiter.tokens
+		limiter.mu.Unlock()
+		if tokens < 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if err := limiter.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-waited; err != nil {
+		t.Fatalf("expected the pending request to be let through, got %v", err)
+	}
+	if err := limiter.Wait(context.Background()); !errors.Is(err, ErrRateLimiterClosed) {
+		t.Fatalf("expected new requests to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package options
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/lithic-com/lithic-go/redact"
+)
+
+// WithRedactedFields marks fields of the JSON request body, such as "pan", as
+// sensitive, in addition to those of the redaction policy. Middlewares see the
+// request with these fields replaced by "[REDACTED]", so that logging or
+// debugging middlewares cannot leak them, and the original body is restored
+// after the last middleware. As a consequence, changes that middlewares make to
+// the body of a request with redacted fields are discarded. Fields are matched
+// as the patterns of redact.Policy.
+func WithRedactedFields(fields ...string) RequestOption {
+	return func(r *RequestConfig) error {
+		r.RedactedFields = append(r.RedactedFields, fields...)
+		return nil
+	}
+}
+
+// WithRedactionPolicy redacts the request bodies that middlewares see, and the
+// diagnostics captured by WithDiagnostics, with policy rather than with
+// redact.Default(). As with WithRedactedFields, changes that middlewares make
+// to the body of the request are discarded.
+func WithRedactionPolicy(policy *redact.Policy) RequestOption {
+	return func(r *RequestConfig) error {
+		r.RedactionPolicy = policy
+		return nil
+	}
+}
+
+// redactionPolicy returns the policy of the request, including the fields
+// given to WithRedactedFields.
+func (cfg *RequestConfig) redactionPolicy() *redact.Policy {
+	policy := cfg.RedactionPolicy
+	if policy == nil {
+		policy = redact.Default()
+	}
+	return policy.With(cfg.RedactedFields...)
+}
+
+// redact returns a copy of req whose body is redacted, and wraps next so that
+// the original body is sent. Requests whose body is not JSON, or has no field
+// to redact, are returned as is.
+func (cfg *RequestConfig) redact(req *http.Request, next MiddlewareNext) (*http.Request, MiddlewareNext) {
+	original := cfg.buffer
+	if !json.Valid(original) {
+		return req, next
+	}
+	redacted := cfg.redactionPolicy().JSON(original)
+	if bytes.Equal(redacted, original) {
+		return req, next
+	}
+	send := func(req *http.Request) (*http.Response, error) {
+		return next(withBody(req, original))
+	}
+	return withBody(req, redacted), send
+}
+
+func withBody(req *http.Request, body []byte) *http.Request {
+	req = req.Clone(req.Context())
+	req.ContentLength = int64(len(body))
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	return req
+}
@@ -0,0 +1,105 @@
+package options
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Region identifies the geographic deployment of the Lithic API that requests
+// are served from, which determines where program data is stored.
+type Region string
+
+const (
+	RegionUS Region = "us"
+	RegionEU Region = "eu"
+)
+
+// The header that the API uses to report which region served a request.
+const regionHeader = "X-Lithic-Region"
+
+// Hosts of each environment, keyed by region. The US hosts are the historical
+// hosts of the API and are used when no region is selected.
+var regionalHosts = map[string]map[Region]string{
+	"api.lithic.com": {
+		RegionUS: "api.lithic.com",
+		RegionEU: "api.eu.lithic.com",
+	},
+	"sandbox.lithic.com": {
+		RegionUS: "sandbox.lithic.com",
+		RegionEU: "sandbox.eu.lithic.com",
+	},
+}
+
+// regionalURL rewrites a Lithic base URL to point at the given region. URLs
+// that don't point at a known Lithic host, e.g. a mock server or a proxy, are
+// returned untouched.
+func regionalURL(base *url.URL, region Region) *url.URL {
+	if base == nil || region == "" {
+		return base
+	}
+	for _, hosts := range regionalHosts {
+		for _, host := range hosts {
+			if base.Host != host {
+				continue
+			}
+			regional, ok := hosts[region]
+			if !ok || regional == base.Host {
+				return base
+			}
+			u := *base
+			u.Host = regional
+			return &u
+		}
+	}
+	return base
+}
+
+// ServingRegion reports the region that served the given response. The region
+// reported by the API takes precedence, otherwise it is inferred from the host
+// that the request was sent to. An empty region is returned if neither is
+// known.
+func ServingRegion(res *http.Response) Region {
+	if res == nil {
+		return ""
+	}
+	if region := res.Header.Get(regionHeader); region != "" {
+		return Region(strings.ToLower(region))
+	}
+	if res.Request == nil || res.Request.URL == nil {
+		return ""
+	}
+	for _, hosts := range regionalHosts {
+		for region, host := range hosts {
+			if res.Request.URL.Host == host {
+				return region
+			}
+		}
+	}
+	return ""
+}
+
+// WithRegion selects the region of the API that requests are sent to. It
+// applies on top of the selected environment, so it can be combined with
+// WithEnvironmentSandbox or WithEnvironmentProduction in any order. Custom
+// base URLs are not rewritten. Requests made with a region other than RegionUS
+// and RegionEU fail with an error.
+func WithRegion(region Region) RequestOption {
+	return func(r *RequestConfig) error {
+		if region != RegionUS && region != RegionEU {
+			return fmt.Errorf("unknown region %q, expected %q or %q", region, RegionUS, RegionEU)
+		}
+		r.Region = region
+		return nil
+	}
+}
+
+// WithServingRegionInto stores the region that served the request into dst once
+// the response is received.
+func WithServingRegionInto(dst *Region) RequestOption {
+	return func(r *RequestConfig) error {
+		r.ServingRegionInto = dst
+		return nil
+	}
+}
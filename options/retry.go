@@ -0,0 +1,252 @@
+package options
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/lithic-com/lithic-go/core"
+)
+
+// OperationClass groups API operations that share a retry policy.
+type OperationClass int
+
+const (
+	// OperationClassRead covers requests that don't modify any resources, such as
+	// retrieving or listing cards.
+	OperationClassRead OperationClass = iota
+	// OperationClassWrite covers requests that create or modify resources, such as
+	// creating a card.
+	OperationClassWrite
+	// OperationClassSimulate covers the sandbox simulation endpoints.
+	OperationClassSimulate
+)
+
+func (c OperationClass) String() string {
+	switch c {
+	case OperationClassRead:
+		return "read"
+	case OperationClassWrite:
+		return "write"
+	case OperationClassSimulate:
+		return "simulate"
+	default:
+		return "OperationClass(" + strconv.Itoa(int(c)) + ")"
+	}
+}
+
+// ClassifyRequest returns the operation class of an outgoing request.
+func ClassifyRequest(req *http.Request) OperationClass {
+	path := strings.TrimPrefix(req.URL.Path, "/")
+	if strings.HasPrefix(path, "simulate/") || strings.Contains(path, "/simulate/") {
+		return OperationClassSimulate
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return OperationClassRead
+	default:
+		return OperationClassWrite
+	}
+}
+
+// RetryPolicy controls how failed requests of an operation class are retried.
+type RetryPolicy struct {
+	// The maximum number of times a request is retried after the first attempt.
+	MaxRetries int
+	// The delay before the first retry, which grows exponentially with every
+	// attempt. Defaults to 500ms.
+	InitialBackoff time.Duration
+	// The upper bound of the delay between retries, including delays requested by
+	// the API with the Retry-After header. Defaults to 60s.
+	MaxBackoff time.Duration
+}
+
+func (p RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = 500 * time.Millisecond
+	}
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = 60 * time.Second
+	}
+	duration := initial * time.Duration(math.Exp(float64(attempt)))
+	if res != nil {
+		if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			duration = retryAfter
+		}
+	}
+	if duration > max {
+		duration = max
+	}
+	// Jitter by up to the initial backoff in either direction.
+	duration += time.Duration(rand.Int63n(2*int64(initial)) - int64(initial))
+	if duration < 0 {
+		duration = 0
+	}
+	return duration
+}
+
+// parseRetryAfter parses the Retry-After header, which holds either a number of
+// seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at), true
+	}
+	return 0, false
+}
+
+// shouldRetry reports whether the outcome of an attempt is transient: a request
+// timeout, a conflict, rate limiting, a server error, or a failure to connect to
+// or read from the API. Requests whose context is done are never retried.
+//
+// A connection that fails after the request was written may have been
+// processed by the API, so requests that are not idempotent are then only
+// retried if they carry an idempotency key, see WithIdempotencyKey.
+func (cfg *RequestConfig) shouldRetry(ctx context.Context, res *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		if !isConnectionError(err) {
+			return false
+		}
+		return idempotent(cfg.Request.Method) || cfg.IdempotencyKey != "" || notSent(err)
+	}
+	return transientStatus(res.StatusCode)
+}
+
+// transientStatus reports whether a response with the given status may succeed
+// when the request is retried.
+func transientStatus(status int) bool {
+	switch status {
+	case http.StatusRequestTimeout, http.StatusConflict, http.StatusTooManyRequests:
+		return true
+	}
+	return status >= http.StatusInternalServerError
+}
+
+// IsTransient reports whether err, returned by a request, is a failure that may
+// succeed when the request is retried: an API error with a status that the
+// client retries, or a failure to connect to or read from the API. Errors of
+// middlewares, such as a quota being exceeded, and of requests whose context is
+// done are not transient.
+func IsTransient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr core.APIError
+	if errors.As(err, &apiErr) {
+		return transientStatus(apiErr.Status())
+	}
+	return isConnectionError(err)
+}
+
+// idempotent reports whether requests with method have the same effect when
+// they are sent more than once.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// notSent reports whether err shows that the request never reached the API,
+// because the connection to it could not be established.
+func notSent(err error) bool {
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isConnectionError reports whether err was caused by the connection to the API,
+// as opposed to, for example, a middleware rejecting the request or an invalid
+// TLS certificate.
+func isConnectionError(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary || dnsErr.IsTimeout
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// sleep waits for d, returning early with the context's error if it is done
+// first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retryPolicy returns the policy for the request of this config. Policies set
+// with WithRetryPolicyFor take precedence over WithMaxRetries.
+func (cfg *RequestConfig) retryPolicy() RetryPolicy {
+	if policy, ok := cfg.RetryPolicies[ClassifyRequest(cfg.Request)]; ok {
+		return policy
+	}
+	return RetryPolicy{MaxRetries: cfg.MaxRetries}
+}
+
+// WithIdempotencyKey sends the request with key as its Idempotency-Token, which
+// the API uses to process requests with the same key only once. This allows a
+// request that is not idempotent, such as the creation of a card, to be retried
+// after a connection failure that leaves it unknown whether the API received
+// it. The key must be unique to the operation and reused by every attempt of it.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(r *RequestConfig) error {
+		r.Request.Header.Set("Idempotency-Token", key)
+		r.IdempotencyKey = key
+		return nil
+	}
+}
+
+// WithRetryPolicyFor sets the retry policy of every request of the given
+// operation class, for example to retry reads aggressively while never retrying
+// card creation. For requests of that class the policy takes precedence over
+// WithMaxRetries.
+func WithRetryPolicyFor(class OperationClass, policy RetryPolicy) RequestOption {
+	return func(r *RequestConfig) error {
+		policies := make(map[OperationClass]RetryPolicy, len(r.RetryPolicies)+1)
+		for k, v := range r.RetryPolicies {
+			policies[k] = v
+		}
+		policies[class] = policy
+		r.RetryPolicies = policies
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+package options
+
+import (
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Sampler mirrors a fraction of the round trips of requests to a callback, see
+// WithSampler.
+type Sampler struct {
+	rate float64
+	fn   func(DiagnosticsEntry)
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// WithSampler passes a random fraction rate, between 0 and 1, of the HTTP round
+// trips of every request made with this option to fn, for offline analysis of
+// representative traffic without the cost of logging all of it. Round trips
+// are captured as they are sent on the wire, and redacted like those of
+// WithDiagnostics. fn is called synchronously, before the response is
+// returned, so it should hand the sample off rather than process it.
+func WithSampler(rate float64, fn func(sample DiagnosticsEntry)) RequestOption {
+	sampler := &Sampler{rate: rate, fn: fn, rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+	return func(r *RequestConfig) error {
+		r.Sampler = sampler
+		return nil
+	}
+}
+
+// sample reports whether the next round trip is sampled.
+func (s *Sampler) sample() bool {
+	switch {
+	case s.rate <= 0 || s.fn == nil:
+		return false
+	case s.rate >= 1:
+		return true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rand.Float64() < s.rate
+}
+
+// sampleRoundTrips wraps the handler that sends requests on the wire so that
+// the sampled round trips are passed to the sampler.
+func (cfg *RequestConfig) sampleRoundTrips(next MiddlewareNext) MiddlewareNext {
+	captured := cfg.captureRoundTrips(next, cfg.Sampler.fn)
+	return func(req *http.Request) (*http.Response, error) {
+		if cfg.Sampler.sample() {
+			return captured(req)
+		}
+		return next(req)
+	}
+}
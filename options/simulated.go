@@ -0,0 +1,62 @@
+package options
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// DefaultSimulatedMarker is the marker that WithSimulatedMarker appends by
+// default.
+const DefaultSimulatedMarker = core.SimulatedMarker
+
+// The fields that the simulated marker is appended to, by the path of the
+// endpoint that creates the resource.
+var simulatedMarkerFields = map[string]string{
+	"cards":               "memo",
+	"book_transfers":      "memo",
+	"event_subscriptions": "description",
+}
+
+// WithSimulatedMarker appends marker, or DefaultSimulatedMarker if it is empty,
+// to the memo of the cards and book transfers, and the description of the
+// event subscriptions, that are created in the sandbox environment, so that
+// test artifacts cannot be mistaken for production data by the systems they
+// flow into. Requests to other environments are left unchanged. The
+// IsSimulated methods of the responses report whether a resource carries the
+// marker.
+func WithSimulatedMarker(marker string) RequestOption {
+	if marker == "" {
+		marker = DefaultSimulatedMarker
+	}
+	return func(r *RequestConfig) error {
+		r.SimulatedMarker = marker
+		return nil
+	}
+}
+
+// markSimulated appends the simulated marker to the body of a request that
+// creates a resource at path in the sandbox.
+func (cfg *RequestConfig) markSimulated(path string, u *url.URL) error {
+	field, ok := simulatedMarkerFields[strings.Trim(path, "/")]
+	if !ok || cfg.Request.Method != http.MethodPost || EnvironmentOf(u) != EnvironmentSandbox {
+		return nil
+	}
+	if len(cfg.buffer) == 0 || !core.IsJSONContentType(cfg.Request.Header.Get("Content-Type")) {
+		return nil
+	}
+	value := gjson.GetBytes(cfg.buffer, field).String()
+	if strings.Contains(value, cfg.SimulatedMarker) {
+		return nil
+	}
+	if value != "" {
+		value += " "
+	}
+	var err error
+	cfg.buffer, err = sjson.SetBytes(cfg.buffer, field, value+cfg.SimulatedMarker)
+	return err
+}
@@ -0,0 +1,95 @@
+package options
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// TracerName is the name of the tracer that API calls are traced with.
+const TracerName = "github.com/lithic-com/lithic-go"
+
+// TracerProvider provides the tracer that API calls are traced with. The SDK
+// does not depend on OpenTelemetry, but a TracerProvider is a thin adapter
+// around an OpenTelemetry trace.TracerProvider, see the README.
+type TracerProvider interface {
+	Tracer(name string) Tracer
+}
+
+// Tracer starts spans. The returned context carries the span, and is used for
+// the HTTP request so that spans started by the HTTP client or by middlewares
+// are its children.
+type Tracer interface {
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// Span is a single API call, including all of its retries.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Attribute is a key value pair describing a span. Values are strings or ints.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// HeaderInjector may be implemented by a Tracer to propagate the trace context
+// in the headers of the request, for example with an OpenTelemetry propagator.
+type HeaderInjector interface {
+	Inject(ctx context.Context, header http.Header)
+}
+
+// Attribute keys of the spans of API calls.
+const (
+	AttributeService    = "lithic.service"
+	AttributeAttempts   = "lithic.attempts"
+	AttributeMethod     = "http.request.method"
+	AttributePath       = "url.path"
+	AttributeStatusCode = "http.response.status_code"
+)
+
+// WithTracerProvider traces every API call in a span named after its method and
+// path, such as "lithic GET cards/{token}". Tokens are replaced in the path so
+// that spans of the same endpoint share a name.
+func WithTracerProvider(tp TracerProvider) RequestOption {
+	return func(r *RequestConfig) error {
+		r.TracerProvider = tp
+		return nil
+	}
+}
+
+var tokenSegment = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// pathTemplate returns path with its leading version segment dropped
+// and any tokens replaced by a placeholder, for example "cards/{token}".
+func pathTemplate(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 1 && segments[0] == "v1" {
+		segments = segments[1:]
+	}
+	for i, segment := range segments {
+		if tokenSegment.MatchString(segment) {
+			segments[i] = "{token}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func (cfg *RequestConfig) startSpan(path string) Span {
+	tracer := cfg.TracerProvider.Tracer(TracerName)
+	ctx, span := tracer.Start(cfg.Request.Context(), "lithic "+cfg.Request.Method+" "+path)
+	if injector, ok := tracer.(HeaderInjector); ok {
+		injector.Inject(ctx, cfg.Request.Header)
+	}
+	span.SetAttributes(
+		Attribute{AttributeService, strings.SplitN(path, "/", 2)[0]},
+		Attribute{AttributeMethod, cfg.Request.Method},
+		Attribute{AttributePath, path},
+	)
+	cfg.Request = cfg.Request.WithContext(ctx)
+	return span
+}
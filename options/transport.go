@@ -0,0 +1,125 @@
+package options
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+)
+
+// HTTPVersion selects which HTTP protocol versions the transport may negotiate
+// with the API.
+type HTTPVersion int
+
+const (
+	// Negotiate HTTP/2 when the server supports it, falling back to HTTP/1.1.
+	HTTPVersionAuto HTTPVersion = iota
+	// Only ever use HTTP/1.1.
+	HTTPVersion1
+	// Attempt HTTP/2 even when a custom dialer or TLS config is in use.
+	HTTPVersion2
+)
+
+// TransportConfig holds the connection level knobs of the HTTP transport used to
+// talk to the API. Zero values fall back to the defaults of
+// http.DefaultTransport.
+//
+// Long-lived workers that see connection resets against the API edge generally
+// want a KeepAlive shorter than the edge's idle timeout and an IdleConnTimeout
+// that evicts pooled connections before the edge closes them.
+type TransportConfig struct {
+	HTTPVersion HTTPVersion
+	// Interval between TCP keep-alive probes on open connections. Probes detect
+	// connections that were silently dropped by a middlebox. A negative value
+	// disables keep-alive probes.
+	KeepAlive time.Duration
+	// How long an idle connection stays in the pool before it is closed.
+	IdleConnTimeout time.Duration
+	// Maximum number of idle connections kept per host.
+	MaxIdleConnsPerHost int
+	// Maximum number of connections per host, including those in use. Zero means
+	// no limit.
+	MaxConnsPerHost int
+	// Maximum amount of time a dial will wait for a connect to complete. When
+	// DNSCacheTTL is set, this applies to each resolved address separately.
+	DialTimeout time.Duration
+	// If set, resolved addresses of the API host are cached for the TTL of their
+	// DNS records, up to this long, and a failed dial moves on to the next
+	// address of the host instead of failing the request. Hosts listed in
+	// /etc/hosts resolve to their addresses there.
+	DNSCacheTTL time.Duration
+	// How long an expired DNS cache entry is still used while refreshing it
+	// fails. Defaults to DefaultDNSMaxStale.
+	DNSMaxStale time.Duration
+	// Maximum amount of time to wait for a TLS handshake.
+	TLSHandshakeTimeout time.Duration
+	// Maximum amount of time to wait for the response headers after the request
+	// has been written.
+	ResponseHeaderTimeout time.Duration
+	// If set, an HTTP/2 connection on which no frame was received for this long
+	// is health checked with a ping frame, which detects dead connections that
+	// TCP keep-alive probes miss, such as those dropped by a proxy that answers
+	// the probes itself.
+	HTTP2ReadIdleTimeout time.Duration
+	// How long to wait for the response to a health check ping before the
+	// connection is closed. Defaults to 15s.
+	HTTP2PingTimeout time.Duration
+}
+
+// NewTransport builds an http.Transport from the given config.
+func NewTransport(config TransportConfig) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if config.DialTimeout != 0 {
+		dialer.Timeout = config.DialTimeout
+	}
+	if config.KeepAlive != 0 {
+		dialer.KeepAlive = config.KeepAlive
+	}
+	transport.DialContext = dialer.DialContext
+	if config.DNSCacheTTL > 0 {
+		transport.DialContext = newDNSCache(config.DNSCacheTTL, config.DNSMaxStale, dialer).DialContext
+	}
+
+	if config.IdleConnTimeout != 0 {
+		transport.IdleConnTimeout = config.IdleConnTimeout
+	}
+	if config.MaxIdleConnsPerHost != 0 {
+		transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	}
+	if config.MaxConnsPerHost != 0 {
+		transport.MaxConnsPerHost = config.MaxConnsPerHost
+	}
+	if config.TLSHandshakeTimeout != 0 {
+		transport.TLSHandshakeTimeout = config.TLSHandshakeTimeout
+	}
+	if config.ResponseHeaderTimeout != 0 {
+		transport.ResponseHeaderTimeout = config.ResponseHeaderTimeout
+	}
+
+	switch config.HTTPVersion {
+	case HTTPVersion1:
+		// A non-nil, empty map disables the built-in HTTP/2 support.
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	case HTTPVersion2:
+		transport.ForceAttemptHTTP2 = true
+	}
+	if config.HTTP2ReadIdleTimeout > 0 {
+		configureHTTP2Pings(transport, config.HTTP2ReadIdleTimeout, config.HTTP2PingTimeout)
+	}
+
+	return transport
+}
+
+// WithTransportConfig replaces the HTTP client with one that uses a transport
+// built from the given config. The transport is shared by every request made
+// with this option, so connections are pooled across them.
+func WithTransportConfig(config TransportConfig) RequestOption {
+	client := &http.Client{Transport: NewTransport(config)}
+	return WithHTTPClient(client)
+}
@@ -0,0 +1,20 @@
+//go:build go1.24
+
+package options
+
+import (
+	"net/http"
+	"time"
+)
+
+// configureHTTP2Pings health checks the HTTP/2 connections of transport with
+// ping frames.
+func configureHTTP2Pings(transport *http.Transport, readIdleTimeout time.Duration, pingTimeout time.Duration) {
+	config := &http.HTTP2Config{}
+	if transport.HTTP2 != nil {
+		*config = *transport.HTTP2
+	}
+	config.SendPingTimeout = readIdleTimeout
+	config.PingTimeout = pingTimeout
+	transport.HTTP2 = config
+}
@@ -0,0 +1,25 @@
+//go:build !go1.24
+
+package options
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// configureHTTP2Pings health checks the HTTP/2 connections of transport with
+// ping frames, through golang.org/x/net/http2 as the HTTP/2 support of net/http
+// can only be configured from Go 1.24. It does nothing if HTTP/2 was disabled.
+func configureHTTP2Pings(transport *http.Transport, readIdleTimeout time.Duration, pingTimeout time.Duration) {
+	if transport.TLSNextProto != nil {
+		return
+	}
+	h2, err := http2.ConfigureTransports(transport)
+	if err != nil {
+		return
+	}
+	h2.ReadIdleTimeout = readIdleTimeout
+	h2.PingTimeout = pingTimeout
+}
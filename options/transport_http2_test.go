@@ -0,0 +1,18 @@
+//go:build go1.24
+
+package options
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTransportConfigHTTP2Pings(t *testing.T) {
+	transport := NewTransport(TransportConfig{HTTP2ReadIdleTimeout: 30 * time.Second, HTTP2PingTimeout: 5 * time.Second})
+	if transport.HTTP2 == nil || transport.HTTP2.SendPingTimeout != 30*time.Second || transport.HTTP2.PingTimeout != 5*time.Second {
+		t.Fatalf("expected HTTP/2 health checks to be configured, got %+v", transport.HTTP2)
+	}
+	if transport := NewTransport(TransportConfig{}); transport.HTTP2 != nil && transport.HTTP2.SendPingTimeout != 0 {
+		t.Fatalf("expected no health checks by default, got %+v", transport.HTTP2)
+	}
+}
@@ -0,0 +1,115 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/lithic-com/lithic-go/options"
+)
+
+// ErrResultSetTooLarge is returned by All when a list has more items than the
+// configured limit, see options.WithMaxPaginationItems. It is returned along with
+// the items collected so far and holds the cursor to resume the list from.
+type ErrResultSetTooLarge struct {
+	// The maximum number of items that may be collected.
+	Limit int
+	// For cursor paginated lists, the token of the last item that was collected,
+	// to be passed as the `StartingAfter` parameter to resume the list.
+	StartingAfter string
+	// For page numbered lists, the page to resume the list from, to be passed as
+	// the `Page` parameter. Only whole pages are ever collected.
+	Page int64
+}
+
+func (e *ErrResultSetTooLarge) Error() string {
+	if e.StartingAfter != "" {
+		return fmt.Sprintf("pagination: result set has more than %d items, resume after %s", e.Limit, e.StartingAfter)
+	}
+	return fmt.Sprintf("pagination: result set has more than %d items, resume from page %d", e.Limit, e.Page)
+}
+
+func maxItems(cfg *options.RequestConfig) int {
+	switch {
+	case cfg.MaxPaginationItems < 0:
+		return int(^uint(0) >> 1)
+	case cfg.MaxPaginationItems == 0:
+		return options.DefaultMaxPaginationItems
+	default:
+		return cfg.MaxPaginationItems
+	}
+}
+
+// All fetches every item of this list, starting at the first item of this page,
+// and returns them in a single slice. If the list has more items than allowed by
+// options.WithMaxPaginationItems, the whole pages collected so far are returned
+// along with an *ErrResultSetTooLarge. The page itself is left untouched.
+func (r *Page[T]) All(ctx context.Context) ([]T, error) {
+	if r.res == nil || r.err != nil {
+		return nil, r.err
+	}
+	limit := maxItems(&r.Config)
+	items := []T{}
+	fetcher := newPageFetcher(r)
+	defer fetcher.stop()
+	page := r
+	for {
+		pageItems := page.res.GetItems()
+		if len(items)+len(pageItems) > limit {
+			return items, &ErrResultSetTooLarge{Limit: limit, Page: page.res.Page}
+		}
+		items = append(items, pageItems...)
+
+		next, err := fetcher.fetch(ctx)
+		if err != nil {
+			return items, err
+		}
+		if next == nil {
+			return items, nil
+		}
+		page = next
+	}
+}
+
+// All fetches every item of this list, starting at the first item of this page,
+// and returns them in a single slice. If the list has more items than allowed by
+// options.WithMaxPaginationItems, the items collected so far are returned along
+// with an *ErrResultSetTooLarge. The page itself is left untouched.
+func (r *CursorPage[T]) All(ctx context.Context) ([]T, error) {
+	if r.res == nil || r.err != nil {
+		return nil, r.err
+	}
+	limit := maxItems(&r.Config)
+	items := []T{}
+	page := r
+	for {
+		for _, item := range page.res.GetItems() {
+			if len(items) >= limit {
+				return items, &ErrResultSetTooLarge{Limit: limit, StartingAfter: tokenOf(items[len(items)-1])}
+			}
+			items = append(items, item)
+		}
+
+		cfg := page.NextPageConfig()
+		if cfg == nil {
+			return items, nil
+		}
+		next := &CursorPage[T]{Config: *withContext(cfg, ctx), Options: page.Options}
+		if err := next.Fire(); err != nil {
+			return items, err
+		}
+		page = next
+	}
+}
+
+func tokenOf(item any) string {
+	value := reflect.Indirect(reflect.ValueOf(item))
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+	token := value.FieldByName("Token")
+	if !token.IsValid() || token.Kind() != reflect.String {
+		return ""
+	}
+	return token.String()
+}
@@ -0,0 +1,130 @@
+package pagination
+
+import (
+	"context"
+
+	"github.com/lithic-com/lithic-go/options"
+)
+
+// Iterator walks every item of a paginated list, transparently fetching the
+// following pages as the current one is exhausted.
+//
+//	iter := page.Iterator()
+//	defer iter.Close()
+//	for iter.Next(ctx) {
+//		transaction := iter.Current()
+//		// ...
+//	}
+//	if err := iter.Err(); err != nil {
+//		// ...
+//	}
+type Iterator[T any] struct {
+	items   []T
+	index   int
+	current *T
+	err     error
+	// fetch loads the items of the next page. It returns false once there are
+	// no more pages.
+	fetch func(ctx context.Context) ([]T, bool, error)
+	// stop cancels the pages that are being fetched ahead of their use.
+	stop func()
+}
+
+// Next advances the iterator to the next item, fetching the next page with the
+// given context if needed. It returns false when there are no more items or an
+// error occurred, in which case Err returns it.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for it.index >= len(it.items) {
+		if it.err != nil || it.fetch == nil {
+			return false
+		}
+		items, ok, err := it.fetch(ctx)
+		if err != nil {
+			it.err = err
+			it.Close()
+			return false
+		}
+		if !ok {
+			it.Close()
+			return false
+		}
+		it.items = items
+		it.index = 0
+	}
+	it.current = &it.items[it.index]
+	it.index += 1
+	return true
+}
+
+// Current returns the item that the last call to Next advanced to.
+func (it *Iterator[T]) Current() *T {
+	return it.current
+}
+
+// Close stops fetching the following pages and cancels those that are being
+// fetched ahead of their use, see options.WithPagePrefetch. It must be called
+// when the iteration is abandoned before Next returns false.
+func (it *Iterator[T]) Close() {
+	it.fetch = nil
+	if it.stop != nil {
+		it.stop()
+		it.stop = nil
+	}
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+func withContext(cfg *options.RequestConfig, ctx context.Context) *options.RequestConfig {
+	cfg.Context = ctx
+	cfg.Request = cfg.Request.WithContext(ctx)
+	return cfg
+}
+
+// Iterator returns an iterator over every item of this list, starting at the
+// first item of this page. The page itself is left untouched.
+func (r *Page[T]) Iterator() *Iterator[T] {
+	if r.res == nil {
+		return &Iterator[T]{err: r.err}
+	}
+	fetcher := newPageFetcher(r)
+	return &Iterator[T]{
+		items: r.res.GetItems(),
+		err:   r.err,
+		fetch: func(ctx context.Context) ([]T, bool, error) {
+			next, err := fetcher.fetch(ctx)
+			if next == nil || err != nil {
+				return nil, false, err
+			}
+			return next.res.GetItems(), true, nil
+		},
+		stop: fetcher.stop,
+	}
+}
+
+// Iterator returns an iterator over every item of this list, starting at the
+// first item of this page. The page itself is left untouched.
+func (r *CursorPage[T]) Iterator() *Iterator[T] {
+	if r.res == nil {
+		return &Iterator[T]{err: r.err}
+	}
+	page := r
+	return &Iterator[T]{
+		items: r.res.GetItems(),
+		err:   r.err,
+		fetch: func(ctx context.Context) ([]T, bool, error) {
+			cfg := page.NextPageConfig()
+			if cfg == nil {
+				return nil, false, nil
+			}
+			next := &CursorPage[T]{Config: *withContext(cfg, ctx), Options: page.Options}
+			if err := next.Fire(); err != nil {
+				return nil, false, err
+			}
+			page = next
+			return next.res.GetItems(), true, nil
+		},
+	}
+}
@@ -210,10 +210,18 @@ func (r *CursorPage[T]) NextPageConfig() *options.RequestConfig {
 	if items == nil || len(items) == 0 {
 		return nil
 	}
-	cfg := r.Config.Clone(r.Config.Context)
+	// Lists that are not cursor paginated, such as balances, always report that
+	// there are no more items and have no token to resume from.
+	if !r.res.HasMore && !r.res.JSON.HasMore.IsMissing() {
+		return nil
+	}
 	value := reflect.ValueOf(items[len(items)-1])
 	field := value.FieldByName("Token")
-	cfg.Apply(options.WithQuery("starting_after", field.Interface().(string)))
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return nil
+	}
+	cfg := r.Config.Clone(r.Config.Context)
+	cfg.Apply(options.WithQuery("starting_after", field.String()))
 	return cfg
 }
 
@@ -0,0 +1,329 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go/options"
+)
+
+type item struct {
+	Token string `json:"token"`
+}
+
+func newPageServer(t *testing.T, pages int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":[{"token":"%d-a"},{"token":"%d-b"}],"page":%d,"total_entries":%d,"total_pages":%d}`, page, page, page, pages*2, pages)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func firstPage(t *testing.T, server *httptest.Server) *Page[item] {
+	cfg, err := options.NewRequestConfig(context.Background(), "GET", "items", nil, nil, options.WithBaseURL(server.URL), options.WithMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := &Page[item]{Config: *cfg}
+	if err := page.Fire(); err != nil {
+		t.Fatal(err)
+	}
+	return page
+}
+
+func TestPageIterator(t *testing.T) {
+	server := newPageServer(t, 3)
+	page := firstPage(t, server)
+
+	tokens := []string{}
+	iter := page.Iterator()
+	for iter.Next(context.Background()) {
+		tokens = append(tokens, iter.Current().Token)
+	}
+	if err := iter.Err(); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"1-a", "1-b", "2-a", "2-b", "3-a", "3-b"}
+	if fmt.Sprint(tokens) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v but got %v", expected, tokens)
+	}
+
+	// The page that the iterator was created from is not advanced.
+	if page.GetResponse().GetItem(0).Token != "1-a" {
+		t.Fatalf("expected the original page to be untouched")
+	}
+}
+
+func TestPageIteratorStopsOnCancelledContext(t *testing.T) {
+	server := newPageServer(t, 3)
+	page := firstPage(t, server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	iter := page.Iterator()
+	count := 0
+	for iter.Next(ctx) {
+		count += 1
+		cancel()
+	}
+	if count != 2 || iter.Err() == nil {
+		t.Fatalf("expected iteration to stop after the first page with an error, got %d items and %v", count, iter.Err())
+	}
+}
+
+func TestPageAll(t *testing.T) {
+	server := newPageServer(t, 3)
+	items, err := firstPage(t, server).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 6 || items[5].Token != "3-b" {
+		t.Fatalf("expected all 6 items, got %v", items)
+	}
+}
+
+func TestPagePrefetch(t *testing.T) {
+	var inflight, maxInflight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inflight, 1)
+		defer atomic.AddInt32(&inflight, -1)
+		for max := atomic.LoadInt32(&maxInflight); n > max && !atomic.CompareAndSwapInt32(&maxInflight, max, n); max = atomic.LoadInt32(&maxInflight) {
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		// Later pages respond faster, so that they arrive out of order.
+		time.Sleep(time.Duration(10-page) * 5 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":[{"token":"%d"}],"page":%d,"total_entries":8,"total_pages":8}`, page, page)
+	}))
+	defer server.Close()
+	cfg, err := options.NewRequestConfig(context.Background(), "GET", "items", nil, nil, options.WithBaseURL(server.URL), options.WithMaxRetries(0), options.WithPagePrefetch(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := &Page[item]{Config: *cfg}
+	if err := page.Fire(); err != nil {
+		t.Fatal(err)
+	}
+
+	items, err := page.All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens := []string{}
+	for _, item := range items {
+		tokens = append(tokens, item.Token)
+	}
+	if fmt.Sprint(tokens) != "[1 2 3 4 5 6 7 8]" {
+		t.Fatalf("expected the pages in order, got %v", tokens)
+	}
+	if max := atomic.LoadInt32(&maxInflight); max < 2 || max > 3 {
+		t.Fatalf("expected up to 3 pages to be fetched concurrently, got %d", max)
+	}
+
+	iter := page.Iterator()
+	tokens = tokens[:0]
+	for iter.Next(context.Background()) {
+		tokens = append(tokens, iter.Current().Token)
+	}
+	if iter.Err() != nil || fmt.Sprint(tokens) != "[1 2 3 4 5 6 7 8]" {
+		t.Fatalf("expected the iterator to walk the pages in order, got %v %v", tokens, iter.Err())
+	}
+}
+
+func TestPagePrefetchCancelledWhenStopped(t *testing.T) {
+	cancelled := make(chan int, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		// Pages after the second never respond, until their request is
+		// cancelled.
+		if page > 2 {
+			<-r.Context().Done()
+			cancelled <- page
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":[{"token":"%d"}],"page":%d,"total_entries":5,"total_pages":5}`, page, page)
+	}))
+	defer server.Close()
+	firstPage := func(opts ...options.RequestOption) *Page[item] {
+		opts = append([]options.RequestOption{options.WithBaseURL(server.URL), options.WithMaxRetries(0), options.WithPagePrefetch(3)}, opts...)
+		cfg, err := options.NewRequestConfig(context.Background(), "GET", "items", nil, nil, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		page := &Page[item]{Config: *cfg}
+		if err := page.Fire(); err != nil {
+			t.Fatal(err)
+		}
+		return page
+	}
+	expectCancelled := func(pages int) {
+		t.Helper()
+		for i := 0; i < pages; i++ {
+			select {
+			case <-cancelled:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("expected %d prefetched pages to be cancelled, got %d", pages, i)
+			}
+		}
+	}
+
+	_, err := firstPage(options.WithMaxPaginationItems(1)).All(context.Background())
+	var tooLarge *ErrResultSetTooLarge
+	if !errors.As(err, &tooLarge) {
+		t.Fatalf("expected ErrResultSetTooLarge, got %v", err)
+	}
+	expectCancelled(2)
+
+	iter := firstPage().Iterator()
+	for i := 0; i < 2; i++ {
+		if !iter.Next(context.Background()) {
+			t.Fatalf("expected item %d, got %v", i, iter.Err())
+		}
+	}
+	iter.Close()
+	expectCancelled(2)
+	if iter.Next(context.Background()) {
+		t.Fatal("expected a closed iterator to stop")
+	}
+}
+
+func TestPageAllResultSetTooLarge(t *testing.T) {
+	server := newPageServer(t, 3)
+	page := firstPage(t, server)
+	page.Config.Apply(options.WithMaxPaginationItems(3))
+
+	items, err := page.All(context.Background())
+	var tooLarge *ErrResultSetTooLarge
+	if !errors.As(err, &tooLarge) {
+		t.Fatalf("expected ErrResultSetTooLarge, got %v", err)
+	}
+	if len(items) != 2 || tooLarge.Page != 2 || tooLarge.Limit != 3 {
+		t.Fatalf("expected to resume from page 2 after 2 items, got %v and %+v", items, tooLarge)
+	}
+}
+
+func TestCursorPageAllResultSetTooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("starting_after") {
+		case "":
+			w.Write([]byte(`{"data":[{"token":"a"},{"token":"b"}],"has_more":true}`))
+		case "b":
+			w.Write([]byte(`{"data":[{"token":"c"},{"token":"d"}],"has_more":false}`))
+		default:
+			w.Write([]byte(`{"data":[],"has_more":false}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cfg, err := options.NewRequestConfig(context.Background(), "GET", "items", nil, nil, options.WithBaseURL(server.URL), options.WithMaxRetries(0), options.WithMaxPaginationItems(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := &CursorPage[item]{Config: *cfg}
+	if err := page.Fire(); err != nil {
+		t.Fatal(err)
+	}
+	items, err := page.All(context.Background())
+	var tooLarge *ErrResultSetTooLarge
+	if !errors.As(err, &tooLarge) {
+		t.Fatalf("expected ErrResultSetTooLarge, got %v", err)
+	}
+	if len(items) != 3 || tooLarge.StartingAfter != "c" {
+		t.Fatalf("expected to resume after c, got %v and %+v", items, tooLarge)
+	}
+}
+
+func TestCursorPageStopsWithoutMore(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"currency":"USD"},{"currency":"EUR"}],"has_more":false}`))
+	}))
+	t.Cleanup(server.Close)
+
+	cfg, err := options.NewRequestConfig(context.Background(), "GET", "balances", nil, nil, options.WithBaseURL(server.URL), options.WithMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := &CursorPage[struct {
+		Currency string `json:"currency"`
+	}]{Config: *cfg}
+	if err := page.Fire(); err != nil {
+		t.Fatal(err)
+	}
+	items, err := page.All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || requests != 1 {
+		t.Fatalf("expected 2 items from a single request, got %d items from %d requests", len(items), requests)
+	}
+}
+
+func TestStream(t *testing.T) {
+	server := newPageServer(t, 3)
+	items, errs := firstPage(t, server).Iterator().Stream(context.Background())
+	tokens := []string{}
+	for item := range items {
+		tokens = append(tokens, item.Token)
+	}
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"1-a", "1-b", "2-a", "2-b", "3-a", "3-b"}
+	if fmt.Sprint(tokens) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v but got %v", expected, tokens)
+	}
+
+	failed := errors.New("unavailable")
+	items, errs = Stream(context.Background(), func() (*Iterator[item], error) { return nil, failed })
+	if _, ok := <-items; ok || !errors.Is(<-errs, failed) {
+		t.Fatal("expected the error of the list to be streamed")
+	}
+}
+
+func TestStreamStopsOnCancelledContext(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":[{"token":"%d"}],"page":%d,"total_entries":1000,"total_pages":1000}`, page, page)
+	}))
+	defer server.Close()
+	page := firstPage(t, server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	items, errs := page.Iterator().Stream(ctx)
+	<-items
+	cancel()
+	for range items {
+	}
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the stream to stop with the error of the context, got %v", err)
+	}
+	if n := atomic.LoadInt32(&requests); n >= 1000 {
+		t.Fatalf("expected the prefetching to stop, got %d requests", n)
+	}
+}
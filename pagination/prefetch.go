@@ -0,0 +1,101 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+)
+
+type pageResult[T any] struct {
+	page *Page[T]
+	err  error
+}
+
+type prefetch[T any] struct {
+	res    chan pageResult[T]
+	cancel context.CancelFunc
+}
+
+// pageFetcher fetches the pages that follow a page, in order. If the page was
+// requested with options.WithPagePrefetch, up to that many of the following
+// pages are fetched concurrently, ahead of their use. The pages that are still
+// being fetched when the fetcher is no longer needed are cancelled by stop.
+type pageFetcher[T any] struct {
+	first *Page[T]
+	last  *Page[T]
+	// The number of the next page to request, and of the last page, when
+	// prefetching.
+	next    int64
+	total   int64
+	pending []prefetch[T]
+}
+
+func newPageFetcher[T any](page *Page[T]) *pageFetcher[T] {
+	return &pageFetcher[T]{first: page, last: page, next: page.res.Page + 1, total: page.res.TotalPages}
+}
+
+// fetch returns the next page, or nil once there are no more pages.
+func (f *pageFetcher[T]) fetch(ctx context.Context) (*Page[T], error) {
+	prefetch := f.first.Config.PagePrefetch
+	if prefetch <= 0 {
+		cfg := f.last.NextPageConfig()
+		if cfg == nil {
+			return nil, nil
+		}
+		next := &Page[T]{Config: *withContext(cfg, ctx), Options: f.last.Options}
+		if err := next.Fire(); err != nil {
+			return nil, err
+		}
+		f.last = next
+		return next, nil
+	}
+
+	for len(f.pending) < prefetch && f.next <= f.total {
+		f.pending = append(f.pending, f.start(ctx, f.next))
+		f.next += 1
+	}
+	if len(f.pending) == 0 {
+		return nil, nil
+	}
+	res := <-f.pending[0].res
+	f.pending[0].cancel()
+	f.pending = f.pending[1:]
+	if res.err != nil {
+		f.stop()
+		return nil, res.err
+	}
+	f.last = res.page
+	return res.page, nil
+}
+
+// stop cancels the pages that are still being fetched ahead of their use.
+func (f *pageFetcher[T]) stop() {
+	for _, p := range f.pending {
+		p.cancel()
+	}
+	f.pending = nil
+	f.next = f.total + 1
+}
+
+// start fetches the page of the given number in the background, with a context
+// derived from ctx so that stop can cancel it.
+func (f *pageFetcher[T]) start(ctx context.Context, number int64) prefetch[T] {
+	// The channel is buffered, so that the page can be dropped if the fetcher
+	// is abandoned.
+	res := make(chan pageResult[T], 1)
+	ctx, cancel := context.WithCancel(ctx)
+	cfg := f.first.Config.Clone(context.Background())
+	if cfg == nil {
+		res <- pageResult[T]{err: fmt.Errorf("pagination: could not copy the request for page %d", number)}
+		return prefetch[T]{res, cancel}
+	}
+	query := cfg.Request.URL.Query()
+	query.Set("page", fmt.Sprintf("%d", number))
+	cfg.Request.URL.RawQuery = query.Encode()
+	page := &Page[T]{Config: *withContext(cfg, ctx), Options: f.first.Options}
+
+	go func() {
+		err := page.Fire()
+		res <- pageResult[T]{page, err}
+	}()
+	return prefetch[T]{res, cancel}
+}
@@ -0,0 +1,59 @@
+package pagination
+
+import (
+	"context"
+)
+
+// StreamBuffer is the number of items that a stream fetches ahead of its
+// consumer.
+const StreamBuffer = 100
+
+// Stream calls list in the background, fetches the pages of the list that it
+// returns as they are consumed and sends their items on the returned channel:
+//
+//	items, errs := pagination.Stream(ctx, list)
+//	for item := range items {
+//		// ...
+//	}
+//	if err := <-errs; err != nil {
+//		// ...
+//	}
+//
+// The channel of items is closed once the list is exhausted, fetching a page
+// failed or ctx is done. The error, if any, is then sent on the error channel,
+// which is closed after. Cancelling ctx stops the prefetching of pages, and
+// consumers that stop reading early must cancel it to release the goroutine.
+func Stream[T any](ctx context.Context, list func() (*Iterator[T], error)) (<-chan T, <-chan error) {
+	items := make(chan T, StreamBuffer)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(items)
+		it, err := list()
+		if err != nil {
+			errs <- err
+			return
+		}
+		defer it.Close()
+		for it.Next(ctx) {
+			select {
+			case items <- *it.Current():
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			errs <- err
+		} else if err := ctx.Err(); err != nil {
+			errs <- err
+		}
+	}()
+	return items, errs
+}
+
+// Stream sends the remaining items of the iterator on the returned channel,
+// fetching the following pages in the background, see Stream.
+func (it *Iterator[T]) Stream(ctx context.Context) (<-chan T, <-chan error) {
+	return Stream(ctx, func() (*Iterator[T], error) { return it, nil })
+}
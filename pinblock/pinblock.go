@@ -0,0 +1,92 @@
+// Package pinblock produces the encrypted PIN blocks expected by the `pin` field
+// of `CardNewParams` and `CardUpdateParams`.
+//
+// A PIN block is the JSON object `{"nonce": <random integer>, "pin": "<pin>"}`,
+// encrypted with RSA-OAEP (SHA-1) using Lithic's public key and encoded in
+// base64. See https://docs.lithic.com/docs/cards#encrypted-pin-block-enterprise.
+package pinblock
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// ErrInvalidPIN is returned when the PIN is not 4 to 12 digits long.
+var ErrInvalidPIN = errors.New("pinblock: PIN must be 4 to 12 digits")
+
+// The nonce is a random integer of at least 8 digits.
+var (
+	minNonce = big.NewInt(1e8)
+	maxNonce = big.NewInt(1e12)
+)
+
+// ParsePublicKey parses Lithic's PEM encoded RSA public key, in either PKIX
+// ("PUBLIC KEY") or PKCS #1 ("RSA PUBLIC KEY") form.
+func ParsePublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("pinblock: no PEM encoded public key found")
+	}
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("pinblock: expected an RSA public key, got %T", key)
+	}
+	return rsaKey, nil
+}
+
+// Encrypt returns the base64 encoded PIN block of pin, encrypted with key.
+//
+//	block, err := pinblock.Encrypt("1234", key)
+//	card, err := client.Cards.Update(ctx, token, &requests.CardUpdateParams{Pin: fields.F(block)})
+func Encrypt(pin string, key *rsa.PublicKey) (string, error) {
+	return encrypt(rand.Reader, pin, key)
+}
+
+func encrypt(random io.Reader, pin string, key *rsa.PublicKey) (string, error) {
+	if len(pin) < 4 || len(pin) > 12 {
+		return "", ErrInvalidPIN
+	}
+	for _, c := range pin {
+		if c < '0' || c > '9' {
+			return "", ErrInvalidPIN
+		}
+	}
+	if key == nil {
+		return "", errors.New("pinblock: a public key is required")
+	}
+
+	nonce, err := rand.Int(random, new(big.Int).Sub(maxNonce, minNonce))
+	if err != nil {
+		return "", err
+	}
+	nonce.Add(nonce, minNonce)
+	block, err := json.Marshal(struct {
+		Nonce int64  `json:"nonce"`
+		PIN   string `json:"pin"`
+	}{nonce.Int64(), pin})
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := rsa.EncryptOAEP(sha1.New(), random, key, block, nil)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
@@ -0,0 +1,59 @@
+package pinblock
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"testing"
+)
+
+func TestEncrypt(t *testing.T) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&private.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, err := Encrypt("0123", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := base64.StdEncoding.DecodeString(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := rsa.DecryptOAEP(sha1.New(), nil, private, encrypted, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload struct {
+		Nonce int64  `json:"nonce"`
+		PIN   string `json:"pin"`
+	}
+	if err := json.Unmarshal(decrypted, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.PIN != "0123" || payload.Nonce < 1e8 {
+		t.Fatalf("unexpected PIN block %s", decrypted)
+	}
+}
+
+func TestEncryptInvalidPIN(t *testing.T) {
+	for _, pin := range []string{"123", "12a4", "1234567890123"} {
+		if _, err := Encrypt(pin, &rsa.PublicKey{}); !errors.Is(err, ErrInvalidPIN) {
+			t.Fatalf("expected %q to be rejected, got %v", pin, err)
+		}
+	}
+}
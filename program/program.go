@@ -0,0 +1,264 @@
+// Package program walks the hierarchy of a Lithic program for back-office
+// tools: its accounts, the account holder, cards and financial accounts of
+// each account, and the business accounts that the accounts of authorized
+// users belong to.
+//
+// Walk hydrates the accounts concurrently and fetches every account once, even
+// when accounts refer to each other, and returns the hierarchy as a
+// ProgramTree:
+//
+//	tree, err := program.Walk(ctx, client, program.Options{})
+//	for _, node := range tree.Accounts {
+//		fmt.Println(node.Account.Token, len(node.Cards), len(node.FinancialAccounts))
+//	}
+package program
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+// The number of accounts that Walk hydrates at once by default.
+const DefaultConcurrency = 8
+
+// Options configures Walk.
+type Options struct {
+	// The tokens of the accounts to walk. Defaults to every account of the
+	// program.
+	AccountTokens []string
+	// The number of accounts hydrated at once. Defaults to DefaultConcurrency.
+	Concurrency int
+}
+
+// ProgramTree is the hierarchy of the accounts of a program.
+type ProgramTree struct {
+	// The accounts that were walked, in the order they were listed or given.
+	Accounts []*AccountNode
+	// The tokens of the accounts that could not be fully hydrated, and why. Their
+	// nodes hold what could be fetched.
+	Failed map[string]error
+
+	nodes map[string]*AccountNode
+}
+
+// Account returns the node of an account, which is either one of Accounts or
+// a business account that they belong to.
+func (t *ProgramTree) Account(token string) (*AccountNode, bool) {
+	node, ok := t.nodes[token]
+	return node, ok
+}
+
+// AccountNode is an account and the resources that belong to it.
+type AccountNode struct {
+	Account responses.Account
+	// The account holder of the account, or nil if the account has none.
+	AccountHolder     *responses.AccountHolder
+	Cards             []responses.Card
+	FinancialAccounts []responses.FinancialAccount
+	// The business account that the account of an authorized user belongs to,
+	// or nil.
+	Business *AccountNode
+	// The accounts of the authorized users of a business account, sorted by
+	// token.
+	AuthorizedUsers []*AccountNode
+}
+
+// BusinessAccountToken returns the token of the business account that the
+// account belongs to, or "".
+func (n *AccountNode) BusinessAccountToken() string {
+	if n.AccountHolder != nil && n.AccountHolder.BusinessAccountToken != "" {
+		return n.AccountHolder.BusinessAccountToken
+	}
+	return n.Account.AccountHolder.BusinessAccountToken
+}
+
+// Walk fetches the accounts of the program, or those of opts.AccountTokens,
+// and hydrates each of them with its account holder, cards and financial
+// accounts, with bounded concurrency. The business accounts that they belong
+// to are walked as well, once each.
+//
+// Accounts that fail to be hydrated are reported in the Failed of the tree
+// without stopping the others. An error is returned if the accounts could not
+// be listed, or ctx was cancelled.
+func Walk(ctx context.Context, client *lithic.Lithic, opts Options, reqOpts ...options.RequestOption) (*ProgramTree, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	w := &walker{
+		ctx: ctx,
+		// Service methods append to Options, which must not have spare capacity
+		// when they are called concurrently.
+		accounts:          &services.AccountService{Options: capped(client.Accounts.Options)},
+		accountHolders:    &services.AccountHolderService{Options: capped(client.AccountHolders.Options)},
+		cards:             &services.CardService{Options: capped(client.Cards.Options)},
+		financialAccounts: &services.FinancialAccountService{Options: capped(client.FinancialAccounts.Options)},
+		opts:              reqOpts,
+		sem:               make(chan struct{}, concurrency),
+		tree:              &ProgramTree{nodes: map[string]*AccountNode{}},
+	}
+
+	if len(opts.AccountTokens) != 0 {
+		for _, token := range opts.AccountTokens {
+			w.visit(token, nil, true)
+		}
+	} else {
+		page, err := w.accounts.List(ctx, &requests.AccountListParams{}, w.opts...)
+		if err != nil {
+			return nil, err
+		}
+		iter := page.Iterator()
+		for iter.Next(ctx) {
+			account := *iter.Current()
+			w.visit(account.Token, &account, true)
+		}
+		if err := iter.Err(); err != nil {
+			w.wg.Wait()
+			return nil, err
+		}
+	}
+	w.wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	w.link()
+	return w.tree, nil
+}
+
+func capped[T any](s []T) []T {
+	return s[:len(s):len(s)]
+}
+
+type walker struct {
+	ctx               context.Context
+	accounts          *services.AccountService
+	accountHolders    *services.AccountHolderService
+	cards             *services.CardService
+	financialAccounts *services.FinancialAccountService
+	opts              []options.RequestOption
+	sem               chan struct{}
+	wg                sync.WaitGroup
+
+	mu   sync.Mutex
+	tree *ProgramTree
+}
+
+// visit hydrates the account of token in the background, unless it was
+// visited before. account is the account if it was already fetched.
+func (w *walker) visit(token string, account *responses.Account, root bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	node, ok := w.tree.nodes[token]
+	if !ok {
+		node = &AccountNode{}
+		w.tree.nodes[token] = node
+		w.wg.Add(1)
+		go w.hydrate(node, token, account)
+	}
+	if root {
+		w.tree.Accounts = append(w.tree.Accounts, node)
+	}
+}
+
+func (w *walker) fail(token string, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.tree.Failed == nil {
+		w.tree.Failed = map[string]error{}
+	}
+	if w.tree.Failed[token] == nil {
+		w.tree.Failed[token] = err
+	}
+}
+
+func (w *walker) hydrate(node *AccountNode, token string, account *responses.Account) {
+	defer w.wg.Done()
+	select {
+	case w.sem <- struct{}{}:
+	case <-w.ctx.Done():
+		w.fail(token, w.ctx.Err())
+		return
+	}
+	defer func() { <-w.sem }()
+
+	if account == nil {
+		var err error
+		if account, err = w.accounts.Get(w.ctx, token, w.opts...); err != nil {
+			node.Account.Token = token
+			w.fail(token, err)
+			return
+		}
+	}
+	node.Account = *account
+
+	if holder := account.AccountHolder.Token; holder != "" {
+		res, err := w.accountHolders.Get(w.ctx, holder, w.opts...)
+		if err != nil {
+			w.fail(token, err)
+		} else {
+			node.AccountHolder = res
+		}
+	}
+
+	if err := w.listCards(node, token); err != nil {
+		w.fail(token, err)
+	}
+	if err := w.listFinancialAccounts(node, token); err != nil {
+		w.fail(token, err)
+	}
+
+	if business := node.BusinessAccountToken(); business != "" && business != token {
+		w.visit(business, nil, false)
+	}
+}
+
+func (w *walker) listCards(node *AccountNode, token string) error {
+	page, err := w.cards.List(w.ctx, &requests.CardListParams{AccountToken: fields.F(token)}, w.opts...)
+	if err != nil {
+		return err
+	}
+	iter := page.Iterator()
+	for iter.Next(w.ctx) {
+		node.Cards = append(node.Cards, *iter.Current())
+	}
+	return iter.Err()
+}
+
+func (w *walker) listFinancialAccounts(node *AccountNode, token string) error {
+	page, err := w.financialAccounts.List(w.ctx, &requests.FinancialAccountListParams{AccountToken: fields.F(token)}, w.opts...)
+	if err != nil {
+		return err
+	}
+	iter := page.Iterator()
+	for iter.Next(w.ctx) {
+		node.FinancialAccounts = append(node.FinancialAccounts, *iter.Current())
+	}
+	return iter.Err()
+}
+
+// link connects the accounts of authorized users with their business
+// accounts, once every account was hydrated.
+func (w *walker) link() {
+	for _, node := range w.tree.nodes {
+		business, ok := w.tree.nodes[node.BusinessAccountToken()]
+		if !ok || business == node {
+			continue
+		}
+		node.Business = business
+		business.AuthorizedUsers = append(business.AuthorizedUsers, node)
+	}
+	for _, node := range w.tree.nodes {
+		sort.Slice(node.AuthorizedUsers, func(i, j int) bool {
+			return node.AuthorizedUsers[i].Account.Token < node.AuthorizedUsers[j].Account.Token
+		})
+	}
+}
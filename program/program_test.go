@@ -0,0 +1,125 @@
+package program
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/options"
+)
+
+func TestWalk(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	account := func(token, holder, business string) map[string]interface{} {
+		return map[string]interface{}{"token": token, "state": "ACTIVE", "account_holder": map[string]string{"token": holder, "business_account_token": business}}
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path+"?"+r.URL.RawQuery]++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/accounts":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"page": 1, "total_pages": 1, "total_entries": 2,
+				"data": []interface{}{account("user", "holder_user", "business"), account("solo", "holder_solo", "")},
+			})
+		case r.URL.Path == "/accounts/business":
+			// The business account refers back to an authorized user, which must
+			// not be walked again.
+			json.NewEncoder(w).Encode(account("business", "holder_business", "user"))
+		case r.URL.Path == "/account_holders/holder_solo":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message":"unavailable"}`))
+		case strings.HasPrefix(r.URL.Path, "/account_holders/"):
+			token := strings.TrimPrefix(r.URL.Path, "/account_holders/")
+			json.NewEncoder(w).Encode(map[string]string{"token": token})
+		case r.URL.Path == "/cards":
+			token := r.URL.Query().Get("account_token")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"page": 1, "total_pages": 1, "total_entries": 1,
+				"data": []map[string]string{{"token": "card_" + token, "account_token": token}},
+			})
+		case r.URL.Path == "/financial_accounts":
+			token := r.URL.Query().Get("account_token")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"has_more": false,
+				"data":     []map[string]string{{"token": "fa_" + token, "type": "ISSUING"}},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+	client := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL), options.WithMaxRetries(0))
+
+	tree, err := Walk(context.Background(), client, Options{Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tree.Accounts) != 2 || tree.Accounts[0].Account.Token != "user" || tree.Accounts[1].Account.Token != "solo" {
+		t.Fatalf("expected the listed accounts in order, got %+v", tree.Accounts)
+	}
+	user := tree.Accounts[0]
+	if user.AccountHolder == nil || user.AccountHolder.Token != "holder_user" {
+		t.Fatalf("expected the account holder to be hydrated, got %+v", user.AccountHolder)
+	}
+	if len(user.Cards) != 1 || user.Cards[0].Token != "card_user" || len(user.FinancialAccounts) != 1 || user.FinancialAccounts[0].Token != "fa_user" {
+		t.Fatalf("expected the cards and financial accounts to be hydrated, got %+v %+v", user.Cards, user.FinancialAccounts)
+	}
+	business, ok := tree.Account("business")
+	if !ok || user.Business != business || len(business.AuthorizedUsers) != 1 || business.AuthorizedUsers[0] != user {
+		t.Fatalf("expected the user to be linked to the business account, got %+v", business)
+	}
+	if business.Business != user || len(business.Cards) != 1 {
+		t.Fatalf("expected the business account to be hydrated, got %+v", business)
+	}
+
+	solo := tree.Accounts[1]
+	if solo.AccountHolder != nil || len(solo.Cards) != 1 || tree.Failed["solo"] == nil || len(tree.Failed) != 1 {
+		t.Fatalf("expected the failed account holder to be reported, got %+v %v", solo, tree.Failed)
+	}
+	for path, n := range requests {
+		if n != 1 {
+			t.Errorf("expected %s to be requested once, got %d", path, n)
+		}
+	}
+}
+
+func TestWalkAccountTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/accounts/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+		case "/accounts/given":
+			w.Write([]byte(`{"token":"given","state":"ACTIVE"}`))
+		case "/cards":
+			w.Write([]byte(`{"page":1,"total_pages":1,"total_entries":0,"data":[]}`))
+		case "/financial_accounts":
+			w.Write([]byte(`{"has_more":false,"data":[]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+	client := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL), options.WithMaxRetries(0))
+
+	tree, err := Walk(context.Background(), client, Options{AccountTokens: []string{"given", "missing"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tree.Accounts) != 2 || tree.Accounts[0].Account.State != "ACTIVE" || tree.Accounts[0].AccountHolder != nil {
+		t.Fatalf("expected the given account to be walked, got %+v", tree.Accounts)
+	}
+	if tree.Accounts[1].Account.Token != "missing" || tree.Failed["missing"] == nil {
+		t.Fatalf("expected the missing account to be reported, got %v", tree.Failed)
+	}
+}
@@ -0,0 +1,206 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lithic-com/lithic-go/options"
+)
+
+// ErrQuotaExceeded is returned by the tracker's middleware when a card creation
+// would exceed the configured limit for the current window.
+var ErrQuotaExceeded = errors.New("quota: card issuance limit reached")
+
+// Store persists card creations so that they can be counted per time window.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Count returns the number of card creations recorded at or after since.
+	Count(ctx context.Context, since time.Time) (int, error)
+	// Record stores a card creation that happened at the given time.
+	Record(ctx context.Context, at time.Time) error
+}
+
+// MemoryStore is an in-process Store. Entries older than the longest window
+// queried are discarded on every call to Count, so that a store can be shared
+// by trackers with different windows. Windows are measured against the wall
+// clock.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries []time.Time
+	longest time.Duration
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (s *MemoryStore) Count(ctx context.Context, since time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if window := now.Sub(since); window > s.longest {
+		s.longest = window
+	}
+	cutoff := now.Add(-s.longest)
+	i := 0
+	for i < len(s.entries) && s.entries[i].Before(cutoff) {
+		i += 1
+	}
+	s.entries = s.entries[i:]
+	count := 0
+	for _, at := range s.entries {
+		if !at.Before(since) {
+			count += 1
+		}
+	}
+	return count, nil
+}
+
+func (s *MemoryStore) Record(ctx context.Context, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, at)
+	return nil
+}
+
+// Usage describes the state of the quota at the time a card creation is
+// attempted.
+type Usage struct {
+	// Number of cards created within the current window, including those whose
+	// creation is in flight but not the request being attempted.
+	Count  int
+	Limit  int
+	Window time.Duration
+}
+
+func (u Usage) String() string {
+	return fmt.Sprintf("%d/%d cards in the last %s", u.Count, u.Limit, u.Window)
+}
+
+// Tracker counts card creations made through the client and warns or blocks
+// when the configured limit is being approached.
+type Tracker struct {
+	// Store used to record card creations. Defaults to a MemoryStore.
+	Store Store
+	// Maximum number of cards that may be created within Window. A limit of 0
+	// disables blocking.
+	Limit int
+	// Length of the rolling window that Limit applies to.
+	Window time.Duration
+	// Number of cards created within Window at which OnWarn starts being called.
+	// A value of 0 disables warnings.
+	WarnAt int
+	// Called before a card creation is sent when the usage is at or above WarnAt.
+	OnWarn func(Usage)
+	// If set, requests over the limit are sent anyway and only OnWarn is called.
+	WarnOnly bool
+	// Called when a card was created but its creation could not be recorded in
+	// Store, in which case the card is still returned to the caller.
+	OnRecordError func(err error)
+
+	now  func() time.Time
+	once sync.Once
+	// mu makes checking the quota and reserving a slot for a creation atomic.
+	// pending is the number of creations that are in flight.
+	mu      sync.Mutex
+	pending int
+}
+
+// NewTracker returns a tracker that allows `limit` card creations per `window`,
+// backed by an in-memory store.
+func NewTracker(limit int, window time.Duration) *Tracker {
+	return &Tracker{
+		Store:  NewMemoryStore(),
+		Limit:  limit,
+		Window: window,
+	}
+}
+
+// Usage returns the number of cards created within the current window.
+func (t *Tracker) Usage(ctx context.Context) (Usage, error) {
+	count, err := t.store().Count(ctx, t.clock().Add(-t.Window))
+	return Usage{Count: count, Limit: t.Limit, Window: t.Window}, err
+}
+
+// Middleware returns an options.Middleware that applies the quota to card
+// creations, i.e. requests made by `CardService.New`. All other requests are
+// passed through untouched.
+//
+//	tracker := quota.NewTracker(1000, 24*time.Hour)
+//	client := lithic.NewLithic(options.WithMiddleware(tracker.Middleware()))
+func (t *Tracker) Middleware() options.Middleware {
+	return func(req *http.Request, next options.MiddlewareNext) (*http.Response, error) {
+		if !isCardCreation(req) {
+			return next(req)
+		}
+		usage, err := t.reserve(req.Context())
+		if t.WarnAt > 0 && usage.Count >= t.WarnAt && t.OnWarn != nil {
+			t.OnWarn(usage)
+		}
+		if err != nil {
+			return nil, err
+		}
+		res, err := next(req)
+		t.release(req.Context(), err == nil && res.StatusCode >= 200 && res.StatusCode < 300)
+		return res, err
+	}
+}
+
+// reserve checks the quota and, unless it is exceeded, reserves a slot for a
+// card creation, which must then be released. Creations in flight count against
+// the quota, so that concurrent creations cannot exceed it.
+func (t *Tracker) reserve(ctx context.Context) (Usage, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	usage, err := t.Usage(ctx)
+	if err != nil {
+		return usage, err
+	}
+	usage.Count += t.pending
+	if t.Limit > 0 && usage.Count >= t.Limit && !t.WarnOnly {
+		return usage, fmt.Errorf("%w: %s", ErrQuotaExceeded, usage)
+	}
+	t.pending += 1
+	return usage, nil
+}
+
+// release releases the slot of a card creation, recording the creation if the
+// card was created.
+func (t *Tracker) release(ctx context.Context, created bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending -= 1
+	if !created {
+		return
+	}
+	// The card exists whether or not it is recorded, so a failure to record it
+	// is reported without failing the request.
+	if err := t.store().Record(ctx, t.clock()); err != nil && t.OnRecordError != nil {
+		t.OnRecordError(err)
+	}
+}
+
+func (t *Tracker) store() Store {
+	t.once.Do(func() {
+		if t.Store == nil {
+			t.Store = NewMemoryStore()
+		}
+	})
+	return t.Store
+}
+
+func (t *Tracker) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+func isCardCreation(req *http.Request) bool {
+	return req.Method == http.MethodPost && strings.HasSuffix(strings.TrimSuffix(req.URL.Path, "/"), "/cards")
+}
@@ -0,0 +1,176 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token":"card_token"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTrackerBlocksOverLimit(t *testing.T) {
+	server := newTestServer(t)
+	tracker := NewTracker(2, time.Hour)
+	warnings := 0
+	tracker.WarnAt = 1
+	tracker.OnWarn = func(Usage) { warnings += 1 }
+
+	cards := services.NewCardService(
+		options.WithBaseURL(server.URL+"/v1/"),
+		options.WithMaxRetries(0),
+		options.WithMiddleware(tracker.Middleware()),
+	)
+	params := &requests.CardNewParams{Type: fields.F(requests.CardNewParamsTypeVirtual)}
+
+	for i := 0; i < 2; i++ {
+		if _, err := cards.New(context.Background(), params); err != nil {
+			t.Fatalf("unexpected error on card %d: %s", i, err)
+		}
+	}
+	_, err := cards.New(context.Background(), params)
+	if !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+	}
+	if warnings != 2 {
+		t.Fatalf("expected 2 warnings, got %d", warnings)
+	}
+
+	// Reads are not counted against the quota.
+	if _, err := cards.Get(context.Background(), "card_token"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestTrackerWindowExpires(t *testing.T) {
+	now := time.Now()
+	tracker := NewTracker(1, time.Minute)
+	tracker.now = func() time.Time { return now }
+	tracker.Store.Record(context.Background(), now.Add(-2*time.Minute))
+
+	usage, err := tracker.Usage(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage.Count != 0 {
+		t.Fatalf("expected expired entries to be ignored, got %d", usage.Count)
+	}
+}
+
+func TestTrackerReservesConcurrentCreations(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token":"card_token"}`))
+	}))
+	defer server.Close()
+	tracker := NewTracker(3, time.Hour)
+	cards := services.NewCardService(
+		options.WithBaseURL(server.URL+"/v1/"),
+		options.WithMaxRetries(0),
+		options.WithMiddleware(tracker.Middleware()),
+	)
+	params := &requests.CardNewParams{Type: fields.F(requests.CardNewParamsTypeVirtual)}
+
+	const attempts = 10
+	errs := make(chan error, attempts)
+	for i := 0; i < attempts; i++ {
+		go func() {
+			_, err := cards.New(context.Background(), params)
+			errs <- err
+		}()
+	}
+	// Every creation over the limit is rejected while the others are in flight.
+	for i := 0; i < attempts-3; i++ {
+		if err := <-errs; !errors.Is(err, ErrQuotaExceeded) {
+			t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+		}
+	}
+	close(release)
+	for i := 0; i < 3; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if usage, _ := tracker.Usage(context.Background()); usage.Count != 3 {
+		t.Fatalf("expected 3 cards to be recorded, got %d", usage.Count)
+	}
+}
+
+func TestTrackerReleasesFailedCreations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+	tracker := NewTracker(1, time.Hour)
+	cards := services.NewCardService(
+		options.WithBaseURL(server.URL+"/v1/"),
+		options.WithMaxRetries(0),
+		options.WithMiddleware(tracker.Middleware()),
+	)
+	params := &requests.CardNewParams{Type: fields.F(requests.CardNewParamsTypeVirtual)}
+	for i := 0; i < 2; i++ {
+		if _, err := cards.New(context.Background(), params); err == nil || errors.Is(err, ErrQuotaExceeded) {
+			t.Fatalf("expected the API error, got %v", err)
+		}
+	}
+}
+
+func TestMemoryStoreSharedByWindows(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Now()
+	store.Record(context.Background(), now.Add(-30*time.Minute))
+	store.Record(context.Background(), now.Add(-time.Minute))
+
+	if count, _ := store.Count(context.Background(), now.Add(-time.Hour)); count != 2 {
+		t.Fatalf("expected 2 entries within the hour, got %d", count)
+	}
+	if count, _ := store.Count(context.Background(), now.Add(-5*time.Minute)); count != 1 {
+		t.Fatalf("expected 1 entry within 5 minutes, got %d", count)
+	}
+	if count, _ := store.Count(context.Background(), now.Add(-time.Hour)); count != 2 {
+		t.Fatalf("expected the shorter window not to discard entries of the longer one, got %d", count)
+	}
+}
+
+type failingStore struct{ MemoryStore }
+
+func (s *failingStore) Record(ctx context.Context, at time.Time) error {
+	return errors.New("store unavailable")
+}
+
+func TestTrackerReturnsCardWhenRecordFails(t *testing.T) {
+	server := newTestServer(t)
+	tracker := NewTracker(2, time.Hour)
+	tracker.Store = &failingStore{}
+	var recordErr error
+	tracker.OnRecordError = func(err error) { recordErr = err }
+
+	cards := services.NewCardService(
+		options.WithBaseURL(server.URL+"/v1/"),
+		options.WithMaxRetries(0),
+		options.WithMiddleware(tracker.Middleware()),
+	)
+	card, err := cards.New(context.Background(), &requests.CardNewParams{Type: fields.F(requests.CardNewParamsTypeVirtual)})
+	if err != nil || card.Token != "card_token" {
+		t.Fatalf("expected the created card, got %+v %v", card, err)
+	}
+	if recordErr == nil {
+		t.Fatal("expected the record failure to be reported")
+	}
+}
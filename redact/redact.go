@@ -0,0 +1,281 @@
+// Package redact defines the policy that the SDK redacts sensitive data with
+// wherever it outputs it: the request bodies that middlewares such as loggers
+// see, diagnostics dumps, ASA audit records and the String methods of request
+// params. A single Policy can be set for the whole process with SetDefault, or
+// for some requests with options.WithRedactionPolicy, so that one redaction
+// standard is enforced across all of these outputs.
+//
+//	redact.SetDefault(&redact.Policy{
+//		Fields:  append(redact.DefaultFields, "memo", "address.*"),
+//		Hash:    true,
+//		HashKey: key,
+//	})
+package redact
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// Masked is the value that redacted values are replaced with, unless the
+// policy hashes them.
+const Masked = "[REDACTED]"
+
+// DefaultFields are the fields of the default policy, which hold cardholder,
+// account or credential data.
+var DefaultFields = []string{"pan", "cvv", "pin", "dob", "government_id", "account_number", "routing_number", "secret"}
+
+// DefaultHeaders are the headers that every policy redacts.
+var DefaultHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// Policy decides which values are redacted, and how.
+type Policy struct {
+	// Field path patterns, such as "pan", "individual.dob" or "accounts.#.number".
+	// A pattern matches the fields whose path ends with it, so "pan" matches a
+	// pan field at any depth. The segments of a path are the keys of the
+	// objects that contain the field, with "#" for the elements of arrays, and
+	// a "*" segment in a pattern matches any segment. Query parameters are
+	// matched by their name.
+	Fields []string
+	// Headers that are redacted in addition to DefaultHeaders.
+	Headers []string
+	// If Hash is true, values are replaced with a hash rather than masked, so
+	// that the same value can be correlated across outputs without being
+	// revealed.
+	Hash bool
+	// The key of the HMAC-SHA256 that values are hashed with. Without a key,
+	// values are hashed with SHA-256, which does not protect values with few
+	// possible values such as PINs from being recovered by brute force.
+	HashKey []byte
+}
+
+var defaultPolicy atomic.Pointer[Policy]
+
+func init() {
+	defaultPolicy.Store(&Policy{Fields: DefaultFields})
+}
+
+// Default returns the policy that is used where no other is given, which
+// redacts DefaultFields unless it is replaced with SetDefault.
+func Default() *Policy {
+	return defaultPolicy.Load()
+}
+
+// SetDefault replaces the default policy. It should be called during
+// initialization, before the SDK outputs anything.
+func SetDefault(policy *Policy) {
+	if policy == nil {
+		policy = &Policy{Fields: DefaultFields}
+	}
+	defaultPolicy.Store(policy)
+}
+
+// With returns a copy of the policy that also redacts fields. The copy of a
+// nil policy only redacts fields.
+func (p *Policy) With(fields ...string) *Policy {
+	copied := &Policy{}
+	if p != nil {
+		*copied = *p
+	}
+	copied.Fields = append(append([]string(nil), copied.Fields...), fields...)
+	return copied
+}
+
+// Matches reports whether the field at path, such as "individual.dob", is
+// redacted.
+func (p *Policy) Matches(path string) bool {
+	if p == nil {
+		return false
+	}
+	segments := strings.Split(path, ".")
+	for _, pattern := range p.Fields {
+		if matchSuffix(strings.Split(pattern, "."), segments) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSuffix(pattern []string, segments []string) bool {
+	if len(pattern) > len(segments) {
+		return false
+	}
+	offset := len(segments) - len(pattern)
+	for i, segment := range pattern {
+		if segment != "*" && segment != segments[offset+i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Value returns the replacement of a redacted value, either Masked or its
+// hash.
+func (p *Policy) Value(value string) string {
+	if p == nil || !p.Hash {
+		return Masked
+	}
+	var sum []byte
+	if len(p.HashKey) != 0 {
+		mac := hmac.New(sha256.New, p.HashKey)
+		mac.Write([]byte(value))
+		sum = mac.Sum(nil)
+	} else {
+		digest := sha256.Sum256([]byte(value))
+		sum = digest[:]
+	}
+	return "[SHA256:" + hex.EncodeToString(sum[:8]) + "]"
+}
+
+// Field returns value, or its replacement if the field at path is redacted.
+func (p *Policy) Field(path string, value string) string {
+	if !p.Matches(path) {
+		return value
+	}
+	return p.Value(value)
+}
+
+// JSON returns a copy of a JSON document with its redacted fields replaced,
+// keeping the order of its keys. A document that is not valid JSON is replaced
+// entirely with Masked, as a JSON string.
+func (p *Policy) JSON(body []byte) []byte {
+	if !gjson.ValidBytes(body) {
+		return []byte(strconv.Quote(Masked))
+	}
+	redacted := append([]byte(nil), body...)
+	var err error
+	p.walk(gjson.ParseBytes(body), nil, nil, func(path string, value gjson.Result) bool {
+		replacement := value.String()
+		if !value.IsObject() && !value.IsArray() && value.Type != gjson.String {
+			replacement = value.Raw
+		}
+		if err == nil {
+			redacted, err = sjson.SetBytes(redacted, path, p.Value(replacement))
+		}
+		return err == nil
+	})
+	if err != nil {
+		return []byte(strconv.Quote(Masked))
+	}
+	return redacted
+}
+
+// walk calls redact with the gjson path of every redacted field of value.
+func (p *Policy) walk(value gjson.Result, path []string, segments []string, redact func(path string, value gjson.Result) bool) bool {
+	if value.IsObject() || value.IsArray() {
+		i := 0
+		ok := true
+		value.ForEach(func(key, inner gjson.Result) bool {
+			segment, escaped := "#", strconv.Itoa(i)
+			if value.IsObject() {
+				segment, escaped = key.String(), escapePath(key.String())
+			}
+			i++
+			innerPath := append(path[:len(path):len(path)], escaped)
+			innerSegments := append(segments[:len(segments):len(segments)], segment)
+			if p.Matches(strings.Join(innerSegments, ".")) {
+				ok = redact(strings.Join(innerPath, "."), inner)
+			} else {
+				ok = p.walk(inner, innerPath, innerSegments, redact)
+			}
+			return ok
+		})
+		return ok
+	}
+	return true
+}
+
+// escapePath escapes the characters of an object key that have a meaning in
+// gjson and sjson paths.
+func escapePath(key string) string {
+	var b strings.Builder
+	for _, c := range key {
+		switch c {
+		case '.', '*', '?', '|', '#', '@', '\\', '!', '=', '<', '>', '%', ':':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// Decoded redacts a JSON value decoded by encoding/json into interface{}, in
+// place, and returns it.
+func (p *Policy) Decoded(value interface{}) interface{} {
+	return p.decoded(value, nil)
+}
+
+func (p *Policy) decoded(value interface{}, segments []string) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range value {
+			value[key] = p.decodedField(inner, append(segments[:len(segments):len(segments)], key))
+		}
+	case []interface{}:
+		for i, inner := range value {
+			value[i] = p.decodedField(inner, append(segments[:len(segments):len(segments)], "#"))
+		}
+	}
+	return value
+}
+
+func (p *Policy) decodedField(value interface{}, segments []string) interface{} {
+	if !p.Matches(strings.Join(segments, ".")) {
+		return p.decoded(value, segments)
+	}
+	if s, ok := value.(string); ok {
+		return p.Value(s)
+	}
+	raw, _ := json.Marshal(value)
+	return p.Value(string(raw))
+}
+
+// Header returns a copy of header with DefaultHeaders and the headers of the
+// policy redacted.
+func (p *Policy) Header(header http.Header) http.Header {
+	header = header.Clone()
+	keys := DefaultHeaders
+	if p != nil {
+		keys = append(append([]string(nil), keys...), p.Headers...)
+	}
+	for _, key := range keys {
+		if values := header.Values(key); len(values) != 0 {
+			redacted := make([]string, len(values))
+			for i, value := range values {
+				redacted[i] = p.Value(value)
+			}
+			header[http.CanonicalHeaderKey(key)] = redacted
+		}
+	}
+	return header
+}
+
+// URL returns a copy of u with its redacted query parameters replaced.
+func (p *Policy) URL(u *url.URL) *url.URL {
+	copied := *u
+	query := u.Query()
+	changed := false
+	for key, values := range query {
+		if !p.Matches(key) {
+			continue
+		}
+		for i, value := range values {
+			values[i] = p.Value(value)
+		}
+		changed = true
+	}
+	if changed {
+		copied.RawQuery = query.Encode()
+	}
+	return &copied
+}
@@ -0,0 +1,98 @@
+package redact
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestMatches(t *testing.T) {
+	policy := &Policy{Fields: []string{"pan", "individual.dob", "accounts.#.number", "address.*"}}
+	for path, expected := range map[string]bool{
+		"pan":                     true,
+		"card.pan":                true,
+		"individual.dob":          true,
+		"dob":                     false,
+		"business.dob":            false,
+		"accounts.#.number":       true,
+		"accounts.number":         false,
+		"shipping.address.city":   true,
+		"address":                 false,
+		"panda":                   false,
+		"individual.address.city": true,
+	} {
+		if policy.Matches(path) != expected {
+			t.Errorf("expected Matches(%q) to be %v", path, expected)
+		}
+	}
+	var none *Policy
+	if none.Matches("pan") {
+		t.Error("expected a nil policy to match nothing")
+	}
+}
+
+func TestJSONKeepsOrder(t *testing.T) {
+	policy := &Policy{Fields: []string{"pan", "accounts.#.number", "a\\.b"}}
+	body := `{"memo":"m","card":{"pan":"4111111289144142","cvv":"123"},"accounts":[{"number":1234},{"number":"5678"}],"a.b":"c"}`
+	redacted := string(policy.JSON([]byte(body)))
+	expected := `{"memo":"m","card":{"pan":"[REDACTED]","cvv":"123"},"accounts":[{"number":"[REDACTED]"},{"number":"[REDACTED]"}],"a.b":"c"}`
+	if redacted != expected {
+		t.Fatalf("expected %s, got %s", expected, redacted)
+	}
+	if string(policy.JSON([]byte("not json"))) != `"[REDACTED]"` {
+		t.Fatal("expected an invalid document to be masked entirely")
+	}
+}
+
+func TestHash(t *testing.T) {
+	policy := &Policy{Fields: []string{"pan"}, Hash: true, HashKey: []byte("key")}
+	first := string(policy.JSON([]byte(`{"pan":"4111111289144142"}`)))
+	second := string(policy.JSON([]byte(`{"card":{"pan":"4111111289144142"}}`)))
+	if strings.Contains(first, "4111") || !strings.Contains(first, "[SHA256:") {
+		t.Fatalf("expected a hashed value, got %s", first)
+	}
+	if !strings.Contains(second, first[len(`{"pan":`):len(first)-1]) {
+		t.Fatalf("expected equal values to hash equally, got %s and %s", first, second)
+	}
+	other := &Policy{Fields: []string{"pan"}, Hash: true, HashKey: []byte("other")}
+	if other.Value("4111111289144142") == policy.Value("4111111289144142") {
+		t.Fatal("expected the hash to depend on the key")
+	}
+}
+
+func TestDecoded(t *testing.T) {
+	var value interface{}
+	json.Unmarshal([]byte(`{"data":[{"cvv":"123","memo":"m"}],"pin":1234}`), &value)
+	contents, _ := json.Marshal((&Policy{Fields: []string{"cvv", "pin"}}).Decoded(value))
+	if string(contents) != `{"data":[{"cvv":"[REDACTED]","memo":"m"}],"pin":"[REDACTED]"}` {
+		t.Fatalf("unexpected redacted value %s", contents)
+	}
+}
+
+func TestHeaderAndURL(t *testing.T) {
+	policy := &Policy{Fields: []string{"account_token"}, Headers: []string{"X-Secret"}}
+	header := policy.Header(http.Header{"Authorization": {"key"}, "X-Secret": {"s"}, "X-Other": {"o"}})
+	if header.Get("Authorization") != Masked || header.Get("X-Secret") != Masked || header.Get("X-Other") != "o" {
+		t.Fatalf("unexpected redacted header %v", header)
+	}
+	u, _ := url.Parse("https://api.lithic.com/v1/cards?account_token=a&page_size=10")
+	if redacted := policy.URL(u).String(); redacted != "https://api.lithic.com/v1/cards?account_token=%5BREDACTED%5D&page_size=10" {
+		t.Fatalf("unexpected redacted URL %s", redacted)
+	}
+	if u.RawQuery != "account_token=a&page_size=10" {
+		t.Fatal("expected the original URL to be unchanged")
+	}
+}
+
+func TestSetDefault(t *testing.T) {
+	defer SetDefault(nil)
+	if Default().Field("pan", "4111111289144142") != Masked {
+		t.Fatal("expected the default policy to redact the PAN")
+	}
+	SetDefault(&Policy{Fields: []string{"memo"}})
+	if Default().Field("memo", "m") != Masked || Default().Field("pan", "p") != "p" {
+		t.Fatal("expected the default policy to be replaced")
+	}
+}
@@ -0,0 +1,12 @@
+package lithic
+
+import "github.com/lithic-com/lithic-go/options"
+
+// Region identifies the geographic deployment of the Lithic API. Select one with
+// options.WithRegion.
+type Region = options.Region
+
+const (
+	RegionUS = options.RegionUS
+	RegionEU = options.RegionEU
+)
@@ -1,17 +1,45 @@
 package requests
 
 import (
-	"fmt"
+	"errors"
+	"io"
 
-	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/core/form"
 	pjson "github.com/lithic-com/lithic-go/core/json"
 	"github.com/lithic-com/lithic-go/fields"
 )
 
-// 🚧 Undiscriminated unions are still being implemented.
-type AccountHolderNewParams struct{}
+// AccountHolderNewParams enrolls either a business (KYB), an individual (KYC) or
+// a KYC exempt individual. Exactly one of the applicants must be set.
+type AccountHolderNewParams struct {
+	KYB       *KYB
+	KYC       *KYC
+	KYCExempt *KYCExempt
+}
 
-func (r *AccountHolderNewParams) MarshalJSON() ([]byte, error) { return nil, nil }
+func (r *AccountHolderNewParams) MarshalJSON() (data []byte, err error) {
+	set := 0
+	for _, present := range []bool{r.KYB != nil, r.KYC != nil, r.KYCExempt != nil} {
+		if present {
+			set += 1
+		}
+	}
+	if set != 1 {
+		return nil, errors.New("exactly one of KYB, KYC or KYCExempt must be set")
+	}
+	switch {
+	case r.KYB != nil:
+		return pjson.Marshal(r.KYB)
+	case r.KYC != nil:
+		return pjson.Marshal(r.KYC)
+	default:
+		return pjson.Marshal(r.KYCExempt)
+	}
+}
+
+func (r AccountHolderNewParams) String() (result string) {
+	return format(r)
+}
 
 type KYB struct {
 	// Information for business for which the account is being opened and KYB is being
@@ -61,7 +89,7 @@ type KYB struct {
 }
 
 func (r KYB) String() (result string) {
-	return fmt.Sprintf("&KYB{BusinessEntity:%s BeneficialOwnerEntities:%s BeneficialOwnerIndividuals:%s ControlPerson:%s KYBPassedTimestamp:%s NatureOfBusiness:%s TosTimestamp:%s WebsiteURL:%s Workflow:%s}", r.BusinessEntity, core.Fmt(r.BeneficialOwnerEntities), core.Fmt(r.BeneficialOwnerIndividuals), r.ControlPerson, r.KYBPassedTimestamp, r.NatureOfBusiness, r.TosTimestamp, r.WebsiteURL, r.Workflow)
+	return format(r)
 }
 
 type BusinessEntity struct {
@@ -85,7 +113,7 @@ type BusinessEntity struct {
 }
 
 func (r BusinessEntity) String() (result string) {
-	return fmt.Sprintf("&BusinessEntity{Address:%s DbaBusinessName:%s GovernmentID:%s LegalBusinessName:%s ParentCompany:%s PhoneNumbers:%s}", r.Address, r.DbaBusinessName, r.GovernmentID, r.LegalBusinessName, r.ParentCompany, core.Fmt(r.PhoneNumbers))
+	return format(r)
 }
 
 type Individual struct {
@@ -111,7 +139,7 @@ type Individual struct {
 }
 
 func (r Individual) String() (result string) {
-	return fmt.Sprintf("&Individual{Address:%s Dob:%s Email:%s FirstName:%s GovernmentID:%s LastName:%s PhoneNumber:%s}", r.Address, r.Dob, r.Email, r.FirstName, r.GovernmentID, r.LastName, r.PhoneNumber)
+	return format(r)
 }
 
 type KYBWorkflow string
@@ -139,7 +167,7 @@ type KYC struct {
 }
 
 func (r KYC) String() (result string) {
-	return fmt.Sprintf("&KYC{Individual:%s KYCPassedTimestamp:%s TosTimestamp:%s Workflow:%s}", r.Individual, r.KYCPassedTimestamp, r.TosTimestamp, r.Workflow)
+	return format(r)
 }
 
 type KYCWorkflow string
@@ -173,7 +201,7 @@ type KYCExempt struct {
 }
 
 func (r KYCExempt) String() (result string) {
-	return fmt.Sprintf("&KYCExempt{Workflow:%s KYCExemptionType:%s FirstName:%s LastName:%s Email:%s PhoneNumber:%s BusinessAccountToken:%s Address:%s}", r.Workflow, r.KYCExemptionType, r.FirstName, r.LastName, r.Email, r.PhoneNumber, r.BusinessAccountToken, r.Address)
+	return format(r)
 }
 
 type KYCExemptWorkflow string
@@ -189,6 +217,9 @@ const (
 	KYCExemptKYCExemptionTypePrepaidCardUser KYCExemptKYCExemptionType = "PREPAID_CARD_USER"
 )
 
+// AccountHolderUpdateParams are the fields of an account holder to update.
+// Fields that are not set are omitted and left unchanged, and fields set to
+// fields.Null are sent as an explicit null, which clears them.
 type AccountHolderUpdateParams struct {
 	// Account holder's email address. The primary purpose of this field is for
 	// cardholder identification and verification during the digital wallet
@@ -202,17 +233,23 @@ type AccountHolderUpdateParams struct {
 	// users of businesses. Pass the account_token of the enrolled business associated
 	// with the AUTHORIZED_USER in this field.
 	BusinessAccountToken fields.Field[string] `json:"business_account_token"`
+	// If FieldMask is not nil, it must list exactly the fields that are set, by
+	// their JSON names, or the params fail to marshal.
+	FieldMask fields.FieldMask
 }
 
 // MarshalJSON serializes AccountHolderUpdateParams into an array of bytes using
 // the gjson library. Members of the `jsonFields` field are serialized into the
 // top-level, and will overwrite known members of the same name.
 func (r *AccountHolderUpdateParams) MarshalJSON() (data []byte, err error) {
+	if err := r.FieldMask.Verify(r); err != nil {
+		return nil, err
+	}
 	return pjson.MarshalRoot(r)
 }
 
 func (r AccountHolderUpdateParams) String() (result string) {
-	return fmt.Sprintf("&AccountHolderUpdateParams{Email:%s PhoneNumber:%s BusinessAccountToken:%s}", r.Email, r.PhoneNumber, r.BusinessAccountToken)
+	return format(r)
 }
 
 type AccountHolderNewWebhookParams struct {
@@ -228,7 +265,7 @@ func (r *AccountHolderNewWebhookParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r AccountHolderNewWebhookParams) String() (result string) {
-	return fmt.Sprintf("&AccountHolderNewWebhookParams{URL:%s}", r.URL)
+	return format(r)
 }
 
 type AccountHolderResubmitParams struct {
@@ -250,7 +287,7 @@ func (r *AccountHolderResubmitParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r AccountHolderResubmitParams) String() (result string) {
-	return fmt.Sprintf("&AccountHolderResubmitParams{Workflow:%s TosTimestamp:%s Individual:%s}", r.Workflow, r.TosTimestamp, r.Individual)
+	return format(r)
 }
 
 type AccountHolderResubmitParamsWorkflow string
@@ -267,6 +304,9 @@ type AccountHoldersGetDocumentParams struct {
 type AccountHolderUploadDocumentParams struct {
 	// Type of the document to upload.
 	DocumentType fields.Field[AccountHolderUploadDocumentParamsDocumentType] `json:"document_type,required"`
+	// Globally unique identifier for the entity of a business account holder,
+	// such as a beneficial owner, that the document is for. Only used for KYB.
+	EntityToken fields.Field[string] `json:"entity_token" format:"uuid"`
 }
 
 // MarshalJSON serializes AccountHolderUploadDocumentParams into an array of bytes
@@ -277,7 +317,7 @@ func (r *AccountHolderUploadDocumentParams) MarshalJSON() (data []byte, err erro
 }
 
 func (r AccountHolderUploadDocumentParams) String() (result string) {
-	return fmt.Sprintf("&AccountHolderUploadDocumentParams{DocumentType:%s}", r.DocumentType)
+	return format(r)
 }
 
 type AccountHolderUploadDocumentParamsDocumentType string
@@ -288,4 +328,37 @@ const (
 	AccountHolderUploadDocumentParamsDocumentTypePassport          AccountHolderUploadDocumentParamsDocumentType = "passport"
 	AccountHolderUploadDocumentParamsDocumentTypePassportCard      AccountHolderUploadDocumentParamsDocumentType = "passport_card"
 	AccountHolderUploadDocumentParamsDocumentTypeVisa              AccountHolderUploadDocumentParamsDocumentType = "visa"
+	// Documents of businesses and their entities, for KYB.
+	AccountHolderUploadDocumentParamsDocumentTypeEinLetter                 AccountHolderUploadDocumentParamsDocumentType = "EIN_LETTER"
+	AccountHolderUploadDocumentParamsDocumentTypeTaxReturn                 AccountHolderUploadDocumentParamsDocumentType = "TAX_RETURN"
+	AccountHolderUploadDocumentParamsDocumentTypeOperatingAgreement        AccountHolderUploadDocumentParamsDocumentType = "OPERATING_AGREEMENT"
+	AccountHolderUploadDocumentParamsDocumentTypeCertificateOfFormation    AccountHolderUploadDocumentParamsDocumentType = "CERTIFICATE_OF_FORMATION"
+	AccountHolderUploadDocumentParamsDocumentTypeCertificateOfGoodStanding AccountHolderUploadDocumentParamsDocumentType = "CERTIFICATE_OF_GOOD_STANDING"
+	AccountHolderUploadDocumentParamsDocumentTypeArticlesOfIncorporation   AccountHolderUploadDocumentParamsDocumentType = "ARTICLES_OF_INCORPORATION"
+	AccountHolderUploadDocumentParamsDocumentTypeArticlesOfOrganization    AccountHolderUploadDocumentParamsDocumentType = "ARTICLES_OF_ORGANIZATION"
+	AccountHolderUploadDocumentParamsDocumentTypeBylaws                    AccountHolderUploadDocumentParamsDocumentType = "BYLAWS"
+	AccountHolderUploadDocumentParamsDocumentTypeGovernmentBusinessLicense AccountHolderUploadDocumentParamsDocumentType = "GOVERNMENT_BUSINESS_LICENSE"
+	AccountHolderUploadDocumentParamsDocumentTypePartnershipAgreement      AccountHolderUploadDocumentParamsDocumentType = "PARTNERSHIP_AGREEMENT"
+	AccountHolderUploadDocumentParamsDocumentTypeSs4Form                   AccountHolderUploadDocumentParamsDocumentType = "SS4_FORM"
+	AccountHolderUploadDocumentParamsDocumentTypeBankStatement             AccountHolderUploadDocumentParamsDocumentType = "BANK_STATEMENT"
+	AccountHolderUploadDocumentParamsDocumentTypeUtilityBillStatement      AccountHolderUploadDocumentParamsDocumentType = "UTILITY_BILL_STATEMENT"
 )
+
+type AccountHolderUploadDocumentImageParams struct {
+	// Name of the image file, such as `front.jpg`, which is sent as the filename
+	// of the multipart part.
+	Filename fields.Field[string]
+	// Contents of the image, which must be a `jpg` or `png` file of less than
+	// 15 MiB.
+	Image io.Reader
+}
+
+// MarshalMultipart serializes AccountHolderUploadDocumentImageParams into a
+// multipart form with the image in its `file` part.
+func (r *AccountHolderUploadDocumentImageParams) MarshalMultipart() (data []byte, contentType string, err error) {
+	return form.Encode(nil, form.File{Field: "file", Name: r.Filename.Value, Reader: r.Image})
+}
+
+func (r AccountHolderUploadDocumentImageParams) String() (result string) {
+	return format(r)
+}
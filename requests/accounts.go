@@ -1,7 +1,6 @@
 package requests
 
 import (
-	"fmt"
 	"net/url"
 	"time"
 
@@ -10,6 +9,9 @@ import (
 	"github.com/lithic-com/lithic-go/fields"
 )
 
+// AccountUpdateParams are the fields of an account to update. Fields that are
+// not set are omitted and left unchanged, and fields set to fields.Null are
+// sent as an explicit null, which clears them.
 type AccountUpdateParams struct {
 	// Amount (in cents) for the account's new daily spend limit. Note that a spend
 	// limit of 0 is effectively no limit, and should only be used to reset or remove a
@@ -33,17 +35,23 @@ type AccountUpdateParams struct {
 	VerificationAddress fields.Field[AccountUpdateParamsVerificationAddress] `json:"verification_address"`
 	// Account states.
 	State fields.Field[AccountUpdateParamsState] `json:"state"`
+	// If FieldMask is not nil, it must list exactly the fields that are set, by
+	// their JSON names, or the params fail to marshal.
+	FieldMask fields.FieldMask
 }
 
 // MarshalJSON serializes AccountUpdateParams into an array of bytes using the
 // gjson library. Members of the `jsonFields` field are serialized into the
 // top-level, and will overwrite known members of the same name.
 func (r *AccountUpdateParams) MarshalJSON() (data []byte, err error) {
+	if err := r.FieldMask.Verify(r); err != nil {
+		return nil, err
+	}
 	return pjson.MarshalRoot(r)
 }
 
 func (r AccountUpdateParams) String() (result string) {
-	return fmt.Sprintf("&AccountUpdateParams{DailySpendLimit:%s LifetimeSpendLimit:%s MonthlySpendLimit:%s VerificationAddress:%s State:%s}", r.DailySpendLimit, r.LifetimeSpendLimit, r.MonthlySpendLimit, r.VerificationAddress, r.State)
+	return format(r)
 }
 
 type AccountUpdateParamsVerificationAddress struct {
@@ -56,7 +64,7 @@ type AccountUpdateParamsVerificationAddress struct {
 }
 
 func (r AccountUpdateParamsVerificationAddress) String() (result string) {
-	return fmt.Sprintf("&AccountUpdateParamsVerificationAddress{Address1:%s Address2:%s City:%s State:%s PostalCode:%s Country:%s}", r.Address1, r.Address2, r.City, r.State, r.PostalCode, r.Country)
+	return format(r)
 }
 
 type AccountUpdateParamsState string
@@ -86,5 +94,5 @@ func (r *AccountListParams) URLQuery() (v url.Values) {
 }
 
 func (r AccountListParams) String() (result string) {
-	return fmt.Sprintf("&AccountListParams{Begin:%s End:%s Page:%s PageSize:%s}", r.Begin, r.End, r.Page, r.PageSize)
+	return format(r)
 }
@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"net/url"
+
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+type AggregateBalanceListParams struct {
+	// Get the aggregate balance for a given Financial Account type.
+	FinancialAccountType fields.Field[AggregateBalanceListParamsFinancialAccountType] `query:"financial_account_type"`
+}
+
+// URLQuery serializes AggregateBalanceListParams into a url.Values of the query
+// parameters associated with this value
+func (r *AggregateBalanceListParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r AggregateBalanceListParams) String() (result string) {
+	return format(r)
+}
+
+type AggregateBalanceListParamsFinancialAccountType string
+
+const (
+	AggregateBalanceListParamsFinancialAccountTypeIssuing   AggregateBalanceListParamsFinancialAccountType = "ISSUING"
+	AggregateBalanceListParamsFinancialAccountTypeOperating AggregateBalanceListParamsFinancialAccountType = "OPERATING"
+	AggregateBalanceListParamsFinancialAccountTypeReserve   AggregateBalanceListParamsFinancialAccountType = "RESERVE"
+)
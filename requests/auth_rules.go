@@ -1,10 +1,8 @@
 package requests
 
 import (
-	"fmt"
 	"net/url"
 
-	"github.com/lithic-com/lithic-go/core"
 	pjson "github.com/lithic-com/lithic-go/core/json"
 	"github.com/lithic-com/lithic-go/core/query"
 	"github.com/lithic-com/lithic-go/fields"
@@ -50,15 +48,22 @@ func (r *AuthRuleRequest) MarshalJSON() (data []byte, err error) {
 }
 
 func (r AuthRuleRequest) String() (result string) {
-	return fmt.Sprintf("&AuthRuleRequest{AllowedMcc:%s BlockedMcc:%s AllowedCountries:%s BlockedCountries:%s AvsType:%s AccountTokens:%s CardTokens:%s ProgramLevel:%s}", core.Fmt(r.AllowedMcc), core.Fmt(r.BlockedMcc), core.Fmt(r.AllowedCountries), core.Fmt(r.BlockedCountries), r.AvsType, core.Fmt(r.AccountTokens), core.Fmt(r.CardTokens), r.ProgramLevel)
+	return format(r)
 }
 
+// AuthRuleNewParams are the parameters of AuthRuleService.New, named like the
+// parameters of every other create endpoint.
+type AuthRuleNewParams = AuthRuleRequest
+
 type AuthRuleRequestAvsType string
 
 const (
 	AuthRuleRequestAvsTypeZipOnly AuthRuleRequestAvsType = "ZIP_ONLY"
 )
 
+// AuthRuleUpdateParams are the fields of an auth rule to update. Fields that
+// are not set are omitted and left unchanged, and fields set to fields.Null are
+// sent as an explicit null, which clears them.
 type AuthRuleUpdateParams struct {
 	// Array of merchant category codes for which the Auth Rule will permit
 	// transactions. Note that only this field or `blocked_mcc` can be used for a given
@@ -78,17 +83,23 @@ type AuthRuleUpdateParams struct {
 	// Address verification to confirm that postal code entered at point of transaction
 	// (if applicable) matches the postal code on file for a given card.
 	AvsType fields.Field[AuthRuleUpdateParamsAvsType] `json:"avs_type"`
+	// If FieldMask is not nil, it must list exactly the fields that are set, by
+	// their JSON names, or the params fail to marshal.
+	FieldMask fields.FieldMask
 }
 
 // MarshalJSON serializes AuthRuleUpdateParams into an array of bytes using the
 // gjson library. Members of the `jsonFields` field are serialized into the
 // top-level, and will overwrite known members of the same name.
 func (r *AuthRuleUpdateParams) MarshalJSON() (data []byte, err error) {
+	if err := r.FieldMask.Verify(r); err != nil {
+		return nil, err
+	}
 	return pjson.MarshalRoot(r)
 }
 
 func (r AuthRuleUpdateParams) String() (result string) {
-	return fmt.Sprintf("&AuthRuleUpdateParams{AllowedMcc:%s BlockedMcc:%s AllowedCountries:%s BlockedCountries:%s AvsType:%s}", core.Fmt(r.AllowedMcc), core.Fmt(r.BlockedMcc), core.Fmt(r.AllowedCountries), core.Fmt(r.BlockedCountries), r.AvsType)
+	return format(r)
 }
 
 type AuthRuleUpdateParamsAvsType string
@@ -111,7 +122,7 @@ func (r *AuthRuleListParams) URLQuery() (v url.Values) {
 }
 
 func (r AuthRuleListParams) String() (result string) {
-	return fmt.Sprintf("&AuthRuleListParams{Page:%s PageSize:%s}", r.Page, r.PageSize)
+	return format(r)
 }
 
 type AuthRuleApplyParams struct {
@@ -134,7 +145,7 @@ func (r *AuthRuleApplyParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r AuthRuleApplyParams) String() (result string) {
-	return fmt.Sprintf("&AuthRuleApplyParams{CardTokens:%s AccountTokens:%s ProgramLevel:%s}", core.Fmt(r.CardTokens), core.Fmt(r.AccountTokens), r.ProgramLevel)
+	return format(r)
 }
 
 type AuthRuleRemoveParams struct {
@@ -157,5 +168,5 @@ func (r *AuthRuleRemoveParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r AuthRuleRemoveParams) String() (result string) {
-	return fmt.Sprintf("&AuthRuleRemoveParams{CardTokens:%s AccountTokens:%s ProgramLevel:%s}", core.Fmt(r.CardTokens), core.Fmt(r.AccountTokens), r.ProgramLevel)
+	return format(r)
 }
@@ -1,8 +1,6 @@
 package requests
 
 import (
-	"fmt"
-
 	pjson "github.com/lithic-com/lithic-go/core/json"
 	"github.com/lithic-com/lithic-go/fields"
 )
@@ -20,5 +18,5 @@ func (r *AuthStreamEnrollmentEnrollParams) MarshalJSON() (data []byte, err error
 }
 
 func (r AuthStreamEnrollmentEnrollParams) String() (result string) {
-	return fmt.Sprintf("&AuthStreamEnrollmentEnrollParams{WebhookURL:%s}", r.WebhookURL)
+	return format(r)
 }
@@ -0,0 +1,56 @@
+package requests
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+type BalanceListParams struct {
+	// List balances for all financial accounts of a given account_token.
+	AccountToken fields.Field[string] `query:"account_token" format:"uuid"`
+	// UTC date and time of the balances to retrieve. Defaults to latest available
+	// balances
+	BalanceDate fields.Field[time.Time] `query:"balance_date" format:"date-time"`
+	// List balances for a given Financial Account type.
+	FinancialAccountType fields.Field[BalanceListParamsFinancialAccountType] `query:"financial_account_type"`
+}
+
+// URLQuery serializes BalanceListParams into a url.Values of the query parameters
+// associated with this value
+func (r *BalanceListParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r BalanceListParams) String() (result string) {
+	return format(r)
+}
+
+type BalanceListParamsFinancialAccountType string
+
+const (
+	BalanceListParamsFinancialAccountTypeIssuing   BalanceListParamsFinancialAccountType = "ISSUING"
+	BalanceListParamsFinancialAccountTypeOperating BalanceListParamsFinancialAccountType = "OPERATING"
+	BalanceListParamsFinancialAccountTypeReserve   BalanceListParamsFinancialAccountType = "RESERVE"
+)
+
+type FinancialAccountBalanceListParams struct {
+	// UTC date of the balance to retrieve. Defaults to latest available balance
+	BalanceDate fields.Field[time.Time] `query:"balance_date" format:"date-time"`
+	// Balance after a given financial event occured. For example, passing the
+	// event_token of a $5 CARD_CLEARING financial event will return a balance
+	// decreased by $5
+	LastTransactionEventToken fields.Field[string] `query:"last_transaction_event_token" format:"uuid"`
+}
+
+// URLQuery serializes FinancialAccountBalanceListParams into a url.Values of the
+// query parameters associated with this value
+func (r *FinancialAccountBalanceListParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r FinancialAccountBalanceListParams) String() (result string) {
+	return format(r)
+}
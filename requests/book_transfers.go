@@ -0,0 +1,163 @@
+package requests
+
+import (
+	"net/url"
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+type BookTransferNewParams struct {
+	// Amount to be transferred in the currency’s smallest unit (e.g., cents for
+	// USD). This should always be a positive value.
+	Amount fields.Field[int64] `json:"amount,required"`
+	// Category of the book transfer.
+	Category fields.Field[BookTransferCategory] `json:"category,required"`
+	// Globally unique identifier for the financial account or card that will send
+	// the funds. Accepted type dependent on the program's use case.
+	FromFinancialAccountToken fields.Field[string] `json:"from_financial_account_token,required" format:"uuid"`
+	// Globally unique identifier for the financial account or card that will
+	// receive the funds. Accepted type dependent on the program's use case.
+	ToFinancialAccountToken fields.Field[string] `json:"to_financial_account_token,required" format:"uuid"`
+	// The program specific subtype code for the specified category/type.
+	Subtype fields.Field[string] `json:"subtype,required"`
+	// Type of the book transfer.
+	Type fields.Field[BookTransferType] `json:"type,required"`
+	// Customer-provided token that will serve as an idempotency token. This token
+	// will become the transaction token.
+	Token fields.Field[string] `json:"token" format:"uuid"`
+	// Optional descriptor for the transfer.
+	Memo fields.Field[string] `json:"memo"`
+}
+
+// MarshalJSON serializes BookTransferNewParams into an array of bytes using the
+// gjson library. Members of the `jsonFields` field are serialized into the
+// top-level, and will overwrite known members of the same name.
+func (r *BookTransferNewParams) MarshalJSON() (data []byte, err error) {
+	return pjson.MarshalRoot(r)
+}
+
+func (r BookTransferNewParams) String() (result string) {
+	return format(r)
+}
+
+type BookTransferCategory string
+
+const (
+	BookTransferCategoryAdjustment       BookTransferCategory = "ADJUSTMENT"
+	BookTransferCategoryBalanceOrFunding BookTransferCategory = "BALANCE_OR_FUNDING"
+	BookTransferCategoryDerecognition    BookTransferCategory = "DERECOGNITION"
+	BookTransferCategoryDispute          BookTransferCategory = "DISPUTE"
+	BookTransferCategoryFee              BookTransferCategory = "FEE"
+	BookTransferCategoryReward           BookTransferCategory = "REWARD"
+	BookTransferCategoryTransfer         BookTransferCategory = "TRANSFER"
+)
+
+type BookTransferType string
+
+const (
+	BookTransferTypeAtmWithdrawal              BookTransferType = "ATM_WITHDRAWAL"
+	BookTransferTypeAtmDecline                 BookTransferType = "ATM_DECLINE"
+	BookTransferTypeInternationalAtmWithdrawal BookTransferType = "INTERNATIONAL_ATM_WITHDRAWAL"
+	BookTransferTypeInactivity                 BookTransferType = "INACTIVITY"
+	BookTransferTypeStatement                  BookTransferType = "STATEMENT"
+	BookTransferTypeMonthly                    BookTransferType = "MONTHLY"
+	BookTransferTypeQuarterly                  BookTransferType = "QUARTERLY"
+	BookTransferTypeAnnual                     BookTransferType = "ANNUAL"
+	BookTransferTypeCustomerService            BookTransferType = "CUSTOMER_SERVICE"
+	BookTransferTypeAccountMaintenance         BookTransferType = "ACCOUNT_MAINTENANCE"
+	BookTransferTypeAccountActivation          BookTransferType = "ACCOUNT_ACTIVATION"
+	BookTransferTypeAccountClosure             BookTransferType = "ACCOUNT_CLOSURE"
+	BookTransferTypeCardReplacement            BookTransferType = "CARD_REPLACEMENT"
+	BookTransferTypeCardDelivery               BookTransferType = "CARD_DELIVERY"
+	BookTransferTypeCardCreate                 BookTransferType = "CARD_CREATE"
+	BookTransferTypeCurrencyConversion         BookTransferType = "CURRENCY_CONVERSION"
+	BookTransferTypeInterest                   BookTransferType = "INTEREST"
+	BookTransferTypeLatePayment                BookTransferType = "LATE_PAYMENT"
+	BookTransferTypeBillPayment                BookTransferType = "BILL_PAYMENT"
+	BookTransferTypeCashBack                   BookTransferType = "CASH_BACK"
+	BookTransferTypeAccountToAccount           BookTransferType = "ACCOUNT_TO_ACCOUNT"
+	BookTransferTypeCardToCard                 BookTransferType = "CARD_TO_CARD"
+	BookTransferTypeDisburse                   BookTransferType = "DISBURSE"
+	BookTransferTypeBillingError               BookTransferType = "BILLING_ERROR"
+	BookTransferTypeLossWriteOff               BookTransferType = "LOSS_WRITE_OFF"
+	BookTransferTypeExpiredCard                BookTransferType = "EXPIRED_CARD"
+	BookTransferTypeEarlyDerecognition         BookTransferType = "EARLY_DERECOGNITION"
+	BookTransferTypeEscheatment                BookTransferType = "ESCHEATMENT"
+	BookTransferTypeInactivityFeeDown          BookTransferType = "INACTIVITY_FEE_DOWN"
+	BookTransferTypeProvisionalCredit          BookTransferType = "PROVISIONAL_CREDIT"
+	BookTransferTypeDisputeWon                 BookTransferType = "DISPUTE_WON"
+	BookTransferTypeTransfer                   BookTransferType = "TRANSFER"
+)
+
+type BookTransferListParams struct {
+	// List book transfers of the given account.
+	AccountToken fields.Field[string] `query:"account_token" format:"uuid"`
+	// List book transfers of the given business account.
+	BusinessAccountToken fields.Field[string] `query:"business_account_token" format:"uuid"`
+	// List book transfers of the given category.
+	Category fields.Field[BookTransferCategory] `query:"category"`
+	// List book transfers to or from the given financial account.
+	FinancialAccountToken fields.Field[string] `query:"financial_account_token" format:"uuid"`
+	// List book transfers with the given result.
+	Result fields.Field[BookTransferListParamsResult] `query:"result"`
+	// List book transfers with the given status.
+	Status fields.Field[BookTransferListParamsStatus] `query:"status"`
+	// Date string in RFC 3339 format. Only entries created after the specified
+	// date will be included. UTC time zone.
+	Begin fields.Field[time.Time] `query:"begin" format:"date-time"`
+	// Date string in RFC 3339 format. Only entries created before the specified
+	// date will be included. UTC time zone.
+	End fields.Field[time.Time] `query:"end" format:"date-time"`
+	// Page size (for pagination).
+	PageSize fields.Field[int64] `query:"page_size"`
+	// The unique identifier of the last item in the previous page. Used to retrieve
+	// the next page.
+	StartingAfter fields.Field[string] `query:"starting_after"`
+	// The unique identifier of the first item in the previous page. Used to retrieve
+	// the previous page.
+	EndingBefore fields.Field[string] `query:"ending_before"`
+}
+
+// URLQuery serializes BookTransferListParams into a url.Values of the query
+// parameters associated with this value
+func (r *BookTransferListParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r BookTransferListParams) String() (result string) {
+	return format(r)
+}
+
+type BookTransferListParamsResult string
+
+const (
+	BookTransferListParamsResultApproved BookTransferListParamsResult = "APPROVED"
+	BookTransferListParamsResultDeclined BookTransferListParamsResult = "DECLINED"
+)
+
+type BookTransferListParamsStatus string
+
+const (
+	BookTransferListParamsStatusDeclined BookTransferListParamsStatus = "DECLINED"
+	BookTransferListParamsStatusReversed BookTransferListParamsStatus = "REVERSED"
+	BookTransferListParamsStatusSettled  BookTransferListParamsStatus = "SETTLED"
+)
+
+type BookTransferReverseParams struct {
+	// Optional descriptor for the reversal.
+	Memo fields.Field[string] `json:"memo"`
+}
+
+// MarshalJSON serializes BookTransferReverseParams into an array of bytes using
+// the gjson library. Members of the `jsonFields` field are serialized into the
+// top-level, and will overwrite known members of the same name.
+func (r *BookTransferReverseParams) MarshalJSON() (data []byte, err error) {
+	return pjson.MarshalRoot(r)
+}
+
+func (r BookTransferReverseParams) String() (result string) {
+	return format(r)
+}
@@ -0,0 +1,59 @@
+package requests
+
+import (
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+// CardTemplate holds the defaults shared by the cards a platform issues, such as
+// those issued for a single account or card program, so that they do not have to
+// be repeated at every call site. Use Apply to produce the CardNewParams for a
+// single card.
+type CardTemplate struct {
+	AccountToken        fields.Field[string]
+	CardProgramToken    fields.Field[string]
+	DigitalCardArtToken fields.Field[string]
+	FundingToken        fields.Field[string]
+	ProductID           fields.Field[string]
+	ShippingAddress     fields.Field[ShippingAddress]
+	ShippingMethod      fields.Field[CardNewParamsShippingMethod]
+	SpendLimit          fields.Field[int64]
+	SpendLimitDuration  fields.Field[SpendLimitDuration]
+	State               fields.Field[CardNewParamsState]
+	Type                fields.Field[CardNewParamsType]
+}
+
+// Apply returns the CardNewParams described by the template, with every field
+// that is present in overrides, including explicit nulls, taking precedence over
+// the template's default. Fields that the template has no default for, such as
+// the memo or expiry, are taken from overrides as is. overrides may be nil and is
+// not modified.
+func (r CardTemplate) Apply(overrides *CardNewParams) *CardNewParams {
+	params := CardNewParams{}
+	if overrides != nil {
+		params = *overrides
+	}
+	params.AccountToken = withDefault(params.AccountToken, r.AccountToken)
+	params.CardProgramToken = withDefault(params.CardProgramToken, r.CardProgramToken)
+	params.DigitalCardArtToken = withDefault(params.DigitalCardArtToken, r.DigitalCardArtToken)
+	params.FundingToken = withDefault(params.FundingToken, r.FundingToken)
+	params.ProductID = withDefault(params.ProductID, r.ProductID)
+	params.ShippingAddress = withDefault(params.ShippingAddress, r.ShippingAddress)
+	params.ShippingMethod = withDefault(params.ShippingMethod, r.ShippingMethod)
+	params.SpendLimit = withDefault(params.SpendLimit, r.SpendLimit)
+	params.SpendLimitDuration = withDefault(params.SpendLimitDuration, r.SpendLimitDuration)
+	params.State = withDefault(params.State, r.State)
+	params.Type = withDefault(params.Type, r.Type)
+	return &params
+}
+
+func (r CardTemplate) String() (result string) {
+	return format(r)
+}
+
+// withDefault returns value if it was set and fallback otherwise.
+func withDefault[T any](value fields.Field[T], fallback fields.Field[T]) fields.Field[T] {
+	if value.Present {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+func TestCardTemplateApply(t *testing.T) {
+	template := CardTemplate{
+		Type:               fields.F(CardNewParamsTypePhysical),
+		SpendLimit:         fields.F(int64(50000)),
+		SpendLimitDuration: fields.F(SpendLimitDurationMonthly),
+		ProductID:          fields.F("standard"),
+	}
+	overrides := &CardNewParams{
+		Memo:       fields.F("Jane's card"),
+		SpendLimit: fields.F(int64(100000)),
+		ProductID:  fields.NullField[string](),
+	}
+
+	params := template.Apply(overrides)
+	if params.Type.Value != CardNewParamsTypePhysical || params.SpendLimitDuration.Value != SpendLimitDurationMonthly {
+		t.Fatalf("expected the template's defaults, got %s", params)
+	}
+	if params.SpendLimit.Value != 100000 || params.Memo.Value != "Jane's card" || !params.ProductID.Null {
+		t.Fatalf("expected the overrides to take precedence, got %s", params)
+	}
+	if overrides.Type.Present {
+		t.Fatalf("expected the overrides not to be modified")
+	}
+	if params := template.Apply(nil); params.Type.Value != CardNewParamsTypePhysical {
+		t.Fatalf("expected nil overrides to produce the template, got %s", params)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	pjson "github.com/lithic-com/lithic-go/core/json"
 	"github.com/lithic-com/lithic-go/core/query"
 	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/redact"
 )
 
 type SpendLimitDuration string
@@ -50,7 +51,7 @@ func (r *EmbedRequestParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r EmbedRequestParams) String() (result string) {
-	return fmt.Sprintf("&EmbedRequestParams{Css:%s Expiration:%s Token:%s TargetOrigin:%s}", r.Css, r.Expiration, r.Token, r.TargetOrigin)
+	return format(r)
 }
 
 type CardNewParams struct {
@@ -150,7 +151,7 @@ func (r *CardNewParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r CardNewParams) String() (result string) {
-	return fmt.Sprintf("&CardNewParams{AccountToken:%s CardProgramToken:%s ExpMonth:%s ExpYear:%s FundingToken:%s Memo:%s SpendLimit:%s SpendLimitDuration:%s State:%s Type:%s Pin:%s DigitalCardArtToken:%s ProductID:%s ShippingAddress:%s ShippingMethod:%s}", r.AccountToken, r.CardProgramToken, r.ExpMonth, r.ExpYear, r.FundingToken, r.Memo, r.SpendLimit, r.SpendLimitDuration, r.State, r.Type, r.Pin, r.DigitalCardArtToken, r.ProductID, r.ShippingAddress, r.ShippingMethod)
+	return format(r)
 }
 
 type CardNewParamsState string
@@ -177,6 +178,9 @@ const (
 	CardNewParamsShippingMethodExpedited            CardNewParamsShippingMethod = "EXPEDITED"
 )
 
+// CardUpdateParams are the fields of a card to update. Fields that are not set
+// are omitted and left unchanged, and fields set to fields.Null are sent as an
+// explicit null, which clears them.
 type CardUpdateParams struct {
 	// The token for the desired `FundingAccount` to use when making transactions with
 	// this card.
@@ -222,17 +226,23 @@ type CardUpdateParams struct {
 	// by Lithic to use. See
 	// [Flexible Card Art Guide](https://docs.lithic.com/docs/about-digital-wallets#flexible-card-art).
 	DigitalCardArtToken fields.Field[string] `json:"digital_card_art_token" format:"uuid"`
+	// If FieldMask is not nil, it must list exactly the fields that are set, by
+	// their JSON names, or the params fail to marshal.
+	FieldMask fields.FieldMask
 }
 
 // MarshalJSON serializes CardUpdateParams into an array of bytes using the gjson
 // library. Members of the `jsonFields` field are serialized into the top-level,
 // and will overwrite known members of the same name.
 func (r *CardUpdateParams) MarshalJSON() (data []byte, err error) {
+	if err := r.FieldMask.Verify(r); err != nil {
+		return nil, err
+	}
 	return pjson.MarshalRoot(r)
 }
 
 func (r CardUpdateParams) String() (result string) {
-	return fmt.Sprintf("&CardUpdateParams{FundingToken:%s Memo:%s SpendLimit:%s SpendLimitDuration:%s AuthRuleToken:%s State:%s Pin:%s DigitalCardArtToken:%s}", r.FundingToken, r.Memo, r.SpendLimit, r.SpendLimitDuration, r.AuthRuleToken, r.State, r.Pin, r.DigitalCardArtToken)
+	return format(r)
 }
 
 type CardUpdateParamsState string
@@ -265,7 +275,7 @@ func (r *CardListParams) URLQuery() (v url.Values) {
 }
 
 func (r CardListParams) String() (result string) {
-	return fmt.Sprintf("&CardListParams{AccountToken:%s Begin:%s End:%s Page:%s PageSize:%s}", r.AccountToken, r.Begin, r.End, r.Page, r.PageSize)
+	return format(r)
 }
 
 type CardEmbedParams struct {
@@ -282,7 +292,7 @@ func (r *CardEmbedParams) URLQuery() (v url.Values) {
 }
 
 func (r CardEmbedParams) String() (result string) {
-	return fmt.Sprintf("&CardEmbedParams{EmbedRequest:%s Hmac:%s}", r.EmbedRequest, r.Hmac)
+	return format(r)
 }
 
 type CardProvisionParams struct {
@@ -308,7 +318,7 @@ func (r *CardProvisionParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r CardProvisionParams) String() (result string) {
-	return fmt.Sprintf("&CardProvisionParams{DigitalWallet:%s Nonce:%s NonceSignature:%s Certificate:%s}", r.DigitalWallet, r.Nonce, r.NonceSignature, r.Certificate)
+	return format(r)
 }
 
 type CardProvisionParamsDigitalWallet string
@@ -346,7 +356,7 @@ func (r *CardReissueParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r CardReissueParams) String() (result string) {
-	return fmt.Sprintf("&CardReissueParams{ShippingAddress:%s ShippingMethod:%s ProductID:%s}", r.ShippingAddress, r.ShippingMethod, r.ProductID)
+	return format(r)
 }
 
 type CardReissueParamsShippingMethod string
@@ -356,3 +366,21 @@ const (
 	CardReissueParamsShippingMethodStandardWithTracking CardReissueParamsShippingMethod = "STANDARD_WITH_TRACKING"
 	CardReissueParamsShippingMethodExpedited            CardReissueParamsShippingMethod = "EXPEDITED"
 )
+
+type CardSearchByPANParams struct {
+	// The PAN for the card being retrieved.
+	Pan fields.Field[string] `json:"pan,required"`
+}
+
+// MarshalJSON serializes CardSearchByPANParams into an array of bytes using the
+// gjson library. Members of the `jsonFields` field are serialized into the
+// top-level, and will overwrite known members of the same name.
+func (r *CardSearchByPANParams) MarshalJSON() (data []byte, err error) {
+	return pjson.MarshalRoot(r)
+}
+
+// String never includes the PAN, even if the default redaction policy does not
+// redact it.
+func (r CardSearchByPANParams) String() (result string) {
+	return fmt.Sprintf("&CardSearchByPANParams{Pan:%#v}", redact.Default().Value(r.Pan.Value))
+}
@@ -0,0 +1,74 @@
+package requests
+
+import (
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// CardUpdateParamsFromCard returns the params that update a card from its
+// current state to updated, usually a modified copy of current. Only the fields
+// that differ are set, so that fields the caller did not touch, such as the
+// memo, are never reset by sending stale values.
+func CardUpdateParamsFromCard(current responses.Card, updated responses.Card) *CardUpdateParams {
+	r := &CardUpdateParams{}
+	setIfChanged(&r.FundingToken, current.Funding.Token, updated.Funding.Token)
+	setIfChanged(&r.Memo, current.Memo, updated.Memo)
+	setIfChanged(&r.SpendLimit, current.SpendLimit, updated.SpendLimit)
+	setIfChanged(&r.SpendLimitDuration, SpendLimitDuration(current.SpendLimitDuration), SpendLimitDuration(updated.SpendLimitDuration))
+	setIfChanged(&r.State, CardUpdateParamsState(current.State), CardUpdateParamsState(updated.State))
+	setIfChanged(&r.DigitalCardArtToken, current.DigitalCardArtToken, updated.DigitalCardArtToken)
+	return r
+}
+
+// AccountUpdateParamsFromAccount returns the params that update an account from
+// its current state to updated, setting only the fields that differ. The
+// verification address is sent in full if any part of it changed.
+func AccountUpdateParamsFromAccount(current responses.Account, updated responses.Account) *AccountUpdateParams {
+	r := &AccountUpdateParams{}
+	setIfChanged(&r.DailySpendLimit, current.SpendLimit.Daily, updated.SpendLimit.Daily)
+	setIfChanged(&r.MonthlySpendLimit, current.SpendLimit.Monthly, updated.SpendLimit.Monthly)
+	setIfChanged(&r.LifetimeSpendLimit, current.SpendLimit.Lifetime, updated.SpendLimit.Lifetime)
+	setIfChanged(&r.State, AccountUpdateParamsState(current.State), AccountUpdateParamsState(updated.State))
+	before, after := current.VerificationAddress, updated.VerificationAddress
+	if before.Address1 != after.Address1 || before.Address2 != after.Address2 || before.City != after.City ||
+		before.State != after.State || before.PostalCode != after.PostalCode || before.Country != after.Country {
+		r.VerificationAddress = fields.F(AccountUpdateParamsVerificationAddress{
+			Address1:   fields.F(after.Address1),
+			Address2:   fields.F(after.Address2),
+			City:       fields.F(after.City),
+			State:      fields.F(after.State),
+			PostalCode: fields.F(after.PostalCode),
+			Country:    fields.F(after.Country),
+		})
+	}
+	return r
+}
+
+// AuthRuleUpdateParamsFromAuthRule returns the params that update an auth rule
+// from its current state to updated, setting only the fields that differ.
+// Lists are compared in order.
+func AuthRuleUpdateParamsFromAuthRule(current responses.AuthRule, updated responses.AuthRule) *AuthRuleUpdateParams {
+	r := &AuthRuleUpdateParams{}
+	setListIfChanged(&r.AllowedMcc, current.AllowedMcc, updated.AllowedMcc)
+	setListIfChanged(&r.BlockedMcc, current.BlockedMcc, updated.BlockedMcc)
+	setListIfChanged(&r.AllowedCountries, current.AllowedCountries, updated.AllowedCountries)
+	setListIfChanged(&r.BlockedCountries, current.BlockedCountries, updated.BlockedCountries)
+	setIfChanged(&r.AvsType, AuthRuleUpdateParamsAvsType(current.AvsType), AuthRuleUpdateParamsAvsType(updated.AvsType))
+	return r
+}
+
+func setIfChanged[T comparable](field *fields.Field[T], current T, updated T) {
+	if current != updated {
+		*field = fields.F(updated)
+	}
+}
+
+func setListIfChanged(field *fields.Field[[]string], current []string, updated []string) {
+	changed := len(current) != len(updated)
+	for i := 0; !changed && i < len(current); i += 1 {
+		changed = current[i] != updated[i]
+	}
+	if changed {
+		*field = fields.F(updated)
+	}
+}
@@ -0,0 +1,53 @@
+package requests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestCardUpdateParamsFromCard(t *testing.T) {
+	current := responses.Card{Token: "card_token", Memo: "Groceries", SpendLimit: 1000, SpendLimitDuration: "MONTHLY", State: "OPEN"}
+	updated := current
+	updated.SpendLimit = 2000
+	updated.State = "PAUSED"
+
+	params := CardUpdateParamsFromCard(current, updated)
+	data, err := params.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"spend_limit":2000,"state":"PAUSED"}` {
+		t.Fatalf("expected only the changed fields to be set, got %s", data)
+	}
+	if params := CardUpdateParamsFromCard(current, current); params.Memo.Present || params.State.Present {
+		t.Fatalf("expected no fields to be set, got %s", params)
+	}
+}
+
+func TestAuthRuleUpdateParamsFromAuthRule(t *testing.T) {
+	current := responses.AuthRule{AllowedMcc: []string{"5411"}, BlockedCountries: []string{"CAN"}}
+	updated := current
+	updated.AllowedMcc = []string{"5411", "5812"}
+
+	params := AuthRuleUpdateParamsFromAuthRule(current, updated)
+	if len(params.AllowedMcc.Value) != 2 || params.BlockedCountries.Present || params.AvsType.Present {
+		t.Fatalf("expected only the allowed MCCs to be set, got %s", params)
+	}
+}
+
+func TestUpdateParamsNull(t *testing.T) {
+	params := &CardUpdateParams{Memo: fields.Null[string](), State: fields.F(CardUpdateParamsStateOpen)}
+	data, err := params.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"memo":null,"state":"OPEN"}` {
+		t.Fatalf("expected the null memo to be sent and the unset fields to be omitted, got %s", data)
+	}
+	if s := params.String(); !strings.Contains(s, "Memo:null") {
+		t.Fatalf("expected the null memo to be printed, got %s", s)
+	}
+}
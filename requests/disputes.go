@@ -1,7 +1,6 @@
 package requests
 
 import (
-	"fmt"
 	"net/url"
 	"time"
 
@@ -31,7 +30,7 @@ func (r *DisputeNewParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r DisputeNewParams) String() (result string) {
-	return fmt.Sprintf("&DisputeNewParams{Amount:%s CustomerFiledDate:%s Reason:%s TransactionToken:%s CustomerNote:%s}", r.Amount, r.CustomerFiledDate, r.Reason, r.TransactionToken, r.CustomerNote)
+	return format(r)
 }
 
 type DisputeNewParamsReason string
@@ -53,6 +52,9 @@ const (
 	DisputeNewParamsReasonRecurringTransactionNotCancelled DisputeNewParamsReason = "RECURRING_TRANSACTION_NOT_CANCELLED"
 )
 
+// DisputeUpdateParams are the fields of a dispute to update. Fields that are
+// not set are omitted and left unchanged, and fields set to fields.Null are
+// sent as an explicit null, which clears them.
 type DisputeUpdateParams struct {
 	// Amount to dispute
 	Amount fields.Field[int64] `json:"amount"`
@@ -62,17 +64,23 @@ type DisputeUpdateParams struct {
 	CustomerNote fields.Field[string] `json:"customer_note"`
 	// Reason for dispute
 	Reason fields.Field[DisputeUpdateParamsReason] `json:"reason"`
+	// If FieldMask is not nil, it must list exactly the fields that are set, by
+	// their JSON names, or the params fail to marshal.
+	FieldMask fields.FieldMask
 }
 
 // MarshalJSON serializes DisputeUpdateParams into an array of bytes using the
 // gjson library. Members of the `jsonFields` field are serialized into the
 // top-level, and will overwrite known members of the same name.
 func (r *DisputeUpdateParams) MarshalJSON() (data []byte, err error) {
+	if err := r.FieldMask.Verify(r); err != nil {
+		return nil, err
+	}
 	return pjson.MarshalRoot(r)
 }
 
 func (r DisputeUpdateParams) String() (result string) {
-	return fmt.Sprintf("&DisputeUpdateParams{Amount:%s CustomerFiledDate:%s CustomerNote:%s Reason:%s}", r.Amount, r.CustomerFiledDate, r.CustomerNote, r.Reason)
+	return format(r)
 }
 
 type DisputeUpdateParamsReason string
@@ -122,7 +130,7 @@ func (r *DisputeListParams) URLQuery() (v url.Values) {
 }
 
 func (r DisputeListParams) String() (result string) {
-	return fmt.Sprintf("&DisputeListParams{TransactionToken:%s Status:%s PageSize:%s Begin:%s End:%s StartingAfter:%s EndingBefore:%s}", r.TransactionToken, r.Status, r.PageSize, r.Begin, r.End, r.StartingAfter, r.EndingBefore)
+	return format(r)
 }
 
 type DisputeListParamsStatus string
@@ -168,7 +176,7 @@ func (r *DisputeListEvidencesParams) URLQuery() (v url.Values) {
 }
 
 func (r DisputeListEvidencesParams) String() (result string) {
-	return fmt.Sprintf("&DisputeListEvidencesParams{PageSize:%s Begin:%s End:%s StartingAfter:%s EndingBefore:%s}", r.PageSize, r.Begin, r.End, r.StartingAfter, r.EndingBefore)
+	return format(r)
 }
 
 type DisputesGetEvidenceParams struct {
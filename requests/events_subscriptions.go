@@ -1,11 +1,9 @@
 package requests
 
 import (
-	"fmt"
 	"net/url"
 	"time"
 
-	"github.com/lithic-com/lithic-go/core"
 	pjson "github.com/lithic-com/lithic-go/core/json"
 	"github.com/lithic-com/lithic-go/core/query"
 	"github.com/lithic-com/lithic-go/fields"
@@ -31,7 +29,7 @@ func (r *SubscriptionNewParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r SubscriptionNewParams) String() (result string) {
-	return fmt.Sprintf("&SubscriptionNewParams{Description:%s Disabled:%s EventTypes:%s URL:%s}", r.Description, r.Disabled, core.Fmt(r.EventTypes), r.URL)
+	return format(r)
 }
 
 type SubscriptionNewParamsEventTypes string
@@ -41,6 +39,9 @@ const (
 	SubscriptionNewParamsEventTypesDigitalWalletTokenizationApprovalRequest SubscriptionNewParamsEventTypes = "digital_wallet.tokenization_approval_request"
 )
 
+// SubscriptionUpdateParams are the fields of an event subscription to update.
+// Fields that are not set are omitted and left unchanged, and fields set to
+// fields.Null are sent as an explicit null, which clears them.
 type SubscriptionUpdateParams struct {
 	// Event subscription description.
 	Description fields.Field[string] `json:"description"`
@@ -51,17 +52,23 @@ type SubscriptionUpdateParams struct {
 	EventTypes fields.Field[[]SubscriptionUpdateParamsEventTypes] `json:"event_types"`
 	// URL to which event webhooks will be sent. URL must be a valid HTTPS address.
 	URL fields.Field[string] `json:"url,required" format:"uri"`
+	// If FieldMask is not nil, it must list exactly the fields that are set, by
+	// their JSON names, or the params fail to marshal.
+	FieldMask fields.FieldMask
 }
 
 // MarshalJSON serializes SubscriptionUpdateParams into an array of bytes using the
 // gjson library. Members of the `jsonFields` field are serialized into the
 // top-level, and will overwrite known members of the same name.
 func (r *SubscriptionUpdateParams) MarshalJSON() (data []byte, err error) {
+	if err := r.FieldMask.Verify(r); err != nil {
+		return nil, err
+	}
 	return pjson.MarshalRoot(r)
 }
 
 func (r SubscriptionUpdateParams) String() (result string) {
-	return fmt.Sprintf("&SubscriptionUpdateParams{Description:%s Disabled:%s EventTypes:%s URL:%s}", r.Description, r.Disabled, core.Fmt(r.EventTypes), r.URL)
+	return format(r)
 }
 
 type SubscriptionUpdateParamsEventTypes string
@@ -89,7 +96,7 @@ func (r *SubscriptionListParams) URLQuery() (v url.Values) {
 }
 
 func (r SubscriptionListParams) String() (result string) {
-	return fmt.Sprintf("&SubscriptionListParams{PageSize:%s StartingAfter:%s EndingBefore:%s}", r.PageSize, r.StartingAfter, r.EndingBefore)
+	return format(r)
 }
 
 type SubscriptionRecoverParams struct {
@@ -108,7 +115,7 @@ func (r *SubscriptionRecoverParams) URLQuery() (v url.Values) {
 }
 
 func (r SubscriptionRecoverParams) String() (result string) {
-	return fmt.Sprintf("&SubscriptionRecoverParams{Begin:%s End:%s}", r.Begin, r.End)
+	return format(r)
 }
 
 type SubscriptionReplayMissingParams struct {
@@ -127,5 +134,42 @@ func (r *SubscriptionReplayMissingParams) URLQuery() (v url.Values) {
 }
 
 func (r SubscriptionReplayMissingParams) String() (result string) {
-	return fmt.Sprintf("&SubscriptionReplayMissingParams{Begin:%s End:%s}", r.Begin, r.End)
+	return format(r)
 }
+
+type SubscriptionListAttemptsParams struct {
+	// Date string in RFC 3339 format. Only entries created after the specified date
+	// will be included. UTC time zone.
+	Begin fields.Field[time.Time] `query:"begin" format:"date-time"`
+	// Date string in RFC 3339 format. Only entries created before the specified date
+	// will be included. UTC time zone.
+	End fields.Field[time.Time] `query:"end" format:"date-time"`
+	// Page size (for pagination).
+	PageSize fields.Field[int64] `query:"page_size"`
+	// The unique identifier of the last item in the previous page. Used to retrieve
+	// the next page.
+	StartingAfter fields.Field[string] `query:"starting_after"`
+	// The unique identifier of the first item in the previous page. Used to retrieve
+	// the previous page.
+	EndingBefore fields.Field[string]                               `query:"ending_before"`
+	Status       fields.Field[SubscriptionListAttemptsParamsStatus] `query:"status"`
+}
+
+// URLQuery serializes SubscriptionListAttemptsParams into a url.Values of the
+// query parameters associated with this value
+func (r *SubscriptionListAttemptsParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r SubscriptionListAttemptsParams) String() (result string) {
+	return format(r)
+}
+
+type SubscriptionListAttemptsParamsStatus string
+
+const (
+	SubscriptionListAttemptsParamsStatusFailed  SubscriptionListAttemptsParamsStatus = "FAILED"
+	SubscriptionListAttemptsParamsStatusPending SubscriptionListAttemptsParamsStatus = "PENDING"
+	SubscriptionListAttemptsParamsStatusSending SubscriptionListAttemptsParamsStatus = "SENDING"
+	SubscriptionListAttemptsParamsStatusSuccess SubscriptionListAttemptsParamsStatus = "SUCCESS"
+)
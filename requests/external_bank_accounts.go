@@ -0,0 +1,202 @@
+package requests
+
+import (
+	"net/url"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+type ExternalBankAccountNewParams struct {
+	// Verification method for the account:
+	//
+	//   - `MANUAL` - The account is verified by the program.
+	//   - `MICRO_DEPOSIT` - Lithic sends two micro-deposits to the account, whose
+	//     amounts must be confirmed with
+	//     ExternalBankAccountMicroDepositService.New.
+	//   - `PRENOTE` - Lithic sends a zero dollar prenotification to the account.
+	VerificationMethod fields.Field[ExternalBankAccountVerificationMethod] `json:"verification_method,required"`
+	// Owner type of the account.
+	OwnerType fields.Field[ExternalBankAccountOwnerType] `json:"owner_type,required"`
+	// Legal name of the entity that owns the account, which is used to verify it.
+	Owner fields.Field[string] `json:"owner,required"`
+	// Account type of the account.
+	Type fields.Field[ExternalBankAccountType] `json:"type,required"`
+	// The account number of the bank account.
+	AccountNumber fields.Field[string] `json:"account_number,required"`
+	// The routing number of the bank account.
+	RoutingNumber fields.Field[string] `json:"routing_number,required"`
+	// The country that the bank account is located in, as an ISO 3166-1 alpha-3
+	// code, such as `USA`.
+	Country fields.Field[string] `json:"country,required"`
+	// The currency of the bank account, as an ISO 4217 code, such as `USD`.
+	Currency fields.Field[string] `json:"currency,required"`
+	// The financial account that micro-deposits and prenotes are sent from.
+	// Required for `MICRO_DEPOSIT` and `PRENOTE`.
+	FinancialAccountToken fields.Field[string] `json:"financial_account_token" format:"uuid"`
+	// Indicates which Lithic account the external account is associated with.
+	AccountToken fields.Field[string] `json:"account_token" format:"uuid"`
+	// Address of the owner of the account.
+	Address fields.Field[Address] `json:"address"`
+	// Optional field that helps identify bank accounts in receipts.
+	CompanyID fields.Field[string] `json:"company_id"`
+	// Date of birth of the owner, as `YYYY-MM-DD`, for individual owners.
+	Dob fields.Field[string] `json:"dob" format:"date"`
+	// Doing business as, for business owners.
+	DoingBusinessAs fields.Field[string] `json:"doing_business_as"`
+	// The nickname given to the account.
+	Name fields.Field[string] `json:"name"`
+	// User defined identifier of the account.
+	UserDefinedID fields.Field[string] `json:"user_defined_id"`
+}
+
+// MarshalJSON serializes ExternalBankAccountNewParams into an array of bytes
+// using the gjson library. Members of the `jsonFields` field are serialized into
+// the top-level, and will overwrite known members of the same name.
+func (r *ExternalBankAccountNewParams) MarshalJSON() (data []byte, err error) {
+	return pjson.MarshalRoot(r)
+}
+
+func (r ExternalBankAccountNewParams) String() (result string) {
+	return format(r)
+}
+
+type ExternalBankAccountVerificationMethod string
+
+const (
+	ExternalBankAccountVerificationMethodManual       ExternalBankAccountVerificationMethod = "MANUAL"
+	ExternalBankAccountVerificationMethodMicroDeposit ExternalBankAccountVerificationMethod = "MICRO_DEPOSIT"
+	ExternalBankAccountVerificationMethodPrenote      ExternalBankAccountVerificationMethod = "PRENOTE"
+)
+
+type ExternalBankAccountOwnerType string
+
+const (
+	ExternalBankAccountOwnerTypeBusiness   ExternalBankAccountOwnerType = "BUSINESS"
+	ExternalBankAccountOwnerTypeIndividual ExternalBankAccountOwnerType = "INDIVIDUAL"
+)
+
+type ExternalBankAccountType string
+
+const (
+	ExternalBankAccountTypeChecking ExternalBankAccountType = "CHECKING"
+	ExternalBankAccountTypeSavings  ExternalBankAccountType = "SAVINGS"
+)
+
+// ExternalBankAccountUpdateParams are the fields of an external bank account to
+// update. Fields that are not set are omitted and left unchanged, and fields
+// set to fields.Null are sent as an explicit null, which clears them.
+type ExternalBankAccountUpdateParams struct {
+	// Legal name of the entity that owns the account.
+	Owner fields.Field[string] `json:"owner"`
+	// Owner type of the account.
+	OwnerType fields.Field[ExternalBankAccountOwnerType] `json:"owner_type"`
+	// Address of the owner of the account.
+	Address fields.Field[Address] `json:"address"`
+	// Optional field that helps identify bank accounts in receipts.
+	CompanyID fields.Field[string] `json:"company_id"`
+	// Date of birth of the owner, as `YYYY-MM-DD`, for individual owners.
+	Dob fields.Field[string] `json:"dob" format:"date"`
+	// Doing business as, for business owners.
+	DoingBusinessAs fields.Field[string] `json:"doing_business_as"`
+	// The nickname given to the account.
+	Name fields.Field[string] `json:"name"`
+	// User defined identifier of the account.
+	UserDefinedID fields.Field[string] `json:"user_defined_id"`
+}
+
+// MarshalJSON serializes ExternalBankAccountUpdateParams into an array of bytes
+// using the gjson library. Members of the `jsonFields` field are serialized into
+// the top-level, and will overwrite known members of the same name.
+func (r *ExternalBankAccountUpdateParams) MarshalJSON() (data []byte, err error) {
+	return pjson.MarshalRoot(r)
+}
+
+func (r ExternalBankAccountUpdateParams) String() (result string) {
+	return format(r)
+}
+
+type ExternalBankAccountListParams struct {
+	// List external bank accounts for a given account_token
+	AccountToken fields.Field[string] `query:"account_token" format:"uuid"`
+	// List external bank accounts of the given account types
+	AccountTypes fields.Field[[]ExternalBankAccountType] `query:"account_types[]"`
+	// List external bank accounts in the given countries
+	Countries fields.Field[[]string] `query:"countries[]"`
+	// List external bank accounts of the given owner types
+	OwnerTypes fields.Field[[]ExternalBankAccountOwnerType] `query:"owner_types[]"`
+	// List external bank accounts in the given states
+	States fields.Field[[]ExternalBankAccountListParamsState] `query:"states[]"`
+	// List external bank accounts in the given verification states
+	VerificationStates fields.Field[[]ExternalBankAccountListParamsVerificationState] `query:"verification_states[]"`
+	// Page size (for pagination).
+	PageSize fields.Field[int64] `query:"page_size"`
+	// The unique identifier of the last item in the previous page. Used to retrieve
+	// the next page.
+	StartingAfter fields.Field[string] `query:"starting_after"`
+	// The unique identifier of the first item in the previous page. Used to retrieve
+	// the previous page.
+	EndingBefore fields.Field[string] `query:"ending_before"`
+}
+
+// URLQuery serializes ExternalBankAccountListParams into a url.Values of the
+// query parameters associated with this value
+func (r *ExternalBankAccountListParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r ExternalBankAccountListParams) String() (result string) {
+	return format(r)
+}
+
+type ExternalBankAccountListParamsState string
+
+const (
+	ExternalBankAccountListParamsStateEnabled ExternalBankAccountListParamsState = "ENABLED"
+	ExternalBankAccountListParamsStateClosed  ExternalBankAccountListParamsState = "CLOSED"
+	ExternalBankAccountListParamsStatePaused  ExternalBankAccountListParamsState = "PAUSED"
+)
+
+type ExternalBankAccountListParamsVerificationState string
+
+const (
+	ExternalBankAccountListParamsVerificationStatePending            ExternalBankAccountListParamsVerificationState = "PENDING"
+	ExternalBankAccountListParamsVerificationStateEnabled            ExternalBankAccountListParamsVerificationState = "ENABLED"
+	ExternalBankAccountListParamsVerificationStateFailedVerification ExternalBankAccountListParamsVerificationState = "FAILED_VERIFICATION"
+)
+
+type ExternalBankAccountMicroDepositNewParams struct {
+	// The amounts (in cents) of the two micro-deposits received in the account.
+	MicroDeposits fields.Field[[]int64] `json:"micro_deposits,required"`
+}
+
+// MarshalJSON serializes ExternalBankAccountMicroDepositNewParams into an array
+// of bytes using the gjson library. Members of the `jsonFields` field are
+// serialized into the top-level, and will overwrite known members of the same
+// name.
+func (r *ExternalBankAccountMicroDepositNewParams) MarshalJSON() (data []byte, err error) {
+	return pjson.MarshalRoot(r)
+}
+
+func (r ExternalBankAccountMicroDepositNewParams) String() (result string) {
+	return format(r)
+}
+
+type ExternalBankAccountRetryMicroDepositsParams struct {
+	// The financial account that the new micro-deposits are sent from. Defaults
+	// to the financial account of the previous attempt.
+	FinancialAccountToken fields.Field[string] `json:"financial_account_token" format:"uuid"`
+}
+
+// MarshalJSON serializes ExternalBankAccountRetryMicroDepositsParams into an
+// array of bytes using the gjson library. Members of the `jsonFields` field are
+// serialized into the top-level, and will overwrite known members of the same
+// name.
+func (r *ExternalBankAccountRetryMicroDepositsParams) MarshalJSON() (data []byte, err error) {
+	return pjson.MarshalRoot(r)
+}
+
+func (r ExternalBankAccountRetryMicroDepositsParams) String() (result string) {
+	return format(r)
+}
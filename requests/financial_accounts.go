@@ -0,0 +1,92 @@
+package requests
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+type FinancialAccountListParams struct {
+	// List financial accounts for a given account_token
+	AccountToken fields.Field[string] `query:"account_token" format:"uuid"`
+	// List financial accounts for a given business_account_token
+	BusinessAccountToken fields.Field[string] `query:"business_account_token" format:"uuid"`
+	// List financial accounts of a given type
+	Type fields.Field[FinancialAccountListParamsType] `query:"type"`
+}
+
+// URLQuery serializes FinancialAccountListParams into a url.Values of the query
+// parameters associated with this value
+func (r *FinancialAccountListParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r FinancialAccountListParams) String() (result string) {
+	return format(r)
+}
+
+type FinancialAccountListParamsType string
+
+const (
+	FinancialAccountListParamsTypeIssuing   FinancialAccountListParamsType = "ISSUING"
+	FinancialAccountListParamsTypeOperating FinancialAccountListParamsType = "OPERATING"
+	FinancialAccountListParamsTypeReserve   FinancialAccountListParamsType = "RESERVE"
+)
+
+type FinancialTransactionListParams struct {
+	// Date string in RFC 3339 format. Only entries created after the specified date
+	// will be included. UTC time zone.
+	Begin fields.Field[time.Time] `query:"begin" format:"date-time"`
+	// Financial Transaction category to be returned.
+	Category fields.Field[FinancialTransactionListParamsCategory] `query:"category"`
+	// Date string in RFC 3339 format. Only entries created before the specified date
+	// will be included. UTC time zone.
+	End fields.Field[time.Time] `query:"end" format:"date-time"`
+	// The unique identifier of the first item in the previous page. Used to retrieve
+	// the previous page.
+	EndingBefore fields.Field[string] `query:"ending_before"`
+	// Financial Transaction result to be returned.
+	Result fields.Field[FinancialTransactionListParamsResult] `query:"result"`
+	// The unique identifier of the last item in the previous page. Used to retrieve
+	// the next page.
+	StartingAfter fields.Field[string] `query:"starting_after"`
+	// Financial Transaction status to be returned.
+	Status fields.Field[FinancialTransactionListParamsStatus] `query:"status"`
+}
+
+// URLQuery serializes FinancialTransactionListParams into a url.Values of the
+// query parameters associated with this value
+func (r *FinancialTransactionListParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r FinancialTransactionListParams) String() (result string) {
+	return format(r)
+}
+
+type FinancialTransactionListParamsCategory string
+
+const (
+	FinancialTransactionListParamsCategoryACH      FinancialTransactionListParamsCategory = "ACH"
+	FinancialTransactionListParamsCategoryCard     FinancialTransactionListParamsCategory = "CARD"
+	FinancialTransactionListParamsCategoryTransfer FinancialTransactionListParamsCategory = "TRANSFER"
+)
+
+type FinancialTransactionListParamsResult string
+
+const (
+	FinancialTransactionListParamsResultApproved FinancialTransactionListParamsResult = "APPROVED"
+	FinancialTransactionListParamsResultDeclined FinancialTransactionListParamsResult = "DECLINED"
+)
+
+type FinancialTransactionListParamsStatus string
+
+const (
+	FinancialTransactionListParamsStatusDeclined FinancialTransactionListParamsStatus = "DECLINED"
+	FinancialTransactionListParamsStatusExpired  FinancialTransactionListParamsStatus = "EXPIRED"
+	FinancialTransactionListParamsStatusPending  FinancialTransactionListParamsStatus = "PENDING"
+	FinancialTransactionListParamsStatusSettled  FinancialTransactionListParamsStatus = "SETTLED"
+	FinancialTransactionListParamsStatusVoided   FinancialTransactionListParamsStatus = "VOIDED"
+)
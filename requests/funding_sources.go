@@ -2,10 +2,8 @@ package requests
 
 import (
 	"errors"
-	"fmt"
 	"net/url"
 
-	"github.com/lithic-com/lithic-go/core"
 	pjson "github.com/lithic-com/lithic-go/core/json"
 	"github.com/lithic-com/lithic-go/core/query"
 	"github.com/lithic-com/lithic-go/fields"
@@ -53,7 +51,7 @@ type Bank struct {
 }
 
 func (r Bank) String() (result string) {
-	return fmt.Sprintf("&Bank{ValidationMethod:%s AccountName:%s AccountNumber:%s AccountToken:%s RoutingNumber:%s}", r.ValidationMethod, r.AccountName, r.AccountNumber, r.AccountToken, r.RoutingNumber)
+	return format(r)
 }
 
 type BankValidationMethod string
@@ -74,7 +72,7 @@ type Plaid struct {
 }
 
 func (r Plaid) String() (result string) {
-	return fmt.Sprintf("&Plaid{ValidationMethod:%s AccountToken:%s ProcessorToken:%s}", r.ValidationMethod, r.AccountToken, r.ProcessorToken)
+	return format(r)
 }
 
 type PlaidValidationMethod string
@@ -83,6 +81,9 @@ const (
 	PlaidValidationMethodPlaid PlaidValidationMethod = "PLAID"
 )
 
+// FundingSourceUpdateParams are the fields of a funding source to update.
+// Fields that are not set are omitted and left unchanged, and fields set to
+// fields.Null are sent as an explicit null, which clears them.
 type FundingSourceUpdateParams struct {
 	// Only required for multi-account users. Token identifying the account that the
 	// bank account will be associated with. Only applicable if using account holder
@@ -97,17 +98,23 @@ type FundingSourceUpdateParams struct {
 	// `ENABLED` on the account, authorizations will not be accepted on the card until
 	// a new funding account is added.
 	State fields.Field[FundingSourceUpdateParamsState] `json:"state"`
+	// If FieldMask is not nil, it must list exactly the fields that are set, by
+	// their JSON names, or the params fail to marshal.
+	FieldMask fields.FieldMask
 }
 
 // MarshalJSON serializes FundingSourceUpdateParams into an array of bytes using
 // the gjson library. Members of the `jsonFields` field are serialized into the
 // top-level, and will overwrite known members of the same name.
 func (r *FundingSourceUpdateParams) MarshalJSON() (data []byte, err error) {
+	if err := r.FieldMask.Verify(r); err != nil {
+		return nil, err
+	}
 	return pjson.MarshalRoot(r)
 }
 
 func (r FundingSourceUpdateParams) String() (result string) {
-	return fmt.Sprintf("&FundingSourceUpdateParams{AccountToken:%s State:%s}", r.AccountToken, r.State)
+	return format(r)
 }
 
 type FundingSourceUpdateParamsState string
@@ -132,7 +139,7 @@ func (r *FundingSourceListParams) URLQuery() (v url.Values) {
 }
 
 func (r FundingSourceListParams) String() (result string) {
-	return fmt.Sprintf("&FundingSourceListParams{AccountToken:%s Page:%s PageSize:%s}", r.AccountToken, r.Page, r.PageSize)
+	return format(r)
 }
 
 type FundingSourceVerifyParams struct {
@@ -154,5 +161,5 @@ func (r *FundingSourceVerifyParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r FundingSourceVerifyParams) String() (result string) {
-	return fmt.Sprintf("&FundingSourceVerifyParams{AccountToken:%s MicroDeposits:%s}", r.AccountToken, core.Fmt(r.MicroDeposits))
+	return format(r)
 }
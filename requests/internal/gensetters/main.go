@@ -0,0 +1,132 @@
+// Command gensetters generates builder-style setters for the request types of
+// the requests package. It is run with `go generate ./requests`.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const output = "setters.go"
+
+type field struct {
+	name string
+	typ  string
+}
+
+type params struct {
+	name   string
+	fields []field
+}
+
+func main() {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var types []params
+	for _, name := range files {
+		if name == output || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				spec := spec.(*ast.TypeSpec)
+				st, ok := spec.Type.(*ast.StructType)
+				if !ok || spec.Assign.IsValid() || !spec.Name.IsExported() {
+					continue
+				}
+				p := params{name: spec.Name.Name}
+				for _, f := range st.Fields.List {
+					typ, ok := fieldType(fset, f.Type)
+					if !ok {
+						continue
+					}
+					for _, n := range f.Names {
+						p.fields = append(p.fields, field{n.Name, typ})
+					}
+				}
+				if len(p.fields) > 0 {
+					types = append(types, p)
+				}
+			}
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].name < types[j].name })
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "// Code generated by gensetters. DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package requests")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "//go:generate go run ./internal/gensetters")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "import (")
+	if usesTime(types) {
+		fmt.Fprint(buf, "\t\"time\"\n\n")
+	}
+	fmt.Fprintln(buf, "\t\"github.com/lithic-com/lithic-go/fields\"\n)")
+	for _, p := range types {
+		fmt.Fprintf(buf, "\n// New%[1]s returns an empty %[1]s, to be populated with its setters.\nfunc New%[1]s() *%[1]s {\n\treturn &%[1]s{}\n}\n", p.name)
+		for _, f := range p.fields {
+			fmt.Fprintf(buf, "\n// Set%[2]s sets the %[2]s field of %[1]s.\nfunc (r *%[1]s) Set%[2]s(value %[3]s) *%[1]s {\n\tr.%[2]s = fields.F(value)\n\treturn r\n}\n", p.name, f.name, f.typ)
+		}
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usesTime(types []params) bool {
+	for _, p := range types {
+		for _, f := range p.fields {
+			if strings.Contains(f.typ, "time.") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fieldType returns the type T of a field declared as fields.Field[T].
+func fieldType(fset *token.FileSet, expr ast.Expr) (string, bool) {
+	index, ok := expr.(*ast.IndexExpr)
+	if !ok {
+		return "", false
+	}
+	sel, ok := index.X.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Field" {
+		return "", false
+	}
+	if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "fields" {
+		return "", false
+	}
+	buf := &bytes.Buffer{}
+	if err := printer.Fprint(buf, fset, index.Index); err != nil {
+		return "", false
+	}
+	return buf.String(), true
+}
@@ -0,0 +1,1508 @@
+// Code generated by genmatch. DO NOT EDIT.
+
+package requests
+
+//go:generate go run ../internal/genmatch
+
+// AccountHolderResubmitParamsWorkflowMatchFuncs has a function for every value of AccountHolderResubmitParamsWorkflow, see MatchAccountHolderResubmitParamsWorkflow.
+type AccountHolderResubmitParamsWorkflowMatchFuncs[R any] struct {
+	KYCAdvanced func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AccountHolderResubmitParamsWorkflow) R
+}
+
+// MatchAccountHolderResubmitParamsWorkflow calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAccountHolderResubmitParamsWorkflow[R any](v AccountHolderResubmitParamsWorkflow, m AccountHolderResubmitParamsWorkflowMatchFuncs[R]) R {
+	if m.KYCAdvanced == nil || m.Unknown == nil {
+		panic("requests: MatchAccountHolderResubmitParamsWorkflow needs a function for every value")
+	}
+	switch v {
+	case AccountHolderResubmitParamsWorkflowKYCAdvanced:
+		return m.KYCAdvanced()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AccountHolderUploadDocumentParamsDocumentTypeMatchFuncs has a function for every value of AccountHolderUploadDocumentParamsDocumentType, see MatchAccountHolderUploadDocumentParamsDocumentType.
+type AccountHolderUploadDocumentParamsDocumentTypeMatchFuncs[R any] struct {
+	CommercialLicense         func() R
+	DriversLicense            func() R
+	Passport                  func() R
+	PassportCard              func() R
+	Visa                      func() R
+	EinLetter                 func() R
+	TaxReturn                 func() R
+	OperatingAgreement        func() R
+	CertificateOfFormation    func() R
+	CertificateOfGoodStanding func() R
+	ArticlesOfIncorporation   func() R
+	ArticlesOfOrganization    func() R
+	Bylaws                    func() R
+	GovernmentBusinessLicense func() R
+	PartnershipAgreement      func() R
+	Ss4Form                   func() R
+	BankStatement             func() R
+	UtilityBillStatement      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AccountHolderUploadDocumentParamsDocumentType) R
+}
+
+// MatchAccountHolderUploadDocumentParamsDocumentType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAccountHolderUploadDocumentParamsDocumentType[R any](v AccountHolderUploadDocumentParamsDocumentType, m AccountHolderUploadDocumentParamsDocumentTypeMatchFuncs[R]) R {
+	if m.CommercialLicense == nil || m.DriversLicense == nil || m.Passport == nil || m.PassportCard == nil || m.Visa == nil || m.EinLetter == nil || m.TaxReturn == nil || m.OperatingAgreement == nil || m.CertificateOfFormation == nil || m.CertificateOfGoodStanding == nil || m.ArticlesOfIncorporation == nil || m.ArticlesOfOrganization == nil || m.Bylaws == nil || m.GovernmentBusinessLicense == nil || m.PartnershipAgreement == nil || m.Ss4Form == nil || m.BankStatement == nil || m.UtilityBillStatement == nil || m.Unknown == nil {
+		panic("requests: MatchAccountHolderUploadDocumentParamsDocumentType needs a function for every value")
+	}
+	switch v {
+	case AccountHolderUploadDocumentParamsDocumentTypeCommercialLicense:
+		return m.CommercialLicense()
+	case AccountHolderUploadDocumentParamsDocumentTypeDriversLicense:
+		return m.DriversLicense()
+	case AccountHolderUploadDocumentParamsDocumentTypePassport:
+		return m.Passport()
+	case AccountHolderUploadDocumentParamsDocumentTypePassportCard:
+		return m.PassportCard()
+	case AccountHolderUploadDocumentParamsDocumentTypeVisa:
+		return m.Visa()
+	case AccountHolderUploadDocumentParamsDocumentTypeEinLetter:
+		return m.EinLetter()
+	case AccountHolderUploadDocumentParamsDocumentTypeTaxReturn:
+		return m.TaxReturn()
+	case AccountHolderUploadDocumentParamsDocumentTypeOperatingAgreement:
+		return m.OperatingAgreement()
+	case AccountHolderUploadDocumentParamsDocumentTypeCertificateOfFormation:
+		return m.CertificateOfFormation()
+	case AccountHolderUploadDocumentParamsDocumentTypeCertificateOfGoodStanding:
+		return m.CertificateOfGoodStanding()
+	case AccountHolderUploadDocumentParamsDocumentTypeArticlesOfIncorporation:
+		return m.ArticlesOfIncorporation()
+	case AccountHolderUploadDocumentParamsDocumentTypeArticlesOfOrganization:
+		return m.ArticlesOfOrganization()
+	case AccountHolderUploadDocumentParamsDocumentTypeBylaws:
+		return m.Bylaws()
+	case AccountHolderUploadDocumentParamsDocumentTypeGovernmentBusinessLicense:
+		return m.GovernmentBusinessLicense()
+	case AccountHolderUploadDocumentParamsDocumentTypePartnershipAgreement:
+		return m.PartnershipAgreement()
+	case AccountHolderUploadDocumentParamsDocumentTypeSs4Form:
+		return m.Ss4Form()
+	case AccountHolderUploadDocumentParamsDocumentTypeBankStatement:
+		return m.BankStatement()
+	case AccountHolderUploadDocumentParamsDocumentTypeUtilityBillStatement:
+		return m.UtilityBillStatement()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AccountUpdateParamsStateMatchFuncs has a function for every value of AccountUpdateParamsState, see MatchAccountUpdateParamsState.
+type AccountUpdateParamsStateMatchFuncs[R any] struct {
+	Active func() R
+	Paused func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AccountUpdateParamsState) R
+}
+
+// MatchAccountUpdateParamsState calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAccountUpdateParamsState[R any](v AccountUpdateParamsState, m AccountUpdateParamsStateMatchFuncs[R]) R {
+	if m.Active == nil || m.Paused == nil || m.Unknown == nil {
+		panic("requests: MatchAccountUpdateParamsState needs a function for every value")
+	}
+	switch v {
+	case AccountUpdateParamsStateActive:
+		return m.Active()
+	case AccountUpdateParamsStatePaused:
+		return m.Paused()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AggregateBalanceListParamsFinancialAccountTypeMatchFuncs has a function for every value of AggregateBalanceListParamsFinancialAccountType, see MatchAggregateBalanceListParamsFinancialAccountType.
+type AggregateBalanceListParamsFinancialAccountTypeMatchFuncs[R any] struct {
+	Issuing   func() R
+	Operating func() R
+	Reserve   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AggregateBalanceListParamsFinancialAccountType) R
+}
+
+// MatchAggregateBalanceListParamsFinancialAccountType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAggregateBalanceListParamsFinancialAccountType[R any](v AggregateBalanceListParamsFinancialAccountType, m AggregateBalanceListParamsFinancialAccountTypeMatchFuncs[R]) R {
+	if m.Issuing == nil || m.Operating == nil || m.Reserve == nil || m.Unknown == nil {
+		panic("requests: MatchAggregateBalanceListParamsFinancialAccountType needs a function for every value")
+	}
+	switch v {
+	case AggregateBalanceListParamsFinancialAccountTypeIssuing:
+		return m.Issuing()
+	case AggregateBalanceListParamsFinancialAccountTypeOperating:
+		return m.Operating()
+	case AggregateBalanceListParamsFinancialAccountTypeReserve:
+		return m.Reserve()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AuthRuleRequestAvsTypeMatchFuncs has a function for every value of AuthRuleRequestAvsType, see MatchAuthRuleRequestAvsType.
+type AuthRuleRequestAvsTypeMatchFuncs[R any] struct {
+	ZipOnly func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AuthRuleRequestAvsType) R
+}
+
+// MatchAuthRuleRequestAvsType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAuthRuleRequestAvsType[R any](v AuthRuleRequestAvsType, m AuthRuleRequestAvsTypeMatchFuncs[R]) R {
+	if m.ZipOnly == nil || m.Unknown == nil {
+		panic("requests: MatchAuthRuleRequestAvsType needs a function for every value")
+	}
+	switch v {
+	case AuthRuleRequestAvsTypeZipOnly:
+		return m.ZipOnly()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AuthRuleUpdateParamsAvsTypeMatchFuncs has a function for every value of AuthRuleUpdateParamsAvsType, see MatchAuthRuleUpdateParamsAvsType.
+type AuthRuleUpdateParamsAvsTypeMatchFuncs[R any] struct {
+	ZipOnly func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AuthRuleUpdateParamsAvsType) R
+}
+
+// MatchAuthRuleUpdateParamsAvsType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAuthRuleUpdateParamsAvsType[R any](v AuthRuleUpdateParamsAvsType, m AuthRuleUpdateParamsAvsTypeMatchFuncs[R]) R {
+	if m.ZipOnly == nil || m.Unknown == nil {
+		panic("requests: MatchAuthRuleUpdateParamsAvsType needs a function for every value")
+	}
+	switch v {
+	case AuthRuleUpdateParamsAvsTypeZipOnly:
+		return m.ZipOnly()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// BalanceListParamsFinancialAccountTypeMatchFuncs has a function for every value of BalanceListParamsFinancialAccountType, see MatchBalanceListParamsFinancialAccountType.
+type BalanceListParamsFinancialAccountTypeMatchFuncs[R any] struct {
+	Issuing   func() R
+	Operating func() R
+	Reserve   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(BalanceListParamsFinancialAccountType) R
+}
+
+// MatchBalanceListParamsFinancialAccountType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchBalanceListParamsFinancialAccountType[R any](v BalanceListParamsFinancialAccountType, m BalanceListParamsFinancialAccountTypeMatchFuncs[R]) R {
+	if m.Issuing == nil || m.Operating == nil || m.Reserve == nil || m.Unknown == nil {
+		panic("requests: MatchBalanceListParamsFinancialAccountType needs a function for every value")
+	}
+	switch v {
+	case BalanceListParamsFinancialAccountTypeIssuing:
+		return m.Issuing()
+	case BalanceListParamsFinancialAccountTypeOperating:
+		return m.Operating()
+	case BalanceListParamsFinancialAccountTypeReserve:
+		return m.Reserve()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// BankValidationMethodMatchFuncs has a function for every value of BankValidationMethod, see MatchBankValidationMethod.
+type BankValidationMethodMatchFuncs[R any] struct {
+	Bank func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(BankValidationMethod) R
+}
+
+// MatchBankValidationMethod calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchBankValidationMethod[R any](v BankValidationMethod, m BankValidationMethodMatchFuncs[R]) R {
+	if m.Bank == nil || m.Unknown == nil {
+		panic("requests: MatchBankValidationMethod needs a function for every value")
+	}
+	switch v {
+	case BankValidationMethodBank:
+		return m.Bank()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// BookTransferCategoryMatchFuncs has a function for every value of BookTransferCategory, see MatchBookTransferCategory.
+type BookTransferCategoryMatchFuncs[R any] struct {
+	Adjustment       func() R
+	BalanceOrFunding func() R
+	Derecognition    func() R
+	Dispute          func() R
+	Fee              func() R
+	Reward           func() R
+	Transfer         func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(BookTransferCategory) R
+}
+
+// MatchBookTransferCategory calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchBookTransferCategory[R any](v BookTransferCategory, m BookTransferCategoryMatchFuncs[R]) R {
+	if m.Adjustment == nil || m.BalanceOrFunding == nil || m.Derecognition == nil || m.Dispute == nil || m.Fee == nil || m.Reward == nil || m.Transfer == nil || m.Unknown == nil {
+		panic("requests: MatchBookTransferCategory needs a function for every value")
+	}
+	switch v {
+	case BookTransferCategoryAdjustment:
+		return m.Adjustment()
+	case BookTransferCategoryBalanceOrFunding:
+		return m.BalanceOrFunding()
+	case BookTransferCategoryDerecognition:
+		return m.Derecognition()
+	case BookTransferCategoryDispute:
+		return m.Dispute()
+	case BookTransferCategoryFee:
+		return m.Fee()
+	case BookTransferCategoryReward:
+		return m.Reward()
+	case BookTransferCategoryTransfer:
+		return m.Transfer()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// BookTransferListParamsResultMatchFuncs has a function for every value of BookTransferListParamsResult, see MatchBookTransferListParamsResult.
+type BookTransferListParamsResultMatchFuncs[R any] struct {
+	Approved func() R
+	Declined func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(BookTransferListParamsResult) R
+}
+
+// MatchBookTransferListParamsResult calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchBookTransferListParamsResult[R any](v BookTransferListParamsResult, m BookTransferListParamsResultMatchFuncs[R]) R {
+	if m.Approved == nil || m.Declined == nil || m.Unknown == nil {
+		panic("requests: MatchBookTransferListParamsResult needs a function for every value")
+	}
+	switch v {
+	case BookTransferListParamsResultApproved:
+		return m.Approved()
+	case BookTransferListParamsResultDeclined:
+		return m.Declined()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// BookTransferListParamsStatusMatchFuncs has a function for every value of BookTransferListParamsStatus, see MatchBookTransferListParamsStatus.
+type BookTransferListParamsStatusMatchFuncs[R any] struct {
+	Declined func() R
+	Reversed func() R
+	Settled  func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(BookTransferListParamsStatus) R
+}
+
+// MatchBookTransferListParamsStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchBookTransferListParamsStatus[R any](v BookTransferListParamsStatus, m BookTransferListParamsStatusMatchFuncs[R]) R {
+	if m.Declined == nil || m.Reversed == nil || m.Settled == nil || m.Unknown == nil {
+		panic("requests: MatchBookTransferListParamsStatus needs a function for every value")
+	}
+	switch v {
+	case BookTransferListParamsStatusDeclined:
+		return m.Declined()
+	case BookTransferListParamsStatusReversed:
+		return m.Reversed()
+	case BookTransferListParamsStatusSettled:
+		return m.Settled()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// BookTransferTypeMatchFuncs has a function for every value of BookTransferType, see MatchBookTransferType.
+type BookTransferTypeMatchFuncs[R any] struct {
+	AtmWithdrawal              func() R
+	AtmDecline                 func() R
+	InternationalAtmWithdrawal func() R
+	Inactivity                 func() R
+	Statement                  func() R
+	Monthly                    func() R
+	Quarterly                  func() R
+	Annual                     func() R
+	CustomerService            func() R
+	AccountMaintenance         func() R
+	AccountActivation          func() R
+	AccountClosure             func() R
+	CardReplacement            func() R
+	CardDelivery               func() R
+	CardCreate                 func() R
+	CurrencyConversion         func() R
+	Interest                   func() R
+	LatePayment                func() R
+	BillPayment                func() R
+	CashBack                   func() R
+	AccountToAccount           func() R
+	CardToCard                 func() R
+	Disburse                   func() R
+	BillingError               func() R
+	LossWriteOff               func() R
+	ExpiredCard                func() R
+	EarlyDerecognition         func() R
+	Escheatment                func() R
+	InactivityFeeDown          func() R
+	ProvisionalCredit          func() R
+	DisputeWon                 func() R
+	Transfer                   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(BookTransferType) R
+}
+
+// MatchBookTransferType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchBookTransferType[R any](v BookTransferType, m BookTransferTypeMatchFuncs[R]) R {
+	if m.AtmWithdrawal == nil || m.AtmDecline == nil || m.InternationalAtmWithdrawal == nil || m.Inactivity == nil || m.Statement == nil || m.Monthly == nil || m.Quarterly == nil || m.Annual == nil || m.CustomerService == nil || m.AccountMaintenance == nil || m.AccountActivation == nil || m.AccountClosure == nil || m.CardReplacement == nil || m.CardDelivery == nil || m.CardCreate == nil || m.CurrencyConversion == nil || m.Interest == nil || m.LatePayment == nil || m.BillPayment == nil || m.CashBack == nil || m.AccountToAccount == nil || m.CardToCard == nil || m.Disburse == nil || m.BillingError == nil || m.LossWriteOff == nil || m.ExpiredCard == nil || m.EarlyDerecognition == nil || m.Escheatment == nil || m.InactivityFeeDown == nil || m.ProvisionalCredit == nil || m.DisputeWon == nil || m.Transfer == nil || m.Unknown == nil {
+		panic("requests: MatchBookTransferType needs a function for every value")
+	}
+	switch v {
+	case BookTransferTypeAtmWithdrawal:
+		return m.AtmWithdrawal()
+	case BookTransferTypeAtmDecline:
+		return m.AtmDecline()
+	case BookTransferTypeInternationalAtmWithdrawal:
+		return m.InternationalAtmWithdrawal()
+	case BookTransferTypeInactivity:
+		return m.Inactivity()
+	case BookTransferTypeStatement:
+		return m.Statement()
+	case BookTransferTypeMonthly:
+		return m.Monthly()
+	case BookTransferTypeQuarterly:
+		return m.Quarterly()
+	case BookTransferTypeAnnual:
+		return m.Annual()
+	case BookTransferTypeCustomerService:
+		return m.CustomerService()
+	case BookTransferTypeAccountMaintenance:
+		return m.AccountMaintenance()
+	case BookTransferTypeAccountActivation:
+		return m.AccountActivation()
+	case BookTransferTypeAccountClosure:
+		return m.AccountClosure()
+	case BookTransferTypeCardReplacement:
+		return m.CardReplacement()
+	case BookTransferTypeCardDelivery:
+		return m.CardDelivery()
+	case BookTransferTypeCardCreate:
+		return m.CardCreate()
+	case BookTransferTypeCurrencyConversion:
+		return m.CurrencyConversion()
+	case BookTransferTypeInterest:
+		return m.Interest()
+	case BookTransferTypeLatePayment:
+		return m.LatePayment()
+	case BookTransferTypeBillPayment:
+		return m.BillPayment()
+	case BookTransferTypeCashBack:
+		return m.CashBack()
+	case BookTransferTypeAccountToAccount:
+		return m.AccountToAccount()
+	case BookTransferTypeCardToCard:
+		return m.CardToCard()
+	case BookTransferTypeDisburse:
+		return m.Disburse()
+	case BookTransferTypeBillingError:
+		return m.BillingError()
+	case BookTransferTypeLossWriteOff:
+		return m.LossWriteOff()
+	case BookTransferTypeExpiredCard:
+		return m.ExpiredCard()
+	case BookTransferTypeEarlyDerecognition:
+		return m.EarlyDerecognition()
+	case BookTransferTypeEscheatment:
+		return m.Escheatment()
+	case BookTransferTypeInactivityFeeDown:
+		return m.InactivityFeeDown()
+	case BookTransferTypeProvisionalCredit:
+		return m.ProvisionalCredit()
+	case BookTransferTypeDisputeWon:
+		return m.DisputeWon()
+	case BookTransferTypeTransfer:
+		return m.Transfer()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardNewParamsShippingMethodMatchFuncs has a function for every value of CardNewParamsShippingMethod, see MatchCardNewParamsShippingMethod.
+type CardNewParamsShippingMethodMatchFuncs[R any] struct {
+	Standard             func() R
+	StandardWithTracking func() R
+	Expedited            func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardNewParamsShippingMethod) R
+}
+
+// MatchCardNewParamsShippingMethod calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardNewParamsShippingMethod[R any](v CardNewParamsShippingMethod, m CardNewParamsShippingMethodMatchFuncs[R]) R {
+	if m.Standard == nil || m.StandardWithTracking == nil || m.Expedited == nil || m.Unknown == nil {
+		panic("requests: MatchCardNewParamsShippingMethod needs a function for every value")
+	}
+	switch v {
+	case CardNewParamsShippingMethodStandard:
+		return m.Standard()
+	case CardNewParamsShippingMethodStandardWithTracking:
+		return m.StandardWithTracking()
+	case CardNewParamsShippingMethodExpedited:
+		return m.Expedited()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardNewParamsStateMatchFuncs has a function for every value of CardNewParamsState, see MatchCardNewParamsState.
+type CardNewParamsStateMatchFuncs[R any] struct {
+	Open   func() R
+	Paused func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardNewParamsState) R
+}
+
+// MatchCardNewParamsState calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardNewParamsState[R any](v CardNewParamsState, m CardNewParamsStateMatchFuncs[R]) R {
+	if m.Open == nil || m.Paused == nil || m.Unknown == nil {
+		panic("requests: MatchCardNewParamsState needs a function for every value")
+	}
+	switch v {
+	case CardNewParamsStateOpen:
+		return m.Open()
+	case CardNewParamsStatePaused:
+		return m.Paused()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardNewParamsTypeMatchFuncs has a function for every value of CardNewParamsType, see MatchCardNewParamsType.
+type CardNewParamsTypeMatchFuncs[R any] struct {
+	Virtual        func() R
+	Physical       func() R
+	MerchantLocked func() R
+	SingleUse      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardNewParamsType) R
+}
+
+// MatchCardNewParamsType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardNewParamsType[R any](v CardNewParamsType, m CardNewParamsTypeMatchFuncs[R]) R {
+	if m.Virtual == nil || m.Physical == nil || m.MerchantLocked == nil || m.SingleUse == nil || m.Unknown == nil {
+		panic("requests: MatchCardNewParamsType needs a function for every value")
+	}
+	switch v {
+	case CardNewParamsTypeVirtual:
+		return m.Virtual()
+	case CardNewParamsTypePhysical:
+		return m.Physical()
+	case CardNewParamsTypeMerchantLocked:
+		return m.MerchantLocked()
+	case CardNewParamsTypeSingleUse:
+		return m.SingleUse()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardProvisionParamsDigitalWalletMatchFuncs has a function for every value of CardProvisionParamsDigitalWallet, see MatchCardProvisionParamsDigitalWallet.
+type CardProvisionParamsDigitalWalletMatchFuncs[R any] struct {
+	ApplePay   func() R
+	GooglePay  func() R
+	SamsungPay func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardProvisionParamsDigitalWallet) R
+}
+
+// MatchCardProvisionParamsDigitalWallet calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardProvisionParamsDigitalWallet[R any](v CardProvisionParamsDigitalWallet, m CardProvisionParamsDigitalWalletMatchFuncs[R]) R {
+	if m.ApplePay == nil || m.GooglePay == nil || m.SamsungPay == nil || m.Unknown == nil {
+		panic("requests: MatchCardProvisionParamsDigitalWallet needs a function for every value")
+	}
+	switch v {
+	case CardProvisionParamsDigitalWalletApplePay:
+		return m.ApplePay()
+	case CardProvisionParamsDigitalWalletGooglePay:
+		return m.GooglePay()
+	case CardProvisionParamsDigitalWalletSamsungPay:
+		return m.SamsungPay()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardReissueParamsShippingMethodMatchFuncs has a function for every value of CardReissueParamsShippingMethod, see MatchCardReissueParamsShippingMethod.
+type CardReissueParamsShippingMethodMatchFuncs[R any] struct {
+	Standard             func() R
+	StandardWithTracking func() R
+	Expedited            func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardReissueParamsShippingMethod) R
+}
+
+// MatchCardReissueParamsShippingMethod calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardReissueParamsShippingMethod[R any](v CardReissueParamsShippingMethod, m CardReissueParamsShippingMethodMatchFuncs[R]) R {
+	if m.Standard == nil || m.StandardWithTracking == nil || m.Expedited == nil || m.Unknown == nil {
+		panic("requests: MatchCardReissueParamsShippingMethod needs a function for every value")
+	}
+	switch v {
+	case CardReissueParamsShippingMethodStandard:
+		return m.Standard()
+	case CardReissueParamsShippingMethodStandardWithTracking:
+		return m.StandardWithTracking()
+	case CardReissueParamsShippingMethodExpedited:
+		return m.Expedited()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardUpdateParamsStateMatchFuncs has a function for every value of CardUpdateParamsState, see MatchCardUpdateParamsState.
+type CardUpdateParamsStateMatchFuncs[R any] struct {
+	Closed func() R
+	Open   func() R
+	Paused func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardUpdateParamsState) R
+}
+
+// MatchCardUpdateParamsState calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardUpdateParamsState[R any](v CardUpdateParamsState, m CardUpdateParamsStateMatchFuncs[R]) R {
+	if m.Closed == nil || m.Open == nil || m.Paused == nil || m.Unknown == nil {
+		panic("requests: MatchCardUpdateParamsState needs a function for every value")
+	}
+	switch v {
+	case CardUpdateParamsStateClosed:
+		return m.Closed()
+	case CardUpdateParamsStateOpen:
+		return m.Open()
+	case CardUpdateParamsStatePaused:
+		return m.Paused()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// DisputeListParamsStatusMatchFuncs has a function for every value of DisputeListParamsStatus, see MatchDisputeListParamsStatus.
+type DisputeListParamsStatusMatchFuncs[R any] struct {
+	New             func() R
+	PendingCustomer func() R
+	Submitted       func() R
+	Representment   func() R
+	Prearbitration  func() R
+	Arbitration     func() R
+	CaseWon         func() R
+	CaseClosed      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(DisputeListParamsStatus) R
+}
+
+// MatchDisputeListParamsStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchDisputeListParamsStatus[R any](v DisputeListParamsStatus, m DisputeListParamsStatusMatchFuncs[R]) R {
+	if m.New == nil || m.PendingCustomer == nil || m.Submitted == nil || m.Representment == nil || m.Prearbitration == nil || m.Arbitration == nil || m.CaseWon == nil || m.CaseClosed == nil || m.Unknown == nil {
+		panic("requests: MatchDisputeListParamsStatus needs a function for every value")
+	}
+	switch v {
+	case DisputeListParamsStatusNew:
+		return m.New()
+	case DisputeListParamsStatusPendingCustomer:
+		return m.PendingCustomer()
+	case DisputeListParamsStatusSubmitted:
+		return m.Submitted()
+	case DisputeListParamsStatusRepresentment:
+		return m.Representment()
+	case DisputeListParamsStatusPrearbitration:
+		return m.Prearbitration()
+	case DisputeListParamsStatusArbitration:
+		return m.Arbitration()
+	case DisputeListParamsStatusCaseWon:
+		return m.CaseWon()
+	case DisputeListParamsStatusCaseClosed:
+		return m.CaseClosed()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// DisputeNewParamsReasonMatchFuncs has a function for every value of DisputeNewParamsReason, see MatchDisputeNewParamsReason.
+type DisputeNewParamsReasonMatchFuncs[R any] struct {
+	AtmCashMisdispense               func() R
+	Cancelled                        func() R
+	Duplicated                       func() R
+	FraudCardNotPresent              func() R
+	FraudCardPresent                 func() R
+	FraudOther                       func() R
+	GoodsServicesNotAsDescribed      func() R
+	GoodsServicesNotReceived         func() R
+	IncorrectAmount                  func() R
+	MissingAuth                      func() R
+	Other                            func() R
+	ProcessingError                  func() R
+	RefundNotProcessed               func() R
+	RecurringTransactionNotCancelled func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(DisputeNewParamsReason) R
+}
+
+// MatchDisputeNewParamsReason calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchDisputeNewParamsReason[R any](v DisputeNewParamsReason, m DisputeNewParamsReasonMatchFuncs[R]) R {
+	if m.AtmCashMisdispense == nil || m.Cancelled == nil || m.Duplicated == nil || m.FraudCardNotPresent == nil || m.FraudCardPresent == nil || m.FraudOther == nil || m.GoodsServicesNotAsDescribed == nil || m.GoodsServicesNotReceived == nil || m.IncorrectAmount == nil || m.MissingAuth == nil || m.Other == nil || m.ProcessingError == nil || m.RefundNotProcessed == nil || m.RecurringTransactionNotCancelled == nil || m.Unknown == nil {
+		panic("requests: MatchDisputeNewParamsReason needs a function for every value")
+	}
+	switch v {
+	case DisputeNewParamsReasonAtmCashMisdispense:
+		return m.AtmCashMisdispense()
+	case DisputeNewParamsReasonCancelled:
+		return m.Cancelled()
+	case DisputeNewParamsReasonDuplicated:
+		return m.Duplicated()
+	case DisputeNewParamsReasonFraudCardNotPresent:
+		return m.FraudCardNotPresent()
+	case DisputeNewParamsReasonFraudCardPresent:
+		return m.FraudCardPresent()
+	case DisputeNewParamsReasonFraudOther:
+		return m.FraudOther()
+	case DisputeNewParamsReasonGoodsServicesNotAsDescribed:
+		return m.GoodsServicesNotAsDescribed()
+	case DisputeNewParamsReasonGoodsServicesNotReceived:
+		return m.GoodsServicesNotReceived()
+	case DisputeNewParamsReasonIncorrectAmount:
+		return m.IncorrectAmount()
+	case DisputeNewParamsReasonMissingAuth:
+		return m.MissingAuth()
+	case DisputeNewParamsReasonOther:
+		return m.Other()
+	case DisputeNewParamsReasonProcessingError:
+		return m.ProcessingError()
+	case DisputeNewParamsReasonRefundNotProcessed:
+		return m.RefundNotProcessed()
+	case DisputeNewParamsReasonRecurringTransactionNotCancelled:
+		return m.RecurringTransactionNotCancelled()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// DisputeUpdateParamsReasonMatchFuncs has a function for every value of DisputeUpdateParamsReason, see MatchDisputeUpdateParamsReason.
+type DisputeUpdateParamsReasonMatchFuncs[R any] struct {
+	AtmCashMisdispense               func() R
+	Cancelled                        func() R
+	Duplicated                       func() R
+	FraudCardNotPresent              func() R
+	FraudCardPresent                 func() R
+	FraudOther                       func() R
+	GoodsServicesNotAsDescribed      func() R
+	GoodsServicesNotReceived         func() R
+	IncorrectAmount                  func() R
+	MissingAuth                      func() R
+	Other                            func() R
+	ProcessingError                  func() R
+	RefundNotProcessed               func() R
+	RecurringTransactionNotCancelled func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(DisputeUpdateParamsReason) R
+}
+
+// MatchDisputeUpdateParamsReason calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchDisputeUpdateParamsReason[R any](v DisputeUpdateParamsReason, m DisputeUpdateParamsReasonMatchFuncs[R]) R {
+	if m.AtmCashMisdispense == nil || m.Cancelled == nil || m.Duplicated == nil || m.FraudCardNotPresent == nil || m.FraudCardPresent == nil || m.FraudOther == nil || m.GoodsServicesNotAsDescribed == nil || m.GoodsServicesNotReceived == nil || m.IncorrectAmount == nil || m.MissingAuth == nil || m.Other == nil || m.ProcessingError == nil || m.RefundNotProcessed == nil || m.RecurringTransactionNotCancelled == nil || m.Unknown == nil {
+		panic("requests: MatchDisputeUpdateParamsReason needs a function for every value")
+	}
+	switch v {
+	case DisputeUpdateParamsReasonAtmCashMisdispense:
+		return m.AtmCashMisdispense()
+	case DisputeUpdateParamsReasonCancelled:
+		return m.Cancelled()
+	case DisputeUpdateParamsReasonDuplicated:
+		return m.Duplicated()
+	case DisputeUpdateParamsReasonFraudCardNotPresent:
+		return m.FraudCardNotPresent()
+	case DisputeUpdateParamsReasonFraudCardPresent:
+		return m.FraudCardPresent()
+	case DisputeUpdateParamsReasonFraudOther:
+		return m.FraudOther()
+	case DisputeUpdateParamsReasonGoodsServicesNotAsDescribed:
+		return m.GoodsServicesNotAsDescribed()
+	case DisputeUpdateParamsReasonGoodsServicesNotReceived:
+		return m.GoodsServicesNotReceived()
+	case DisputeUpdateParamsReasonIncorrectAmount:
+		return m.IncorrectAmount()
+	case DisputeUpdateParamsReasonMissingAuth:
+		return m.MissingAuth()
+	case DisputeUpdateParamsReasonOther:
+		return m.Other()
+	case DisputeUpdateParamsReasonProcessingError:
+		return m.ProcessingError()
+	case DisputeUpdateParamsReasonRefundNotProcessed:
+		return m.RefundNotProcessed()
+	case DisputeUpdateParamsReasonRecurringTransactionNotCancelled:
+		return m.RecurringTransactionNotCancelled()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// EventListAttemptsParamsStatusMatchFuncs has a function for every value of EventListAttemptsParamsStatus, see MatchEventListAttemptsParamsStatus.
+type EventListAttemptsParamsStatusMatchFuncs[R any] struct {
+	Failed  func() R
+	Pending func() R
+	Sending func() R
+	Success func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(EventListAttemptsParamsStatus) R
+}
+
+// MatchEventListAttemptsParamsStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchEventListAttemptsParamsStatus[R any](v EventListAttemptsParamsStatus, m EventListAttemptsParamsStatusMatchFuncs[R]) R {
+	if m.Failed == nil || m.Pending == nil || m.Sending == nil || m.Success == nil || m.Unknown == nil {
+		panic("requests: MatchEventListAttemptsParamsStatus needs a function for every value")
+	}
+	switch v {
+	case EventListAttemptsParamsStatusFailed:
+		return m.Failed()
+	case EventListAttemptsParamsStatusPending:
+		return m.Pending()
+	case EventListAttemptsParamsStatusSending:
+		return m.Sending()
+	case EventListAttemptsParamsStatusSuccess:
+		return m.Success()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// EventListParamsEventTypesMatchFuncs has a function for every value of EventListParamsEventTypes, see MatchEventListParamsEventTypes.
+type EventListParamsEventTypesMatchFuncs[R any] struct {
+	DisputeUpdated                           func() R
+	DigitalWalletTokenizationApprovalRequest func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(EventListParamsEventTypes) R
+}
+
+// MatchEventListParamsEventTypes calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchEventListParamsEventTypes[R any](v EventListParamsEventTypes, m EventListParamsEventTypesMatchFuncs[R]) R {
+	if m.DisputeUpdated == nil || m.DigitalWalletTokenizationApprovalRequest == nil || m.Unknown == nil {
+		panic("requests: MatchEventListParamsEventTypes needs a function for every value")
+	}
+	switch v {
+	case EventListParamsEventTypesDisputeUpdated:
+		return m.DisputeUpdated()
+	case EventListParamsEventTypesDigitalWalletTokenizationApprovalRequest:
+		return m.DigitalWalletTokenizationApprovalRequest()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ExternalBankAccountListParamsStateMatchFuncs has a function for every value of ExternalBankAccountListParamsState, see MatchExternalBankAccountListParamsState.
+type ExternalBankAccountListParamsStateMatchFuncs[R any] struct {
+	Enabled func() R
+	Closed  func() R
+	Paused  func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ExternalBankAccountListParamsState) R
+}
+
+// MatchExternalBankAccountListParamsState calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchExternalBankAccountListParamsState[R any](v ExternalBankAccountListParamsState, m ExternalBankAccountListParamsStateMatchFuncs[R]) R {
+	if m.Enabled == nil || m.Closed == nil || m.Paused == nil || m.Unknown == nil {
+		panic("requests: MatchExternalBankAccountListParamsState needs a function for every value")
+	}
+	switch v {
+	case ExternalBankAccountListParamsStateEnabled:
+		return m.Enabled()
+	case ExternalBankAccountListParamsStateClosed:
+		return m.Closed()
+	case ExternalBankAccountListParamsStatePaused:
+		return m.Paused()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ExternalBankAccountListParamsVerificationStateMatchFuncs has a function for every value of ExternalBankAccountListParamsVerificationState, see MatchExternalBankAccountListParamsVerificationState.
+type ExternalBankAccountListParamsVerificationStateMatchFuncs[R any] struct {
+	Pending            func() R
+	Enabled            func() R
+	FailedVerification func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ExternalBankAccountListParamsVerificationState) R
+}
+
+// MatchExternalBankAccountListParamsVerificationState calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchExternalBankAccountListParamsVerificationState[R any](v ExternalBankAccountListParamsVerificationState, m ExternalBankAccountListParamsVerificationStateMatchFuncs[R]) R {
+	if m.Pending == nil || m.Enabled == nil || m.FailedVerification == nil || m.Unknown == nil {
+		panic("requests: MatchExternalBankAccountListParamsVerificationState needs a function for every value")
+	}
+	switch v {
+	case ExternalBankAccountListParamsVerificationStatePending:
+		return m.Pending()
+	case ExternalBankAccountListParamsVerificationStateEnabled:
+		return m.Enabled()
+	case ExternalBankAccountListParamsVerificationStateFailedVerification:
+		return m.FailedVerification()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ExternalBankAccountOwnerTypeMatchFuncs has a function for every value of ExternalBankAccountOwnerType, see MatchExternalBankAccountOwnerType.
+type ExternalBankAccountOwnerTypeMatchFuncs[R any] struct {
+	Business   func() R
+	Individual func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ExternalBankAccountOwnerType) R
+}
+
+// MatchExternalBankAccountOwnerType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchExternalBankAccountOwnerType[R any](v ExternalBankAccountOwnerType, m ExternalBankAccountOwnerTypeMatchFuncs[R]) R {
+	if m.Business == nil || m.Individual == nil || m.Unknown == nil {
+		panic("requests: MatchExternalBankAccountOwnerType needs a function for every value")
+	}
+	switch v {
+	case ExternalBankAccountOwnerTypeBusiness:
+		return m.Business()
+	case ExternalBankAccountOwnerTypeIndividual:
+		return m.Individual()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ExternalBankAccountTypeMatchFuncs has a function for every value of ExternalBankAccountType, see MatchExternalBankAccountType.
+type ExternalBankAccountTypeMatchFuncs[R any] struct {
+	Checking func() R
+	Savings  func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ExternalBankAccountType) R
+}
+
+// MatchExternalBankAccountType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchExternalBankAccountType[R any](v ExternalBankAccountType, m ExternalBankAccountTypeMatchFuncs[R]) R {
+	if m.Checking == nil || m.Savings == nil || m.Unknown == nil {
+		panic("requests: MatchExternalBankAccountType needs a function for every value")
+	}
+	switch v {
+	case ExternalBankAccountTypeChecking:
+		return m.Checking()
+	case ExternalBankAccountTypeSavings:
+		return m.Savings()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ExternalBankAccountVerificationMethodMatchFuncs has a function for every value of ExternalBankAccountVerificationMethod, see MatchExternalBankAccountVerificationMethod.
+type ExternalBankAccountVerificationMethodMatchFuncs[R any] struct {
+	Manual       func() R
+	MicroDeposit func() R
+	Prenote      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ExternalBankAccountVerificationMethod) R
+}
+
+// MatchExternalBankAccountVerificationMethod calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchExternalBankAccountVerificationMethod[R any](v ExternalBankAccountVerificationMethod, m ExternalBankAccountVerificationMethodMatchFuncs[R]) R {
+	if m.Manual == nil || m.MicroDeposit == nil || m.Prenote == nil || m.Unknown == nil {
+		panic("requests: MatchExternalBankAccountVerificationMethod needs a function for every value")
+	}
+	switch v {
+	case ExternalBankAccountVerificationMethodManual:
+		return m.Manual()
+	case ExternalBankAccountVerificationMethodMicroDeposit:
+		return m.MicroDeposit()
+	case ExternalBankAccountVerificationMethodPrenote:
+		return m.Prenote()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FinancialAccountListParamsTypeMatchFuncs has a function for every value of FinancialAccountListParamsType, see MatchFinancialAccountListParamsType.
+type FinancialAccountListParamsTypeMatchFuncs[R any] struct {
+	Issuing   func() R
+	Operating func() R
+	Reserve   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FinancialAccountListParamsType) R
+}
+
+// MatchFinancialAccountListParamsType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFinancialAccountListParamsType[R any](v FinancialAccountListParamsType, m FinancialAccountListParamsTypeMatchFuncs[R]) R {
+	if m.Issuing == nil || m.Operating == nil || m.Reserve == nil || m.Unknown == nil {
+		panic("requests: MatchFinancialAccountListParamsType needs a function for every value")
+	}
+	switch v {
+	case FinancialAccountListParamsTypeIssuing:
+		return m.Issuing()
+	case FinancialAccountListParamsTypeOperating:
+		return m.Operating()
+	case FinancialAccountListParamsTypeReserve:
+		return m.Reserve()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FinancialTransactionListParamsCategoryMatchFuncs has a function for every value of FinancialTransactionListParamsCategory, see MatchFinancialTransactionListParamsCategory.
+type FinancialTransactionListParamsCategoryMatchFuncs[R any] struct {
+	ACH      func() R
+	Card     func() R
+	Transfer func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FinancialTransactionListParamsCategory) R
+}
+
+// MatchFinancialTransactionListParamsCategory calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFinancialTransactionListParamsCategory[R any](v FinancialTransactionListParamsCategory, m FinancialTransactionListParamsCategoryMatchFuncs[R]) R {
+	if m.ACH == nil || m.Card == nil || m.Transfer == nil || m.Unknown == nil {
+		panic("requests: MatchFinancialTransactionListParamsCategory needs a function for every value")
+	}
+	switch v {
+	case FinancialTransactionListParamsCategoryACH:
+		return m.ACH()
+	case FinancialTransactionListParamsCategoryCard:
+		return m.Card()
+	case FinancialTransactionListParamsCategoryTransfer:
+		return m.Transfer()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FinancialTransactionListParamsResultMatchFuncs has a function for every value of FinancialTransactionListParamsResult, see MatchFinancialTransactionListParamsResult.
+type FinancialTransactionListParamsResultMatchFuncs[R any] struct {
+	Approved func() R
+	Declined func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FinancialTransactionListParamsResult) R
+}
+
+// MatchFinancialTransactionListParamsResult calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFinancialTransactionListParamsResult[R any](v FinancialTransactionListParamsResult, m FinancialTransactionListParamsResultMatchFuncs[R]) R {
+	if m.Approved == nil || m.Declined == nil || m.Unknown == nil {
+		panic("requests: MatchFinancialTransactionListParamsResult needs a function for every value")
+	}
+	switch v {
+	case FinancialTransactionListParamsResultApproved:
+		return m.Approved()
+	case FinancialTransactionListParamsResultDeclined:
+		return m.Declined()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FinancialTransactionListParamsStatusMatchFuncs has a function for every value of FinancialTransactionListParamsStatus, see MatchFinancialTransactionListParamsStatus.
+type FinancialTransactionListParamsStatusMatchFuncs[R any] struct {
+	Declined func() R
+	Expired  func() R
+	Pending  func() R
+	Settled  func() R
+	Voided   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FinancialTransactionListParamsStatus) R
+}
+
+// MatchFinancialTransactionListParamsStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFinancialTransactionListParamsStatus[R any](v FinancialTransactionListParamsStatus, m FinancialTransactionListParamsStatusMatchFuncs[R]) R {
+	if m.Declined == nil || m.Expired == nil || m.Pending == nil || m.Settled == nil || m.Voided == nil || m.Unknown == nil {
+		panic("requests: MatchFinancialTransactionListParamsStatus needs a function for every value")
+	}
+	switch v {
+	case FinancialTransactionListParamsStatusDeclined:
+		return m.Declined()
+	case FinancialTransactionListParamsStatusExpired:
+		return m.Expired()
+	case FinancialTransactionListParamsStatusPending:
+		return m.Pending()
+	case FinancialTransactionListParamsStatusSettled:
+		return m.Settled()
+	case FinancialTransactionListParamsStatusVoided:
+		return m.Voided()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FundingSourceUpdateParamsStateMatchFuncs has a function for every value of FundingSourceUpdateParamsState, see MatchFundingSourceUpdateParamsState.
+type FundingSourceUpdateParamsStateMatchFuncs[R any] struct {
+	Deleted func() R
+	Enabled func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FundingSourceUpdateParamsState) R
+}
+
+// MatchFundingSourceUpdateParamsState calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFundingSourceUpdateParamsState[R any](v FundingSourceUpdateParamsState, m FundingSourceUpdateParamsStateMatchFuncs[R]) R {
+	if m.Deleted == nil || m.Enabled == nil || m.Unknown == nil {
+		panic("requests: MatchFundingSourceUpdateParamsState needs a function for every value")
+	}
+	switch v {
+	case FundingSourceUpdateParamsStateDeleted:
+		return m.Deleted()
+	case FundingSourceUpdateParamsStateEnabled:
+		return m.Enabled()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// KYBWorkflowMatchFuncs has a function for every value of KYBWorkflow, see MatchKYBWorkflow.
+type KYBWorkflowMatchFuncs[R any] struct {
+	KYBBasic func() R
+	KYBByo   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(KYBWorkflow) R
+}
+
+// MatchKYBWorkflow calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchKYBWorkflow[R any](v KYBWorkflow, m KYBWorkflowMatchFuncs[R]) R {
+	if m.KYBBasic == nil || m.KYBByo == nil || m.Unknown == nil {
+		panic("requests: MatchKYBWorkflow needs a function for every value")
+	}
+	switch v {
+	case KYBWorkflowKYBBasic:
+		return m.KYBBasic()
+	case KYBWorkflowKYBByo:
+		return m.KYBByo()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// KYCExemptKYCExemptionTypeMatchFuncs has a function for every value of KYCExemptKYCExemptionType, see MatchKYCExemptKYCExemptionType.
+type KYCExemptKYCExemptionTypeMatchFuncs[R any] struct {
+	AuthorizedUser  func() R
+	PrepaidCardUser func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(KYCExemptKYCExemptionType) R
+}
+
+// MatchKYCExemptKYCExemptionType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchKYCExemptKYCExemptionType[R any](v KYCExemptKYCExemptionType, m KYCExemptKYCExemptionTypeMatchFuncs[R]) R {
+	if m.AuthorizedUser == nil || m.PrepaidCardUser == nil || m.Unknown == nil {
+		panic("requests: MatchKYCExemptKYCExemptionType needs a function for every value")
+	}
+	switch v {
+	case KYCExemptKYCExemptionTypeAuthorizedUser:
+		return m.AuthorizedUser()
+	case KYCExemptKYCExemptionTypePrepaidCardUser:
+		return m.PrepaidCardUser()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// KYCExemptWorkflowMatchFuncs has a function for every value of KYCExemptWorkflow, see MatchKYCExemptWorkflow.
+type KYCExemptWorkflowMatchFuncs[R any] struct {
+	KYCExempt func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(KYCExemptWorkflow) R
+}
+
+// MatchKYCExemptWorkflow calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchKYCExemptWorkflow[R any](v KYCExemptWorkflow, m KYCExemptWorkflowMatchFuncs[R]) R {
+	if m.KYCExempt == nil || m.Unknown == nil {
+		panic("requests: MatchKYCExemptWorkflow needs a function for every value")
+	}
+	switch v {
+	case KYCExemptWorkflowKYCExempt:
+		return m.KYCExempt()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// KYCWorkflowMatchFuncs has a function for every value of KYCWorkflow, see MatchKYCWorkflow.
+type KYCWorkflowMatchFuncs[R any] struct {
+	KYCAdvanced func() R
+	KYCBasic    func() R
+	KYCByo      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(KYCWorkflow) R
+}
+
+// MatchKYCWorkflow calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchKYCWorkflow[R any](v KYCWorkflow, m KYCWorkflowMatchFuncs[R]) R {
+	if m.KYCAdvanced == nil || m.KYCBasic == nil || m.KYCByo == nil || m.Unknown == nil {
+		panic("requests: MatchKYCWorkflow needs a function for every value")
+	}
+	switch v {
+	case KYCWorkflowKYCAdvanced:
+		return m.KYCAdvanced()
+	case KYCWorkflowKYCBasic:
+		return m.KYCBasic()
+	case KYCWorkflowKYCByo:
+		return m.KYCByo()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// PlaidValidationMethodMatchFuncs has a function for every value of PlaidValidationMethod, see MatchPlaidValidationMethod.
+type PlaidValidationMethodMatchFuncs[R any] struct {
+	Plaid func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(PlaidValidationMethod) R
+}
+
+// MatchPlaidValidationMethod calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchPlaidValidationMethod[R any](v PlaidValidationMethod, m PlaidValidationMethodMatchFuncs[R]) R {
+	if m.Plaid == nil || m.Unknown == nil {
+		panic("requests: MatchPlaidValidationMethod needs a function for every value")
+	}
+	switch v {
+	case PlaidValidationMethodPlaid:
+		return m.Plaid()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ResponderEndpointTypeMatchFuncs has a function for every value of ResponderEndpointType, see MatchResponderEndpointType.
+type ResponderEndpointTypeMatchFuncs[R any] struct {
+	AuthStreamAccess        func() R
+	ThreeDSDecisioning      func() R
+	TokenizationDecisioning func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ResponderEndpointType) R
+}
+
+// MatchResponderEndpointType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchResponderEndpointType[R any](v ResponderEndpointType, m ResponderEndpointTypeMatchFuncs[R]) R {
+	if m.AuthStreamAccess == nil || m.ThreeDSDecisioning == nil || m.TokenizationDecisioning == nil || m.Unknown == nil {
+		panic("requests: MatchResponderEndpointType needs a function for every value")
+	}
+	switch v {
+	case ResponderEndpointTypeAuthStreamAccess:
+		return m.AuthStreamAccess()
+	case ResponderEndpointTypeThreeDSDecisioning:
+		return m.ThreeDSDecisioning()
+	case ResponderEndpointTypeTokenizationDecisioning:
+		return m.TokenizationDecisioning()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// SpendLimitDurationMatchFuncs has a function for every value of SpendLimitDuration, see MatchSpendLimitDuration.
+type SpendLimitDurationMatchFuncs[R any] struct {
+	Annually    func() R
+	Forever     func() R
+	Monthly     func() R
+	Transaction func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(SpendLimitDuration) R
+}
+
+// MatchSpendLimitDuration calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchSpendLimitDuration[R any](v SpendLimitDuration, m SpendLimitDurationMatchFuncs[R]) R {
+	if m.Annually == nil || m.Forever == nil || m.Monthly == nil || m.Transaction == nil || m.Unknown == nil {
+		panic("requests: MatchSpendLimitDuration needs a function for every value")
+	}
+	switch v {
+	case SpendLimitDurationAnnually:
+		return m.Annually()
+	case SpendLimitDurationForever:
+		return m.Forever()
+	case SpendLimitDurationMonthly:
+		return m.Monthly()
+	case SpendLimitDurationTransaction:
+		return m.Transaction()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// SubscriptionListAttemptsParamsStatusMatchFuncs has a function for every value of SubscriptionListAttemptsParamsStatus, see MatchSubscriptionListAttemptsParamsStatus.
+type SubscriptionListAttemptsParamsStatusMatchFuncs[R any] struct {
+	Failed  func() R
+	Pending func() R
+	Sending func() R
+	Success func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(SubscriptionListAttemptsParamsStatus) R
+}
+
+// MatchSubscriptionListAttemptsParamsStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchSubscriptionListAttemptsParamsStatus[R any](v SubscriptionListAttemptsParamsStatus, m SubscriptionListAttemptsParamsStatusMatchFuncs[R]) R {
+	if m.Failed == nil || m.Pending == nil || m.Sending == nil || m.Success == nil || m.Unknown == nil {
+		panic("requests: MatchSubscriptionListAttemptsParamsStatus needs a function for every value")
+	}
+	switch v {
+	case SubscriptionListAttemptsParamsStatusFailed:
+		return m.Failed()
+	case SubscriptionListAttemptsParamsStatusPending:
+		return m.Pending()
+	case SubscriptionListAttemptsParamsStatusSending:
+		return m.Sending()
+	case SubscriptionListAttemptsParamsStatusSuccess:
+		return m.Success()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// SubscriptionNewParamsEventTypesMatchFuncs has a function for every value of SubscriptionNewParamsEventTypes, see MatchSubscriptionNewParamsEventTypes.
+type SubscriptionNewParamsEventTypesMatchFuncs[R any] struct {
+	DisputeUpdated                           func() R
+	DigitalWalletTokenizationApprovalRequest func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(SubscriptionNewParamsEventTypes) R
+}
+
+// MatchSubscriptionNewParamsEventTypes calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchSubscriptionNewParamsEventTypes[R any](v SubscriptionNewParamsEventTypes, m SubscriptionNewParamsEventTypesMatchFuncs[R]) R {
+	if m.DisputeUpdated == nil || m.DigitalWalletTokenizationApprovalRequest == nil || m.Unknown == nil {
+		panic("requests: MatchSubscriptionNewParamsEventTypes needs a function for every value")
+	}
+	switch v {
+	case SubscriptionNewParamsEventTypesDisputeUpdated:
+		return m.DisputeUpdated()
+	case SubscriptionNewParamsEventTypesDigitalWalletTokenizationApprovalRequest:
+		return m.DigitalWalletTokenizationApprovalRequest()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// SubscriptionUpdateParamsEventTypesMatchFuncs has a function for every value of SubscriptionUpdateParamsEventTypes, see MatchSubscriptionUpdateParamsEventTypes.
+type SubscriptionUpdateParamsEventTypesMatchFuncs[R any] struct {
+	DisputeUpdated                           func() R
+	DigitalWalletTokenizationApprovalRequest func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(SubscriptionUpdateParamsEventTypes) R
+}
+
+// MatchSubscriptionUpdateParamsEventTypes calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchSubscriptionUpdateParamsEventTypes[R any](v SubscriptionUpdateParamsEventTypes, m SubscriptionUpdateParamsEventTypesMatchFuncs[R]) R {
+	if m.DisputeUpdated == nil || m.DigitalWalletTokenizationApprovalRequest == nil || m.Unknown == nil {
+		panic("requests: MatchSubscriptionUpdateParamsEventTypes needs a function for every value")
+	}
+	switch v {
+	case SubscriptionUpdateParamsEventTypesDisputeUpdated:
+		return m.DisputeUpdated()
+	case SubscriptionUpdateParamsEventTypesDigitalWalletTokenizationApprovalRequest:
+		return m.DigitalWalletTokenizationApprovalRequest()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ThreeDSChallengeResponseParamsChallengeResponseMatchFuncs has a function for every value of ThreeDSChallengeResponseParamsChallengeResponse, see MatchThreeDSChallengeResponseParamsChallengeResponse.
+type ThreeDSChallengeResponseParamsChallengeResponseMatchFuncs[R any] struct {
+	Approve           func() R
+	DeclineByCustomer func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ThreeDSChallengeResponseParamsChallengeResponse) R
+}
+
+// MatchThreeDSChallengeResponseParamsChallengeResponse calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchThreeDSChallengeResponseParamsChallengeResponse[R any](v ThreeDSChallengeResponseParamsChallengeResponse, m ThreeDSChallengeResponseParamsChallengeResponseMatchFuncs[R]) R {
+	if m.Approve == nil || m.DeclineByCustomer == nil || m.Unknown == nil {
+		panic("requests: MatchThreeDSChallengeResponseParamsChallengeResponse needs a function for every value")
+	}
+	switch v {
+	case ThreeDSChallengeResponseParamsChallengeResponseApprove:
+		return m.Approve()
+	case ThreeDSChallengeResponseParamsChallengeResponseDeclineByCustomer:
+		return m.DeclineByCustomer()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TokenizationListParamsTokenizationChannelMatchFuncs has a function for every value of TokenizationListParamsTokenizationChannel, see MatchTokenizationListParamsTokenizationChannel.
+type TokenizationListParamsTokenizationChannelMatchFuncs[R any] struct {
+	DigitalWallet func() R
+	Merchant      func() R
+	All           func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TokenizationListParamsTokenizationChannel) R
+}
+
+// MatchTokenizationListParamsTokenizationChannel calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTokenizationListParamsTokenizationChannel[R any](v TokenizationListParamsTokenizationChannel, m TokenizationListParamsTokenizationChannelMatchFuncs[R]) R {
+	if m.DigitalWallet == nil || m.Merchant == nil || m.All == nil || m.Unknown == nil {
+		panic("requests: MatchTokenizationListParamsTokenizationChannel needs a function for every value")
+	}
+	switch v {
+	case TokenizationListParamsTokenizationChannelDigitalWallet:
+		return m.DigitalWallet()
+	case TokenizationListParamsTokenizationChannelMerchant:
+		return m.Merchant()
+	case TokenizationListParamsTokenizationChannelAll:
+		return m.All()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TransactionListParamsResultMatchFuncs has a function for every value of TransactionListParamsResult, see MatchTransactionListParamsResult.
+type TransactionListParamsResultMatchFuncs[R any] struct {
+	Approved func() R
+	Declined func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TransactionListParamsResult) R
+}
+
+// MatchTransactionListParamsResult calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTransactionListParamsResult[R any](v TransactionListParamsResult, m TransactionListParamsResultMatchFuncs[R]) R {
+	if m.Approved == nil || m.Declined == nil || m.Unknown == nil {
+		panic("requests: MatchTransactionListParamsResult needs a function for every value")
+	}
+	switch v {
+	case TransactionListParamsResultApproved:
+		return m.Approved()
+	case TransactionListParamsResultDeclined:
+		return m.Declined()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TransactionListParamsStatusMatchFuncs has a function for every value of TransactionListParamsStatus, see MatchTransactionListParamsStatus.
+type TransactionListParamsStatusMatchFuncs[R any] struct {
+	Bounced  func() R
+	Declined func() R
+	Expired  func() R
+	Pending  func() R
+	Settled  func() R
+	Settling func() R
+	Voided   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TransactionListParamsStatus) R
+}
+
+// MatchTransactionListParamsStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTransactionListParamsStatus[R any](v TransactionListParamsStatus, m TransactionListParamsStatusMatchFuncs[R]) R {
+	if m.Bounced == nil || m.Declined == nil || m.Expired == nil || m.Pending == nil || m.Settled == nil || m.Settling == nil || m.Voided == nil || m.Unknown == nil {
+		panic("requests: MatchTransactionListParamsStatus needs a function for every value")
+	}
+	switch v {
+	case TransactionListParamsStatusBounced:
+		return m.Bounced()
+	case TransactionListParamsStatusDeclined:
+		return m.Declined()
+	case TransactionListParamsStatusExpired:
+		return m.Expired()
+	case TransactionListParamsStatusPending:
+		return m.Pending()
+	case TransactionListParamsStatusSettled:
+		return m.Settled()
+	case TransactionListParamsStatusSettling:
+		return m.Settling()
+	case TransactionListParamsStatusVoided:
+		return m.Voided()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TransactionSimulateAuthorizationParamsStatusMatchFuncs has a function for every value of TransactionSimulateAuthorizationParamsStatus, see MatchTransactionSimulateAuthorizationParamsStatus.
+type TransactionSimulateAuthorizationParamsStatusMatchFuncs[R any] struct {
+	Authorization                func() R
+	BalanceInquiry               func() R
+	CreditAuthorization          func() R
+	FinancialAuthorization       func() R
+	FinancialCreditAuthorization func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TransactionSimulateAuthorizationParamsStatus) R
+}
+
+// MatchTransactionSimulateAuthorizationParamsStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTransactionSimulateAuthorizationParamsStatus[R any](v TransactionSimulateAuthorizationParamsStatus, m TransactionSimulateAuthorizationParamsStatusMatchFuncs[R]) R {
+	if m.Authorization == nil || m.BalanceInquiry == nil || m.CreditAuthorization == nil || m.FinancialAuthorization == nil || m.FinancialCreditAuthorization == nil || m.Unknown == nil {
+		panic("requests: MatchTransactionSimulateAuthorizationParamsStatus needs a function for every value")
+	}
+	switch v {
+	case TransactionSimulateAuthorizationParamsStatusAuthorization:
+		return m.Authorization()
+	case TransactionSimulateAuthorizationParamsStatusBalanceInquiry:
+		return m.BalanceInquiry()
+	case TransactionSimulateAuthorizationParamsStatusCreditAuthorization:
+		return m.CreditAuthorization()
+	case TransactionSimulateAuthorizationParamsStatusFinancialAuthorization:
+		return m.FinancialAuthorization()
+	case TransactionSimulateAuthorizationParamsStatusFinancialCreditAuthorization:
+		return m.FinancialCreditAuthorization()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TransactionSimulateVoidParamsTypeMatchFuncs has a function for every value of TransactionSimulateVoidParamsType, see MatchTransactionSimulateVoidParamsType.
+type TransactionSimulateVoidParamsTypeMatchFuncs[R any] struct {
+	AuthorizationExpiry   func() R
+	AuthorizationReversal func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TransactionSimulateVoidParamsType) R
+}
+
+// MatchTransactionSimulateVoidParamsType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTransactionSimulateVoidParamsType[R any](v TransactionSimulateVoidParamsType, m TransactionSimulateVoidParamsTypeMatchFuncs[R]) R {
+	if m.AuthorizationExpiry == nil || m.AuthorizationReversal == nil || m.Unknown == nil {
+		panic("requests: MatchTransactionSimulateVoidParamsType needs a function for every value")
+	}
+	switch v {
+	case TransactionSimulateVoidParamsTypeAuthorizationExpiry:
+		return m.AuthorizationExpiry()
+	case TransactionSimulateVoidParamsTypeAuthorizationReversal:
+		return m.AuthorizationReversal()
+	default:
+		return m.Unknown(v)
+	}
+}
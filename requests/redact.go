@@ -0,0 +1,118 @@
+package requests
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/redact"
+)
+
+var fieldLike = reflect.TypeOf((*fields.FieldLike)(nil)).Elem()
+
+// format formats params for a String method, as &Name{Field:value ...}, with
+// the values of the fields that redact.Default() matches redacted. Fields are
+// matched by their path in the request, such as "shipping_address.address1".
+func format(params interface{}) string {
+	return formatParams(reflect.ValueOf(params), nil, redact.Default())
+}
+
+func formatParams(v reflect.Value, segments []string, policy *redact.Policy) string {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "<nil>"
+		}
+		v = v.Elem()
+	}
+	t := v.Type()
+	var b strings.Builder
+	b.WriteString("&" + t.Name() + "{")
+	first := true
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		var value string
+		switch {
+		case !field.IsExported():
+			continue
+		case field.Type.Implements(fieldLike):
+			value = formatField(v.Field(i), append(segments[:len(segments):len(segments)], fieldName(field)), policy)
+		case field.Type.Kind() == reflect.Pointer && isParams(field.Type.Elem()):
+			// The variants of a union are serialized in place of the union.
+			value = formatParams(v.Field(i), segments, policy)
+		default:
+			continue
+		}
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		b.WriteString(field.Name + ":" + value)
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// formatField formats a fields.Field, redacting its value if policy matches the
+// path of its segments.
+func formatField(f reflect.Value, segments []string, policy *redact.Policy) string {
+	value := f.FieldByName("Value")
+	if !f.FieldByName("Present").Bool() || f.FieldByName("Null").Bool() {
+		return formatValue(f, value, segments, policy)
+	}
+	if policy.Matches(strings.Join(segments, ".")) {
+		if raw := f.FieldByName("Raw"); !raw.IsNil() {
+			return fmt.Sprintf("%#v", policy.Value(fmt.Sprint(raw.Interface())))
+		}
+		if value.Kind() == reflect.String {
+			return fmt.Sprintf("%#v", policy.Value(value.String()))
+		}
+		return fmt.Sprintf("%#v", policy.Value(fmt.Sprint(value.Interface())))
+	}
+	return formatValue(f, value, segments, policy)
+}
+
+func formatValue(f reflect.Value, value reflect.Value, segments []string, policy *redact.Policy) string {
+	if f.FieldByName("Null").Bool() {
+		return "null"
+	}
+	switch {
+	case isParams(value.Type()):
+		return formatParams(value, segments, policy)
+	case value.Kind() == reflect.Slice && isParams(value.Type().Elem()) && !value.IsNil():
+		elements := make([]string, value.Len())
+		for i := range elements {
+			elements[i] = formatParams(value.Index(i), append(segments[:len(segments):len(segments)], "#"), policy)
+		}
+		return "[" + strings.Join(elements, " ") + "]"
+	}
+	return fmt.Sprint(f.Interface())
+}
+
+// isParams reports whether t is a struct of fields.Field, such as
+// ShippingAddress.
+func isParams(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Type.Implements(fieldLike) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldName returns the name of a field in the request, from its json or query
+// tag.
+func fieldName(field reflect.StructField) string {
+	tag, ok := field.Tag.Lookup("json")
+	if !ok {
+		tag = field.Tag.Get("query")
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/redact"
+)
+
+func TestStringRedactsPolicyFields(t *testing.T) {
+	params := CardNewParams{
+		Memo:            fields.F("rent"),
+		Pin:             fields.F("1234"),
+		ShippingAddress: fields.F(ShippingAddress{Address1: fields.F("1 Main St"), City: fields.F("Springfield")}),
+	}
+	if s := params.String(); strings.Contains(s, "1234") || !strings.Contains(s, `Memo:"rent"`) || !strings.Contains(s, `Address1:"1 Main St"`) {
+		t.Fatalf("expected only the pin to be redacted by default, got %s", s)
+	}
+
+	redact.SetDefault(redact.Default().With("memo", "shipping_address.address1"))
+	defer redact.SetDefault(nil)
+	s := params.String()
+	if strings.Contains(s, "rent") || strings.Contains(s, "1 Main St") || strings.Contains(s, "1234") {
+		t.Fatalf("expected the fields of the policy to be redacted, got %s", s)
+	}
+	if !strings.Contains(s, `City:"Springfield"`) || !strings.Contains(s, `Memo:"[REDACTED]"`) {
+		t.Fatalf("expected the other fields to be formatted, got %s", s)
+	}
+
+	kyc := AccountHolderNewParams{KYC: &KYC{Individual: fields.F(Individual{Dob: fields.F("1990-01-01"), FirstName: fields.F("Ada")})}}
+	if s := kyc.String(); strings.Contains(s, "1990-01-01") || !strings.Contains(s, `FirstName:"Ada"`) {
+		t.Fatalf("expected the fields of union variants to be redacted, got %s", s)
+	}
+}
@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"net/url"
+
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+type ReportSettlementListDetailsParams struct {
+	// Page size (for pagination).
+	PageSize fields.Field[int64] `query:"page_size"`
+	// The unique identifier of the last item in the previous page. Used to retrieve
+	// the next page.
+	StartingAfter fields.Field[string] `query:"starting_after"`
+	// The unique identifier of the first item in the previous page. Used to retrieve
+	// the previous page.
+	EndingBefore fields.Field[string] `query:"ending_before"`
+}
+
+// URLQuery serializes ReportSettlementListDetailsParams into a url.Values of the
+// query parameters associated with this value
+func (r *ReportSettlementListDetailsParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r ReportSettlementListDetailsParams) String() (result string) {
+	return format(r)
+}
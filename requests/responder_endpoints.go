@@ -0,0 +1,67 @@
+package requests
+
+import (
+	"net/url"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+// ResponderEndpointType is the kind of decisioning that a responder endpoint
+// answers.
+type ResponderEndpointType string
+
+const (
+	ResponderEndpointTypeAuthStreamAccess        ResponderEndpointType = "AUTH_STREAM_ACCESS"
+	ResponderEndpointTypeThreeDSDecisioning      ResponderEndpointType = "THREE_DS_DECISIONING"
+	ResponderEndpointTypeTokenizationDecisioning ResponderEndpointType = "TOKENIZATION_DECISIONING"
+)
+
+type ResponderEndpointNewParams struct {
+	// The type of the endpoint.
+	Type fields.Field[ResponderEndpointType] `json:"type,required"`
+	// The URL for the responder endpoint (must be http(s)).
+	URL fields.Field[string] `json:"url,required" format:"uri"`
+}
+
+// MarshalJSON serializes ResponderEndpointNewParams into an array of bytes using
+// the gjson library. Members of the `jsonFields` field are serialized into the
+// top-level, and will overwrite known members of the same name.
+func (r *ResponderEndpointNewParams) MarshalJSON() (data []byte, err error) {
+	return pjson.MarshalRoot(r)
+}
+
+func (r ResponderEndpointNewParams) String() (result string) {
+	return format(r)
+}
+
+type ResponderEndpointDeleteParams struct {
+	// The type of the endpoint.
+	Type fields.Field[ResponderEndpointType] `query:"type"`
+}
+
+// URLQuery serializes ResponderEndpointDeleteParams into a url.Values of the
+// query parameters associated with this value
+func (r *ResponderEndpointDeleteParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r ResponderEndpointDeleteParams) String() (result string) {
+	return format(r)
+}
+
+type ResponderEndpointCheckStatusParams struct {
+	// The type of the endpoint.
+	Type fields.Field[ResponderEndpointType] `query:"type"`
+}
+
+// URLQuery serializes ResponderEndpointCheckStatusParams into a url.Values of
+// the query parameters associated with this value
+func (r *ResponderEndpointCheckStatusParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r ResponderEndpointCheckStatusParams) String() (result string) {
+	return format(r)
+}
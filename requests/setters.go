@@ -0,0 +1,2496 @@
+// Code generated by gensetters. DO NOT EDIT.
+
+package requests
+
+//go:generate go run ./internal/gensetters
+
+import (
+	"time"
+
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+// NewAccountHolderNewWebhookParams returns an empty AccountHolderNewWebhookParams, to be populated with its setters.
+func NewAccountHolderNewWebhookParams() *AccountHolderNewWebhookParams {
+	return &AccountHolderNewWebhookParams{}
+}
+
+// SetURL sets the URL field of AccountHolderNewWebhookParams.
+func (r *AccountHolderNewWebhookParams) SetURL(value string) *AccountHolderNewWebhookParams {
+	r.URL = fields.F(value)
+	return r
+}
+
+// NewAccountHolderResubmitParams returns an empty AccountHolderResubmitParams, to be populated with its setters.
+func NewAccountHolderResubmitParams() *AccountHolderResubmitParams {
+	return &AccountHolderResubmitParams{}
+}
+
+// SetWorkflow sets the Workflow field of AccountHolderResubmitParams.
+func (r *AccountHolderResubmitParams) SetWorkflow(value AccountHolderResubmitParamsWorkflow) *AccountHolderResubmitParams {
+	r.Workflow = fields.F(value)
+	return r
+}
+
+// SetTosTimestamp sets the TosTimestamp field of AccountHolderResubmitParams.
+func (r *AccountHolderResubmitParams) SetTosTimestamp(value string) *AccountHolderResubmitParams {
+	r.TosTimestamp = fields.F(value)
+	return r
+}
+
+// SetIndividual sets the Individual field of AccountHolderResubmitParams.
+func (r *AccountHolderResubmitParams) SetIndividual(value Individual) *AccountHolderResubmitParams {
+	r.Individual = fields.F(value)
+	return r
+}
+
+// NewAccountHolderUpdateParams returns an empty AccountHolderUpdateParams, to be populated with its setters.
+func NewAccountHolderUpdateParams() *AccountHolderUpdateParams {
+	return &AccountHolderUpdateParams{}
+}
+
+// SetEmail sets the Email field of AccountHolderUpdateParams.
+func (r *AccountHolderUpdateParams) SetEmail(value string) *AccountHolderUpdateParams {
+	r.Email = fields.F(value)
+	return r
+}
+
+// SetPhoneNumber sets the PhoneNumber field of AccountHolderUpdateParams.
+func (r *AccountHolderUpdateParams) SetPhoneNumber(value string) *AccountHolderUpdateParams {
+	r.PhoneNumber = fields.F(value)
+	return r
+}
+
+// SetBusinessAccountToken sets the BusinessAccountToken field of AccountHolderUpdateParams.
+func (r *AccountHolderUpdateParams) SetBusinessAccountToken(value string) *AccountHolderUpdateParams {
+	r.BusinessAccountToken = fields.F(value)
+	return r
+}
+
+// NewAccountHolderUploadDocumentImageParams returns an empty AccountHolderUploadDocumentImageParams, to be populated with its setters.
+func NewAccountHolderUploadDocumentImageParams() *AccountHolderUploadDocumentImageParams {
+	return &AccountHolderUploadDocumentImageParams{}
+}
+
+// SetFilename sets the Filename field of AccountHolderUploadDocumentImageParams.
+func (r *AccountHolderUploadDocumentImageParams) SetFilename(value string) *AccountHolderUploadDocumentImageParams {
+	r.Filename = fields.F(value)
+	return r
+}
+
+// NewAccountHolderUploadDocumentParams returns an empty AccountHolderUploadDocumentParams, to be populated with its setters.
+func NewAccountHolderUploadDocumentParams() *AccountHolderUploadDocumentParams {
+	return &AccountHolderUploadDocumentParams{}
+}
+
+// SetDocumentType sets the DocumentType field of AccountHolderUploadDocumentParams.
+func (r *AccountHolderUploadDocumentParams) SetDocumentType(value AccountHolderUploadDocumentParamsDocumentType) *AccountHolderUploadDocumentParams {
+	r.DocumentType = fields.F(value)
+	return r
+}
+
+// SetEntityToken sets the EntityToken field of AccountHolderUploadDocumentParams.
+func (r *AccountHolderUploadDocumentParams) SetEntityToken(value string) *AccountHolderUploadDocumentParams {
+	r.EntityToken = fields.F(value)
+	return r
+}
+
+// NewAccountListParams returns an empty AccountListParams, to be populated with its setters.
+func NewAccountListParams() *AccountListParams {
+	return &AccountListParams{}
+}
+
+// SetBegin sets the Begin field of AccountListParams.
+func (r *AccountListParams) SetBegin(value time.Time) *AccountListParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of AccountListParams.
+func (r *AccountListParams) SetEnd(value time.Time) *AccountListParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetPage sets the Page field of AccountListParams.
+func (r *AccountListParams) SetPage(value int64) *AccountListParams {
+	r.Page = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of AccountListParams.
+func (r *AccountListParams) SetPageSize(value int64) *AccountListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// NewAccountUpdateParams returns an empty AccountUpdateParams, to be populated with its setters.
+func NewAccountUpdateParams() *AccountUpdateParams {
+	return &AccountUpdateParams{}
+}
+
+// SetDailySpendLimit sets the DailySpendLimit field of AccountUpdateParams.
+func (r *AccountUpdateParams) SetDailySpendLimit(value int64) *AccountUpdateParams {
+	r.DailySpendLimit = fields.F(value)
+	return r
+}
+
+// SetLifetimeSpendLimit sets the LifetimeSpendLimit field of AccountUpdateParams.
+func (r *AccountUpdateParams) SetLifetimeSpendLimit(value int64) *AccountUpdateParams {
+	r.LifetimeSpendLimit = fields.F(value)
+	return r
+}
+
+// SetMonthlySpendLimit sets the MonthlySpendLimit field of AccountUpdateParams.
+func (r *AccountUpdateParams) SetMonthlySpendLimit(value int64) *AccountUpdateParams {
+	r.MonthlySpendLimit = fields.F(value)
+	return r
+}
+
+// SetVerificationAddress sets the VerificationAddress field of AccountUpdateParams.
+func (r *AccountUpdateParams) SetVerificationAddress(value AccountUpdateParamsVerificationAddress) *AccountUpdateParams {
+	r.VerificationAddress = fields.F(value)
+	return r
+}
+
+// SetState sets the State field of AccountUpdateParams.
+func (r *AccountUpdateParams) SetState(value AccountUpdateParamsState) *AccountUpdateParams {
+	r.State = fields.F(value)
+	return r
+}
+
+// NewAccountUpdateParamsVerificationAddress returns an empty AccountUpdateParamsVerificationAddress, to be populated with its setters.
+func NewAccountUpdateParamsVerificationAddress() *AccountUpdateParamsVerificationAddress {
+	return &AccountUpdateParamsVerificationAddress{}
+}
+
+// SetAddress1 sets the Address1 field of AccountUpdateParamsVerificationAddress.
+func (r *AccountUpdateParamsVerificationAddress) SetAddress1(value string) *AccountUpdateParamsVerificationAddress {
+	r.Address1 = fields.F(value)
+	return r
+}
+
+// SetAddress2 sets the Address2 field of AccountUpdateParamsVerificationAddress.
+func (r *AccountUpdateParamsVerificationAddress) SetAddress2(value string) *AccountUpdateParamsVerificationAddress {
+	r.Address2 = fields.F(value)
+	return r
+}
+
+// SetCity sets the City field of AccountUpdateParamsVerificationAddress.
+func (r *AccountUpdateParamsVerificationAddress) SetCity(value string) *AccountUpdateParamsVerificationAddress {
+	r.City = fields.F(value)
+	return r
+}
+
+// SetState sets the State field of AccountUpdateParamsVerificationAddress.
+func (r *AccountUpdateParamsVerificationAddress) SetState(value string) *AccountUpdateParamsVerificationAddress {
+	r.State = fields.F(value)
+	return r
+}
+
+// SetPostalCode sets the PostalCode field of AccountUpdateParamsVerificationAddress.
+func (r *AccountUpdateParamsVerificationAddress) SetPostalCode(value string) *AccountUpdateParamsVerificationAddress {
+	r.PostalCode = fields.F(value)
+	return r
+}
+
+// SetCountry sets the Country field of AccountUpdateParamsVerificationAddress.
+func (r *AccountUpdateParamsVerificationAddress) SetCountry(value string) *AccountUpdateParamsVerificationAddress {
+	r.Country = fields.F(value)
+	return r
+}
+
+// NewAddress returns an empty Address, to be populated with its setters.
+func NewAddress() *Address {
+	return &Address{}
+}
+
+// SetAddress1 sets the Address1 field of Address.
+func (r *Address) SetAddress1(value string) *Address {
+	r.Address1 = fields.F(value)
+	return r
+}
+
+// SetAddress2 sets the Address2 field of Address.
+func (r *Address) SetAddress2(value string) *Address {
+	r.Address2 = fields.F(value)
+	return r
+}
+
+// SetCity sets the City field of Address.
+func (r *Address) SetCity(value string) *Address {
+	r.City = fields.F(value)
+	return r
+}
+
+// SetCountry sets the Country field of Address.
+func (r *Address) SetCountry(value string) *Address {
+	r.Country = fields.F(value)
+	return r
+}
+
+// SetPostalCode sets the PostalCode field of Address.
+func (r *Address) SetPostalCode(value string) *Address {
+	r.PostalCode = fields.F(value)
+	return r
+}
+
+// SetState sets the State field of Address.
+func (r *Address) SetState(value string) *Address {
+	r.State = fields.F(value)
+	return r
+}
+
+// NewAggregateBalanceListParams returns an empty AggregateBalanceListParams, to be populated with its setters.
+func NewAggregateBalanceListParams() *AggregateBalanceListParams {
+	return &AggregateBalanceListParams{}
+}
+
+// SetFinancialAccountType sets the FinancialAccountType field of AggregateBalanceListParams.
+func (r *AggregateBalanceListParams) SetFinancialAccountType(value AggregateBalanceListParamsFinancialAccountType) *AggregateBalanceListParams {
+	r.FinancialAccountType = fields.F(value)
+	return r
+}
+
+// NewAuthRuleApplyParams returns an empty AuthRuleApplyParams, to be populated with its setters.
+func NewAuthRuleApplyParams() *AuthRuleApplyParams {
+	return &AuthRuleApplyParams{}
+}
+
+// SetCardTokens sets the CardTokens field of AuthRuleApplyParams.
+func (r *AuthRuleApplyParams) SetCardTokens(value []string) *AuthRuleApplyParams {
+	r.CardTokens = fields.F(value)
+	return r
+}
+
+// SetAccountTokens sets the AccountTokens field of AuthRuleApplyParams.
+func (r *AuthRuleApplyParams) SetAccountTokens(value []string) *AuthRuleApplyParams {
+	r.AccountTokens = fields.F(value)
+	return r
+}
+
+// SetProgramLevel sets the ProgramLevel field of AuthRuleApplyParams.
+func (r *AuthRuleApplyParams) SetProgramLevel(value bool) *AuthRuleApplyParams {
+	r.ProgramLevel = fields.F(value)
+	return r
+}
+
+// NewAuthRuleListParams returns an empty AuthRuleListParams, to be populated with its setters.
+func NewAuthRuleListParams() *AuthRuleListParams {
+	return &AuthRuleListParams{}
+}
+
+// SetPage sets the Page field of AuthRuleListParams.
+func (r *AuthRuleListParams) SetPage(value int64) *AuthRuleListParams {
+	r.Page = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of AuthRuleListParams.
+func (r *AuthRuleListParams) SetPageSize(value int64) *AuthRuleListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// NewAuthRuleRemoveParams returns an empty AuthRuleRemoveParams, to be populated with its setters.
+func NewAuthRuleRemoveParams() *AuthRuleRemoveParams {
+	return &AuthRuleRemoveParams{}
+}
+
+// SetCardTokens sets the CardTokens field of AuthRuleRemoveParams.
+func (r *AuthRuleRemoveParams) SetCardTokens(value []string) *AuthRuleRemoveParams {
+	r.CardTokens = fields.F(value)
+	return r
+}
+
+// SetAccountTokens sets the AccountTokens field of AuthRuleRemoveParams.
+func (r *AuthRuleRemoveParams) SetAccountTokens(value []string) *AuthRuleRemoveParams {
+	r.AccountTokens = fields.F(value)
+	return r
+}
+
+// SetProgramLevel sets the ProgramLevel field of AuthRuleRemoveParams.
+func (r *AuthRuleRemoveParams) SetProgramLevel(value bool) *AuthRuleRemoveParams {
+	r.ProgramLevel = fields.F(value)
+	return r
+}
+
+// NewAuthRuleRequest returns an empty AuthRuleRequest, to be populated with its setters.
+func NewAuthRuleRequest() *AuthRuleRequest {
+	return &AuthRuleRequest{}
+}
+
+// SetAllowedMcc sets the AllowedMcc field of AuthRuleRequest.
+func (r *AuthRuleRequest) SetAllowedMcc(value []string) *AuthRuleRequest {
+	r.AllowedMcc = fields.F(value)
+	return r
+}
+
+// SetBlockedMcc sets the BlockedMcc field of AuthRuleRequest.
+func (r *AuthRuleRequest) SetBlockedMcc(value []string) *AuthRuleRequest {
+	r.BlockedMcc = fields.F(value)
+	return r
+}
+
+// SetAllowedCountries sets the AllowedCountries field of AuthRuleRequest.
+func (r *AuthRuleRequest) SetAllowedCountries(value []string) *AuthRuleRequest {
+	r.AllowedCountries = fields.F(value)
+	return r
+}
+
+// SetBlockedCountries sets the BlockedCountries field of AuthRuleRequest.
+func (r *AuthRuleRequest) SetBlockedCountries(value []string) *AuthRuleRequest {
+	r.BlockedCountries = fields.F(value)
+	return r
+}
+
+// SetAvsType sets the AvsType field of AuthRuleRequest.
+func (r *AuthRuleRequest) SetAvsType(value AuthRuleRequestAvsType) *AuthRuleRequest {
+	r.AvsType = fields.F(value)
+	return r
+}
+
+// SetAccountTokens sets the AccountTokens field of AuthRuleRequest.
+func (r *AuthRuleRequest) SetAccountTokens(value []string) *AuthRuleRequest {
+	r.AccountTokens = fields.F(value)
+	return r
+}
+
+// SetCardTokens sets the CardTokens field of AuthRuleRequest.
+func (r *AuthRuleRequest) SetCardTokens(value []string) *AuthRuleRequest {
+	r.CardTokens = fields.F(value)
+	return r
+}
+
+// SetProgramLevel sets the ProgramLevel field of AuthRuleRequest.
+func (r *AuthRuleRequest) SetProgramLevel(value bool) *AuthRuleRequest {
+	r.ProgramLevel = fields.F(value)
+	return r
+}
+
+// NewAuthRuleUpdateParams returns an empty AuthRuleUpdateParams, to be populated with its setters.
+func NewAuthRuleUpdateParams() *AuthRuleUpdateParams {
+	return &AuthRuleUpdateParams{}
+}
+
+// SetAllowedMcc sets the AllowedMcc field of AuthRuleUpdateParams.
+func (r *AuthRuleUpdateParams) SetAllowedMcc(value []string) *AuthRuleUpdateParams {
+	r.AllowedMcc = fields.F(value)
+	return r
+}
+
+// SetBlockedMcc sets the BlockedMcc field of AuthRuleUpdateParams.
+func (r *AuthRuleUpdateParams) SetBlockedMcc(value []string) *AuthRuleUpdateParams {
+	r.BlockedMcc = fields.F(value)
+	return r
+}
+
+// SetAllowedCountries sets the AllowedCountries field of AuthRuleUpdateParams.
+func (r *AuthRuleUpdateParams) SetAllowedCountries(value []string) *AuthRuleUpdateParams {
+	r.AllowedCountries = fields.F(value)
+	return r
+}
+
+// SetBlockedCountries sets the BlockedCountries field of AuthRuleUpdateParams.
+func (r *AuthRuleUpdateParams) SetBlockedCountries(value []string) *AuthRuleUpdateParams {
+	r.BlockedCountries = fields.F(value)
+	return r
+}
+
+// SetAvsType sets the AvsType field of AuthRuleUpdateParams.
+func (r *AuthRuleUpdateParams) SetAvsType(value AuthRuleUpdateParamsAvsType) *AuthRuleUpdateParams {
+	r.AvsType = fields.F(value)
+	return r
+}
+
+// NewAuthStreamEnrollmentEnrollParams returns an empty AuthStreamEnrollmentEnrollParams, to be populated with its setters.
+func NewAuthStreamEnrollmentEnrollParams() *AuthStreamEnrollmentEnrollParams {
+	return &AuthStreamEnrollmentEnrollParams{}
+}
+
+// SetWebhookURL sets the WebhookURL field of AuthStreamEnrollmentEnrollParams.
+func (r *AuthStreamEnrollmentEnrollParams) SetWebhookURL(value string) *AuthStreamEnrollmentEnrollParams {
+	r.WebhookURL = fields.F(value)
+	return r
+}
+
+// NewBalanceListParams returns an empty BalanceListParams, to be populated with its setters.
+func NewBalanceListParams() *BalanceListParams {
+	return &BalanceListParams{}
+}
+
+// SetAccountToken sets the AccountToken field of BalanceListParams.
+func (r *BalanceListParams) SetAccountToken(value string) *BalanceListParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetBalanceDate sets the BalanceDate field of BalanceListParams.
+func (r *BalanceListParams) SetBalanceDate(value time.Time) *BalanceListParams {
+	r.BalanceDate = fields.F(value)
+	return r
+}
+
+// SetFinancialAccountType sets the FinancialAccountType field of BalanceListParams.
+func (r *BalanceListParams) SetFinancialAccountType(value BalanceListParamsFinancialAccountType) *BalanceListParams {
+	r.FinancialAccountType = fields.F(value)
+	return r
+}
+
+// NewBank returns an empty Bank, to be populated with its setters.
+func NewBank() *Bank {
+	return &Bank{}
+}
+
+// SetValidationMethod sets the ValidationMethod field of Bank.
+func (r *Bank) SetValidationMethod(value BankValidationMethod) *Bank {
+	r.ValidationMethod = fields.F(value)
+	return r
+}
+
+// SetAccountName sets the AccountName field of Bank.
+func (r *Bank) SetAccountName(value string) *Bank {
+	r.AccountName = fields.F(value)
+	return r
+}
+
+// SetAccountNumber sets the AccountNumber field of Bank.
+func (r *Bank) SetAccountNumber(value string) *Bank {
+	r.AccountNumber = fields.F(value)
+	return r
+}
+
+// SetAccountToken sets the AccountToken field of Bank.
+func (r *Bank) SetAccountToken(value string) *Bank {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetRoutingNumber sets the RoutingNumber field of Bank.
+func (r *Bank) SetRoutingNumber(value string) *Bank {
+	r.RoutingNumber = fields.F(value)
+	return r
+}
+
+// NewBookTransferListParams returns an empty BookTransferListParams, to be populated with its setters.
+func NewBookTransferListParams() *BookTransferListParams {
+	return &BookTransferListParams{}
+}
+
+// SetAccountToken sets the AccountToken field of BookTransferListParams.
+func (r *BookTransferListParams) SetAccountToken(value string) *BookTransferListParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetBusinessAccountToken sets the BusinessAccountToken field of BookTransferListParams.
+func (r *BookTransferListParams) SetBusinessAccountToken(value string) *BookTransferListParams {
+	r.BusinessAccountToken = fields.F(value)
+	return r
+}
+
+// SetCategory sets the Category field of BookTransferListParams.
+func (r *BookTransferListParams) SetCategory(value BookTransferCategory) *BookTransferListParams {
+	r.Category = fields.F(value)
+	return r
+}
+
+// SetFinancialAccountToken sets the FinancialAccountToken field of BookTransferListParams.
+func (r *BookTransferListParams) SetFinancialAccountToken(value string) *BookTransferListParams {
+	r.FinancialAccountToken = fields.F(value)
+	return r
+}
+
+// SetResult sets the Result field of BookTransferListParams.
+func (r *BookTransferListParams) SetResult(value BookTransferListParamsResult) *BookTransferListParams {
+	r.Result = fields.F(value)
+	return r
+}
+
+// SetStatus sets the Status field of BookTransferListParams.
+func (r *BookTransferListParams) SetStatus(value BookTransferListParamsStatus) *BookTransferListParams {
+	r.Status = fields.F(value)
+	return r
+}
+
+// SetBegin sets the Begin field of BookTransferListParams.
+func (r *BookTransferListParams) SetBegin(value time.Time) *BookTransferListParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of BookTransferListParams.
+func (r *BookTransferListParams) SetEnd(value time.Time) *BookTransferListParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of BookTransferListParams.
+func (r *BookTransferListParams) SetPageSize(value int64) *BookTransferListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of BookTransferListParams.
+func (r *BookTransferListParams) SetStartingAfter(value string) *BookTransferListParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of BookTransferListParams.
+func (r *BookTransferListParams) SetEndingBefore(value string) *BookTransferListParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// NewBookTransferNewParams returns an empty BookTransferNewParams, to be populated with its setters.
+func NewBookTransferNewParams() *BookTransferNewParams {
+	return &BookTransferNewParams{}
+}
+
+// SetAmount sets the Amount field of BookTransferNewParams.
+func (r *BookTransferNewParams) SetAmount(value int64) *BookTransferNewParams {
+	r.Amount = fields.F(value)
+	return r
+}
+
+// SetCategory sets the Category field of BookTransferNewParams.
+func (r *BookTransferNewParams) SetCategory(value BookTransferCategory) *BookTransferNewParams {
+	r.Category = fields.F(value)
+	return r
+}
+
+// SetFromFinancialAccountToken sets the FromFinancialAccountToken field of BookTransferNewParams.
+func (r *BookTransferNewParams) SetFromFinancialAccountToken(value string) *BookTransferNewParams {
+	r.FromFinancialAccountToken = fields.F(value)
+	return r
+}
+
+// SetToFinancialAccountToken sets the ToFinancialAccountToken field of BookTransferNewParams.
+func (r *BookTransferNewParams) SetToFinancialAccountToken(value string) *BookTransferNewParams {
+	r.ToFinancialAccountToken = fields.F(value)
+	return r
+}
+
+// SetSubtype sets the Subtype field of BookTransferNewParams.
+func (r *BookTransferNewParams) SetSubtype(value string) *BookTransferNewParams {
+	r.Subtype = fields.F(value)
+	return r
+}
+
+// SetType sets the Type field of BookTransferNewParams.
+func (r *BookTransferNewParams) SetType(value BookTransferType) *BookTransferNewParams {
+	r.Type = fields.F(value)
+	return r
+}
+
+// SetToken sets the Token field of BookTransferNewParams.
+func (r *BookTransferNewParams) SetToken(value string) *BookTransferNewParams {
+	r.Token = fields.F(value)
+	return r
+}
+
+// SetMemo sets the Memo field of BookTransferNewParams.
+func (r *BookTransferNewParams) SetMemo(value string) *BookTransferNewParams {
+	r.Memo = fields.F(value)
+	return r
+}
+
+// NewBookTransferReverseParams returns an empty BookTransferReverseParams, to be populated with its setters.
+func NewBookTransferReverseParams() *BookTransferReverseParams {
+	return &BookTransferReverseParams{}
+}
+
+// SetMemo sets the Memo field of BookTransferReverseParams.
+func (r *BookTransferReverseParams) SetMemo(value string) *BookTransferReverseParams {
+	r.Memo = fields.F(value)
+	return r
+}
+
+// NewBusinessEntity returns an empty BusinessEntity, to be populated with its setters.
+func NewBusinessEntity() *BusinessEntity {
+	return &BusinessEntity{}
+}
+
+// SetAddress sets the Address field of BusinessEntity.
+func (r *BusinessEntity) SetAddress(value Address) *BusinessEntity {
+	r.Address = fields.F(value)
+	return r
+}
+
+// SetDbaBusinessName sets the DbaBusinessName field of BusinessEntity.
+func (r *BusinessEntity) SetDbaBusinessName(value string) *BusinessEntity {
+	r.DbaBusinessName = fields.F(value)
+	return r
+}
+
+// SetGovernmentID sets the GovernmentID field of BusinessEntity.
+func (r *BusinessEntity) SetGovernmentID(value string) *BusinessEntity {
+	r.GovernmentID = fields.F(value)
+	return r
+}
+
+// SetLegalBusinessName sets the LegalBusinessName field of BusinessEntity.
+func (r *BusinessEntity) SetLegalBusinessName(value string) *BusinessEntity {
+	r.LegalBusinessName = fields.F(value)
+	return r
+}
+
+// SetParentCompany sets the ParentCompany field of BusinessEntity.
+func (r *BusinessEntity) SetParentCompany(value string) *BusinessEntity {
+	r.ParentCompany = fields.F(value)
+	return r
+}
+
+// SetPhoneNumbers sets the PhoneNumbers field of BusinessEntity.
+func (r *BusinessEntity) SetPhoneNumbers(value []string) *BusinessEntity {
+	r.PhoneNumbers = fields.F(value)
+	return r
+}
+
+// NewCardEmbedParams returns an empty CardEmbedParams, to be populated with its setters.
+func NewCardEmbedParams() *CardEmbedParams {
+	return &CardEmbedParams{}
+}
+
+// SetEmbedRequest sets the EmbedRequest field of CardEmbedParams.
+func (r *CardEmbedParams) SetEmbedRequest(value string) *CardEmbedParams {
+	r.EmbedRequest = fields.F(value)
+	return r
+}
+
+// SetHmac sets the Hmac field of CardEmbedParams.
+func (r *CardEmbedParams) SetHmac(value string) *CardEmbedParams {
+	r.Hmac = fields.F(value)
+	return r
+}
+
+// NewCardListParams returns an empty CardListParams, to be populated with its setters.
+func NewCardListParams() *CardListParams {
+	return &CardListParams{}
+}
+
+// SetAccountToken sets the AccountToken field of CardListParams.
+func (r *CardListParams) SetAccountToken(value string) *CardListParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetBegin sets the Begin field of CardListParams.
+func (r *CardListParams) SetBegin(value time.Time) *CardListParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of CardListParams.
+func (r *CardListParams) SetEnd(value time.Time) *CardListParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetPage sets the Page field of CardListParams.
+func (r *CardListParams) SetPage(value int64) *CardListParams {
+	r.Page = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of CardListParams.
+func (r *CardListParams) SetPageSize(value int64) *CardListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// NewCardNewParams returns an empty CardNewParams, to be populated with its setters.
+func NewCardNewParams() *CardNewParams {
+	return &CardNewParams{}
+}
+
+// SetAccountToken sets the AccountToken field of CardNewParams.
+func (r *CardNewParams) SetAccountToken(value string) *CardNewParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetCardProgramToken sets the CardProgramToken field of CardNewParams.
+func (r *CardNewParams) SetCardProgramToken(value string) *CardNewParams {
+	r.CardProgramToken = fields.F(value)
+	return r
+}
+
+// SetExpMonth sets the ExpMonth field of CardNewParams.
+func (r *CardNewParams) SetExpMonth(value string) *CardNewParams {
+	r.ExpMonth = fields.F(value)
+	return r
+}
+
+// SetExpYear sets the ExpYear field of CardNewParams.
+func (r *CardNewParams) SetExpYear(value string) *CardNewParams {
+	r.ExpYear = fields.F(value)
+	return r
+}
+
+// SetFundingToken sets the FundingToken field of CardNewParams.
+func (r *CardNewParams) SetFundingToken(value string) *CardNewParams {
+	r.FundingToken = fields.F(value)
+	return r
+}
+
+// SetMemo sets the Memo field of CardNewParams.
+func (r *CardNewParams) SetMemo(value string) *CardNewParams {
+	r.Memo = fields.F(value)
+	return r
+}
+
+// SetSpendLimit sets the SpendLimit field of CardNewParams.
+func (r *CardNewParams) SetSpendLimit(value int64) *CardNewParams {
+	r.SpendLimit = fields.F(value)
+	return r
+}
+
+// SetSpendLimitDuration sets the SpendLimitDuration field of CardNewParams.
+func (r *CardNewParams) SetSpendLimitDuration(value SpendLimitDuration) *CardNewParams {
+	r.SpendLimitDuration = fields.F(value)
+	return r
+}
+
+// SetState sets the State field of CardNewParams.
+func (r *CardNewParams) SetState(value CardNewParamsState) *CardNewParams {
+	r.State = fields.F(value)
+	return r
+}
+
+// SetType sets the Type field of CardNewParams.
+func (r *CardNewParams) SetType(value CardNewParamsType) *CardNewParams {
+	r.Type = fields.F(value)
+	return r
+}
+
+// SetPin sets the Pin field of CardNewParams.
+func (r *CardNewParams) SetPin(value string) *CardNewParams {
+	r.Pin = fields.F(value)
+	return r
+}
+
+// SetDigitalCardArtToken sets the DigitalCardArtToken field of CardNewParams.
+func (r *CardNewParams) SetDigitalCardArtToken(value string) *CardNewParams {
+	r.DigitalCardArtToken = fields.F(value)
+	return r
+}
+
+// SetProductID sets the ProductID field of CardNewParams.
+func (r *CardNewParams) SetProductID(value string) *CardNewParams {
+	r.ProductID = fields.F(value)
+	return r
+}
+
+// SetShippingAddress sets the ShippingAddress field of CardNewParams.
+func (r *CardNewParams) SetShippingAddress(value ShippingAddress) *CardNewParams {
+	r.ShippingAddress = fields.F(value)
+	return r
+}
+
+// SetShippingMethod sets the ShippingMethod field of CardNewParams.
+func (r *CardNewParams) SetShippingMethod(value CardNewParamsShippingMethod) *CardNewParams {
+	r.ShippingMethod = fields.F(value)
+	return r
+}
+
+// NewCardProvisionParams returns an empty CardProvisionParams, to be populated with its setters.
+func NewCardProvisionParams() *CardProvisionParams {
+	return &CardProvisionParams{}
+}
+
+// SetDigitalWallet sets the DigitalWallet field of CardProvisionParams.
+func (r *CardProvisionParams) SetDigitalWallet(value CardProvisionParamsDigitalWallet) *CardProvisionParams {
+	r.DigitalWallet = fields.F(value)
+	return r
+}
+
+// SetNonce sets the Nonce field of CardProvisionParams.
+func (r *CardProvisionParams) SetNonce(value string) *CardProvisionParams {
+	r.Nonce = fields.F(value)
+	return r
+}
+
+// SetNonceSignature sets the NonceSignature field of CardProvisionParams.
+func (r *CardProvisionParams) SetNonceSignature(value string) *CardProvisionParams {
+	r.NonceSignature = fields.F(value)
+	return r
+}
+
+// SetCertificate sets the Certificate field of CardProvisionParams.
+func (r *CardProvisionParams) SetCertificate(value string) *CardProvisionParams {
+	r.Certificate = fields.F(value)
+	return r
+}
+
+// NewCardReissueParams returns an empty CardReissueParams, to be populated with its setters.
+func NewCardReissueParams() *CardReissueParams {
+	return &CardReissueParams{}
+}
+
+// SetShippingAddress sets the ShippingAddress field of CardReissueParams.
+func (r *CardReissueParams) SetShippingAddress(value ShippingAddress) *CardReissueParams {
+	r.ShippingAddress = fields.F(value)
+	return r
+}
+
+// SetShippingMethod sets the ShippingMethod field of CardReissueParams.
+func (r *CardReissueParams) SetShippingMethod(value CardReissueParamsShippingMethod) *CardReissueParams {
+	r.ShippingMethod = fields.F(value)
+	return r
+}
+
+// SetProductID sets the ProductID field of CardReissueParams.
+func (r *CardReissueParams) SetProductID(value string) *CardReissueParams {
+	r.ProductID = fields.F(value)
+	return r
+}
+
+// NewCardSearchByPANParams returns an empty CardSearchByPANParams, to be populated with its setters.
+func NewCardSearchByPANParams() *CardSearchByPANParams {
+	return &CardSearchByPANParams{}
+}
+
+// SetPan sets the Pan field of CardSearchByPANParams.
+func (r *CardSearchByPANParams) SetPan(value string) *CardSearchByPANParams {
+	r.Pan = fields.F(value)
+	return r
+}
+
+// NewCardTemplate returns an empty CardTemplate, to be populated with its setters.
+func NewCardTemplate() *CardTemplate {
+	return &CardTemplate{}
+}
+
+// SetAccountToken sets the AccountToken field of CardTemplate.
+func (r *CardTemplate) SetAccountToken(value string) *CardTemplate {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetCardProgramToken sets the CardProgramToken field of CardTemplate.
+func (r *CardTemplate) SetCardProgramToken(value string) *CardTemplate {
+	r.CardProgramToken = fields.F(value)
+	return r
+}
+
+// SetDigitalCardArtToken sets the DigitalCardArtToken field of CardTemplate.
+func (r *CardTemplate) SetDigitalCardArtToken(value string) *CardTemplate {
+	r.DigitalCardArtToken = fields.F(value)
+	return r
+}
+
+// SetFundingToken sets the FundingToken field of CardTemplate.
+func (r *CardTemplate) SetFundingToken(value string) *CardTemplate {
+	r.FundingToken = fields.F(value)
+	return r
+}
+
+// SetProductID sets the ProductID field of CardTemplate.
+func (r *CardTemplate) SetProductID(value string) *CardTemplate {
+	r.ProductID = fields.F(value)
+	return r
+}
+
+// SetShippingAddress sets the ShippingAddress field of CardTemplate.
+func (r *CardTemplate) SetShippingAddress(value ShippingAddress) *CardTemplate {
+	r.ShippingAddress = fields.F(value)
+	return r
+}
+
+// SetShippingMethod sets the ShippingMethod field of CardTemplate.
+func (r *CardTemplate) SetShippingMethod(value CardNewParamsShippingMethod) *CardTemplate {
+	r.ShippingMethod = fields.F(value)
+	return r
+}
+
+// SetSpendLimit sets the SpendLimit field of CardTemplate.
+func (r *CardTemplate) SetSpendLimit(value int64) *CardTemplate {
+	r.SpendLimit = fields.F(value)
+	return r
+}
+
+// SetSpendLimitDuration sets the SpendLimitDuration field of CardTemplate.
+func (r *CardTemplate) SetSpendLimitDuration(value SpendLimitDuration) *CardTemplate {
+	r.SpendLimitDuration = fields.F(value)
+	return r
+}
+
+// SetState sets the State field of CardTemplate.
+func (r *CardTemplate) SetState(value CardNewParamsState) *CardTemplate {
+	r.State = fields.F(value)
+	return r
+}
+
+// SetType sets the Type field of CardTemplate.
+func (r *CardTemplate) SetType(value CardNewParamsType) *CardTemplate {
+	r.Type = fields.F(value)
+	return r
+}
+
+// NewCardUpdateParams returns an empty CardUpdateParams, to be populated with its setters.
+func NewCardUpdateParams() *CardUpdateParams {
+	return &CardUpdateParams{}
+}
+
+// SetFundingToken sets the FundingToken field of CardUpdateParams.
+func (r *CardUpdateParams) SetFundingToken(value string) *CardUpdateParams {
+	r.FundingToken = fields.F(value)
+	return r
+}
+
+// SetMemo sets the Memo field of CardUpdateParams.
+func (r *CardUpdateParams) SetMemo(value string) *CardUpdateParams {
+	r.Memo = fields.F(value)
+	return r
+}
+
+// SetSpendLimit sets the SpendLimit field of CardUpdateParams.
+func (r *CardUpdateParams) SetSpendLimit(value int64) *CardUpdateParams {
+	r.SpendLimit = fields.F(value)
+	return r
+}
+
+// SetSpendLimitDuration sets the SpendLimitDuration field of CardUpdateParams.
+func (r *CardUpdateParams) SetSpendLimitDuration(value SpendLimitDuration) *CardUpdateParams {
+	r.SpendLimitDuration = fields.F(value)
+	return r
+}
+
+// SetAuthRuleToken sets the AuthRuleToken field of CardUpdateParams.
+func (r *CardUpdateParams) SetAuthRuleToken(value string) *CardUpdateParams {
+	r.AuthRuleToken = fields.F(value)
+	return r
+}
+
+// SetState sets the State field of CardUpdateParams.
+func (r *CardUpdateParams) SetState(value CardUpdateParamsState) *CardUpdateParams {
+	r.State = fields.F(value)
+	return r
+}
+
+// SetPin sets the Pin field of CardUpdateParams.
+func (r *CardUpdateParams) SetPin(value string) *CardUpdateParams {
+	r.Pin = fields.F(value)
+	return r
+}
+
+// SetDigitalCardArtToken sets the DigitalCardArtToken field of CardUpdateParams.
+func (r *CardUpdateParams) SetDigitalCardArtToken(value string) *CardUpdateParams {
+	r.DigitalCardArtToken = fields.F(value)
+	return r
+}
+
+// NewDisputeListEvidencesParams returns an empty DisputeListEvidencesParams, to be populated with its setters.
+func NewDisputeListEvidencesParams() *DisputeListEvidencesParams {
+	return &DisputeListEvidencesParams{}
+}
+
+// SetPageSize sets the PageSize field of DisputeListEvidencesParams.
+func (r *DisputeListEvidencesParams) SetPageSize(value int64) *DisputeListEvidencesParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetBegin sets the Begin field of DisputeListEvidencesParams.
+func (r *DisputeListEvidencesParams) SetBegin(value time.Time) *DisputeListEvidencesParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of DisputeListEvidencesParams.
+func (r *DisputeListEvidencesParams) SetEnd(value time.Time) *DisputeListEvidencesParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of DisputeListEvidencesParams.
+func (r *DisputeListEvidencesParams) SetStartingAfter(value string) *DisputeListEvidencesParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of DisputeListEvidencesParams.
+func (r *DisputeListEvidencesParams) SetEndingBefore(value string) *DisputeListEvidencesParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// NewDisputeListParams returns an empty DisputeListParams, to be populated with its setters.
+func NewDisputeListParams() *DisputeListParams {
+	return &DisputeListParams{}
+}
+
+// SetTransactionToken sets the TransactionToken field of DisputeListParams.
+func (r *DisputeListParams) SetTransactionToken(value string) *DisputeListParams {
+	r.TransactionToken = fields.F(value)
+	return r
+}
+
+// SetStatus sets the Status field of DisputeListParams.
+func (r *DisputeListParams) SetStatus(value DisputeListParamsStatus) *DisputeListParams {
+	r.Status = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of DisputeListParams.
+func (r *DisputeListParams) SetPageSize(value int64) *DisputeListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetBegin sets the Begin field of DisputeListParams.
+func (r *DisputeListParams) SetBegin(value time.Time) *DisputeListParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of DisputeListParams.
+func (r *DisputeListParams) SetEnd(value time.Time) *DisputeListParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of DisputeListParams.
+func (r *DisputeListParams) SetStartingAfter(value string) *DisputeListParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of DisputeListParams.
+func (r *DisputeListParams) SetEndingBefore(value string) *DisputeListParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// NewDisputeNewParams returns an empty DisputeNewParams, to be populated with its setters.
+func NewDisputeNewParams() *DisputeNewParams {
+	return &DisputeNewParams{}
+}
+
+// SetAmount sets the Amount field of DisputeNewParams.
+func (r *DisputeNewParams) SetAmount(value int64) *DisputeNewParams {
+	r.Amount = fields.F(value)
+	return r
+}
+
+// SetCustomerFiledDate sets the CustomerFiledDate field of DisputeNewParams.
+func (r *DisputeNewParams) SetCustomerFiledDate(value time.Time) *DisputeNewParams {
+	r.CustomerFiledDate = fields.F(value)
+	return r
+}
+
+// SetReason sets the Reason field of DisputeNewParams.
+func (r *DisputeNewParams) SetReason(value DisputeNewParamsReason) *DisputeNewParams {
+	r.Reason = fields.F(value)
+	return r
+}
+
+// SetTransactionToken sets the TransactionToken field of DisputeNewParams.
+func (r *DisputeNewParams) SetTransactionToken(value string) *DisputeNewParams {
+	r.TransactionToken = fields.F(value)
+	return r
+}
+
+// SetCustomerNote sets the CustomerNote field of DisputeNewParams.
+func (r *DisputeNewParams) SetCustomerNote(value string) *DisputeNewParams {
+	r.CustomerNote = fields.F(value)
+	return r
+}
+
+// NewDisputeUpdateParams returns an empty DisputeUpdateParams, to be populated with its setters.
+func NewDisputeUpdateParams() *DisputeUpdateParams {
+	return &DisputeUpdateParams{}
+}
+
+// SetAmount sets the Amount field of DisputeUpdateParams.
+func (r *DisputeUpdateParams) SetAmount(value int64) *DisputeUpdateParams {
+	r.Amount = fields.F(value)
+	return r
+}
+
+// SetCustomerFiledDate sets the CustomerFiledDate field of DisputeUpdateParams.
+func (r *DisputeUpdateParams) SetCustomerFiledDate(value time.Time) *DisputeUpdateParams {
+	r.CustomerFiledDate = fields.F(value)
+	return r
+}
+
+// SetCustomerNote sets the CustomerNote field of DisputeUpdateParams.
+func (r *DisputeUpdateParams) SetCustomerNote(value string) *DisputeUpdateParams {
+	r.CustomerNote = fields.F(value)
+	return r
+}
+
+// SetReason sets the Reason field of DisputeUpdateParams.
+func (r *DisputeUpdateParams) SetReason(value DisputeUpdateParamsReason) *DisputeUpdateParams {
+	r.Reason = fields.F(value)
+	return r
+}
+
+// NewEmbedRequestParams returns an empty EmbedRequestParams, to be populated with its setters.
+func NewEmbedRequestParams() *EmbedRequestParams {
+	return &EmbedRequestParams{}
+}
+
+// SetCss sets the Css field of EmbedRequestParams.
+func (r *EmbedRequestParams) SetCss(value string) *EmbedRequestParams {
+	r.Css = fields.F(value)
+	return r
+}
+
+// SetExpiration sets the Expiration field of EmbedRequestParams.
+func (r *EmbedRequestParams) SetExpiration(value time.Time) *EmbedRequestParams {
+	r.Expiration = fields.F(value)
+	return r
+}
+
+// SetToken sets the Token field of EmbedRequestParams.
+func (r *EmbedRequestParams) SetToken(value string) *EmbedRequestParams {
+	r.Token = fields.F(value)
+	return r
+}
+
+// SetTargetOrigin sets the TargetOrigin field of EmbedRequestParams.
+func (r *EmbedRequestParams) SetTargetOrigin(value string) *EmbedRequestParams {
+	r.TargetOrigin = fields.F(value)
+	return r
+}
+
+// NewEventListAttemptsParams returns an empty EventListAttemptsParams, to be populated with its setters.
+func NewEventListAttemptsParams() *EventListAttemptsParams {
+	return &EventListAttemptsParams{}
+}
+
+// SetBegin sets the Begin field of EventListAttemptsParams.
+func (r *EventListAttemptsParams) SetBegin(value time.Time) *EventListAttemptsParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of EventListAttemptsParams.
+func (r *EventListAttemptsParams) SetEnd(value time.Time) *EventListAttemptsParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of EventListAttemptsParams.
+func (r *EventListAttemptsParams) SetPageSize(value int64) *EventListAttemptsParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of EventListAttemptsParams.
+func (r *EventListAttemptsParams) SetStartingAfter(value string) *EventListAttemptsParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of EventListAttemptsParams.
+func (r *EventListAttemptsParams) SetEndingBefore(value string) *EventListAttemptsParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// SetStatus sets the Status field of EventListAttemptsParams.
+func (r *EventListAttemptsParams) SetStatus(value EventListAttemptsParamsStatus) *EventListAttemptsParams {
+	r.Status = fields.F(value)
+	return r
+}
+
+// NewEventListParams returns an empty EventListParams, to be populated with its setters.
+func NewEventListParams() *EventListParams {
+	return &EventListParams{}
+}
+
+// SetBegin sets the Begin field of EventListParams.
+func (r *EventListParams) SetBegin(value time.Time) *EventListParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of EventListParams.
+func (r *EventListParams) SetEnd(value time.Time) *EventListParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of EventListParams.
+func (r *EventListParams) SetPageSize(value int64) *EventListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of EventListParams.
+func (r *EventListParams) SetStartingAfter(value string) *EventListParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of EventListParams.
+func (r *EventListParams) SetEndingBefore(value string) *EventListParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// SetEventTypes sets the EventTypes field of EventListParams.
+func (r *EventListParams) SetEventTypes(value []EventListParamsEventTypes) *EventListParams {
+	r.EventTypes = fields.F(value)
+	return r
+}
+
+// NewExternalBankAccountListParams returns an empty ExternalBankAccountListParams, to be populated with its setters.
+func NewExternalBankAccountListParams() *ExternalBankAccountListParams {
+	return &ExternalBankAccountListParams{}
+}
+
+// SetAccountToken sets the AccountToken field of ExternalBankAccountListParams.
+func (r *ExternalBankAccountListParams) SetAccountToken(value string) *ExternalBankAccountListParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetAccountTypes sets the AccountTypes field of ExternalBankAccountListParams.
+func (r *ExternalBankAccountListParams) SetAccountTypes(value []ExternalBankAccountType) *ExternalBankAccountListParams {
+	r.AccountTypes = fields.F(value)
+	return r
+}
+
+// SetCountries sets the Countries field of ExternalBankAccountListParams.
+func (r *ExternalBankAccountListParams) SetCountries(value []string) *ExternalBankAccountListParams {
+	r.Countries = fields.F(value)
+	return r
+}
+
+// SetOwnerTypes sets the OwnerTypes field of ExternalBankAccountListParams.
+func (r *ExternalBankAccountListParams) SetOwnerTypes(value []ExternalBankAccountOwnerType) *ExternalBankAccountListParams {
+	r.OwnerTypes = fields.F(value)
+	return r
+}
+
+// SetStates sets the States field of ExternalBankAccountListParams.
+func (r *ExternalBankAccountListParams) SetStates(value []ExternalBankAccountListParamsState) *ExternalBankAccountListParams {
+	r.States = fields.F(value)
+	return r
+}
+
+// SetVerificationStates sets the VerificationStates field of ExternalBankAccountListParams.
+func (r *ExternalBankAccountListParams) SetVerificationStates(value []ExternalBankAccountListParamsVerificationState) *ExternalBankAccountListParams {
+	r.VerificationStates = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of ExternalBankAccountListParams.
+func (r *ExternalBankAccountListParams) SetPageSize(value int64) *ExternalBankAccountListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of ExternalBankAccountListParams.
+func (r *ExternalBankAccountListParams) SetStartingAfter(value string) *ExternalBankAccountListParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of ExternalBankAccountListParams.
+func (r *ExternalBankAccountListParams) SetEndingBefore(value string) *ExternalBankAccountListParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// NewExternalBankAccountMicroDepositNewParams returns an empty ExternalBankAccountMicroDepositNewParams, to be populated with its setters.
+func NewExternalBankAccountMicroDepositNewParams() *ExternalBankAccountMicroDepositNewParams {
+	return &ExternalBankAccountMicroDepositNewParams{}
+}
+
+// SetMicroDeposits sets the MicroDeposits field of ExternalBankAccountMicroDepositNewParams.
+func (r *ExternalBankAccountMicroDepositNewParams) SetMicroDeposits(value []int64) *ExternalBankAccountMicroDepositNewParams {
+	r.MicroDeposits = fields.F(value)
+	return r
+}
+
+// NewExternalBankAccountNewParams returns an empty ExternalBankAccountNewParams, to be populated with its setters.
+func NewExternalBankAccountNewParams() *ExternalBankAccountNewParams {
+	return &ExternalBankAccountNewParams{}
+}
+
+// SetVerificationMethod sets the VerificationMethod field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetVerificationMethod(value ExternalBankAccountVerificationMethod) *ExternalBankAccountNewParams {
+	r.VerificationMethod = fields.F(value)
+	return r
+}
+
+// SetOwnerType sets the OwnerType field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetOwnerType(value ExternalBankAccountOwnerType) *ExternalBankAccountNewParams {
+	r.OwnerType = fields.F(value)
+	return r
+}
+
+// SetOwner sets the Owner field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetOwner(value string) *ExternalBankAccountNewParams {
+	r.Owner = fields.F(value)
+	return r
+}
+
+// SetType sets the Type field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetType(value ExternalBankAccountType) *ExternalBankAccountNewParams {
+	r.Type = fields.F(value)
+	return r
+}
+
+// SetAccountNumber sets the AccountNumber field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetAccountNumber(value string) *ExternalBankAccountNewParams {
+	r.AccountNumber = fields.F(value)
+	return r
+}
+
+// SetRoutingNumber sets the RoutingNumber field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetRoutingNumber(value string) *ExternalBankAccountNewParams {
+	r.RoutingNumber = fields.F(value)
+	return r
+}
+
+// SetCountry sets the Country field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetCountry(value string) *ExternalBankAccountNewParams {
+	r.Country = fields.F(value)
+	return r
+}
+
+// SetCurrency sets the Currency field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetCurrency(value string) *ExternalBankAccountNewParams {
+	r.Currency = fields.F(value)
+	return r
+}
+
+// SetFinancialAccountToken sets the FinancialAccountToken field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetFinancialAccountToken(value string) *ExternalBankAccountNewParams {
+	r.FinancialAccountToken = fields.F(value)
+	return r
+}
+
+// SetAccountToken sets the AccountToken field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetAccountToken(value string) *ExternalBankAccountNewParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetAddress sets the Address field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetAddress(value Address) *ExternalBankAccountNewParams {
+	r.Address = fields.F(value)
+	return r
+}
+
+// SetCompanyID sets the CompanyID field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetCompanyID(value string) *ExternalBankAccountNewParams {
+	r.CompanyID = fields.F(value)
+	return r
+}
+
+// SetDob sets the Dob field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetDob(value string) *ExternalBankAccountNewParams {
+	r.Dob = fields.F(value)
+	return r
+}
+
+// SetDoingBusinessAs sets the DoingBusinessAs field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetDoingBusinessAs(value string) *ExternalBankAccountNewParams {
+	r.DoingBusinessAs = fields.F(value)
+	return r
+}
+
+// SetName sets the Name field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetName(value string) *ExternalBankAccountNewParams {
+	r.Name = fields.F(value)
+	return r
+}
+
+// SetUserDefinedID sets the UserDefinedID field of ExternalBankAccountNewParams.
+func (r *ExternalBankAccountNewParams) SetUserDefinedID(value string) *ExternalBankAccountNewParams {
+	r.UserDefinedID = fields.F(value)
+	return r
+}
+
+// NewExternalBankAccountRetryMicroDepositsParams returns an empty ExternalBankAccountRetryMicroDepositsParams, to be populated with its setters.
+func NewExternalBankAccountRetryMicroDepositsParams() *ExternalBankAccountRetryMicroDepositsParams {
+	return &ExternalBankAccountRetryMicroDepositsParams{}
+}
+
+// SetFinancialAccountToken sets the FinancialAccountToken field of ExternalBankAccountRetryMicroDepositsParams.
+func (r *ExternalBankAccountRetryMicroDepositsParams) SetFinancialAccountToken(value string) *ExternalBankAccountRetryMicroDepositsParams {
+	r.FinancialAccountToken = fields.F(value)
+	return r
+}
+
+// NewExternalBankAccountUpdateParams returns an empty ExternalBankAccountUpdateParams, to be populated with its setters.
+func NewExternalBankAccountUpdateParams() *ExternalBankAccountUpdateParams {
+	return &ExternalBankAccountUpdateParams{}
+}
+
+// SetOwner sets the Owner field of ExternalBankAccountUpdateParams.
+func (r *ExternalBankAccountUpdateParams) SetOwner(value string) *ExternalBankAccountUpdateParams {
+	r.Owner = fields.F(value)
+	return r
+}
+
+// SetOwnerType sets the OwnerType field of ExternalBankAccountUpdateParams.
+func (r *ExternalBankAccountUpdateParams) SetOwnerType(value ExternalBankAccountOwnerType) *ExternalBankAccountUpdateParams {
+	r.OwnerType = fields.F(value)
+	return r
+}
+
+// SetAddress sets the Address field of ExternalBankAccountUpdateParams.
+func (r *ExternalBankAccountUpdateParams) SetAddress(value Address) *ExternalBankAccountUpdateParams {
+	r.Address = fields.F(value)
+	return r
+}
+
+// SetCompanyID sets the CompanyID field of ExternalBankAccountUpdateParams.
+func (r *ExternalBankAccountUpdateParams) SetCompanyID(value string) *ExternalBankAccountUpdateParams {
+	r.CompanyID = fields.F(value)
+	return r
+}
+
+// SetDob sets the Dob field of ExternalBankAccountUpdateParams.
+func (r *ExternalBankAccountUpdateParams) SetDob(value string) *ExternalBankAccountUpdateParams {
+	r.Dob = fields.F(value)
+	return r
+}
+
+// SetDoingBusinessAs sets the DoingBusinessAs field of ExternalBankAccountUpdateParams.
+func (r *ExternalBankAccountUpdateParams) SetDoingBusinessAs(value string) *ExternalBankAccountUpdateParams {
+	r.DoingBusinessAs = fields.F(value)
+	return r
+}
+
+// SetName sets the Name field of ExternalBankAccountUpdateParams.
+func (r *ExternalBankAccountUpdateParams) SetName(value string) *ExternalBankAccountUpdateParams {
+	r.Name = fields.F(value)
+	return r
+}
+
+// SetUserDefinedID sets the UserDefinedID field of ExternalBankAccountUpdateParams.
+func (r *ExternalBankAccountUpdateParams) SetUserDefinedID(value string) *ExternalBankAccountUpdateParams {
+	r.UserDefinedID = fields.F(value)
+	return r
+}
+
+// NewFinancialAccountBalanceListParams returns an empty FinancialAccountBalanceListParams, to be populated with its setters.
+func NewFinancialAccountBalanceListParams() *FinancialAccountBalanceListParams {
+	return &FinancialAccountBalanceListParams{}
+}
+
+// SetBalanceDate sets the BalanceDate field of FinancialAccountBalanceListParams.
+func (r *FinancialAccountBalanceListParams) SetBalanceDate(value time.Time) *FinancialAccountBalanceListParams {
+	r.BalanceDate = fields.F(value)
+	return r
+}
+
+// SetLastTransactionEventToken sets the LastTransactionEventToken field of FinancialAccountBalanceListParams.
+func (r *FinancialAccountBalanceListParams) SetLastTransactionEventToken(value string) *FinancialAccountBalanceListParams {
+	r.LastTransactionEventToken = fields.F(value)
+	return r
+}
+
+// NewFinancialAccountListParams returns an empty FinancialAccountListParams, to be populated with its setters.
+func NewFinancialAccountListParams() *FinancialAccountListParams {
+	return &FinancialAccountListParams{}
+}
+
+// SetAccountToken sets the AccountToken field of FinancialAccountListParams.
+func (r *FinancialAccountListParams) SetAccountToken(value string) *FinancialAccountListParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetBusinessAccountToken sets the BusinessAccountToken field of FinancialAccountListParams.
+func (r *FinancialAccountListParams) SetBusinessAccountToken(value string) *FinancialAccountListParams {
+	r.BusinessAccountToken = fields.F(value)
+	return r
+}
+
+// SetType sets the Type field of FinancialAccountListParams.
+func (r *FinancialAccountListParams) SetType(value FinancialAccountListParamsType) *FinancialAccountListParams {
+	r.Type = fields.F(value)
+	return r
+}
+
+// NewFinancialTransactionListParams returns an empty FinancialTransactionListParams, to be populated with its setters.
+func NewFinancialTransactionListParams() *FinancialTransactionListParams {
+	return &FinancialTransactionListParams{}
+}
+
+// SetBegin sets the Begin field of FinancialTransactionListParams.
+func (r *FinancialTransactionListParams) SetBegin(value time.Time) *FinancialTransactionListParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetCategory sets the Category field of FinancialTransactionListParams.
+func (r *FinancialTransactionListParams) SetCategory(value FinancialTransactionListParamsCategory) *FinancialTransactionListParams {
+	r.Category = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of FinancialTransactionListParams.
+func (r *FinancialTransactionListParams) SetEnd(value time.Time) *FinancialTransactionListParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of FinancialTransactionListParams.
+func (r *FinancialTransactionListParams) SetEndingBefore(value string) *FinancialTransactionListParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// SetResult sets the Result field of FinancialTransactionListParams.
+func (r *FinancialTransactionListParams) SetResult(value FinancialTransactionListParamsResult) *FinancialTransactionListParams {
+	r.Result = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of FinancialTransactionListParams.
+func (r *FinancialTransactionListParams) SetStartingAfter(value string) *FinancialTransactionListParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetStatus sets the Status field of FinancialTransactionListParams.
+func (r *FinancialTransactionListParams) SetStatus(value FinancialTransactionListParamsStatus) *FinancialTransactionListParams {
+	r.Status = fields.F(value)
+	return r
+}
+
+// NewFundingSourceListParams returns an empty FundingSourceListParams, to be populated with its setters.
+func NewFundingSourceListParams() *FundingSourceListParams {
+	return &FundingSourceListParams{}
+}
+
+// SetAccountToken sets the AccountToken field of FundingSourceListParams.
+func (r *FundingSourceListParams) SetAccountToken(value string) *FundingSourceListParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetPage sets the Page field of FundingSourceListParams.
+func (r *FundingSourceListParams) SetPage(value int64) *FundingSourceListParams {
+	r.Page = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of FundingSourceListParams.
+func (r *FundingSourceListParams) SetPageSize(value int64) *FundingSourceListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// NewFundingSourceUpdateParams returns an empty FundingSourceUpdateParams, to be populated with its setters.
+func NewFundingSourceUpdateParams() *FundingSourceUpdateParams {
+	return &FundingSourceUpdateParams{}
+}
+
+// SetAccountToken sets the AccountToken field of FundingSourceUpdateParams.
+func (r *FundingSourceUpdateParams) SetAccountToken(value string) *FundingSourceUpdateParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetState sets the State field of FundingSourceUpdateParams.
+func (r *FundingSourceUpdateParams) SetState(value FundingSourceUpdateParamsState) *FundingSourceUpdateParams {
+	r.State = fields.F(value)
+	return r
+}
+
+// NewFundingSourceVerifyParams returns an empty FundingSourceVerifyParams, to be populated with its setters.
+func NewFundingSourceVerifyParams() *FundingSourceVerifyParams {
+	return &FundingSourceVerifyParams{}
+}
+
+// SetAccountToken sets the AccountToken field of FundingSourceVerifyParams.
+func (r *FundingSourceVerifyParams) SetAccountToken(value string) *FundingSourceVerifyParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetMicroDeposits sets the MicroDeposits field of FundingSourceVerifyParams.
+func (r *FundingSourceVerifyParams) SetMicroDeposits(value []int64) *FundingSourceVerifyParams {
+	r.MicroDeposits = fields.F(value)
+	return r
+}
+
+// NewIndividual returns an empty Individual, to be populated with its setters.
+func NewIndividual() *Individual {
+	return &Individual{}
+}
+
+// SetAddress sets the Address field of Individual.
+func (r *Individual) SetAddress(value Address) *Individual {
+	r.Address = fields.F(value)
+	return r
+}
+
+// SetDob sets the Dob field of Individual.
+func (r *Individual) SetDob(value string) *Individual {
+	r.Dob = fields.F(value)
+	return r
+}
+
+// SetEmail sets the Email field of Individual.
+func (r *Individual) SetEmail(value string) *Individual {
+	r.Email = fields.F(value)
+	return r
+}
+
+// SetFirstName sets the FirstName field of Individual.
+func (r *Individual) SetFirstName(value string) *Individual {
+	r.FirstName = fields.F(value)
+	return r
+}
+
+// SetGovernmentID sets the GovernmentID field of Individual.
+func (r *Individual) SetGovernmentID(value string) *Individual {
+	r.GovernmentID = fields.F(value)
+	return r
+}
+
+// SetLastName sets the LastName field of Individual.
+func (r *Individual) SetLastName(value string) *Individual {
+	r.LastName = fields.F(value)
+	return r
+}
+
+// SetPhoneNumber sets the PhoneNumber field of Individual.
+func (r *Individual) SetPhoneNumber(value string) *Individual {
+	r.PhoneNumber = fields.F(value)
+	return r
+}
+
+// NewKYB returns an empty KYB, to be populated with its setters.
+func NewKYB() *KYB {
+	return &KYB{}
+}
+
+// SetBusinessEntity sets the BusinessEntity field of KYB.
+func (r *KYB) SetBusinessEntity(value BusinessEntity) *KYB {
+	r.BusinessEntity = fields.F(value)
+	return r
+}
+
+// SetBeneficialOwnerEntities sets the BeneficialOwnerEntities field of KYB.
+func (r *KYB) SetBeneficialOwnerEntities(value []BusinessEntity) *KYB {
+	r.BeneficialOwnerEntities = fields.F(value)
+	return r
+}
+
+// SetBeneficialOwnerIndividuals sets the BeneficialOwnerIndividuals field of KYB.
+func (r *KYB) SetBeneficialOwnerIndividuals(value []Individual) *KYB {
+	r.BeneficialOwnerIndividuals = fields.F(value)
+	return r
+}
+
+// SetControlPerson sets the ControlPerson field of KYB.
+func (r *KYB) SetControlPerson(value Individual) *KYB {
+	r.ControlPerson = fields.F(value)
+	return r
+}
+
+// SetKYBPassedTimestamp sets the KYBPassedTimestamp field of KYB.
+func (r *KYB) SetKYBPassedTimestamp(value string) *KYB {
+	r.KYBPassedTimestamp = fields.F(value)
+	return r
+}
+
+// SetNatureOfBusiness sets the NatureOfBusiness field of KYB.
+func (r *KYB) SetNatureOfBusiness(value string) *KYB {
+	r.NatureOfBusiness = fields.F(value)
+	return r
+}
+
+// SetTosTimestamp sets the TosTimestamp field of KYB.
+func (r *KYB) SetTosTimestamp(value string) *KYB {
+	r.TosTimestamp = fields.F(value)
+	return r
+}
+
+// SetWebsiteURL sets the WebsiteURL field of KYB.
+func (r *KYB) SetWebsiteURL(value string) *KYB {
+	r.WebsiteURL = fields.F(value)
+	return r
+}
+
+// SetWorkflow sets the Workflow field of KYB.
+func (r *KYB) SetWorkflow(value KYBWorkflow) *KYB {
+	r.Workflow = fields.F(value)
+	return r
+}
+
+// NewKYC returns an empty KYC, to be populated with its setters.
+func NewKYC() *KYC {
+	return &KYC{}
+}
+
+// SetIndividual sets the Individual field of KYC.
+func (r *KYC) SetIndividual(value Individual) *KYC {
+	r.Individual = fields.F(value)
+	return r
+}
+
+// SetKYCPassedTimestamp sets the KYCPassedTimestamp field of KYC.
+func (r *KYC) SetKYCPassedTimestamp(value string) *KYC {
+	r.KYCPassedTimestamp = fields.F(value)
+	return r
+}
+
+// SetTosTimestamp sets the TosTimestamp field of KYC.
+func (r *KYC) SetTosTimestamp(value string) *KYC {
+	r.TosTimestamp = fields.F(value)
+	return r
+}
+
+// SetWorkflow sets the Workflow field of KYC.
+func (r *KYC) SetWorkflow(value KYCWorkflow) *KYC {
+	r.Workflow = fields.F(value)
+	return r
+}
+
+// NewKYCExempt returns an empty KYCExempt, to be populated with its setters.
+func NewKYCExempt() *KYCExempt {
+	return &KYCExempt{}
+}
+
+// SetWorkflow sets the Workflow field of KYCExempt.
+func (r *KYCExempt) SetWorkflow(value KYCExemptWorkflow) *KYCExempt {
+	r.Workflow = fields.F(value)
+	return r
+}
+
+// SetKYCExemptionType sets the KYCExemptionType field of KYCExempt.
+func (r *KYCExempt) SetKYCExemptionType(value KYCExemptKYCExemptionType) *KYCExempt {
+	r.KYCExemptionType = fields.F(value)
+	return r
+}
+
+// SetFirstName sets the FirstName field of KYCExempt.
+func (r *KYCExempt) SetFirstName(value string) *KYCExempt {
+	r.FirstName = fields.F(value)
+	return r
+}
+
+// SetLastName sets the LastName field of KYCExempt.
+func (r *KYCExempt) SetLastName(value string) *KYCExempt {
+	r.LastName = fields.F(value)
+	return r
+}
+
+// SetEmail sets the Email field of KYCExempt.
+func (r *KYCExempt) SetEmail(value string) *KYCExempt {
+	r.Email = fields.F(value)
+	return r
+}
+
+// SetPhoneNumber sets the PhoneNumber field of KYCExempt.
+func (r *KYCExempt) SetPhoneNumber(value string) *KYCExempt {
+	r.PhoneNumber = fields.F(value)
+	return r
+}
+
+// SetBusinessAccountToken sets the BusinessAccountToken field of KYCExempt.
+func (r *KYCExempt) SetBusinessAccountToken(value string) *KYCExempt {
+	r.BusinessAccountToken = fields.F(value)
+	return r
+}
+
+// SetAddress sets the Address field of KYCExempt.
+func (r *KYCExempt) SetAddress(value Address) *KYCExempt {
+	r.Address = fields.F(value)
+	return r
+}
+
+// NewPlaid returns an empty Plaid, to be populated with its setters.
+func NewPlaid() *Plaid {
+	return &Plaid{}
+}
+
+// SetValidationMethod sets the ValidationMethod field of Plaid.
+func (r *Plaid) SetValidationMethod(value PlaidValidationMethod) *Plaid {
+	r.ValidationMethod = fields.F(value)
+	return r
+}
+
+// SetAccountToken sets the AccountToken field of Plaid.
+func (r *Plaid) SetAccountToken(value string) *Plaid {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetProcessorToken sets the ProcessorToken field of Plaid.
+func (r *Plaid) SetProcessorToken(value string) *Plaid {
+	r.ProcessorToken = fields.F(value)
+	return r
+}
+
+// NewReportSettlementListDetailsParams returns an empty ReportSettlementListDetailsParams, to be populated with its setters.
+func NewReportSettlementListDetailsParams() *ReportSettlementListDetailsParams {
+	return &ReportSettlementListDetailsParams{}
+}
+
+// SetPageSize sets the PageSize field of ReportSettlementListDetailsParams.
+func (r *ReportSettlementListDetailsParams) SetPageSize(value int64) *ReportSettlementListDetailsParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of ReportSettlementListDetailsParams.
+func (r *ReportSettlementListDetailsParams) SetStartingAfter(value string) *ReportSettlementListDetailsParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of ReportSettlementListDetailsParams.
+func (r *ReportSettlementListDetailsParams) SetEndingBefore(value string) *ReportSettlementListDetailsParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// NewResponderEndpointCheckStatusParams returns an empty ResponderEndpointCheckStatusParams, to be populated with its setters.
+func NewResponderEndpointCheckStatusParams() *ResponderEndpointCheckStatusParams {
+	return &ResponderEndpointCheckStatusParams{}
+}
+
+// SetType sets the Type field of ResponderEndpointCheckStatusParams.
+func (r *ResponderEndpointCheckStatusParams) SetType(value ResponderEndpointType) *ResponderEndpointCheckStatusParams {
+	r.Type = fields.F(value)
+	return r
+}
+
+// NewResponderEndpointDeleteParams returns an empty ResponderEndpointDeleteParams, to be populated with its setters.
+func NewResponderEndpointDeleteParams() *ResponderEndpointDeleteParams {
+	return &ResponderEndpointDeleteParams{}
+}
+
+// SetType sets the Type field of ResponderEndpointDeleteParams.
+func (r *ResponderEndpointDeleteParams) SetType(value ResponderEndpointType) *ResponderEndpointDeleteParams {
+	r.Type = fields.F(value)
+	return r
+}
+
+// NewResponderEndpointNewParams returns an empty ResponderEndpointNewParams, to be populated with its setters.
+func NewResponderEndpointNewParams() *ResponderEndpointNewParams {
+	return &ResponderEndpointNewParams{}
+}
+
+// SetType sets the Type field of ResponderEndpointNewParams.
+func (r *ResponderEndpointNewParams) SetType(value ResponderEndpointType) *ResponderEndpointNewParams {
+	r.Type = fields.F(value)
+	return r
+}
+
+// SetURL sets the URL field of ResponderEndpointNewParams.
+func (r *ResponderEndpointNewParams) SetURL(value string) *ResponderEndpointNewParams {
+	r.URL = fields.F(value)
+	return r
+}
+
+// NewShippingAddress returns an empty ShippingAddress, to be populated with its setters.
+func NewShippingAddress() *ShippingAddress {
+	return &ShippingAddress{}
+}
+
+// SetFirstName sets the FirstName field of ShippingAddress.
+func (r *ShippingAddress) SetFirstName(value string) *ShippingAddress {
+	r.FirstName = fields.F(value)
+	return r
+}
+
+// SetLastName sets the LastName field of ShippingAddress.
+func (r *ShippingAddress) SetLastName(value string) *ShippingAddress {
+	r.LastName = fields.F(value)
+	return r
+}
+
+// SetLine2Text sets the Line2Text field of ShippingAddress.
+func (r *ShippingAddress) SetLine2Text(value string) *ShippingAddress {
+	r.Line2Text = fields.F(value)
+	return r
+}
+
+// SetAddress1 sets the Address1 field of ShippingAddress.
+func (r *ShippingAddress) SetAddress1(value string) *ShippingAddress {
+	r.Address1 = fields.F(value)
+	return r
+}
+
+// SetAddress2 sets the Address2 field of ShippingAddress.
+func (r *ShippingAddress) SetAddress2(value string) *ShippingAddress {
+	r.Address2 = fields.F(value)
+	return r
+}
+
+// SetCity sets the City field of ShippingAddress.
+func (r *ShippingAddress) SetCity(value string) *ShippingAddress {
+	r.City = fields.F(value)
+	return r
+}
+
+// SetState sets the State field of ShippingAddress.
+func (r *ShippingAddress) SetState(value string) *ShippingAddress {
+	r.State = fields.F(value)
+	return r
+}
+
+// SetPostalCode sets the PostalCode field of ShippingAddress.
+func (r *ShippingAddress) SetPostalCode(value string) *ShippingAddress {
+	r.PostalCode = fields.F(value)
+	return r
+}
+
+// SetCountry sets the Country field of ShippingAddress.
+func (r *ShippingAddress) SetCountry(value string) *ShippingAddress {
+	r.Country = fields.F(value)
+	return r
+}
+
+// SetEmail sets the Email field of ShippingAddress.
+func (r *ShippingAddress) SetEmail(value string) *ShippingAddress {
+	r.Email = fields.F(value)
+	return r
+}
+
+// SetPhoneNumber sets the PhoneNumber field of ShippingAddress.
+func (r *ShippingAddress) SetPhoneNumber(value string) *ShippingAddress {
+	r.PhoneNumber = fields.F(value)
+	return r
+}
+
+// NewStatementLineItemListParams returns an empty StatementLineItemListParams, to be populated with its setters.
+func NewStatementLineItemListParams() *StatementLineItemListParams {
+	return &StatementLineItemListParams{}
+}
+
+// SetPageSize sets the PageSize field of StatementLineItemListParams.
+func (r *StatementLineItemListParams) SetPageSize(value int64) *StatementLineItemListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of StatementLineItemListParams.
+func (r *StatementLineItemListParams) SetStartingAfter(value string) *StatementLineItemListParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of StatementLineItemListParams.
+func (r *StatementLineItemListParams) SetEndingBefore(value string) *StatementLineItemListParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// NewStatementListParams returns an empty StatementListParams, to be populated with its setters.
+func NewStatementListParams() *StatementListParams {
+	return &StatementListParams{}
+}
+
+// SetBegin sets the Begin field of StatementListParams.
+func (r *StatementListParams) SetBegin(value time.Time) *StatementListParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of StatementListParams.
+func (r *StatementListParams) SetEnd(value time.Time) *StatementListParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of StatementListParams.
+func (r *StatementListParams) SetPageSize(value int64) *StatementListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of StatementListParams.
+func (r *StatementListParams) SetStartingAfter(value string) *StatementListParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of StatementListParams.
+func (r *StatementListParams) SetEndingBefore(value string) *StatementListParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// NewSubscriptionListAttemptsParams returns an empty SubscriptionListAttemptsParams, to be populated with its setters.
+func NewSubscriptionListAttemptsParams() *SubscriptionListAttemptsParams {
+	return &SubscriptionListAttemptsParams{}
+}
+
+// SetBegin sets the Begin field of SubscriptionListAttemptsParams.
+func (r *SubscriptionListAttemptsParams) SetBegin(value time.Time) *SubscriptionListAttemptsParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of SubscriptionListAttemptsParams.
+func (r *SubscriptionListAttemptsParams) SetEnd(value time.Time) *SubscriptionListAttemptsParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of SubscriptionListAttemptsParams.
+func (r *SubscriptionListAttemptsParams) SetPageSize(value int64) *SubscriptionListAttemptsParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of SubscriptionListAttemptsParams.
+func (r *SubscriptionListAttemptsParams) SetStartingAfter(value string) *SubscriptionListAttemptsParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of SubscriptionListAttemptsParams.
+func (r *SubscriptionListAttemptsParams) SetEndingBefore(value string) *SubscriptionListAttemptsParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// SetStatus sets the Status field of SubscriptionListAttemptsParams.
+func (r *SubscriptionListAttemptsParams) SetStatus(value SubscriptionListAttemptsParamsStatus) *SubscriptionListAttemptsParams {
+	r.Status = fields.F(value)
+	return r
+}
+
+// NewSubscriptionListParams returns an empty SubscriptionListParams, to be populated with its setters.
+func NewSubscriptionListParams() *SubscriptionListParams {
+	return &SubscriptionListParams{}
+}
+
+// SetPageSize sets the PageSize field of SubscriptionListParams.
+func (r *SubscriptionListParams) SetPageSize(value int64) *SubscriptionListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of SubscriptionListParams.
+func (r *SubscriptionListParams) SetStartingAfter(value string) *SubscriptionListParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of SubscriptionListParams.
+func (r *SubscriptionListParams) SetEndingBefore(value string) *SubscriptionListParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// NewSubscriptionNewParams returns an empty SubscriptionNewParams, to be populated with its setters.
+func NewSubscriptionNewParams() *SubscriptionNewParams {
+	return &SubscriptionNewParams{}
+}
+
+// SetDescription sets the Description field of SubscriptionNewParams.
+func (r *SubscriptionNewParams) SetDescription(value string) *SubscriptionNewParams {
+	r.Description = fields.F(value)
+	return r
+}
+
+// SetDisabled sets the Disabled field of SubscriptionNewParams.
+func (r *SubscriptionNewParams) SetDisabled(value bool) *SubscriptionNewParams {
+	r.Disabled = fields.F(value)
+	return r
+}
+
+// SetEventTypes sets the EventTypes field of SubscriptionNewParams.
+func (r *SubscriptionNewParams) SetEventTypes(value []SubscriptionNewParamsEventTypes) *SubscriptionNewParams {
+	r.EventTypes = fields.F(value)
+	return r
+}
+
+// SetURL sets the URL field of SubscriptionNewParams.
+func (r *SubscriptionNewParams) SetURL(value string) *SubscriptionNewParams {
+	r.URL = fields.F(value)
+	return r
+}
+
+// NewSubscriptionRecoverParams returns an empty SubscriptionRecoverParams, to be populated with its setters.
+func NewSubscriptionRecoverParams() *SubscriptionRecoverParams {
+	return &SubscriptionRecoverParams{}
+}
+
+// SetBegin sets the Begin field of SubscriptionRecoverParams.
+func (r *SubscriptionRecoverParams) SetBegin(value time.Time) *SubscriptionRecoverParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of SubscriptionRecoverParams.
+func (r *SubscriptionRecoverParams) SetEnd(value time.Time) *SubscriptionRecoverParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// NewSubscriptionReplayMissingParams returns an empty SubscriptionReplayMissingParams, to be populated with its setters.
+func NewSubscriptionReplayMissingParams() *SubscriptionReplayMissingParams {
+	return &SubscriptionReplayMissingParams{}
+}
+
+// SetBegin sets the Begin field of SubscriptionReplayMissingParams.
+func (r *SubscriptionReplayMissingParams) SetBegin(value time.Time) *SubscriptionReplayMissingParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of SubscriptionReplayMissingParams.
+func (r *SubscriptionReplayMissingParams) SetEnd(value time.Time) *SubscriptionReplayMissingParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// NewSubscriptionUpdateParams returns an empty SubscriptionUpdateParams, to be populated with its setters.
+func NewSubscriptionUpdateParams() *SubscriptionUpdateParams {
+	return &SubscriptionUpdateParams{}
+}
+
+// SetDescription sets the Description field of SubscriptionUpdateParams.
+func (r *SubscriptionUpdateParams) SetDescription(value string) *SubscriptionUpdateParams {
+	r.Description = fields.F(value)
+	return r
+}
+
+// SetDisabled sets the Disabled field of SubscriptionUpdateParams.
+func (r *SubscriptionUpdateParams) SetDisabled(value bool) *SubscriptionUpdateParams {
+	r.Disabled = fields.F(value)
+	return r
+}
+
+// SetEventTypes sets the EventTypes field of SubscriptionUpdateParams.
+func (r *SubscriptionUpdateParams) SetEventTypes(value []SubscriptionUpdateParamsEventTypes) *SubscriptionUpdateParams {
+	r.EventTypes = fields.F(value)
+	return r
+}
+
+// SetURL sets the URL field of SubscriptionUpdateParams.
+func (r *SubscriptionUpdateParams) SetURL(value string) *SubscriptionUpdateParams {
+	r.URL = fields.F(value)
+	return r
+}
+
+// NewThreeDSChallengeResponseParams returns an empty ThreeDSChallengeResponseParams, to be populated with its setters.
+func NewThreeDSChallengeResponseParams() *ThreeDSChallengeResponseParams {
+	return &ThreeDSChallengeResponseParams{}
+}
+
+// SetToken sets the Token field of ThreeDSChallengeResponseParams.
+func (r *ThreeDSChallengeResponseParams) SetToken(value string) *ThreeDSChallengeResponseParams {
+	r.Token = fields.F(value)
+	return r
+}
+
+// SetChallengeResponse sets the ChallengeResponse field of ThreeDSChallengeResponseParams.
+func (r *ThreeDSChallengeResponseParams) SetChallengeResponse(value ThreeDSChallengeResponseParamsChallengeResponse) *ThreeDSChallengeResponseParams {
+	r.ChallengeResponse = fields.F(value)
+	return r
+}
+
+// NewTokenizationListParams returns an empty TokenizationListParams, to be populated with its setters.
+func NewTokenizationListParams() *TokenizationListParams {
+	return &TokenizationListParams{}
+}
+
+// SetAccountToken sets the AccountToken field of TokenizationListParams.
+func (r *TokenizationListParams) SetAccountToken(value string) *TokenizationListParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetCardToken sets the CardToken field of TokenizationListParams.
+func (r *TokenizationListParams) SetCardToken(value string) *TokenizationListParams {
+	r.CardToken = fields.F(value)
+	return r
+}
+
+// SetBegin sets the Begin field of TokenizationListParams.
+func (r *TokenizationListParams) SetBegin(value time.Time) *TokenizationListParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of TokenizationListParams.
+func (r *TokenizationListParams) SetEnd(value time.Time) *TokenizationListParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetTokenizationChannel sets the TokenizationChannel field of TokenizationListParams.
+func (r *TokenizationListParams) SetTokenizationChannel(value TokenizationListParamsTokenizationChannel) *TokenizationListParams {
+	r.TokenizationChannel = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of TokenizationListParams.
+func (r *TokenizationListParams) SetPageSize(value int64) *TokenizationListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// SetStartingAfter sets the StartingAfter field of TokenizationListParams.
+func (r *TokenizationListParams) SetStartingAfter(value string) *TokenizationListParams {
+	r.StartingAfter = fields.F(value)
+	return r
+}
+
+// SetEndingBefore sets the EndingBefore field of TokenizationListParams.
+func (r *TokenizationListParams) SetEndingBefore(value string) *TokenizationListParams {
+	r.EndingBefore = fields.F(value)
+	return r
+}
+
+// NewTokenizationUpdateDigitalCardArtParams returns an empty TokenizationUpdateDigitalCardArtParams, to be populated with its setters.
+func NewTokenizationUpdateDigitalCardArtParams() *TokenizationUpdateDigitalCardArtParams {
+	return &TokenizationUpdateDigitalCardArtParams{}
+}
+
+// SetDigitalCardArtToken sets the DigitalCardArtToken field of TokenizationUpdateDigitalCardArtParams.
+func (r *TokenizationUpdateDigitalCardArtParams) SetDigitalCardArtToken(value string) *TokenizationUpdateDigitalCardArtParams {
+	r.DigitalCardArtToken = fields.F(value)
+	return r
+}
+
+// NewTransactionListParams returns an empty TransactionListParams, to be populated with its setters.
+func NewTransactionListParams() *TransactionListParams {
+	return &TransactionListParams{}
+}
+
+// SetAccountToken sets the AccountToken field of TransactionListParams.
+func (r *TransactionListParams) SetAccountToken(value string) *TransactionListParams {
+	r.AccountToken = fields.F(value)
+	return r
+}
+
+// SetCardToken sets the CardToken field of TransactionListParams.
+func (r *TransactionListParams) SetCardToken(value string) *TransactionListParams {
+	r.CardToken = fields.F(value)
+	return r
+}
+
+// SetResult sets the Result field of TransactionListParams.
+func (r *TransactionListParams) SetResult(value TransactionListParamsResult) *TransactionListParams {
+	r.Result = fields.F(value)
+	return r
+}
+
+// SetStatus sets the Status field of TransactionListParams.
+func (r *TransactionListParams) SetStatus(value TransactionListParamsStatus) *TransactionListParams {
+	r.Status = fields.F(value)
+	return r
+}
+
+// SetBegin sets the Begin field of TransactionListParams.
+func (r *TransactionListParams) SetBegin(value time.Time) *TransactionListParams {
+	r.Begin = fields.F(value)
+	return r
+}
+
+// SetEnd sets the End field of TransactionListParams.
+func (r *TransactionListParams) SetEnd(value time.Time) *TransactionListParams {
+	r.End = fields.F(value)
+	return r
+}
+
+// SetPage sets the Page field of TransactionListParams.
+func (r *TransactionListParams) SetPage(value int64) *TransactionListParams {
+	r.Page = fields.F(value)
+	return r
+}
+
+// SetPageSize sets the PageSize field of TransactionListParams.
+func (r *TransactionListParams) SetPageSize(value int64) *TransactionListParams {
+	r.PageSize = fields.F(value)
+	return r
+}
+
+// NewTransactionSimulateAuthorizationAdviceParams returns an empty TransactionSimulateAuthorizationAdviceParams, to be populated with its setters.
+func NewTransactionSimulateAuthorizationAdviceParams() *TransactionSimulateAuthorizationAdviceParams {
+	return &TransactionSimulateAuthorizationAdviceParams{}
+}
+
+// SetAmount sets the Amount field of TransactionSimulateAuthorizationAdviceParams.
+func (r *TransactionSimulateAuthorizationAdviceParams) SetAmount(value int64) *TransactionSimulateAuthorizationAdviceParams {
+	r.Amount = fields.F(value)
+	return r
+}
+
+// SetToken sets the Token field of TransactionSimulateAuthorizationAdviceParams.
+func (r *TransactionSimulateAuthorizationAdviceParams) SetToken(value string) *TransactionSimulateAuthorizationAdviceParams {
+	r.Token = fields.F(value)
+	return r
+}
+
+// NewTransactionSimulateAuthorizationParams returns an empty TransactionSimulateAuthorizationParams, to be populated with its setters.
+func NewTransactionSimulateAuthorizationParams() *TransactionSimulateAuthorizationParams {
+	return &TransactionSimulateAuthorizationParams{}
+}
+
+// SetAmount sets the Amount field of TransactionSimulateAuthorizationParams.
+func (r *TransactionSimulateAuthorizationParams) SetAmount(value int64) *TransactionSimulateAuthorizationParams {
+	r.Amount = fields.F(value)
+	return r
+}
+
+// SetDescriptor sets the Descriptor field of TransactionSimulateAuthorizationParams.
+func (r *TransactionSimulateAuthorizationParams) SetDescriptor(value string) *TransactionSimulateAuthorizationParams {
+	r.Descriptor = fields.F(value)
+	return r
+}
+
+// SetPan sets the Pan field of TransactionSimulateAuthorizationParams.
+func (r *TransactionSimulateAuthorizationParams) SetPan(value string) *TransactionSimulateAuthorizationParams {
+	r.Pan = fields.F(value)
+	return r
+}
+
+// SetStatus sets the Status field of TransactionSimulateAuthorizationParams.
+func (r *TransactionSimulateAuthorizationParams) SetStatus(value TransactionSimulateAuthorizationParamsStatus) *TransactionSimulateAuthorizationParams {
+	r.Status = fields.F(value)
+	return r
+}
+
+// SetMerchantAcceptorID sets the MerchantAcceptorID field of TransactionSimulateAuthorizationParams.
+func (r *TransactionSimulateAuthorizationParams) SetMerchantAcceptorID(value string) *TransactionSimulateAuthorizationParams {
+	r.MerchantAcceptorID = fields.F(value)
+	return r
+}
+
+// SetMerchantCurrency sets the MerchantCurrency field of TransactionSimulateAuthorizationParams.
+func (r *TransactionSimulateAuthorizationParams) SetMerchantCurrency(value string) *TransactionSimulateAuthorizationParams {
+	r.MerchantCurrency = fields.F(value)
+	return r
+}
+
+// SetMerchantAmount sets the MerchantAmount field of TransactionSimulateAuthorizationParams.
+func (r *TransactionSimulateAuthorizationParams) SetMerchantAmount(value int64) *TransactionSimulateAuthorizationParams {
+	r.MerchantAmount = fields.F(value)
+	return r
+}
+
+// SetMcc sets the Mcc field of TransactionSimulateAuthorizationParams.
+func (r *TransactionSimulateAuthorizationParams) SetMcc(value string) *TransactionSimulateAuthorizationParams {
+	r.Mcc = fields.F(value)
+	return r
+}
+
+// SetPartialApprovalCapable sets the PartialApprovalCapable field of TransactionSimulateAuthorizationParams.
+func (r *TransactionSimulateAuthorizationParams) SetPartialApprovalCapable(value bool) *TransactionSimulateAuthorizationParams {
+	r.PartialApprovalCapable = fields.F(value)
+	return r
+}
+
+// NewTransactionSimulateClearingParams returns an empty TransactionSimulateClearingParams, to be populated with its setters.
+func NewTransactionSimulateClearingParams() *TransactionSimulateClearingParams {
+	return &TransactionSimulateClearingParams{}
+}
+
+// SetAmount sets the Amount field of TransactionSimulateClearingParams.
+func (r *TransactionSimulateClearingParams) SetAmount(value int64) *TransactionSimulateClearingParams {
+	r.Amount = fields.F(value)
+	return r
+}
+
+// SetToken sets the Token field of TransactionSimulateClearingParams.
+func (r *TransactionSimulateClearingParams) SetToken(value string) *TransactionSimulateClearingParams {
+	r.Token = fields.F(value)
+	return r
+}
+
+// NewTransactionSimulateCreditAuthorizationParams returns an empty TransactionSimulateCreditAuthorizationParams, to be populated with its setters.
+func NewTransactionSimulateCreditAuthorizationParams() *TransactionSimulateCreditAuthorizationParams {
+	return &TransactionSimulateCreditAuthorizationParams{}
+}
+
+// SetAmount sets the Amount field of TransactionSimulateCreditAuthorizationParams.
+func (r *TransactionSimulateCreditAuthorizationParams) SetAmount(value int64) *TransactionSimulateCreditAuthorizationParams {
+	r.Amount = fields.F(value)
+	return r
+}
+
+// SetDescriptor sets the Descriptor field of TransactionSimulateCreditAuthorizationParams.
+func (r *TransactionSimulateCreditAuthorizationParams) SetDescriptor(value string) *TransactionSimulateCreditAuthorizationParams {
+	r.Descriptor = fields.F(value)
+	return r
+}
+
+// SetPan sets the Pan field of TransactionSimulateCreditAuthorizationParams.
+func (r *TransactionSimulateCreditAuthorizationParams) SetPan(value string) *TransactionSimulateCreditAuthorizationParams {
+	r.Pan = fields.F(value)
+	return r
+}
+
+// SetMerchantAcceptorID sets the MerchantAcceptorID field of TransactionSimulateCreditAuthorizationParams.
+func (r *TransactionSimulateCreditAuthorizationParams) SetMerchantAcceptorID(value string) *TransactionSimulateCreditAuthorizationParams {
+	r.MerchantAcceptorID = fields.F(value)
+	return r
+}
+
+// SetMcc sets the Mcc field of TransactionSimulateCreditAuthorizationParams.
+func (r *TransactionSimulateCreditAuthorizationParams) SetMcc(value string) *TransactionSimulateCreditAuthorizationParams {
+	r.Mcc = fields.F(value)
+	return r
+}
+
+// NewTransactionSimulateReturnParams returns an empty TransactionSimulateReturnParams, to be populated with its setters.
+func NewTransactionSimulateReturnParams() *TransactionSimulateReturnParams {
+	return &TransactionSimulateReturnParams{}
+}
+
+// SetAmount sets the Amount field of TransactionSimulateReturnParams.
+func (r *TransactionSimulateReturnParams) SetAmount(value int64) *TransactionSimulateReturnParams {
+	r.Amount = fields.F(value)
+	return r
+}
+
+// SetDescriptor sets the Descriptor field of TransactionSimulateReturnParams.
+func (r *TransactionSimulateReturnParams) SetDescriptor(value string) *TransactionSimulateReturnParams {
+	r.Descriptor = fields.F(value)
+	return r
+}
+
+// SetPan sets the Pan field of TransactionSimulateReturnParams.
+func (r *TransactionSimulateReturnParams) SetPan(value string) *TransactionSimulateReturnParams {
+	r.Pan = fields.F(value)
+	return r
+}
+
+// NewTransactionSimulateReturnReversalParams returns an empty TransactionSimulateReturnReversalParams, to be populated with its setters.
+func NewTransactionSimulateReturnReversalParams() *TransactionSimulateReturnReversalParams {
+	return &TransactionSimulateReturnReversalParams{}
+}
+
+// SetToken sets the Token field of TransactionSimulateReturnReversalParams.
+func (r *TransactionSimulateReturnReversalParams) SetToken(value string) *TransactionSimulateReturnReversalParams {
+	r.Token = fields.F(value)
+	return r
+}
+
+// NewTransactionSimulateVoidParams returns an empty TransactionSimulateVoidParams, to be populated with its setters.
+func NewTransactionSimulateVoidParams() *TransactionSimulateVoidParams {
+	return &TransactionSimulateVoidParams{}
+}
+
+// SetAmount sets the Amount field of TransactionSimulateVoidParams.
+func (r *TransactionSimulateVoidParams) SetAmount(value int64) *TransactionSimulateVoidParams {
+	r.Amount = fields.F(value)
+	return r
+}
+
+// SetToken sets the Token field of TransactionSimulateVoidParams.
+func (r *TransactionSimulateVoidParams) SetToken(value string) *TransactionSimulateVoidParams {
+	r.Token = fields.F(value)
+	return r
+}
+
+// SetType sets the Type field of TransactionSimulateVoidParams.
+func (r *TransactionSimulateVoidParams) SetType(value TransactionSimulateVoidParamsType) *TransactionSimulateVoidParams {
+	r.Type = fields.F(value)
+	return r
+}
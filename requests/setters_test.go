@@ -0,0 +1,13 @@
+package requests
+
+import "testing"
+
+func TestSetters(t *testing.T) {
+	params := NewCardNewParams().SetType(CardNewParamsTypeVirtual).SetSpendLimit(5000)
+	if params.Type.Value != CardNewParamsTypeVirtual || params.SpendLimit.Value != 5000 || !params.SpendLimit.Present {
+		t.Fatalf("expected the setters to populate the fields, got %s", params)
+	}
+	if params.Memo.Present {
+		t.Fatalf("expected the fields that were not set to be omitted")
+	}
+}
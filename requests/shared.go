@@ -1,8 +1,6 @@
 package requests
 
 import (
-	"fmt"
-
 	pjson "github.com/lithic-com/lithic-go/core/json"
 	"github.com/lithic-com/lithic-go/fields"
 )
@@ -33,7 +31,7 @@ func (r *Address) MarshalJSON() (data []byte, err error) {
 }
 
 func (r Address) String() (result string) {
-	return fmt.Sprintf("&Address{Address1:%s Address2:%s City:%s Country:%s PostalCode:%s State:%s}", r.Address1, r.Address2, r.City, r.Country, r.PostalCode, r.State)
+	return format(r)
 }
 
 type ShippingAddress struct {
@@ -75,5 +73,5 @@ func (r *ShippingAddress) MarshalJSON() (data []byte, err error) {
 }
 
 func (r ShippingAddress) String() (result string) {
-	return fmt.Sprintf("&ShippingAddress{FirstName:%s LastName:%s Line2Text:%s Address1:%s Address2:%s City:%s State:%s PostalCode:%s Country:%s Email:%s PhoneNumber:%s}", r.FirstName, r.LastName, r.Line2Text, r.Address1, r.Address2, r.City, r.State, r.PostalCode, r.Country, r.Email, r.PhoneNumber)
+	return format(r)
 }
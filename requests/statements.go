@@ -0,0 +1,57 @@
+package requests
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+type StatementListParams struct {
+	// Date string in RFC 3339 format. Only statements that end on or after the
+	// specified date will be included.
+	Begin fields.Field[time.Time] `query:"begin" format:"date"`
+	// Date string in RFC 3339 format. Only statements that end on or before the
+	// specified date will be included.
+	End fields.Field[time.Time] `query:"end" format:"date"`
+	// Page size (for pagination).
+	PageSize fields.Field[int64] `query:"page_size"`
+	// The unique identifier of the last item in the previous page. Used to retrieve
+	// the next page.
+	StartingAfter fields.Field[string] `query:"starting_after"`
+	// The unique identifier of the first item in the previous page. Used to retrieve
+	// the previous page.
+	EndingBefore fields.Field[string] `query:"ending_before"`
+}
+
+// URLQuery serializes StatementListParams into a url.Values of the query
+// parameters associated with this value
+func (r *StatementListParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r StatementListParams) String() (result string) {
+	return format(r)
+}
+
+type StatementLineItemListParams struct {
+	// Page size (for pagination).
+	PageSize fields.Field[int64] `query:"page_size"`
+	// The unique identifier of the last item in the previous page. Used to retrieve
+	// the next page.
+	StartingAfter fields.Field[string] `query:"starting_after"`
+	// The unique identifier of the first item in the previous page. Used to retrieve
+	// the previous page.
+	EndingBefore fields.Field[string] `query:"ending_before"`
+}
+
+// URLQuery serializes StatementLineItemListParams into a url.Values of the query
+// parameters associated with this value
+func (r *StatementLineItemListParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r StatementLineItemListParams) String() (result string) {
+	return format(r)
+}
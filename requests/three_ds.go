@@ -0,0 +1,35 @@
+package requests
+
+import (
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+type ThreeDSChallengeResponseParams struct {
+	// Globally unique identifier for the 3DS authentication that the cardholder
+	// was challenged for.
+	Token fields.Field[string] `json:"token,required" format:"uuid"`
+	// Whether the cardholder approved the challenge:
+	//
+	//   - `APPROVE` - The cardholder approved the challenge.
+	//   - `DECLINE_BY_CUSTOMER` - The cardholder declined the challenge.
+	ChallengeResponse fields.Field[ThreeDSChallengeResponseParamsChallengeResponse] `json:"challenge_response,required"`
+}
+
+// MarshalJSON serializes ThreeDSChallengeResponseParams into an array of bytes
+// using the gjson library. Members of the `jsonFields` field are serialized into
+// the top-level, and will overwrite known members of the same name.
+func (r *ThreeDSChallengeResponseParams) MarshalJSON() (data []byte, err error) {
+	return pjson.MarshalRoot(r)
+}
+
+func (r ThreeDSChallengeResponseParams) String() (result string) {
+	return format(r)
+}
+
+type ThreeDSChallengeResponseParamsChallengeResponse string
+
+const (
+	ThreeDSChallengeResponseParamsChallengeResponseApprove           ThreeDSChallengeResponseParamsChallengeResponse = "APPROVE"
+	ThreeDSChallengeResponseParamsChallengeResponseDeclineByCustomer ThreeDSChallengeResponseParamsChallengeResponse = "DECLINE_BY_CUSTOMER"
+)
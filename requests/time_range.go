@@ -0,0 +1,71 @@
+package requests
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+// TimeRangeError is returned for list params whose Begin and End do not make a
+// valid time range, which the API would answer with an empty or truncated list
+// rather than an error.
+type TimeRangeError struct {
+	// The name of the params type, such as "CardListParams".
+	Params string
+	Reason string
+}
+
+func (e *TimeRangeError) Error() string {
+	return fmt.Sprintf("lithic: invalid time range in %s: %s", e.Params, e.Reason)
+}
+
+// validateTimeRange checks that begin and end are set to actual times, that
+// begin is not after end, and that the range spans at most maxSpan, if it is
+// positive. A range without end spans until now.
+func validateTimeRange(params string, begin fields.Field[time.Time], end fields.Field[time.Time], maxSpan time.Duration) error {
+	set := func(f fields.Field[time.Time]) bool { return f.Present && !f.Null && f.Raw == nil }
+	for _, f := range []struct {
+		name  string
+		field fields.Field[time.Time]
+	}{{"Begin", begin}, {"End", end}} {
+		if set(f.field) && f.field.Value.IsZero() {
+			return &TimeRangeError{Params: params, Reason: f.name + " is the zero time, 0001-01-01"}
+		}
+	}
+	if !set(begin) {
+		return nil
+	}
+	until := time.Now()
+	if set(end) {
+		until = end.Value
+		if begin.Value.After(until) {
+			return &TimeRangeError{Params: params, Reason: fmt.Sprintf("Begin %s is after End %s", begin.Value.Format(time.RFC3339), until.Format(time.RFC3339))}
+		}
+	}
+	if maxSpan > 0 && until.Sub(begin.Value) > maxSpan {
+		return &TimeRangeError{Params: params, Reason: fmt.Sprintf("the range of %s exceeds the maximum of %s", until.Sub(begin.Value).Round(time.Second), maxSpan)}
+	}
+	return nil
+}
+
+// ValidateTimeRange returns a *TimeRangeError if Begin or End is the zero time,
+// Begin is after End, or the range spans more than maxSpan, if it is positive.
+// CardService.List calls it with the maximum of options.WithMaxListTimeRange.
+func (r *CardListParams) ValidateTimeRange(maxSpan time.Duration) error {
+	if r == nil {
+		return nil
+	}
+	return validateTimeRange("CardListParams", r.Begin, r.End, maxSpan)
+}
+
+// ValidateTimeRange returns a *TimeRangeError if Begin or End is the zero time,
+// Begin is after End, or the range spans more than maxSpan, if it is positive.
+// TransactionService.List calls it with the maximum of
+// options.WithMaxListTimeRange.
+func (r *TransactionListParams) ValidateTimeRange(maxSpan time.Duration) error {
+	if r == nil {
+		return nil
+	}
+	return validateTimeRange("TransactionListParams", r.Begin, r.End, maxSpan)
+}
@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+func TestValidateTimeRange(t *testing.T) {
+	now := time.Now()
+	valid := []*TransactionListParams{
+		nil,
+		{},
+		{Begin: fields.F(now.Add(-time.Hour)), End: fields.F(now)},
+		{Begin: fields.F(now.Add(-time.Hour))},
+		{End: fields.F(now)},
+		{Begin: fields.NullField[time.Time]()},
+	}
+	for _, params := range valid {
+		if err := params.ValidateTimeRange(24 * time.Hour); err != nil {
+			t.Errorf("expected %s to be valid, got %s", params, err)
+		}
+	}
+
+	invalid := []*TransactionListParams{
+		{Begin: fields.F(time.Time{})},
+		{End: fields.F(time.Time{})},
+		{Begin: fields.F(now), End: fields.F(now.Add(-time.Hour))},
+		{Begin: fields.F(now.Add(-48 * time.Hour)), End: fields.F(now)},
+		{Begin: fields.F(now.Add(-48 * time.Hour))},
+	}
+	for _, params := range invalid {
+		var rangeErr *TimeRangeError
+		if err := params.ValidateTimeRange(24 * time.Hour); !errors.As(err, &rangeErr) || rangeErr.Params != "TransactionListParams" {
+			t.Errorf("expected %s to be invalid, got %v", params, err)
+		}
+	}
+
+	long := &CardListParams{Begin: fields.F(now.Add(-48 * time.Hour)), End: fields.F(now)}
+	if err := long.ValidateTimeRange(0); err != nil {
+		t.Errorf("expected a range without maximum to be valid, got %s", err)
+	}
+}
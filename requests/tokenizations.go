@@ -0,0 +1,69 @@
+package requests
+
+import (
+	"net/url"
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/core/query"
+	"github.com/lithic-com/lithic-go/fields"
+)
+
+type TokenizationListParams struct {
+	// Filters for tokenizations associated with a specific account.
+	AccountToken fields.Field[string] `query:"account_token" format:"uuid"`
+	// Filters for tokenizations associated with a specific card.
+	CardToken fields.Field[string] `query:"card_token" format:"uuid"`
+	// Filter for tokenizations created after this date.
+	Begin fields.Field[time.Time] `query:"begin" format:"date"`
+	// Filter for tokenizations created before this date.
+	End fields.Field[time.Time] `query:"end" format:"date"`
+	// Filter for tokenizations by tokenization channel. If this is not specified,
+	// only DIGITAL_WALLET tokenizations will be returned.
+	TokenizationChannel fields.Field[TokenizationListParamsTokenizationChannel] `query:"tokenization_channel"`
+	// Page size (for pagination).
+	PageSize fields.Field[int64] `query:"page_size"`
+	// The unique identifier of the last item in the previous page. Used to retrieve
+	// the next page.
+	StartingAfter fields.Field[string] `query:"starting_after"`
+	// The unique identifier of the first item in the previous page. Used to retrieve
+	// the previous page.
+	EndingBefore fields.Field[string] `query:"ending_before"`
+}
+
+// URLQuery serializes TokenizationListParams into a url.Values of the query
+// parameters associated with this value
+func (r *TokenizationListParams) URLQuery() (v url.Values) {
+	return query.Marshal(r)
+}
+
+func (r TokenizationListParams) String() (result string) {
+	return format(r)
+}
+
+type TokenizationListParamsTokenizationChannel string
+
+const (
+	TokenizationListParamsTokenizationChannelDigitalWallet TokenizationListParamsTokenizationChannel = "DIGITAL_WALLET"
+	TokenizationListParamsTokenizationChannelMerchant      TokenizationListParamsTokenizationChannel = "MERCHANT"
+	TokenizationListParamsTokenizationChannelAll           TokenizationListParamsTokenizationChannel = "ALL"
+)
+
+type TokenizationUpdateDigitalCardArtParams struct {
+	// Specifies the digital card art to be displayed in the user’s digital wallet
+	// for a tokenization. This artwork must be approved by the network and
+	// configured by Lithic to use. See
+	// [Flexible Card Art Guide](https://docs.lithic.com/docs/about-digital-wallets#flexible-card-art).
+	DigitalCardArtToken fields.Field[string] `json:"digital_card_art_token" format:"uuid"`
+}
+
+// MarshalJSON serializes TokenizationUpdateDigitalCardArtParams into an array of
+// bytes using the gjson library. Members of the `jsonFields` field are serialized
+// into the top-level, and will overwrite known members of the same name.
+func (r *TokenizationUpdateDigitalCardArtParams) MarshalJSON() (data []byte, err error) {
+	return pjson.MarshalRoot(r)
+}
+
+func (r TokenizationUpdateDigitalCardArtParams) String() (result string) {
+	return format(r)
+}
@@ -1,7 +1,6 @@
 package requests
 
 import (
-	"fmt"
 	"net/url"
 	"time"
 
@@ -18,6 +17,10 @@ type TransactionListParams struct {
 	// Filters for transactions using transaction result field. Can filter by
 	// `APPROVED`, and `DECLINED`.
 	Result fields.Field[TransactionListParamsResult] `query:"result"`
+	// Filters for transactions using transaction status field. Can filter by
+	// `BOUNCED`, `DECLINED`, `EXPIRED`, `PENDING`, `SETTLED`, `SETTLING` and
+	// `VOIDED`.
+	Status fields.Field[TransactionListParamsStatus] `query:"status"`
 	// Date string in RFC 3339 format. Only entries created after the specified date
 	// will be included. UTC time zone.
 	Begin fields.Field[time.Time] `query:"begin" format:"date-time"`
@@ -37,7 +40,7 @@ func (r *TransactionListParams) URLQuery() (v url.Values) {
 }
 
 func (r TransactionListParams) String() (result string) {
-	return fmt.Sprintf("&TransactionListParams{AccountToken:%s CardToken:%s Result:%s Begin:%s End:%s Page:%s PageSize:%s}", r.AccountToken, r.CardToken, r.Result, r.Begin, r.End, r.Page, r.PageSize)
+	return format(r)
 }
 
 type TransactionListParamsResult string
@@ -47,6 +50,18 @@ const (
 	TransactionListParamsResultDeclined TransactionListParamsResult = "DECLINED"
 )
 
+type TransactionListParamsStatus string
+
+const (
+	TransactionListParamsStatusBounced  TransactionListParamsStatus = "BOUNCED"
+	TransactionListParamsStatusDeclined TransactionListParamsStatus = "DECLINED"
+	TransactionListParamsStatusExpired  TransactionListParamsStatus = "EXPIRED"
+	TransactionListParamsStatusPending  TransactionListParamsStatus = "PENDING"
+	TransactionListParamsStatusSettled  TransactionListParamsStatus = "SETTLED"
+	TransactionListParamsStatusSettling TransactionListParamsStatus = "SETTLING"
+	TransactionListParamsStatusVoided   TransactionListParamsStatus = "VOIDED"
+)
+
 type TransactionSimulateAuthorizationParams struct {
 	// Amount (in cents) to authorize. For credit authorizations and financial credit
 	// authorizations, any value entered will be converted into a negative amount in
@@ -100,7 +115,7 @@ func (r *TransactionSimulateAuthorizationParams) MarshalJSON() (data []byte, err
 }
 
 func (r TransactionSimulateAuthorizationParams) String() (result string) {
-	return fmt.Sprintf("&TransactionSimulateAuthorizationParams{Amount:%s Descriptor:%s Pan:%s Status:%s MerchantAcceptorID:%s MerchantCurrency:%s MerchantAmount:%s Mcc:%s PartialApprovalCapable:%s}", r.Amount, r.Descriptor, r.Pan, r.Status, r.MerchantAcceptorID, r.MerchantCurrency, r.MerchantAmount, r.Mcc, r.PartialApprovalCapable)
+	return format(r)
 }
 
 type TransactionSimulateAuthorizationParamsStatus string
@@ -130,7 +145,7 @@ func (r *TransactionSimulateAuthorizationAdviceParams) MarshalJSON() (data []byt
 }
 
 func (r TransactionSimulateAuthorizationAdviceParams) String() (result string) {
-	return fmt.Sprintf("&TransactionSimulateAuthorizationAdviceParams{Amount:%s Token:%s}", r.Amount, r.Token)
+	return format(r)
 }
 
 type TransactionSimulateClearingParams struct {
@@ -153,7 +168,7 @@ func (r *TransactionSimulateClearingParams) MarshalJSON() (data []byte, err erro
 }
 
 func (r TransactionSimulateClearingParams) String() (result string) {
-	return fmt.Sprintf("&TransactionSimulateClearingParams{Amount:%s Token:%s}", r.Amount, r.Token)
+	return format(r)
 }
 
 type TransactionSimulateCreditAuthorizationParams struct {
@@ -182,7 +197,7 @@ func (r *TransactionSimulateCreditAuthorizationParams) MarshalJSON() (data []byt
 }
 
 func (r TransactionSimulateCreditAuthorizationParams) String() (result string) {
-	return fmt.Sprintf("&TransactionSimulateCreditAuthorizationParams{Amount:%s Descriptor:%s Pan:%s MerchantAcceptorID:%s Mcc:%s}", r.Amount, r.Descriptor, r.Pan, r.MerchantAcceptorID, r.Mcc)
+	return format(r)
 }
 
 type TransactionSimulateReturnParams struct {
@@ -202,7 +217,7 @@ func (r *TransactionSimulateReturnParams) MarshalJSON() (data []byte, err error)
 }
 
 func (r TransactionSimulateReturnParams) String() (result string) {
-	return fmt.Sprintf("&TransactionSimulateReturnParams{Amount:%s Descriptor:%s Pan:%s}", r.Amount, r.Descriptor, r.Pan)
+	return format(r)
 }
 
 type TransactionSimulateReturnReversalParams struct {
@@ -218,7 +233,7 @@ func (r *TransactionSimulateReturnReversalParams) MarshalJSON() (data []byte, er
 }
 
 func (r TransactionSimulateReturnReversalParams) String() (result string) {
-	return fmt.Sprintf("&TransactionSimulateReturnReversalParams{Token:%s}", r.Token)
+	return format(r)
 }
 
 type TransactionSimulateVoidParams struct {
@@ -243,7 +258,7 @@ func (r *TransactionSimulateVoidParams) MarshalJSON() (data []byte, err error) {
 }
 
 func (r TransactionSimulateVoidParams) String() (result string) {
-	return fmt.Sprintf("&TransactionSimulateVoidParams{Amount:%s Token:%s Type:%s}", r.Amount, r.Token, r.Type)
+	return format(r)
 }
 
 type TransactionSimulateVoidParamsType string
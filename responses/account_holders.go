@@ -69,7 +69,10 @@ type AccountHolderDocument struct {
 	// Globally unique identifier for the account holder.
 	AccountHolderToken string `json:"account_holder_token" format:"uuid"`
 	// Type of documentation to be submitted for verification.
-	DocumentType            AccountHolderDocumentDocumentType              `json:"document_type"`
+	DocumentType AccountHolderDocumentDocumentType `json:"document_type"`
+	// Globally unique identifier for the entity of a business account holder
+	// that the document is for, if any.
+	EntityToken             string                                         `json:"entity_token" format:"uuid"`
 	RequiredDocumentUploads []AccountHolderDocumentRequiredDocumentUploads `json:"required_document_uploads"`
 	// Globally unique identifier for the document.
 	Token string `json:"token" format:"uuid"`
@@ -79,6 +82,7 @@ type AccountHolderDocument struct {
 type AccountHolderDocumentJSON struct {
 	AccountHolderToken      pjson.Metadata
 	DocumentType            pjson.Metadata
+	EntityToken             pjson.Metadata
 	RequiredDocumentUploads pjson.Metadata
 	Token                   pjson.Metadata
 	Raw                     []byte
@@ -100,6 +104,20 @@ const (
 	AccountHolderDocumentDocumentTypePassport          AccountHolderDocumentDocumentType = "passport"
 	AccountHolderDocumentDocumentTypePassportCard      AccountHolderDocumentDocumentType = "passport_card"
 	AccountHolderDocumentDocumentTypeVisa              AccountHolderDocumentDocumentType = "visa"
+	// Documents of businesses and their entities, for KYB.
+	AccountHolderDocumentDocumentTypeEinLetter                 AccountHolderDocumentDocumentType = "EIN_LETTER"
+	AccountHolderDocumentDocumentTypeTaxReturn                 AccountHolderDocumentDocumentType = "TAX_RETURN"
+	AccountHolderDocumentDocumentTypeOperatingAgreement        AccountHolderDocumentDocumentType = "OPERATING_AGREEMENT"
+	AccountHolderDocumentDocumentTypeCertificateOfFormation    AccountHolderDocumentDocumentType = "CERTIFICATE_OF_FORMATION"
+	AccountHolderDocumentDocumentTypeCertificateOfGoodStanding AccountHolderDocumentDocumentType = "CERTIFICATE_OF_GOOD_STANDING"
+	AccountHolderDocumentDocumentTypeArticlesOfIncorporation   AccountHolderDocumentDocumentType = "ARTICLES_OF_INCORPORATION"
+	AccountHolderDocumentDocumentTypeArticlesOfOrganization    AccountHolderDocumentDocumentType = "ARTICLES_OF_ORGANIZATION"
+	AccountHolderDocumentDocumentTypeBylaws                    AccountHolderDocumentDocumentType = "BYLAWS"
+	AccountHolderDocumentDocumentTypeGovernmentBusinessLicense AccountHolderDocumentDocumentType = "GOVERNMENT_BUSINESS_LICENSE"
+	AccountHolderDocumentDocumentTypePartnershipAgreement      AccountHolderDocumentDocumentType = "PARTNERSHIP_AGREEMENT"
+	AccountHolderDocumentDocumentTypeSs4Form                   AccountHolderDocumentDocumentType = "SS4_FORM"
+	AccountHolderDocumentDocumentTypeBankStatement             AccountHolderDocumentDocumentType = "BANK_STATEMENT"
+	AccountHolderDocumentDocumentTypeUtilityBillStatement      AccountHolderDocumentDocumentType = "UTILITY_BILL_STATEMENT"
 )
 
 type AccountHolderDocumentRequiredDocumentUploads struct {
@@ -0,0 +1,86 @@
+package responses
+
+import (
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/pagination"
+)
+
+// Aggregate Balance across all end-user accounts. Amounts are in the smallest
+// unit of the currency, such as cents for USD.
+type AggregateBalance struct {
+	// Funds available for spend in the currency's smallest unit (e.g., cents for
+	// USD)
+	AvailableAmount int64 `json:"available_amount,required"`
+	// Date and time for when the balance was first created.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// 3-digit alphabetic ISO 4217 code for the local currency of the balance.
+	Currency string `json:"currency,required"`
+	// Type of financial account
+	FinancialAccountType AggregateBalanceFinancialAccountType `json:"financial_account_type,required"`
+	// Globally unique identifier for the financial account that had its balance
+	// updated most recently
+	LastFinancialAccountToken string `json:"last_financial_account_token,required" format:"uuid"`
+	// Globally unique identifier for the last financial transaction event that
+	// impacted this balance
+	LastTransactionEventToken string `json:"last_transaction_event_token,required" format:"uuid"`
+	// Globally unique identifier for the last financial transaction that impacted
+	// this balance
+	LastTransactionToken string `json:"last_transaction_token,required" format:"uuid"`
+	// Funds not available for spend due to card authorizations or pending ACH
+	// release. Shown in the currency's smallest unit (e.g., cents for USD)
+	PendingAmount int64 `json:"pending_amount,required"`
+	// The sum of available and pending balance in the currency's smallest unit
+	// (e.g., cents for USD)
+	TotalAmount int64 `json:"total_amount,required"`
+	// Date and time for when the balance was last updated.
+	Updated time.Time `json:"updated,required" format:"date-time"`
+	JSON    AggregateBalanceJSON
+}
+
+type AggregateBalanceJSON struct {
+	AvailableAmount           pjson.Metadata
+	Created                   pjson.Metadata
+	Currency                  pjson.Metadata
+	FinancialAccountType      pjson.Metadata
+	LastFinancialAccountToken pjson.Metadata
+	LastTransactionEventToken pjson.Metadata
+	LastTransactionToken      pjson.Metadata
+	PendingAmount             pjson.Metadata
+	TotalAmount               pjson.Metadata
+	Updated                   pjson.Metadata
+	Raw                       []byte
+	Extras                    map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into AggregateBalance using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *AggregateBalance) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type AggregateBalanceFinancialAccountType string
+
+const (
+	AggregateBalanceFinancialAccountTypeIssuing   AggregateBalanceFinancialAccountType = "ISSUING"
+	AggregateBalanceFinancialAccountTypeOperating AggregateBalanceFinancialAccountType = "OPERATING"
+	AggregateBalanceFinancialAccountTypeReserve   AggregateBalanceFinancialAccountType = "RESERVE"
+)
+
+type AggregateBalancesCursorPage struct {
+	*pagination.CursorPage[AggregateBalance]
+}
+
+func (r *AggregateBalancesCursorPage) AggregateBalance() *AggregateBalance {
+	return r.Current()
+}
+
+func (r *AggregateBalancesCursorPage) NextPage() (*AggregateBalancesCursorPage, error) {
+	if page, err := r.CursorPage.NextPage(); err != nil {
+		return nil, err
+	} else {
+		return &AggregateBalancesCursorPage{page}, nil
+	}
+}
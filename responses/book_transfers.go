@@ -0,0 +1,159 @@
+package responses
+
+import (
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/pagination"
+)
+
+type BookTransfer struct {
+	// Customer-provided token that will serve as an idempotency token. This token
+	// will become the transaction token.
+	Token string `json:"token,required" format:"uuid"`
+	// Category of the book transfer.
+	Category BookTransferCategory `json:"category,required"`
+	// Date and time when the transfer occurred. UTC time zone.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// 3-digit alphabetic ISO 4217 code for the settling currency of the
+	// transaction.
+	Currency string `json:"currency,required"`
+	// A list of all financial events that have modified this transfer.
+	Events []BookTransferEvent `json:"events,required"`
+	// Globally unique identifier for the financial account or card that will send
+	// the funds.
+	FromFinancialAccountToken string `json:"from_financial_account_token,required" format:"uuid"`
+	// Globally unique identifier for the financial account or card that will
+	// receive the funds.
+	ToFinancialAccountToken string `json:"to_financial_account_token,required" format:"uuid"`
+	// Pending amount of the transaction in the currency's smallest unit (e.g.,
+	// cents), including any acquirer fees. The value of this field will go to zero
+	// over time once the financial transaction is settled.
+	PendingAmount int64 `json:"pending_amount,required"`
+	// Amount of the transaction that has settled in the currency's smallest unit
+	// (e.g., cents).
+	SettledAmount int64 `json:"settled_amount,required"`
+	// `APPROVED` transactions were successful while `DECLINED` transactions were
+	// declined by user, Lithic, or the network.
+	Result BookTransferResult `json:"result,required"`
+	// Status types:
+	//
+	//   - `DECLINED` - The transfer was declined.
+	//   - `REVERSED` - The transfer was reversed.
+	//   - `SETTLED` - The transfer is completed.
+	Status BookTransferStatus `json:"status,required"`
+	// Date and time when the financial transaction was last updated. UTC time
+	// zone.
+	Updated time.Time `json:"updated,required" format:"date-time"`
+	JSON    BookTransferJSON
+}
+
+type BookTransferJSON struct {
+	Token                     pjson.Metadata
+	Category                  pjson.Metadata
+	Created                   pjson.Metadata
+	Currency                  pjson.Metadata
+	Events                    pjson.Metadata
+	FromFinancialAccountToken pjson.Metadata
+	ToFinancialAccountToken   pjson.Metadata
+	PendingAmount             pjson.Metadata
+	SettledAmount             pjson.Metadata
+	Result                    pjson.Metadata
+	Status                    pjson.Metadata
+	Updated                   pjson.Metadata
+	Raw                       []byte
+	Extras                    map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into BookTransfer using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *BookTransfer) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type BookTransferCategory string
+
+const (
+	BookTransferCategoryAdjustment       BookTransferCategory = "ADJUSTMENT"
+	BookTransferCategoryBalanceOrFunding BookTransferCategory = "BALANCE_OR_FUNDING"
+	BookTransferCategoryDerecognition    BookTransferCategory = "DERECOGNITION"
+	BookTransferCategoryDispute          BookTransferCategory = "DISPUTE"
+	BookTransferCategoryFee              BookTransferCategory = "FEE"
+	BookTransferCategoryReward           BookTransferCategory = "REWARD"
+	BookTransferCategoryTransfer         BookTransferCategory = "TRANSFER"
+)
+
+type BookTransferResult string
+
+const (
+	BookTransferResultApproved BookTransferResult = "APPROVED"
+	BookTransferResultDeclined BookTransferResult = "DECLINED"
+)
+
+type BookTransferStatus string
+
+const (
+	BookTransferStatusDeclined BookTransferStatus = "DECLINED"
+	BookTransferStatusReversed BookTransferStatus = "REVERSED"
+	BookTransferStatusSettled  BookTransferStatus = "SETTLED"
+)
+
+type BookTransferEvent struct {
+	// Globally unique identifier.
+	Token string `json:"token,required" format:"uuid"`
+	// Amount of the financial event that has been settled in the currency's
+	// smallest unit (e.g., cents).
+	Amount int64 `json:"amount,required"`
+	// Date and time when the financial event occurred. UTC time zone.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// Detailed results of the event, such as `APPROVED` or
+	// `FUNDS_INSUFFICIENT`.
+	DetailedResults []string `json:"detailed_results,required"`
+	// Memo for the transfer.
+	Memo string `json:"memo,required"`
+	// `APPROVED` financial events were successful while `DECLINED` financial
+	// events were declined by user, Lithic, or the network.
+	Result BookTransferResult `json:"result,required"`
+	// The program specific subtype code for the specified category/type.
+	Subtype string `json:"subtype,required"`
+	// Type of the book transfer.
+	Type string `json:"type,required"`
+	JSON BookTransferEventJSON
+}
+
+type BookTransferEventJSON struct {
+	Token           pjson.Metadata
+	Amount          pjson.Metadata
+	Created         pjson.Metadata
+	DetailedResults pjson.Metadata
+	Memo            pjson.Metadata
+	Result          pjson.Metadata
+	Subtype         pjson.Metadata
+	Type            pjson.Metadata
+	Raw             []byte
+	Extras          map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into BookTransferEvent using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *BookTransferEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type BookTransfersCursorPage struct {
+	*pagination.CursorPage[BookTransfer]
+}
+
+func (r *BookTransfersCursorPage) BookTransfer() *BookTransfer {
+	return r.Current()
+}
+
+func (r *BookTransfersCursorPage) NextPage() (*BookTransfersCursorPage, error) {
+	if page, err := r.CursorPage.NextPage(); err != nil {
+		return nil, err
+	} else {
+		return &BookTransfersCursorPage{page}, nil
+	}
+}
@@ -87,7 +87,10 @@ type Card struct {
 	// by Lithic to use. See
 	// [Flexible Card Art Guide](https://docs.lithic.com/docs/about-digital-wallets#flexible-card-art).
 	DigitalCardArtToken string `json:"digital_card_art_token" format:"uuid"`
-	JSON                CardJSON
+	// The account holder of the card, if cardholder details are embedded in card
+	// payloads for the program.
+	Cardholder Cardholder `json:"cardholder"`
+	JSON       CardJSON
 }
 
 type CardJSON struct {
@@ -107,6 +110,7 @@ type CardJSON struct {
 	Token               pjson.Metadata
 	Type                pjson.Metadata
 	DigitalCardArtToken pjson.Metadata
+	Cardholder          pjson.Metadata
 	Raw                 []byte
 	Extras              map[string]pjson.Metadata
 }
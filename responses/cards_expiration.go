@@ -0,0 +1,25 @@
+package responses
+
+import (
+	"strconv"
+	"time"
+)
+
+// ExpiresAt returns the time at which the card expires, which is the start of
+// the month after `exp_month`/`exp_year` in UTC, as cards are valid through the
+// last day of their expiry month. ok is false if the expiry is missing or
+// malformed.
+func (r *Card) ExpiresAt() (expires time.Time, ok bool) {
+	month, err := strconv.Atoi(r.ExpMonth)
+	if err != nil || month < 1 || month > 12 {
+		return time.Time{}, false
+	}
+	year, err := strconv.Atoi(r.ExpYear)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if year < 100 {
+		year += 2000
+	}
+	return time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, time.UTC), true
+}
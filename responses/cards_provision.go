@@ -0,0 +1,72 @@
+package responses
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+)
+
+// ApplePayProvisioningPayload is the provisioning payload of a card that is
+// provisioned into Apple Pay. Its fields are passed to PassKit in a
+// PKAddPaymentPassRequest.
+type ApplePayProvisioningPayload struct {
+	// Base64 encoded activation data of the pass.
+	ActivationData string `json:"activationData,required"`
+	// Base64 encoded pass data, encrypted for the device.
+	EncryptedPassData string `json:"encryptedPassData,required"`
+	// Base64 encoded ephemeral public key used to encrypt the pass data.
+	EphemeralPublicKey string `json:"ephemeralPublicKey,required"`
+	JSON               ApplePayProvisioningPayloadJSON
+}
+
+type ApplePayProvisioningPayloadJSON struct {
+	ActivationData     pjson.Metadata
+	EncryptedPassData  pjson.Metadata
+	EphemeralPublicKey pjson.Metadata
+	Raw                []byte
+	Extras             map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into ApplePayProvisioningPayload
+// using the internal pjson library. Unrecognized fields are stored in the
+// `jsonFields` property.
+func (r *ApplePayProvisioningPayload) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// GooglePayProvisioningPayload is the provisioning payload of a card that is
+// provisioned into Google Pay.
+type GooglePayProvisioningPayload struct {
+	// The base64 encoded Opaque Payment Card, which is passed as is to
+	// pushTokenize in the Google Pay Push Provisioning API.
+	OpaquePaymentCard string
+}
+
+// ApplePay decodes the provisioning payload of a card provisioned into Apple
+// Pay, which is base64 encoded JSON.
+func (r *CardProvisionResponse) ApplePay() (*ApplePayProvisioningPayload, error) {
+	raw, err := base64.StdEncoding.DecodeString(r.ProvisioningPayload)
+	if err != nil {
+		return nil, fmt.Errorf("malformed Apple Pay provisioning payload: %w", err)
+	}
+	res := &ApplePayProvisioningPayload{}
+	if err := json.Unmarshal(raw, res); err != nil {
+		return nil, fmt.Errorf("malformed Apple Pay provisioning payload: %w", err)
+	}
+	if res.ActivationData == "" || res.EncryptedPassData == "" || res.EphemeralPublicKey == "" {
+		return nil, errors.New("incomplete Apple Pay provisioning payload")
+	}
+	return res, nil
+}
+
+// GooglePay decodes the provisioning payload of a card provisioned into Google
+// Pay, which is a base64 encoded Opaque Payment Card.
+func (r *CardProvisionResponse) GooglePay() (*GooglePayProvisioningPayload, error) {
+	if _, err := base64.StdEncoding.DecodeString(r.ProvisioningPayload); err != nil || r.ProvisioningPayload == "" {
+		return nil, errors.New("malformed Google Pay provisioning payload")
+	}
+	return &GooglePayProvisioningPayload{OpaquePaymentCard: r.ProvisioningPayload}, nil
+}
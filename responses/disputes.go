@@ -181,6 +181,17 @@ const (
 	DisputeStatusCaseClosed      DisputeStatus = "CASE_CLOSED"
 )
 
+// IsResolved reports whether the dispute case has been won, lost or withdrawn.
+func (r DisputeStatus) IsResolved() bool {
+	return r == DisputeStatusCaseWon || r == DisputeStatusCaseClosed
+}
+
+// IsEditable reports whether a dispute in this status can still be updated or
+// withdrawn, which is only the case before it is submitted.
+func (r DisputeStatus) IsEditable() bool {
+	return r == DisputeStatusNew
+}
+
 type DisputeEvidence struct {
 	// Timestamp of when first Dispute was reported.
 	Created time.Time `json:"created,required" format:"date-time"`
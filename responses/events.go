@@ -10,11 +10,14 @@ import (
 type Event struct {
 	// Globally unique identifier.
 	Token string `json:"token,required"`
-	// Event types:
+	// Event types, such as:
 	//
+	//   - `card_transaction.updated` - A transaction has been created or updated.
 	//   - `dispute.updated` - A dispute has been updated.
 	//   - `digital_wallet.tokenization_approval_request` - Card network's request to
 	//     Lithic to activate a digital wallet token.
+	//
+	// The events package decodes the payload of every event type.
 	EventType EventEventType         `json:"event_type,required"`
 	Payload   map[string]interface{} `json:"payload,required"`
 	// An RFC 3339 timestamp for when the event was created. UTC time zone.
@@ -39,11 +42,34 @@ func (r *Event) UnmarshalJSON(data []byte) (err error) {
 	return pjson.UnmarshalRoot(data, r)
 }
 
+// DecodePayload decodes the raw payload of the event into dst, for example into
+// a Dispute for `dispute.updated` events.
+func (r *Event) DecodePayload(dst interface{}) error {
+	return pjson.Unmarshal(r.JSON.Payload.Raw(), dst)
+}
+
 type EventEventType string
 
 const (
-	EventEventTypeDisputeUpdated                           EventEventType = "dispute.updated"
-	EventEventTypeDigitalWalletTokenizationApprovalRequest EventEventType = "digital_wallet.tokenization_approval_request"
+	EventEventTypeAccountHolderCreated                                 EventEventType = "account_holder.created"
+	EventEventTypeAccountHolderUpdated                                 EventEventType = "account_holder.updated"
+	EventEventTypeAccountHolderVerification                            EventEventType = "account_holder.verification"
+	EventEventTypeBalanceUpdated                                       EventEventType = "balance.updated"
+	EventEventTypeCardCreated                                          EventEventType = "card.created"
+	EventEventTypeCardRenewed                                          EventEventType = "card.renewed"
+	EventEventTypeCardShipped                                          EventEventType = "card.shipped"
+	EventEventTypeCardTransactionUpdated                               EventEventType = "card_transaction.updated"
+	EventEventTypeDigitalWalletTokenizationApprovalRequest             EventEventType = "digital_wallet.tokenization_approval_request"
+	EventEventTypeDigitalWalletTokenizationResult                      EventEventType = "digital_wallet.tokenization_result"
+	EventEventTypeDigitalWalletTokenizationTwoFactorAuthenticationCode EventEventType = "digital_wallet.tokenization_two_factor_authentication_code"
+	EventEventTypeDisputeUpdated                                       EventEventType = "dispute.updated"
+	EventEventTypeDisputeEvidenceUploadFailed                          EventEventType = "dispute_evidence.upload_failed"
+	EventEventTypeExternalBankAccountCreated                           EventEventType = "external_bank_account.created"
+	EventEventTypeExternalBankAccountUpdated                           EventEventType = "external_bank_account.updated"
+	EventEventTypeFinancialAccountCreated                              EventEventType = "financial_account.created"
+	EventEventTypeSettlementReportUpdated                              EventEventType = "settlement_report.updated"
+	EventEventTypeStatementsCreated                                    EventEventType = "statements.created"
+	EventEventTypeThreeDSAuthenticationCreated                         EventEventType = "three_ds_authentication.created"
 )
 
 type EventSubscription struct {
@@ -78,8 +104,25 @@ func (r *EventSubscription) UnmarshalJSON(data []byte) (err error) {
 type EventSubscriptionEventTypes string
 
 const (
-	EventSubscriptionEventTypesDisputeUpdated                           EventSubscriptionEventTypes = "dispute.updated"
-	EventSubscriptionEventTypesDigitalWalletTokenizationApprovalRequest EventSubscriptionEventTypes = "digital_wallet.tokenization_approval_request"
+	EventSubscriptionEventTypesAccountHolderCreated                                 EventSubscriptionEventTypes = "account_holder.created"
+	EventSubscriptionEventTypesAccountHolderUpdated                                 EventSubscriptionEventTypes = "account_holder.updated"
+	EventSubscriptionEventTypesAccountHolderVerification                            EventSubscriptionEventTypes = "account_holder.verification"
+	EventSubscriptionEventTypesBalanceUpdated                                       EventSubscriptionEventTypes = "balance.updated"
+	EventSubscriptionEventTypesCardCreated                                          EventSubscriptionEventTypes = "card.created"
+	EventSubscriptionEventTypesCardRenewed                                          EventSubscriptionEventTypes = "card.renewed"
+	EventSubscriptionEventTypesCardShipped                                          EventSubscriptionEventTypes = "card.shipped"
+	EventSubscriptionEventTypesCardTransactionUpdated                               EventSubscriptionEventTypes = "card_transaction.updated"
+	EventSubscriptionEventTypesDigitalWalletTokenizationApprovalRequest             EventSubscriptionEventTypes = "digital_wallet.tokenization_approval_request"
+	EventSubscriptionEventTypesDigitalWalletTokenizationResult                      EventSubscriptionEventTypes = "digital_wallet.tokenization_result"
+	EventSubscriptionEventTypesDigitalWalletTokenizationTwoFactorAuthenticationCode EventSubscriptionEventTypes = "digital_wallet.tokenization_two_factor_authentication_code"
+	EventSubscriptionEventTypesDisputeUpdated                                       EventSubscriptionEventTypes = "dispute.updated"
+	EventSubscriptionEventTypesDisputeEvidenceUploadFailed                          EventSubscriptionEventTypes = "dispute_evidence.upload_failed"
+	EventSubscriptionEventTypesExternalBankAccountCreated                           EventSubscriptionEventTypes = "external_bank_account.created"
+	EventSubscriptionEventTypesExternalBankAccountUpdated                           EventSubscriptionEventTypes = "external_bank_account.updated"
+	EventSubscriptionEventTypesFinancialAccountCreated                              EventSubscriptionEventTypes = "financial_account.created"
+	EventSubscriptionEventTypesSettlementReportUpdated                              EventSubscriptionEventTypes = "settlement_report.updated"
+	EventSubscriptionEventTypesStatementsCreated                                    EventSubscriptionEventTypes = "statements.created"
+	EventSubscriptionEventTypesThreeDSAuthenticationCreated                         EventSubscriptionEventTypes = "three_ds_authentication.created"
 )
 
 type EventListResponse struct {
@@ -117,3 +160,70 @@ func (r *EventsCursorPage) NextPage() (*EventsCursorPage, error) {
 		return &EventsCursorPage{page}, nil
 	}
 }
+
+// A delivery attempt of an event to an event subscription.
+type MessageAttempt struct {
+	// Globally unique identifier.
+	Token string `json:"token,required"`
+	// An RFC 3339 timestamp for when the event was created. UTC time zone.
+	//
+	// If no timezone is specified, UTC will be used.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// Globally unique identifier.
+	EventSubscriptionToken string `json:"event_subscription_token,required"`
+	// Globally unique identifier.
+	EventToken string `json:"event_token,required"`
+	// The response body from the event subscription's URL.
+	Response string `json:"response,required"`
+	// The response status code from the event subscription's URL.
+	ResponseStatusCode int64 `json:"response_status_code,required"`
+	// The status of the event attempt.
+	Status MessageAttemptStatus `json:"status,required"`
+	URL    string               `json:"url,required" format:"uri"`
+	JSON   MessageAttemptJSON
+}
+
+type MessageAttemptJSON struct {
+	Token                  pjson.Metadata
+	Created                pjson.Metadata
+	EventSubscriptionToken pjson.Metadata
+	EventToken             pjson.Metadata
+	Response               pjson.Metadata
+	ResponseStatusCode     pjson.Metadata
+	Status                 pjson.Metadata
+	URL                    pjson.Metadata
+	Raw                    []byte
+	Extras                 map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into MessageAttempt using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *MessageAttempt) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type MessageAttemptStatus string
+
+const (
+	MessageAttemptStatusFailed  MessageAttemptStatus = "FAILED"
+	MessageAttemptStatusPending MessageAttemptStatus = "PENDING"
+	MessageAttemptStatusSending MessageAttemptStatus = "SENDING"
+	MessageAttemptStatusSuccess MessageAttemptStatus = "SUCCESS"
+)
+
+type MessageAttemptsCursorPage struct {
+	*pagination.CursorPage[MessageAttempt]
+}
+
+func (r *MessageAttemptsCursorPage) MessageAttempt() *MessageAttempt {
+	return r.Current()
+}
+
+func (r *MessageAttemptsCursorPage) NextPage() (*MessageAttemptsCursorPage, error) {
+	if page, err := r.CursorPage.NextPage(); err != nil {
+		return nil, err
+	} else {
+		return &MessageAttemptsCursorPage{page}, nil
+	}
+}
@@ -0,0 +1,407 @@
+package responses
+
+import (
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+)
+
+// The payload of `card.created` events.
+type CardCreatedEvent struct {
+	// The token of the card that was created.
+	CardToken string `json:"card_token,required" format:"uuid"`
+	JSON      CardCreatedEventJSON
+}
+
+type CardCreatedEventJSON struct {
+	CardToken pjson.Metadata
+	Raw       []byte
+	Extras    map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into CardCreatedEvent using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *CardCreatedEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The payload of `card.renewed` events.
+type CardRenewedEvent struct {
+	// The token of the card that was renewed.
+	CardToken string `json:"card_token,required" format:"uuid"`
+	// The new two digit expiry month of the card.
+	ExpMonth string `json:"exp_month"`
+	// The new four digit expiry year of the card.
+	ExpYear string `json:"exp_year"`
+	// The two digit expiry month of the card before it was renewed.
+	PreviousExpMonth string `json:"previous_exp_month"`
+	// The four digit expiry year of the card before it was renewed.
+	PreviousExpYear string `json:"previous_exp_year"`
+	JSON            CardRenewedEventJSON
+}
+
+type CardRenewedEventJSON struct {
+	CardToken        pjson.Metadata
+	ExpMonth         pjson.Metadata
+	ExpYear          pjson.Metadata
+	PreviousExpMonth pjson.Metadata
+	PreviousExpYear  pjson.Metadata
+	Raw              []byte
+	Extras           map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into CardRenewedEvent using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *CardRenewedEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The payload of `card.shipped` events.
+type CardShippedEvent struct {
+	// The token of the card that was shipped.
+	CardToken string `json:"card_token,required" format:"uuid"`
+	// The token of the bulk order that the card was shipped in, if any.
+	BulkOrderToken string `json:"bulk_order_token,nullable" format:"uuid"`
+	// The shipping method of the card.
+	ShippingMethod string `json:"shipping_method"`
+	// The tracking number of the shipment, if the shipping method has one.
+	TrackingNumber string `json:"tracking_number,nullable"`
+	JSON           CardShippedEventJSON
+}
+
+type CardShippedEventJSON struct {
+	CardToken      pjson.Metadata
+	BulkOrderToken pjson.Metadata
+	ShippingMethod pjson.Metadata
+	TrackingNumber pjson.Metadata
+	Raw            []byte
+	Extras         map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into CardShippedEvent using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *CardShippedEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The payload of `account_holder.created` events.
+type AccountHolderCreatedEvent struct {
+	// The token of the account holder.
+	Token string `json:"token,required" format:"uuid"`
+	// The token of the account of the account holder.
+	AccountToken string `json:"account_token" format:"uuid"`
+	// KYC and KYB evaluation state.
+	Status AccountHolderStatus `json:"status"`
+	// Reasons for the evaluation status.
+	StatusReasons []AccountHolderStatusReasons `json:"status_reasons"`
+	// When the account holder was created.
+	Created time.Time `json:"created" format:"date-time"`
+	JSON    AccountHolderCreatedEventJSON
+}
+
+type AccountHolderCreatedEventJSON struct {
+	Token         pjson.Metadata
+	AccountToken  pjson.Metadata
+	Status        pjson.Metadata
+	StatusReasons pjson.Metadata
+	Created       pjson.Metadata
+	Raw           []byte
+	Extras        map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into AccountHolderCreatedEvent
+// using the internal pjson library. Unrecognized fields are stored in the
+// `jsonFields` property.
+func (r *AccountHolderCreatedEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The payload of `account_holder.updated` events. The fields that were updated
+// are available in JSON.Extras.
+type AccountHolderUpdatedEvent struct {
+	// The token of the account holder.
+	Token string `json:"token,required" format:"uuid"`
+	// The identifier of the account holder in your system, if it was given.
+	ExternalID string `json:"external_id"`
+	JSON       AccountHolderUpdatedEventJSON
+}
+
+type AccountHolderUpdatedEventJSON struct {
+	Token      pjson.Metadata
+	ExternalID pjson.Metadata
+	Raw        []byte
+	Extras     map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into AccountHolderUpdatedEvent
+// using the internal pjson library. Unrecognized fields are stored in the
+// `jsonFields` property.
+func (r *AccountHolderUpdatedEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The payload of `account_holder.verification` events.
+type AccountHolderVerificationEvent struct {
+	// The token of the account holder.
+	Token string `json:"token,required" format:"uuid"`
+	// The token of the account of the account holder.
+	AccountToken string `json:"account_token" format:"uuid"`
+	// KYC and KYB evaluation state.
+	Status AccountHolderStatus `json:"status"`
+	// Reasons for the evaluation status.
+	StatusReasons []AccountHolderStatusReasons `json:"status_reasons"`
+	// When the verification was completed.
+	Created time.Time `json:"created" format:"date-time"`
+	JSON    AccountHolderVerificationEventJSON
+}
+
+type AccountHolderVerificationEventJSON struct {
+	Token         pjson.Metadata
+	AccountToken  pjson.Metadata
+	Status        pjson.Metadata
+	StatusReasons pjson.Metadata
+	Created       pjson.Metadata
+	Raw           []byte
+	Extras        map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into
+// AccountHolderVerificationEvent using the internal pjson library. Unrecognized
+// fields are stored in the `jsonFields` property.
+func (r *AccountHolderVerificationEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The payload of `balance.updated` events.
+type BalanceUpdatedEvent struct {
+	// The balances that were updated.
+	Data []Balance `json:"data,required"`
+	JSON BalanceUpdatedEventJSON
+}
+
+type BalanceUpdatedEventJSON struct {
+	Data   pjson.Metadata
+	Raw    []byte
+	Extras map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into BalanceUpdatedEvent using
+// the internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *BalanceUpdatedEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The payload of `digital_wallet.tokenization_approval_request` events.
+type DigitalWalletTokenizationApprovalRequestEvent struct {
+	// The token of the account of the card being tokenized.
+	AccountToken string `json:"account_token,required" format:"uuid"`
+	// The token of the card being tokenized.
+	CardToken string `json:"card_token,required" format:"uuid"`
+	// When the approval request was made.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// The decision of your tokenization decisioning responder, if you have one.
+	CustomerTokenizationDecision DigitalWalletTokenizationDecision `json:"customer_tokenization_decision,nullable"`
+	// The decision that Lithic made on the request, such as APPROVED or DECLINED.
+	IssuerDecision string `json:"issuer_decision,required"`
+	// The channel through which the tokenization was requested.
+	TokenizationChannel TokenizationChannel `json:"tokenization_channel"`
+	// The token of the tokenization.
+	TokenizationToken string `json:"tokenization_token" format:"uuid"`
+	// The risk assessment of the digital wallet.
+	WalletDecisioningInfo DigitalWalletDecisioningInfo `json:"wallet_decisioning_info,required"`
+	JSON                  DigitalWalletTokenizationApprovalRequestEventJSON
+}
+
+type DigitalWalletTokenizationApprovalRequestEventJSON struct {
+	AccountToken                 pjson.Metadata
+	CardToken                    pjson.Metadata
+	Created                      pjson.Metadata
+	CustomerTokenizationDecision pjson.Metadata
+	IssuerDecision               pjson.Metadata
+	TokenizationChannel          pjson.Metadata
+	TokenizationToken            pjson.Metadata
+	WalletDecisioningInfo        pjson.Metadata
+	Raw                          []byte
+	Extras                       map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into
+// DigitalWalletTokenizationApprovalRequestEvent using the internal pjson
+// library. Unrecognized fields are stored in the `jsonFields` property.
+func (r *DigitalWalletTokenizationApprovalRequestEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The decision of a tokenization decisioning responder.
+type DigitalWalletTokenizationDecision struct {
+	// The outcome of the call to the responder, such as APPROVED or ERROR.
+	Outcome string `json:"outcome,required"`
+	// The URL of the responder.
+	ResponderURL string `json:"responder_url,required"`
+	// The time the responder took to respond, in milliseconds.
+	Latency string `json:"latency"`
+	// The HTTP status code of the response of the responder.
+	ResponseCode string `json:"response_code"`
+	JSON         DigitalWalletTokenizationDecisionJSON
+}
+
+type DigitalWalletTokenizationDecisionJSON struct {
+	Outcome      pjson.Metadata
+	ResponderURL pjson.Metadata
+	Latency      pjson.Metadata
+	ResponseCode pjson.Metadata
+	Raw          []byte
+	Extras       map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into
+// DigitalWalletTokenizationDecision using the internal pjson library.
+// Unrecognized fields are stored in the `jsonFields` property.
+func (r *DigitalWalletTokenizationDecision) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The risk assessment of a digital wallet tokenization by the card network.
+type DigitalWalletDecisioningInfo struct {
+	// The score given to the account by the card network.
+	AccountScore string `json:"account_score,nullable"`
+	// The score given to the device by the card network.
+	DeviceScore string `json:"device_score,nullable"`
+	// The decision recommended by the card network.
+	RecommendedDecision string `json:"recommended_decision,nullable"`
+	JSON                DigitalWalletDecisioningInfoJSON
+}
+
+type DigitalWalletDecisioningInfoJSON struct {
+	AccountScore        pjson.Metadata
+	DeviceScore         pjson.Metadata
+	RecommendedDecision pjson.Metadata
+	Raw                 []byte
+	Extras              map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into
+// DigitalWalletDecisioningInfo using the internal pjson library. Unrecognized
+// fields are stored in the `jsonFields` property.
+func (r *DigitalWalletDecisioningInfo) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The payload of `digital_wallet.tokenization_result` events.
+type DigitalWalletTokenizationResultEvent struct {
+	// The token of the account of the card that was tokenized.
+	AccountToken string `json:"account_token,required" format:"uuid"`
+	// The token of the card that was tokenized.
+	CardToken string `json:"card_token,required" format:"uuid"`
+	// When the result was created.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// The result of the tokenization.
+	TokenizationResultDetails DigitalWalletTokenizationResultDetails `json:"tokenization_result_details,required"`
+	// The token of the tokenization.
+	TokenizationToken string `json:"tokenization_token" format:"uuid"`
+	JSON              DigitalWalletTokenizationResultEventJSON
+}
+
+type DigitalWalletTokenizationResultEventJSON struct {
+	AccountToken              pjson.Metadata
+	CardToken                 pjson.Metadata
+	Created                   pjson.Metadata
+	TokenizationResultDetails pjson.Metadata
+	TokenizationToken         pjson.Metadata
+	Raw                       []byte
+	Extras                    map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into
+// DigitalWalletTokenizationResultEvent using the internal pjson library.
+// Unrecognized fields are stored in the `jsonFields` property.
+func (r *DigitalWalletTokenizationResultEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The result of a digital wallet tokenization.
+type DigitalWalletTokenizationResultDetails struct {
+	// The decision that Lithic made on the tokenization.
+	IssuerDecision string `json:"issuer_decision,required"`
+	// The reasons that the tokenization was declined for, if it was.
+	TokenizationDeclineReasons []string `json:"tokenization_decline_reasons,required"`
+	JSON                       DigitalWalletTokenizationResultDetailsJSON
+}
+
+type DigitalWalletTokenizationResultDetailsJSON struct {
+	IssuerDecision             pjson.Metadata
+	TokenizationDeclineReasons pjson.Metadata
+	Raw                        []byte
+	Extras                     map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into
+// DigitalWalletTokenizationResultDetails using the internal pjson library.
+// Unrecognized fields are stored in the `jsonFields` property.
+func (r *DigitalWalletTokenizationResultDetails) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// The payload of `digital_wallet.tokenization_two_factor_authentication_code`
+// events, which carry the code that you must send to the cardholder.
+type DigitalWalletTokenizationTwoFactorAuthenticationCodeEvent struct {
+	// The token of the account of the card being tokenized.
+	AccountToken string `json:"account_token,required" format:"uuid"`
+	// The token of the card being tokenized.
+	CardToken string `json:"card_token,required" format:"uuid"`
+	// When the code was created.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// How the code must be sent to the cardholder.
+	ActivationMethod DigitalWalletActivationMethod `json:"activation_method,required"`
+	// The code to send to the cardholder.
+	AuthenticationCode string `json:"authentication_code,required"`
+	// The token of the tokenization.
+	TokenizationToken string `json:"tokenization_token" format:"uuid"`
+	JSON              DigitalWalletTokenizationTwoFactorAuthenticationCodeEventJSON
+}
+
+type DigitalWalletTokenizationTwoFactorAuthenticationCodeEventJSON struct {
+	AccountToken       pjson.Metadata
+	CardToken          pjson.Metadata
+	Created            pjson.Metadata
+	ActivationMethod   pjson.Metadata
+	AuthenticationCode pjson.Metadata
+	TokenizationToken  pjson.Metadata
+	Raw                []byte
+	Extras             map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into
+// DigitalWalletTokenizationTwoFactorAuthenticationCodeEvent using the internal
+// pjson library. Unrecognized fields are stored in the `jsonFields` property.
+func (r *DigitalWalletTokenizationTwoFactorAuthenticationCodeEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// How a two factor authentication code is sent to a cardholder.
+type DigitalWalletActivationMethod struct {
+	// The channel of the code, such as EMAIL_TO_CARDHOLDER_ADDRESS or
+	// TEXT_TO_CARDHOLDER_NUMBER.
+	Type string `json:"type,required"`
+	// The email address or phone number that the code is sent to.
+	Value string `json:"value,required"`
+	JSON  DigitalWalletActivationMethodJSON
+}
+
+type DigitalWalletActivationMethodJSON struct {
+	Type   pjson.Metadata
+	Value  pjson.Metadata
+	Raw    []byte
+	Extras map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into
+// DigitalWalletActivationMethod using the internal pjson library. Unrecognized
+// fields are stored in the `jsonFields` property.
+func (r *DigitalWalletActivationMethod) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
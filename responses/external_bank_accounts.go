@@ -0,0 +1,155 @@
+package responses
+
+import (
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/pagination"
+)
+
+type ExternalBankAccount struct {
+	// A globally unique identifier for this external bank account.
+	Token string `json:"token,required" format:"uuid"`
+	// Indicates which Lithic account the external account is associated with.
+	AccountToken string `json:"account_token,nullable" format:"uuid"`
+	// Account type of the account.
+	Type ExternalBankAccountType `json:"type,required"`
+	// The last 4 digits of the bank account number.
+	LastFour string `json:"last_four,required"`
+	// The routing number of the bank account.
+	RoutingNumber string `json:"routing_number,required"`
+	// The country that the bank account is located in, as an ISO 3166-1 alpha-3
+	// code.
+	Country string `json:"country,required"`
+	// The currency of the bank account, as an ISO 4217 code.
+	Currency string `json:"currency,required"`
+	// Legal name of the entity that owns the account.
+	Owner string `json:"owner,required"`
+	// Owner type of the account.
+	OwnerType ExternalBankAccountOwnerType `json:"owner_type,required"`
+	// Address of the owner of the account.
+	Address Address `json:"address,nullable"`
+	// Optional field that helps identify bank accounts in receipts.
+	CompanyID string `json:"company_id,nullable"`
+	// Date of birth of the owner, for individual owners.
+	Dob string `json:"dob,nullable" format:"date"`
+	// Doing business as, for business owners.
+	DoingBusinessAs string `json:"doing_business_as,nullable"`
+	// The financial account that micro-deposits and prenotes are sent from.
+	FinancialAccountToken string `json:"financial_account_token,nullable" format:"uuid"`
+	// The nickname given to the account.
+	Name string `json:"name,nullable"`
+	// User defined identifier of the account.
+	UserDefinedID string `json:"user_defined_id,nullable"`
+	// State of the account:
+	//
+	//   - `ENABLED` - The account can be used.
+	//   - `PAUSED` - The account cannot be used until it is enabled again.
+	//   - `CLOSED` - The account cannot be used anymore.
+	State ExternalBankAccountState `json:"state,required"`
+	// Verification method of the account.
+	VerificationMethod ExternalBankAccountVerificationMethod `json:"verification_method,required"`
+	// Verification state of the account:
+	//
+	//   - `PENDING` - The account is being verified, for example until its
+	//     micro-deposits are confirmed.
+	//   - `ENABLED` - The account was verified.
+	//   - `FAILED_VERIFICATION` - The account could not be verified. Micro-deposit
+	//     verifications can be retried.
+	VerificationState ExternalBankAccountVerificationState `json:"verification_state,required"`
+	// The number of times verification has been attempted.
+	VerificationAttempts int64 `json:"verification_attempts,required"`
+	// The reason that the last verification failed, if it did.
+	VerificationFailedReason string `json:"verification_failed_reason,nullable"`
+	// An RFC 3339 timestamp for when the account was created. UTC time zone.
+	Created time.Time `json:"created,required" format:"date-time"`
+	JSON    ExternalBankAccountJSON
+}
+
+type ExternalBankAccountJSON struct {
+	Token                    pjson.Metadata
+	AccountToken             pjson.Metadata
+	Type                     pjson.Metadata
+	LastFour                 pjson.Metadata
+	RoutingNumber            pjson.Metadata
+	Country                  pjson.Metadata
+	Currency                 pjson.Metadata
+	Owner                    pjson.Metadata
+	OwnerType                pjson.Metadata
+	Address                  pjson.Metadata
+	CompanyID                pjson.Metadata
+	Dob                      pjson.Metadata
+	DoingBusinessAs          pjson.Metadata
+	FinancialAccountToken    pjson.Metadata
+	Name                     pjson.Metadata
+	UserDefinedID            pjson.Metadata
+	State                    pjson.Metadata
+	VerificationMethod       pjson.Metadata
+	VerificationState        pjson.Metadata
+	VerificationAttempts     pjson.Metadata
+	VerificationFailedReason pjson.Metadata
+	Created                  pjson.Metadata
+	Raw                      []byte
+	Extras                   map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into ExternalBankAccount using
+// the internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *ExternalBankAccount) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type ExternalBankAccountType string
+
+const (
+	ExternalBankAccountTypeChecking ExternalBankAccountType = "CHECKING"
+	ExternalBankAccountTypeSavings  ExternalBankAccountType = "SAVINGS"
+)
+
+type ExternalBankAccountOwnerType string
+
+const (
+	ExternalBankAccountOwnerTypeBusiness   ExternalBankAccountOwnerType = "BUSINESS"
+	ExternalBankAccountOwnerTypeIndividual ExternalBankAccountOwnerType = "INDIVIDUAL"
+)
+
+type ExternalBankAccountState string
+
+const (
+	ExternalBankAccountStateEnabled ExternalBankAccountState = "ENABLED"
+	ExternalBankAccountStateClosed  ExternalBankAccountState = "CLOSED"
+	ExternalBankAccountStatePaused  ExternalBankAccountState = "PAUSED"
+)
+
+type ExternalBankAccountVerificationMethod string
+
+const (
+	ExternalBankAccountVerificationMethodManual       ExternalBankAccountVerificationMethod = "MANUAL"
+	ExternalBankAccountVerificationMethodMicroDeposit ExternalBankAccountVerificationMethod = "MICRO_DEPOSIT"
+	ExternalBankAccountVerificationMethodPrenote      ExternalBankAccountVerificationMethod = "PRENOTE"
+)
+
+type ExternalBankAccountVerificationState string
+
+const (
+	ExternalBankAccountVerificationStatePending            ExternalBankAccountVerificationState = "PENDING"
+	ExternalBankAccountVerificationStateEnabled            ExternalBankAccountVerificationState = "ENABLED"
+	ExternalBankAccountVerificationStateFailedVerification ExternalBankAccountVerificationState = "FAILED_VERIFICATION"
+)
+
+type ExternalBankAccountsCursorPage struct {
+	*pagination.CursorPage[ExternalBankAccount]
+}
+
+func (r *ExternalBankAccountsCursorPage) ExternalBankAccount() *ExternalBankAccount {
+	return r.Current()
+}
+
+func (r *ExternalBankAccountsCursorPage) NextPage() (*ExternalBankAccountsCursorPage, error) {
+	if page, err := r.CursorPage.NextPage(); err != nil {
+		return nil, err
+	} else {
+		return &ExternalBankAccountsCursorPage{page}, nil
+	}
+}
@@ -0,0 +1,214 @@
+package responses
+
+import (
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/pagination"
+)
+
+// Financial Account
+type FinancialAccount struct {
+	// Globally unique identifier for the financial account.
+	Token string `json:"token,required" format:"uuid"`
+	// Date and time for when the financial account was first created.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// Type of financial account
+	Type FinancialAccountType `json:"type,required"`
+	// Date and time for when the financial account was last updated.
+	Updated time.Time `json:"updated,required" format:"date-time"`
+	// Account number for your Lithic-assigned bank account number, if applicable.
+	AccountNumber string `json:"account_number"`
+	// Routing number for your Lithic-assigned bank account number, if applicable.
+	RoutingNumber string `json:"routing_number"`
+	JSON          FinancialAccountJSON
+}
+
+type FinancialAccountJSON struct {
+	Token         pjson.Metadata
+	Created       pjson.Metadata
+	Type          pjson.Metadata
+	Updated       pjson.Metadata
+	AccountNumber pjson.Metadata
+	RoutingNumber pjson.Metadata
+	Raw           []byte
+	Extras        map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into FinancialAccount using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *FinancialAccount) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type FinancialAccountType string
+
+const (
+	FinancialAccountTypeIssuing   FinancialAccountType = "ISSUING"
+	FinancialAccountTypeOperating FinancialAccountType = "OPERATING"
+	FinancialAccountTypeReserve   FinancialAccountType = "RESERVE"
+)
+
+type FinancialTransaction struct {
+	// Status types:
+	//
+	//   - `CARD` - Issuing card transaction.
+	//   - `ACH` - Transaction over ACH.
+	//   - `TRANSFER` - Internal transfer of funds between financial accounts in your
+	//     program.
+	Category FinancialTransactionCategory `json:"category,required"`
+	// Date and time when the financial transaction first occurred. UTC time zone.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// 3-digit alphabetic ISO 4217 code for the settling currency of the transaction.
+	Currency string `json:"currency,required"`
+	// A string that provides a description of the financial transaction; may be
+	// useful to display to users.
+	Descriptor string `json:"descriptor,required"`
+	// A list of all financial events that have modified this financial transaction.
+	Events []FinancialTransactionEvent `json:"events,required"`
+	// Pending amount of the transaction in the currency's smallest unit (e.g., cents),
+	// including any acquirer fees. The value of this field will go to zero over time
+	// once the financial transaction is settled.
+	PendingAmount int64 `json:"pending_amount,required"`
+	// APPROVED transactions were successful while DECLINED transactions were declined
+	// by user, Lithic, or the network.
+	Result FinancialTransactionResult `json:"result,required"`
+	// Amount of the transaction that has been settled in the currency's smallest unit
+	// (e.g., cents), including any acquirer fees. This may change over time.
+	SettledAmount int64 `json:"settled_amount,required"`
+	// Status types:
+	//
+	//   - `DECLINED` - The financial transaction was declined.
+	//   - `EXPIRED` - Lithic reversed the financial transaction.
+	//   - `PENDING` - The financial transaction is pending.
+	//   - `SETTLED` - The financial transaction is settled.
+	//   - `VOIDED` - The merchant has voided the previously pending financial
+	//     transaction.
+	Status FinancialTransactionStatus `json:"status,required"`
+	// Globally unique identifier.
+	Token string `json:"token,required" format:"uuid"`
+	// Date and time when the financial transaction was last updated. UTC time zone.
+	Updated time.Time `json:"updated,required" format:"date-time"`
+	JSON    FinancialTransactionJSON
+}
+
+type FinancialTransactionJSON struct {
+	Category      pjson.Metadata
+	Created       pjson.Metadata
+	Currency      pjson.Metadata
+	Descriptor    pjson.Metadata
+	Events        pjson.Metadata
+	PendingAmount pjson.Metadata
+	Result        pjson.Metadata
+	SettledAmount pjson.Metadata
+	Status        pjson.Metadata
+	Token         pjson.Metadata
+	Updated       pjson.Metadata
+	Raw           []byte
+	Extras        map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into FinancialTransaction using
+// the internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *FinancialTransaction) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type FinancialTransactionCategory string
+
+const (
+	FinancialTransactionCategoryACH      FinancialTransactionCategory = "ACH"
+	FinancialTransactionCategoryCard     FinancialTransactionCategory = "CARD"
+	FinancialTransactionCategoryTransfer FinancialTransactionCategory = "TRANSFER"
+)
+
+type FinancialTransactionResult string
+
+const (
+	FinancialTransactionResultApproved FinancialTransactionResult = "APPROVED"
+	FinancialTransactionResultDeclined FinancialTransactionResult = "DECLINED"
+)
+
+type FinancialTransactionStatus string
+
+const (
+	FinancialTransactionStatusDeclined FinancialTransactionStatus = "DECLINED"
+	FinancialTransactionStatusExpired  FinancialTransactionStatus = "EXPIRED"
+	FinancialTransactionStatusPending  FinancialTransactionStatus = "PENDING"
+	FinancialTransactionStatusSettled  FinancialTransactionStatus = "SETTLED"
+	FinancialTransactionStatusVoided   FinancialTransactionStatus = "VOIDED"
+)
+
+type FinancialTransactionEvent struct {
+	// Amount of the financial event that has been settled in the currency's smallest
+	// unit (e.g., cents).
+	Amount int64 `json:"amount"`
+	// Date and time when the financial event occurred. UTC time zone.
+	Created time.Time `json:"created" format:"date-time"`
+	// APPROVED financial events were successful while DECLINED financial events were
+	// declined by user, Lithic, or the network.
+	Result FinancialTransactionEventResult `json:"result"`
+	// Globally unique identifier.
+	Token string `json:"token" format:"uuid"`
+	// Event types, such as `AUTHORIZATION`, `CLEARING` or `ACH_INSUFFICIENT_FUNDS`.
+	Type string `json:"type"`
+	JSON FinancialTransactionEventJSON
+}
+
+type FinancialTransactionEventJSON struct {
+	Amount  pjson.Metadata
+	Created pjson.Metadata
+	Result  pjson.Metadata
+	Token   pjson.Metadata
+	Type    pjson.Metadata
+	Raw     []byte
+	Extras  map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into FinancialTransactionEvent
+// using the internal pjson library. Unrecognized fields are stored in the
+// `jsonFields` property.
+func (r *FinancialTransactionEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type FinancialTransactionEventResult string
+
+const (
+	FinancialTransactionEventResultApproved FinancialTransactionEventResult = "APPROVED"
+	FinancialTransactionEventResultDeclined FinancialTransactionEventResult = "DECLINED"
+)
+
+type FinancialAccountsCursorPage struct {
+	*pagination.CursorPage[FinancialAccount]
+}
+
+func (r *FinancialAccountsCursorPage) FinancialAccount() *FinancialAccount {
+	return r.Current()
+}
+
+func (r *FinancialAccountsCursorPage) NextPage() (*FinancialAccountsCursorPage, error) {
+	if page, err := r.CursorPage.NextPage(); err != nil {
+		return nil, err
+	} else {
+		return &FinancialAccountsCursorPage{page}, nil
+	}
+}
+
+type FinancialTransactionsCursorPage struct {
+	*pagination.CursorPage[FinancialTransaction]
+}
+
+func (r *FinancialTransactionsCursorPage) FinancialTransaction() *FinancialTransaction {
+	return r.Current()
+}
+
+func (r *FinancialTransactionsCursorPage) NextPage() (*FinancialTransactionsCursorPage, error) {
+	if page, err := r.CursorPage.NextPage(); err != nil {
+		return nil, err
+	} else {
+		return &FinancialTransactionsCursorPage{page}, nil
+	}
+}
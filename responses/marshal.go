@@ -0,0 +1,110 @@
+package responses
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+)
+
+// KeyStyle is the style of the object keys written by Marshal.
+type KeyStyle int
+
+const (
+	// Keys as they are in the Lithic API, such as `last_four`.
+	KeyStyleSnakeCase KeyStyle = iota
+	// Keys in camelCase, such as `lastFour`, as expected by most JavaScript
+	// clients.
+	KeyStyleCamelCase
+)
+
+var metadataType = reflect.TypeOf(pjson.Metadata{})
+
+// Marshal serializes a response, such as a Card or a page of transactions, for
+// forwarding to another client, with its keys in the given style. Unlike
+// json.Marshal, it leaves out the JSON metadata of every struct and keeps the
+// fields that the SDK did not recognize. The keys of maps are left as is.
+func Marshal(v interface{}, style KeyStyle) ([]byte, error) {
+	return json.Marshal(restyle(reflect.ValueOf(v), style))
+}
+
+// restyle converts v into values that encoding/json serializes with keys in the
+// given style.
+func restyle(v reflect.Value, style KeyStyle) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if _, ok := v.Interface().(json.Marshaler); ok {
+		return v.Interface()
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return restyle(v.Elem(), style)
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		fallthrough
+	case reflect.Array:
+		values := make([]interface{}, v.Len())
+		for i := range values {
+			values[i] = restyle(v.Index(i), style)
+		}
+		return values
+	case reflect.Map:
+		if v.IsNil() || v.Type().Key().Kind() != reflect.String {
+			return v.Interface()
+		}
+		values := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			values[iter.Key().String()] = restyle(iter.Value(), style)
+		}
+		return values
+	case reflect.Struct:
+		return restyleStruct(v, style)
+	}
+	return v.Interface()
+}
+
+func restyleStruct(v reflect.Value, style KeyStyle) interface{} {
+	values := map[string]interface{}{}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		tag, ok := field.Tag.Lookup("json")
+		name, _, _ := strings.Cut(tag, ",")
+		if !ok || !field.IsExported() || name == "-" || name == "" {
+			continue
+		}
+		values[styleKey(name, style)] = restyle(v.Field(i), style)
+	}
+	if meta := v.FieldByName("JSON"); meta.IsValid() && meta.Kind() == reflect.Struct {
+		if extras := meta.FieldByName("Extras"); extras.IsValid() && extras.Type() == reflect.MapOf(reflect.TypeOf(""), metadataType) {
+			iter := extras.MapRange()
+			for iter.Next() {
+				key := styleKey(iter.Key().String(), style)
+				if _, known := values[key]; !known {
+					values[key] = json.RawMessage(iter.Value().Interface().(pjson.Metadata).Raw())
+				}
+			}
+		}
+	}
+	return values
+}
+
+func styleKey(key string, style KeyStyle) string {
+	if style != KeyStyleCamelCase {
+		return key
+	}
+	words := strings.Split(strings.TrimLeft(key, "_"), "_")
+	for i := 1; i < len(words); i++ {
+		if words[i] != "" {
+			words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+		}
+	}
+	return strings.Join(words, "")
+}
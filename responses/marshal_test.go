@@ -0,0 +1,55 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshal(t *testing.T) {
+	card := &Card{}
+	err := json.Unmarshal([]byte(`{"token":"card","last_four":"4142","created":"2023-03-01T12:00:00Z","funding":{"token":"funding","last_four":"1234"},"new_field":{"some_value":1}}`), card)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[KeyStyle][]string{
+		KeyStyleSnakeCase: {"last_four", "new_field", "created"},
+		KeyStyleCamelCase: {"lastFour", "newField", "created"},
+	}
+	for style, keys := range tests {
+		data, err := Marshal(card, style)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := map[string]interface{}{}
+		if err := json.Unmarshal(data, &out); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := out["JSON"]; ok {
+			t.Errorf("%d: expected no metadata, got %s", style, data)
+		}
+		if out[keys[0]] != "4142" || out[keys[2]] != "2023-03-01T12:00:00Z" {
+			t.Errorf("%d: unexpected fields in %s", style, data)
+		}
+		// Unrecognized fields are kept, with their contents as is.
+		if extra, ok := out[keys[1]].(map[string]interface{}); !ok || extra["some_value"] != 1.0 {
+			t.Errorf("%d: expected the unrecognized field, got %s", style, data)
+		}
+		funding, ok := out["funding"].(map[string]interface{})
+		if !ok || funding[keys[0]] != "1234" {
+			t.Errorf("%d: expected nested keys in the same style, got %s", style, data)
+		}
+	}
+}
+
+func TestStyleKey(t *testing.T) {
+	for key, want := range map[string]string{
+		"token":                     "token",
+		"acquirer_reference_number": "acquirerReferenceNumber",
+		"3ds_version":               "3dsVersion",
+	} {
+		if got := styleKey(key, KeyStyleCamelCase); got != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+	}
+}
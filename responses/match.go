@@ -0,0 +1,2141 @@
+// Code generated by genmatch. DO NOT EDIT.
+
+package responses
+
+//go:generate go run ../internal/genmatch
+
+// AccountHolderDocumentDocumentTypeMatchFuncs has a function for every value of AccountHolderDocumentDocumentType, see MatchAccountHolderDocumentDocumentType.
+type AccountHolderDocumentDocumentTypeMatchFuncs[R any] struct {
+	CommercialLicense         func() R
+	DriversLicense            func() R
+	Passport                  func() R
+	PassportCard              func() R
+	Visa                      func() R
+	EinLetter                 func() R
+	TaxReturn                 func() R
+	OperatingAgreement        func() R
+	CertificateOfFormation    func() R
+	CertificateOfGoodStanding func() R
+	ArticlesOfIncorporation   func() R
+	ArticlesOfOrganization    func() R
+	Bylaws                    func() R
+	GovernmentBusinessLicense func() R
+	PartnershipAgreement      func() R
+	Ss4Form                   func() R
+	BankStatement             func() R
+	UtilityBillStatement      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AccountHolderDocumentDocumentType) R
+}
+
+// MatchAccountHolderDocumentDocumentType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAccountHolderDocumentDocumentType[R any](v AccountHolderDocumentDocumentType, m AccountHolderDocumentDocumentTypeMatchFuncs[R]) R {
+	if m.CommercialLicense == nil || m.DriversLicense == nil || m.Passport == nil || m.PassportCard == nil || m.Visa == nil || m.EinLetter == nil || m.TaxReturn == nil || m.OperatingAgreement == nil || m.CertificateOfFormation == nil || m.CertificateOfGoodStanding == nil || m.ArticlesOfIncorporation == nil || m.ArticlesOfOrganization == nil || m.Bylaws == nil || m.GovernmentBusinessLicense == nil || m.PartnershipAgreement == nil || m.Ss4Form == nil || m.BankStatement == nil || m.UtilityBillStatement == nil || m.Unknown == nil {
+		panic("responses: MatchAccountHolderDocumentDocumentType needs a function for every value")
+	}
+	switch v {
+	case AccountHolderDocumentDocumentTypeCommercialLicense:
+		return m.CommercialLicense()
+	case AccountHolderDocumentDocumentTypeDriversLicense:
+		return m.DriversLicense()
+	case AccountHolderDocumentDocumentTypePassport:
+		return m.Passport()
+	case AccountHolderDocumentDocumentTypePassportCard:
+		return m.PassportCard()
+	case AccountHolderDocumentDocumentTypeVisa:
+		return m.Visa()
+	case AccountHolderDocumentDocumentTypeEinLetter:
+		return m.EinLetter()
+	case AccountHolderDocumentDocumentTypeTaxReturn:
+		return m.TaxReturn()
+	case AccountHolderDocumentDocumentTypeOperatingAgreement:
+		return m.OperatingAgreement()
+	case AccountHolderDocumentDocumentTypeCertificateOfFormation:
+		return m.CertificateOfFormation()
+	case AccountHolderDocumentDocumentTypeCertificateOfGoodStanding:
+		return m.CertificateOfGoodStanding()
+	case AccountHolderDocumentDocumentTypeArticlesOfIncorporation:
+		return m.ArticlesOfIncorporation()
+	case AccountHolderDocumentDocumentTypeArticlesOfOrganization:
+		return m.ArticlesOfOrganization()
+	case AccountHolderDocumentDocumentTypeBylaws:
+		return m.Bylaws()
+	case AccountHolderDocumentDocumentTypeGovernmentBusinessLicense:
+		return m.GovernmentBusinessLicense()
+	case AccountHolderDocumentDocumentTypePartnershipAgreement:
+		return m.PartnershipAgreement()
+	case AccountHolderDocumentDocumentTypeSs4Form:
+		return m.Ss4Form()
+	case AccountHolderDocumentDocumentTypeBankStatement:
+		return m.BankStatement()
+	case AccountHolderDocumentDocumentTypeUtilityBillStatement:
+		return m.UtilityBillStatement()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AccountHolderDocumentRequiredDocumentUploadsImageTypeMatchFuncs has a function for every value of AccountHolderDocumentRequiredDocumentUploadsImageType, see MatchAccountHolderDocumentRequiredDocumentUploadsImageType.
+type AccountHolderDocumentRequiredDocumentUploadsImageTypeMatchFuncs[R any] struct {
+	Back  func() R
+	Front func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AccountHolderDocumentRequiredDocumentUploadsImageType) R
+}
+
+// MatchAccountHolderDocumentRequiredDocumentUploadsImageType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAccountHolderDocumentRequiredDocumentUploadsImageType[R any](v AccountHolderDocumentRequiredDocumentUploadsImageType, m AccountHolderDocumentRequiredDocumentUploadsImageTypeMatchFuncs[R]) R {
+	if m.Back == nil || m.Front == nil || m.Unknown == nil {
+		panic("responses: MatchAccountHolderDocumentRequiredDocumentUploadsImageType needs a function for every value")
+	}
+	switch v {
+	case AccountHolderDocumentRequiredDocumentUploadsImageTypeBack:
+		return m.Back()
+	case AccountHolderDocumentRequiredDocumentUploadsImageTypeFront:
+		return m.Front()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AccountHolderDocumentRequiredDocumentUploadsStatusMatchFuncs has a function for every value of AccountHolderDocumentRequiredDocumentUploadsStatus, see MatchAccountHolderDocumentRequiredDocumentUploadsStatus.
+type AccountHolderDocumentRequiredDocumentUploadsStatusMatchFuncs[R any] struct {
+	Completed func() R
+	Failed    func() R
+	Pending   func() R
+	Uploaded  func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AccountHolderDocumentRequiredDocumentUploadsStatus) R
+}
+
+// MatchAccountHolderDocumentRequiredDocumentUploadsStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAccountHolderDocumentRequiredDocumentUploadsStatus[R any](v AccountHolderDocumentRequiredDocumentUploadsStatus, m AccountHolderDocumentRequiredDocumentUploadsStatusMatchFuncs[R]) R {
+	if m.Completed == nil || m.Failed == nil || m.Pending == nil || m.Uploaded == nil || m.Unknown == nil {
+		panic("responses: MatchAccountHolderDocumentRequiredDocumentUploadsStatus needs a function for every value")
+	}
+	switch v {
+	case AccountHolderDocumentRequiredDocumentUploadsStatusCompleted:
+		return m.Completed()
+	case AccountHolderDocumentRequiredDocumentUploadsStatusFailed:
+		return m.Failed()
+	case AccountHolderDocumentRequiredDocumentUploadsStatusPending:
+		return m.Pending()
+	case AccountHolderDocumentRequiredDocumentUploadsStatusUploaded:
+		return m.Uploaded()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AccountHolderDocumentRequiredDocumentUploadsStatusReasonsMatchFuncs has a function for every value of AccountHolderDocumentRequiredDocumentUploadsStatusReasons, see MatchAccountHolderDocumentRequiredDocumentUploadsStatusReasons.
+type AccountHolderDocumentRequiredDocumentUploadsStatusReasonsMatchFuncs[R any] struct {
+	BackImageBlurry  func() R
+	FileSizeTooLarge func() R
+	FrontImageBlurry func() R
+	FrontImageGlare  func() R
+	InvalidFileType  func() R
+	UnknownError     func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AccountHolderDocumentRequiredDocumentUploadsStatusReasons) R
+}
+
+// MatchAccountHolderDocumentRequiredDocumentUploadsStatusReasons calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAccountHolderDocumentRequiredDocumentUploadsStatusReasons[R any](v AccountHolderDocumentRequiredDocumentUploadsStatusReasons, m AccountHolderDocumentRequiredDocumentUploadsStatusReasonsMatchFuncs[R]) R {
+	if m.BackImageBlurry == nil || m.FileSizeTooLarge == nil || m.FrontImageBlurry == nil || m.FrontImageGlare == nil || m.InvalidFileType == nil || m.UnknownError == nil || m.Unknown == nil {
+		panic("responses: MatchAccountHolderDocumentRequiredDocumentUploadsStatusReasons needs a function for every value")
+	}
+	switch v {
+	case AccountHolderDocumentRequiredDocumentUploadsStatusReasonsBackImageBlurry:
+		return m.BackImageBlurry()
+	case AccountHolderDocumentRequiredDocumentUploadsStatusReasonsFileSizeTooLarge:
+		return m.FileSizeTooLarge()
+	case AccountHolderDocumentRequiredDocumentUploadsStatusReasonsFrontImageBlurry:
+		return m.FrontImageBlurry()
+	case AccountHolderDocumentRequiredDocumentUploadsStatusReasonsFrontImageGlare:
+		return m.FrontImageGlare()
+	case AccountHolderDocumentRequiredDocumentUploadsStatusReasonsInvalidFileType:
+		return m.InvalidFileType()
+	case AccountHolderDocumentRequiredDocumentUploadsStatusReasonsUnknownError:
+		return m.UnknownError()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AccountHolderStatusMatchFuncs has a function for every value of AccountHolderStatus, see MatchAccountHolderStatus.
+type AccountHolderStatusMatchFuncs[R any] struct {
+	Accepted        func() R
+	Rejected        func() R
+	PendingResubmit func() R
+	PendingDocument func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AccountHolderStatus) R
+}
+
+// MatchAccountHolderStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAccountHolderStatus[R any](v AccountHolderStatus, m AccountHolderStatusMatchFuncs[R]) R {
+	if m.Accepted == nil || m.Rejected == nil || m.PendingResubmit == nil || m.PendingDocument == nil || m.Unknown == nil {
+		panic("responses: MatchAccountHolderStatus needs a function for every value")
+	}
+	switch v {
+	case AccountHolderStatusAccepted:
+		return m.Accepted()
+	case AccountHolderStatusRejected:
+		return m.Rejected()
+	case AccountHolderStatusPendingResubmit:
+		return m.PendingResubmit()
+	case AccountHolderStatusPendingDocument:
+		return m.PendingDocument()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AccountHolderStatusReasonsMatchFuncs has a function for every value of AccountHolderStatusReasons, see MatchAccountHolderStatusReasons.
+type AccountHolderStatusReasonsMatchFuncs[R any] struct {
+	AddressVerificationFailure  func() R
+	AgeThresholdFailure         func() R
+	CompleteVerificationFailure func() R
+	DobVerificationFailure      func() R
+	IDVerificationFailure       func() R
+	MaxDocumentAttempts         func() R
+	MaxResubmissionAttempts     func() R
+	NameVerificationFailure     func() R
+	OtherVerificationFailure    func() R
+	RiskThresholdFailure        func() R
+	WatchlistAlertFailure       func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AccountHolderStatusReasons) R
+}
+
+// MatchAccountHolderStatusReasons calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAccountHolderStatusReasons[R any](v AccountHolderStatusReasons, m AccountHolderStatusReasonsMatchFuncs[R]) R {
+	if m.AddressVerificationFailure == nil || m.AgeThresholdFailure == nil || m.CompleteVerificationFailure == nil || m.DobVerificationFailure == nil || m.IDVerificationFailure == nil || m.MaxDocumentAttempts == nil || m.MaxResubmissionAttempts == nil || m.NameVerificationFailure == nil || m.OtherVerificationFailure == nil || m.RiskThresholdFailure == nil || m.WatchlistAlertFailure == nil || m.Unknown == nil {
+		panic("responses: MatchAccountHolderStatusReasons needs a function for every value")
+	}
+	switch v {
+	case AccountHolderStatusReasonsAddressVerificationFailure:
+		return m.AddressVerificationFailure()
+	case AccountHolderStatusReasonsAgeThresholdFailure:
+		return m.AgeThresholdFailure()
+	case AccountHolderStatusReasonsCompleteVerificationFailure:
+		return m.CompleteVerificationFailure()
+	case AccountHolderStatusReasonsDobVerificationFailure:
+		return m.DobVerificationFailure()
+	case AccountHolderStatusReasonsIDVerificationFailure:
+		return m.IDVerificationFailure()
+	case AccountHolderStatusReasonsMaxDocumentAttempts:
+		return m.MaxDocumentAttempts()
+	case AccountHolderStatusReasonsMaxResubmissionAttempts:
+		return m.MaxResubmissionAttempts()
+	case AccountHolderStatusReasonsNameVerificationFailure:
+		return m.NameVerificationFailure()
+	case AccountHolderStatusReasonsOtherVerificationFailure:
+		return m.OtherVerificationFailure()
+	case AccountHolderStatusReasonsRiskThresholdFailure:
+		return m.RiskThresholdFailure()
+	case AccountHolderStatusReasonsWatchlistAlertFailure:
+		return m.WatchlistAlertFailure()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AccountStateMatchFuncs has a function for every value of AccountState, see MatchAccountState.
+type AccountStateMatchFuncs[R any] struct {
+	Active func() R
+	Paused func() R
+	Closed func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AccountState) R
+}
+
+// MatchAccountState calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAccountState[R any](v AccountState, m AccountStateMatchFuncs[R]) R {
+	if m.Active == nil || m.Paused == nil || m.Closed == nil || m.Unknown == nil {
+		panic("responses: MatchAccountState needs a function for every value")
+	}
+	switch v {
+	case AccountStateActive:
+		return m.Active()
+	case AccountStatePaused:
+		return m.Paused()
+	case AccountStateClosed:
+		return m.Closed()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AggregateBalanceFinancialAccountTypeMatchFuncs has a function for every value of AggregateBalanceFinancialAccountType, see MatchAggregateBalanceFinancialAccountType.
+type AggregateBalanceFinancialAccountTypeMatchFuncs[R any] struct {
+	Issuing   func() R
+	Operating func() R
+	Reserve   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AggregateBalanceFinancialAccountType) R
+}
+
+// MatchAggregateBalanceFinancialAccountType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAggregateBalanceFinancialAccountType[R any](v AggregateBalanceFinancialAccountType, m AggregateBalanceFinancialAccountTypeMatchFuncs[R]) R {
+	if m.Issuing == nil || m.Operating == nil || m.Reserve == nil || m.Unknown == nil {
+		panic("responses: MatchAggregateBalanceFinancialAccountType needs a function for every value")
+	}
+	switch v {
+	case AggregateBalanceFinancialAccountTypeIssuing:
+		return m.Issuing()
+	case AggregateBalanceFinancialAccountTypeOperating:
+		return m.Operating()
+	case AggregateBalanceFinancialAccountTypeReserve:
+		return m.Reserve()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// AuthRuleAvsTypeMatchFuncs has a function for every value of AuthRuleAvsType, see MatchAuthRuleAvsType.
+type AuthRuleAvsTypeMatchFuncs[R any] struct {
+	ZipOnly func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(AuthRuleAvsType) R
+}
+
+// MatchAuthRuleAvsType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchAuthRuleAvsType[R any](v AuthRuleAvsType, m AuthRuleAvsTypeMatchFuncs[R]) R {
+	if m.ZipOnly == nil || m.Unknown == nil {
+		panic("responses: MatchAuthRuleAvsType needs a function for every value")
+	}
+	switch v {
+	case AuthRuleAvsTypeZipOnly:
+		return m.ZipOnly()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// BalanceFinancialAccountTypeMatchFuncs has a function for every value of BalanceFinancialAccountType, see MatchBalanceFinancialAccountType.
+type BalanceFinancialAccountTypeMatchFuncs[R any] struct {
+	Issuing   func() R
+	Operating func() R
+	Reserve   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(BalanceFinancialAccountType) R
+}
+
+// MatchBalanceFinancialAccountType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchBalanceFinancialAccountType[R any](v BalanceFinancialAccountType, m BalanceFinancialAccountTypeMatchFuncs[R]) R {
+	if m.Issuing == nil || m.Operating == nil || m.Reserve == nil || m.Unknown == nil {
+		panic("responses: MatchBalanceFinancialAccountType needs a function for every value")
+	}
+	switch v {
+	case BalanceFinancialAccountTypeIssuing:
+		return m.Issuing()
+	case BalanceFinancialAccountTypeOperating:
+		return m.Operating()
+	case BalanceFinancialAccountTypeReserve:
+		return m.Reserve()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// BookTransferCategoryMatchFuncs has a function for every value of BookTransferCategory, see MatchBookTransferCategory.
+type BookTransferCategoryMatchFuncs[R any] struct {
+	Adjustment       func() R
+	BalanceOrFunding func() R
+	Derecognition    func() R
+	Dispute          func() R
+	Fee              func() R
+	Reward           func() R
+	Transfer         func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(BookTransferCategory) R
+}
+
+// MatchBookTransferCategory calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchBookTransferCategory[R any](v BookTransferCategory, m BookTransferCategoryMatchFuncs[R]) R {
+	if m.Adjustment == nil || m.BalanceOrFunding == nil || m.Derecognition == nil || m.Dispute == nil || m.Fee == nil || m.Reward == nil || m.Transfer == nil || m.Unknown == nil {
+		panic("responses: MatchBookTransferCategory needs a function for every value")
+	}
+	switch v {
+	case BookTransferCategoryAdjustment:
+		return m.Adjustment()
+	case BookTransferCategoryBalanceOrFunding:
+		return m.BalanceOrFunding()
+	case BookTransferCategoryDerecognition:
+		return m.Derecognition()
+	case BookTransferCategoryDispute:
+		return m.Dispute()
+	case BookTransferCategoryFee:
+		return m.Fee()
+	case BookTransferCategoryReward:
+		return m.Reward()
+	case BookTransferCategoryTransfer:
+		return m.Transfer()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// BookTransferResultMatchFuncs has a function for every value of BookTransferResult, see MatchBookTransferResult.
+type BookTransferResultMatchFuncs[R any] struct {
+	Approved func() R
+	Declined func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(BookTransferResult) R
+}
+
+// MatchBookTransferResult calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchBookTransferResult[R any](v BookTransferResult, m BookTransferResultMatchFuncs[R]) R {
+	if m.Approved == nil || m.Declined == nil || m.Unknown == nil {
+		panic("responses: MatchBookTransferResult needs a function for every value")
+	}
+	switch v {
+	case BookTransferResultApproved:
+		return m.Approved()
+	case BookTransferResultDeclined:
+		return m.Declined()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// BookTransferStatusMatchFuncs has a function for every value of BookTransferStatus, see MatchBookTransferStatus.
+type BookTransferStatusMatchFuncs[R any] struct {
+	Declined func() R
+	Reversed func() R
+	Settled  func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(BookTransferStatus) R
+}
+
+// MatchBookTransferStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchBookTransferStatus[R any](v BookTransferStatus, m BookTransferStatusMatchFuncs[R]) R {
+	if m.Declined == nil || m.Reversed == nil || m.Settled == nil || m.Unknown == nil {
+		panic("responses: MatchBookTransferStatus needs a function for every value")
+	}
+	switch v {
+	case BookTransferStatusDeclined:
+		return m.Declined()
+	case BookTransferStatusReversed:
+		return m.Reversed()
+	case BookTransferStatusSettled:
+		return m.Settled()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardStateMatchFuncs has a function for every value of CardState, see MatchCardState.
+type CardStateMatchFuncs[R any] struct {
+	Closed             func() R
+	Open               func() R
+	Paused             func() R
+	PendingActivation  func() R
+	PendingFulfillment func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardState) R
+}
+
+// MatchCardState calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardState[R any](v CardState, m CardStateMatchFuncs[R]) R {
+	if m.Closed == nil || m.Open == nil || m.Paused == nil || m.PendingActivation == nil || m.PendingFulfillment == nil || m.Unknown == nil {
+		panic("responses: MatchCardState needs a function for every value")
+	}
+	switch v {
+	case CardStateClosed:
+		return m.Closed()
+	case CardStateOpen:
+		return m.Open()
+	case CardStatePaused:
+		return m.Paused()
+	case CardStatePendingActivation:
+		return m.PendingActivation()
+	case CardStatePendingFulfillment:
+		return m.PendingFulfillment()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardTypeMatchFuncs has a function for every value of CardType, see MatchCardType.
+type CardTypeMatchFuncs[R any] struct {
+	Virtual        func() R
+	Physical       func() R
+	MerchantLocked func() R
+	SingleUse      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardType) R
+}
+
+// MatchCardType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardType[R any](v CardType, m CardTypeMatchFuncs[R]) R {
+	if m.Virtual == nil || m.Physical == nil || m.MerchantLocked == nil || m.SingleUse == nil || m.Unknown == nil {
+		panic("responses: MatchCardType needs a function for every value")
+	}
+	switch v {
+	case CardTypeVirtual:
+		return m.Virtual()
+	case CardTypePhysical:
+		return m.Physical()
+	case CardTypeMerchantLocked:
+		return m.MerchantLocked()
+	case CardTypeSingleUse:
+		return m.SingleUse()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardholderAuthenticationAcquirerExemptionMatchFuncs has a function for every value of CardholderAuthenticationAcquirerExemption, see MatchCardholderAuthenticationAcquirerExemption.
+type CardholderAuthenticationAcquirerExemptionMatchFuncs[R any] struct {
+	AuthenticationOutageException          func() R
+	LowValue                               func() R
+	MerchantInitiatedTransaction           func() R
+	None                                   func() R
+	RecurringPayment                       func() R
+	SecureCorporatePayment                 func() R
+	StrongCustomerAuthenticationDelegation func() R
+	TransactionRiskAnalysis                func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardholderAuthenticationAcquirerExemption) R
+}
+
+// MatchCardholderAuthenticationAcquirerExemption calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardholderAuthenticationAcquirerExemption[R any](v CardholderAuthenticationAcquirerExemption, m CardholderAuthenticationAcquirerExemptionMatchFuncs[R]) R {
+	if m.AuthenticationOutageException == nil || m.LowValue == nil || m.MerchantInitiatedTransaction == nil || m.None == nil || m.RecurringPayment == nil || m.SecureCorporatePayment == nil || m.StrongCustomerAuthenticationDelegation == nil || m.TransactionRiskAnalysis == nil || m.Unknown == nil {
+		panic("responses: MatchCardholderAuthenticationAcquirerExemption needs a function for every value")
+	}
+	switch v {
+	case CardholderAuthenticationAcquirerExemptionAuthenticationOutageException:
+		return m.AuthenticationOutageException()
+	case CardholderAuthenticationAcquirerExemptionLowValue:
+		return m.LowValue()
+	case CardholderAuthenticationAcquirerExemptionMerchantInitiatedTransaction:
+		return m.MerchantInitiatedTransaction()
+	case CardholderAuthenticationAcquirerExemptionNone:
+		return m.None()
+	case CardholderAuthenticationAcquirerExemptionRecurringPayment:
+		return m.RecurringPayment()
+	case CardholderAuthenticationAcquirerExemptionSecureCorporatePayment:
+		return m.SecureCorporatePayment()
+	case CardholderAuthenticationAcquirerExemptionStrongCustomerAuthenticationDelegation:
+		return m.StrongCustomerAuthenticationDelegation()
+	case CardholderAuthenticationAcquirerExemptionTransactionRiskAnalysis:
+		return m.TransactionRiskAnalysis()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardholderAuthenticationLiabilityShiftMatchFuncs has a function for every value of CardholderAuthenticationLiabilityShift, see MatchCardholderAuthenticationLiabilityShift.
+type CardholderAuthenticationLiabilityShiftMatchFuncs[R any] struct {
+	CardholderAuthenticationLiabilityShift_3DsAuthenticated func() R
+	AcquirerExemption                                       func() R
+	None                                                    func() R
+	TokenAuthenticated                                      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardholderAuthenticationLiabilityShift) R
+}
+
+// MatchCardholderAuthenticationLiabilityShift calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardholderAuthenticationLiabilityShift[R any](v CardholderAuthenticationLiabilityShift, m CardholderAuthenticationLiabilityShiftMatchFuncs[R]) R {
+	if m.CardholderAuthenticationLiabilityShift_3DsAuthenticated == nil || m.AcquirerExemption == nil || m.None == nil || m.TokenAuthenticated == nil || m.Unknown == nil {
+		panic("responses: MatchCardholderAuthenticationLiabilityShift needs a function for every value")
+	}
+	switch v {
+	case CardholderAuthenticationLiabilityShift_3DsAuthenticated:
+		return m.CardholderAuthenticationLiabilityShift_3DsAuthenticated()
+	case CardholderAuthenticationLiabilityShiftAcquirerExemption:
+		return m.AcquirerExemption()
+	case CardholderAuthenticationLiabilityShiftNone:
+		return m.None()
+	case CardholderAuthenticationLiabilityShiftTokenAuthenticated:
+		return m.TokenAuthenticated()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardholderAuthenticationVerificationAttemptedMatchFuncs has a function for every value of CardholderAuthenticationVerificationAttempted, see MatchCardholderAuthenticationVerificationAttempted.
+type CardholderAuthenticationVerificationAttemptedMatchFuncs[R any] struct {
+	AppLogin  func() R
+	Biometric func() R
+	None      func() R
+	Other     func() R
+	Otp       func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardholderAuthenticationVerificationAttempted) R
+}
+
+// MatchCardholderAuthenticationVerificationAttempted calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardholderAuthenticationVerificationAttempted[R any](v CardholderAuthenticationVerificationAttempted, m CardholderAuthenticationVerificationAttemptedMatchFuncs[R]) R {
+	if m.AppLogin == nil || m.Biometric == nil || m.None == nil || m.Other == nil || m.Otp == nil || m.Unknown == nil {
+		panic("responses: MatchCardholderAuthenticationVerificationAttempted needs a function for every value")
+	}
+	switch v {
+	case CardholderAuthenticationVerificationAttemptedAppLogin:
+		return m.AppLogin()
+	case CardholderAuthenticationVerificationAttemptedBiometric:
+		return m.Biometric()
+	case CardholderAuthenticationVerificationAttemptedNone:
+		return m.None()
+	case CardholderAuthenticationVerificationAttemptedOther:
+		return m.Other()
+	case CardholderAuthenticationVerificationAttemptedOtp:
+		return m.Otp()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// CardholderAuthenticationVerificationResultMatchFuncs has a function for every value of CardholderAuthenticationVerificationResult, see MatchCardholderAuthenticationVerificationResult.
+type CardholderAuthenticationVerificationResultMatchFuncs[R any] struct {
+	Cancelled    func() R
+	Failed       func() R
+	Frictionless func() R
+	NotAttempted func() R
+	Rejected     func() R
+	Success      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(CardholderAuthenticationVerificationResult) R
+}
+
+// MatchCardholderAuthenticationVerificationResult calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchCardholderAuthenticationVerificationResult[R any](v CardholderAuthenticationVerificationResult, m CardholderAuthenticationVerificationResultMatchFuncs[R]) R {
+	if m.Cancelled == nil || m.Failed == nil || m.Frictionless == nil || m.NotAttempted == nil || m.Rejected == nil || m.Success == nil || m.Unknown == nil {
+		panic("responses: MatchCardholderAuthenticationVerificationResult needs a function for every value")
+	}
+	switch v {
+	case CardholderAuthenticationVerificationResultCancelled:
+		return m.Cancelled()
+	case CardholderAuthenticationVerificationResultFailed:
+		return m.Failed()
+	case CardholderAuthenticationVerificationResultFrictionless:
+		return m.Frictionless()
+	case CardholderAuthenticationVerificationResultNotAttempted:
+		return m.NotAttempted()
+	case CardholderAuthenticationVerificationResultRejected:
+		return m.Rejected()
+	case CardholderAuthenticationVerificationResultSuccess:
+		return m.Success()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// DisputeEvidenceUploadStatusMatchFuncs has a function for every value of DisputeEvidenceUploadStatus, see MatchDisputeEvidenceUploadStatus.
+type DisputeEvidenceUploadStatusMatchFuncs[R any] struct {
+	Deleted  func() R
+	Error    func() R
+	Pending  func() R
+	Rejected func() R
+	Uploaded func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(DisputeEvidenceUploadStatus) R
+}
+
+// MatchDisputeEvidenceUploadStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchDisputeEvidenceUploadStatus[R any](v DisputeEvidenceUploadStatus, m DisputeEvidenceUploadStatusMatchFuncs[R]) R {
+	if m.Deleted == nil || m.Error == nil || m.Pending == nil || m.Rejected == nil || m.Uploaded == nil || m.Unknown == nil {
+		panic("responses: MatchDisputeEvidenceUploadStatus needs a function for every value")
+	}
+	switch v {
+	case DisputeEvidenceUploadStatusDeleted:
+		return m.Deleted()
+	case DisputeEvidenceUploadStatusError:
+		return m.Error()
+	case DisputeEvidenceUploadStatusPending:
+		return m.Pending()
+	case DisputeEvidenceUploadStatusRejected:
+		return m.Rejected()
+	case DisputeEvidenceUploadStatusUploaded:
+		return m.Uploaded()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// DisputeReasonMatchFuncs has a function for every value of DisputeReason, see MatchDisputeReason.
+type DisputeReasonMatchFuncs[R any] struct {
+	AtmCashMisdispense               func() R
+	Cancelled                        func() R
+	Duplicated                       func() R
+	FraudCardNotPresent              func() R
+	FraudCardPresent                 func() R
+	FraudOther                       func() R
+	GoodsServicesNotAsDescribed      func() R
+	GoodsServicesNotReceived         func() R
+	IncorrectAmount                  func() R
+	MissingAuth                      func() R
+	Other                            func() R
+	ProcessingError                  func() R
+	RefundNotProcessed               func() R
+	RecurringTransactionNotCancelled func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(DisputeReason) R
+}
+
+// MatchDisputeReason calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchDisputeReason[R any](v DisputeReason, m DisputeReasonMatchFuncs[R]) R {
+	if m.AtmCashMisdispense == nil || m.Cancelled == nil || m.Duplicated == nil || m.FraudCardNotPresent == nil || m.FraudCardPresent == nil || m.FraudOther == nil || m.GoodsServicesNotAsDescribed == nil || m.GoodsServicesNotReceived == nil || m.IncorrectAmount == nil || m.MissingAuth == nil || m.Other == nil || m.ProcessingError == nil || m.RefundNotProcessed == nil || m.RecurringTransactionNotCancelled == nil || m.Unknown == nil {
+		panic("responses: MatchDisputeReason needs a function for every value")
+	}
+	switch v {
+	case DisputeReasonAtmCashMisdispense:
+		return m.AtmCashMisdispense()
+	case DisputeReasonCancelled:
+		return m.Cancelled()
+	case DisputeReasonDuplicated:
+		return m.Duplicated()
+	case DisputeReasonFraudCardNotPresent:
+		return m.FraudCardNotPresent()
+	case DisputeReasonFraudCardPresent:
+		return m.FraudCardPresent()
+	case DisputeReasonFraudOther:
+		return m.FraudOther()
+	case DisputeReasonGoodsServicesNotAsDescribed:
+		return m.GoodsServicesNotAsDescribed()
+	case DisputeReasonGoodsServicesNotReceived:
+		return m.GoodsServicesNotReceived()
+	case DisputeReasonIncorrectAmount:
+		return m.IncorrectAmount()
+	case DisputeReasonMissingAuth:
+		return m.MissingAuth()
+	case DisputeReasonOther:
+		return m.Other()
+	case DisputeReasonProcessingError:
+		return m.ProcessingError()
+	case DisputeReasonRefundNotProcessed:
+		return m.RefundNotProcessed()
+	case DisputeReasonRecurringTransactionNotCancelled:
+		return m.RecurringTransactionNotCancelled()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// DisputeResolutionReasonMatchFuncs has a function for every value of DisputeResolutionReason, see MatchDisputeResolutionReason.
+type DisputeResolutionReasonMatchFuncs[R any] struct {
+	CaseLost                      func() R
+	NetworkRejected               func() R
+	NoDisputeRights_3Ds           func() R
+	NoDisputeRightsBelowThreshold func() R
+	NoDisputeRightsContactless    func() R
+	NoDisputeRightsHybrid         func() R
+	NoDisputeRightsMaxChargebacks func() R
+	NoDisputeRightsOther          func() R
+	PastFilingDate                func() R
+	PrearbitrationRejected        func() R
+	ProcessorRejectedOther        func() R
+	Refunded                      func() R
+	RefundedAfterChargeback       func() R
+	Withdrawn                     func() R
+	WonArbitration                func() R
+	WonFirstChargeback            func() R
+	WonPrearbitration             func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(DisputeResolutionReason) R
+}
+
+// MatchDisputeResolutionReason calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchDisputeResolutionReason[R any](v DisputeResolutionReason, m DisputeResolutionReasonMatchFuncs[R]) R {
+	if m.CaseLost == nil || m.NetworkRejected == nil || m.NoDisputeRights_3Ds == nil || m.NoDisputeRightsBelowThreshold == nil || m.NoDisputeRightsContactless == nil || m.NoDisputeRightsHybrid == nil || m.NoDisputeRightsMaxChargebacks == nil || m.NoDisputeRightsOther == nil || m.PastFilingDate == nil || m.PrearbitrationRejected == nil || m.ProcessorRejectedOther == nil || m.Refunded == nil || m.RefundedAfterChargeback == nil || m.Withdrawn == nil || m.WonArbitration == nil || m.WonFirstChargeback == nil || m.WonPrearbitration == nil || m.Unknown == nil {
+		panic("responses: MatchDisputeResolutionReason needs a function for every value")
+	}
+	switch v {
+	case DisputeResolutionReasonCaseLost:
+		return m.CaseLost()
+	case DisputeResolutionReasonNetworkRejected:
+		return m.NetworkRejected()
+	case DisputeResolutionReasonNoDisputeRights_3Ds:
+		return m.NoDisputeRights_3Ds()
+	case DisputeResolutionReasonNoDisputeRightsBelowThreshold:
+		return m.NoDisputeRightsBelowThreshold()
+	case DisputeResolutionReasonNoDisputeRightsContactless:
+		return m.NoDisputeRightsContactless()
+	case DisputeResolutionReasonNoDisputeRightsHybrid:
+		return m.NoDisputeRightsHybrid()
+	case DisputeResolutionReasonNoDisputeRightsMaxChargebacks:
+		return m.NoDisputeRightsMaxChargebacks()
+	case DisputeResolutionReasonNoDisputeRightsOther:
+		return m.NoDisputeRightsOther()
+	case DisputeResolutionReasonPastFilingDate:
+		return m.PastFilingDate()
+	case DisputeResolutionReasonPrearbitrationRejected:
+		return m.PrearbitrationRejected()
+	case DisputeResolutionReasonProcessorRejectedOther:
+		return m.ProcessorRejectedOther()
+	case DisputeResolutionReasonRefunded:
+		return m.Refunded()
+	case DisputeResolutionReasonRefundedAfterChargeback:
+		return m.RefundedAfterChargeback()
+	case DisputeResolutionReasonWithdrawn:
+		return m.Withdrawn()
+	case DisputeResolutionReasonWonArbitration:
+		return m.WonArbitration()
+	case DisputeResolutionReasonWonFirstChargeback:
+		return m.WonFirstChargeback()
+	case DisputeResolutionReasonWonPrearbitration:
+		return m.WonPrearbitration()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// DisputeStatusMatchFuncs has a function for every value of DisputeStatus, see MatchDisputeStatus.
+type DisputeStatusMatchFuncs[R any] struct {
+	New             func() R
+	PendingCustomer func() R
+	Submitted       func() R
+	Representment   func() R
+	Prearbitration  func() R
+	Arbitration     func() R
+	CaseWon         func() R
+	CaseClosed      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(DisputeStatus) R
+}
+
+// MatchDisputeStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchDisputeStatus[R any](v DisputeStatus, m DisputeStatusMatchFuncs[R]) R {
+	if m.New == nil || m.PendingCustomer == nil || m.Submitted == nil || m.Representment == nil || m.Prearbitration == nil || m.Arbitration == nil || m.CaseWon == nil || m.CaseClosed == nil || m.Unknown == nil {
+		panic("responses: MatchDisputeStatus needs a function for every value")
+	}
+	switch v {
+	case DisputeStatusNew:
+		return m.New()
+	case DisputeStatusPendingCustomer:
+		return m.PendingCustomer()
+	case DisputeStatusSubmitted:
+		return m.Submitted()
+	case DisputeStatusRepresentment:
+		return m.Representment()
+	case DisputeStatusPrearbitration:
+		return m.Prearbitration()
+	case DisputeStatusArbitration:
+		return m.Arbitration()
+	case DisputeStatusCaseWon:
+		return m.CaseWon()
+	case DisputeStatusCaseClosed:
+		return m.CaseClosed()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// EventEventTypeMatchFuncs has a function for every value of EventEventType, see MatchEventEventType.
+type EventEventTypeMatchFuncs[R any] struct {
+	AccountHolderCreated                                 func() R
+	AccountHolderUpdated                                 func() R
+	AccountHolderVerification                            func() R
+	BalanceUpdated                                       func() R
+	CardCreated                                          func() R
+	CardRenewed                                          func() R
+	CardShipped                                          func() R
+	CardTransactionUpdated                               func() R
+	DigitalWalletTokenizationApprovalRequest             func() R
+	DigitalWalletTokenizationResult                      func() R
+	DigitalWalletTokenizationTwoFactorAuthenticationCode func() R
+	DisputeUpdated                                       func() R
+	DisputeEvidenceUploadFailed                          func() R
+	ExternalBankAccountCreated                           func() R
+	ExternalBankAccountUpdated                           func() R
+	FinancialAccountCreated                              func() R
+	SettlementReportUpdated                              func() R
+	StatementsCreated                                    func() R
+	ThreeDSAuthenticationCreated                         func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(EventEventType) R
+}
+
+// MatchEventEventType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchEventEventType[R any](v EventEventType, m EventEventTypeMatchFuncs[R]) R {
+	if m.AccountHolderCreated == nil || m.AccountHolderUpdated == nil || m.AccountHolderVerification == nil || m.BalanceUpdated == nil || m.CardCreated == nil || m.CardRenewed == nil || m.CardShipped == nil || m.CardTransactionUpdated == nil || m.DigitalWalletTokenizationApprovalRequest == nil || m.DigitalWalletTokenizationResult == nil || m.DigitalWalletTokenizationTwoFactorAuthenticationCode == nil || m.DisputeUpdated == nil || m.DisputeEvidenceUploadFailed == nil || m.ExternalBankAccountCreated == nil || m.ExternalBankAccountUpdated == nil || m.FinancialAccountCreated == nil || m.SettlementReportUpdated == nil || m.StatementsCreated == nil || m.ThreeDSAuthenticationCreated == nil || m.Unknown == nil {
+		panic("responses: MatchEventEventType needs a function for every value")
+	}
+	switch v {
+	case EventEventTypeAccountHolderCreated:
+		return m.AccountHolderCreated()
+	case EventEventTypeAccountHolderUpdated:
+		return m.AccountHolderUpdated()
+	case EventEventTypeAccountHolderVerification:
+		return m.AccountHolderVerification()
+	case EventEventTypeBalanceUpdated:
+		return m.BalanceUpdated()
+	case EventEventTypeCardCreated:
+		return m.CardCreated()
+	case EventEventTypeCardRenewed:
+		return m.CardRenewed()
+	case EventEventTypeCardShipped:
+		return m.CardShipped()
+	case EventEventTypeCardTransactionUpdated:
+		return m.CardTransactionUpdated()
+	case EventEventTypeDigitalWalletTokenizationApprovalRequest:
+		return m.DigitalWalletTokenizationApprovalRequest()
+	case EventEventTypeDigitalWalletTokenizationResult:
+		return m.DigitalWalletTokenizationResult()
+	case EventEventTypeDigitalWalletTokenizationTwoFactorAuthenticationCode:
+		return m.DigitalWalletTokenizationTwoFactorAuthenticationCode()
+	case EventEventTypeDisputeUpdated:
+		return m.DisputeUpdated()
+	case EventEventTypeDisputeEvidenceUploadFailed:
+		return m.DisputeEvidenceUploadFailed()
+	case EventEventTypeExternalBankAccountCreated:
+		return m.ExternalBankAccountCreated()
+	case EventEventTypeExternalBankAccountUpdated:
+		return m.ExternalBankAccountUpdated()
+	case EventEventTypeFinancialAccountCreated:
+		return m.FinancialAccountCreated()
+	case EventEventTypeSettlementReportUpdated:
+		return m.SettlementReportUpdated()
+	case EventEventTypeStatementsCreated:
+		return m.StatementsCreated()
+	case EventEventTypeThreeDSAuthenticationCreated:
+		return m.ThreeDSAuthenticationCreated()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// EventSubscriptionEventTypesMatchFuncs has a function for every value of EventSubscriptionEventTypes, see MatchEventSubscriptionEventTypes.
+type EventSubscriptionEventTypesMatchFuncs[R any] struct {
+	AccountHolderCreated                                 func() R
+	AccountHolderUpdated                                 func() R
+	AccountHolderVerification                            func() R
+	BalanceUpdated                                       func() R
+	CardCreated                                          func() R
+	CardRenewed                                          func() R
+	CardShipped                                          func() R
+	CardTransactionUpdated                               func() R
+	DigitalWalletTokenizationApprovalRequest             func() R
+	DigitalWalletTokenizationResult                      func() R
+	DigitalWalletTokenizationTwoFactorAuthenticationCode func() R
+	DisputeUpdated                                       func() R
+	DisputeEvidenceUploadFailed                          func() R
+	ExternalBankAccountCreated                           func() R
+	ExternalBankAccountUpdated                           func() R
+	FinancialAccountCreated                              func() R
+	SettlementReportUpdated                              func() R
+	StatementsCreated                                    func() R
+	ThreeDSAuthenticationCreated                         func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(EventSubscriptionEventTypes) R
+}
+
+// MatchEventSubscriptionEventTypes calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchEventSubscriptionEventTypes[R any](v EventSubscriptionEventTypes, m EventSubscriptionEventTypesMatchFuncs[R]) R {
+	if m.AccountHolderCreated == nil || m.AccountHolderUpdated == nil || m.AccountHolderVerification == nil || m.BalanceUpdated == nil || m.CardCreated == nil || m.CardRenewed == nil || m.CardShipped == nil || m.CardTransactionUpdated == nil || m.DigitalWalletTokenizationApprovalRequest == nil || m.DigitalWalletTokenizationResult == nil || m.DigitalWalletTokenizationTwoFactorAuthenticationCode == nil || m.DisputeUpdated == nil || m.DisputeEvidenceUploadFailed == nil || m.ExternalBankAccountCreated == nil || m.ExternalBankAccountUpdated == nil || m.FinancialAccountCreated == nil || m.SettlementReportUpdated == nil || m.StatementsCreated == nil || m.ThreeDSAuthenticationCreated == nil || m.Unknown == nil {
+		panic("responses: MatchEventSubscriptionEventTypes needs a function for every value")
+	}
+	switch v {
+	case EventSubscriptionEventTypesAccountHolderCreated:
+		return m.AccountHolderCreated()
+	case EventSubscriptionEventTypesAccountHolderUpdated:
+		return m.AccountHolderUpdated()
+	case EventSubscriptionEventTypesAccountHolderVerification:
+		return m.AccountHolderVerification()
+	case EventSubscriptionEventTypesBalanceUpdated:
+		return m.BalanceUpdated()
+	case EventSubscriptionEventTypesCardCreated:
+		return m.CardCreated()
+	case EventSubscriptionEventTypesCardRenewed:
+		return m.CardRenewed()
+	case EventSubscriptionEventTypesCardShipped:
+		return m.CardShipped()
+	case EventSubscriptionEventTypesCardTransactionUpdated:
+		return m.CardTransactionUpdated()
+	case EventSubscriptionEventTypesDigitalWalletTokenizationApprovalRequest:
+		return m.DigitalWalletTokenizationApprovalRequest()
+	case EventSubscriptionEventTypesDigitalWalletTokenizationResult:
+		return m.DigitalWalletTokenizationResult()
+	case EventSubscriptionEventTypesDigitalWalletTokenizationTwoFactorAuthenticationCode:
+		return m.DigitalWalletTokenizationTwoFactorAuthenticationCode()
+	case EventSubscriptionEventTypesDisputeUpdated:
+		return m.DisputeUpdated()
+	case EventSubscriptionEventTypesDisputeEvidenceUploadFailed:
+		return m.DisputeEvidenceUploadFailed()
+	case EventSubscriptionEventTypesExternalBankAccountCreated:
+		return m.ExternalBankAccountCreated()
+	case EventSubscriptionEventTypesExternalBankAccountUpdated:
+		return m.ExternalBankAccountUpdated()
+	case EventSubscriptionEventTypesFinancialAccountCreated:
+		return m.FinancialAccountCreated()
+	case EventSubscriptionEventTypesSettlementReportUpdated:
+		return m.SettlementReportUpdated()
+	case EventSubscriptionEventTypesStatementsCreated:
+		return m.StatementsCreated()
+	case EventSubscriptionEventTypesThreeDSAuthenticationCreated:
+		return m.ThreeDSAuthenticationCreated()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ExternalBankAccountOwnerTypeMatchFuncs has a function for every value of ExternalBankAccountOwnerType, see MatchExternalBankAccountOwnerType.
+type ExternalBankAccountOwnerTypeMatchFuncs[R any] struct {
+	Business   func() R
+	Individual func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ExternalBankAccountOwnerType) R
+}
+
+// MatchExternalBankAccountOwnerType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchExternalBankAccountOwnerType[R any](v ExternalBankAccountOwnerType, m ExternalBankAccountOwnerTypeMatchFuncs[R]) R {
+	if m.Business == nil || m.Individual == nil || m.Unknown == nil {
+		panic("responses: MatchExternalBankAccountOwnerType needs a function for every value")
+	}
+	switch v {
+	case ExternalBankAccountOwnerTypeBusiness:
+		return m.Business()
+	case ExternalBankAccountOwnerTypeIndividual:
+		return m.Individual()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ExternalBankAccountStateMatchFuncs has a function for every value of ExternalBankAccountState, see MatchExternalBankAccountState.
+type ExternalBankAccountStateMatchFuncs[R any] struct {
+	Enabled func() R
+	Closed  func() R
+	Paused  func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ExternalBankAccountState) R
+}
+
+// MatchExternalBankAccountState calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchExternalBankAccountState[R any](v ExternalBankAccountState, m ExternalBankAccountStateMatchFuncs[R]) R {
+	if m.Enabled == nil || m.Closed == nil || m.Paused == nil || m.Unknown == nil {
+		panic("responses: MatchExternalBankAccountState needs a function for every value")
+	}
+	switch v {
+	case ExternalBankAccountStateEnabled:
+		return m.Enabled()
+	case ExternalBankAccountStateClosed:
+		return m.Closed()
+	case ExternalBankAccountStatePaused:
+		return m.Paused()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ExternalBankAccountTypeMatchFuncs has a function for every value of ExternalBankAccountType, see MatchExternalBankAccountType.
+type ExternalBankAccountTypeMatchFuncs[R any] struct {
+	Checking func() R
+	Savings  func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ExternalBankAccountType) R
+}
+
+// MatchExternalBankAccountType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchExternalBankAccountType[R any](v ExternalBankAccountType, m ExternalBankAccountTypeMatchFuncs[R]) R {
+	if m.Checking == nil || m.Savings == nil || m.Unknown == nil {
+		panic("responses: MatchExternalBankAccountType needs a function for every value")
+	}
+	switch v {
+	case ExternalBankAccountTypeChecking:
+		return m.Checking()
+	case ExternalBankAccountTypeSavings:
+		return m.Savings()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ExternalBankAccountVerificationMethodMatchFuncs has a function for every value of ExternalBankAccountVerificationMethod, see MatchExternalBankAccountVerificationMethod.
+type ExternalBankAccountVerificationMethodMatchFuncs[R any] struct {
+	Manual       func() R
+	MicroDeposit func() R
+	Prenote      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ExternalBankAccountVerificationMethod) R
+}
+
+// MatchExternalBankAccountVerificationMethod calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchExternalBankAccountVerificationMethod[R any](v ExternalBankAccountVerificationMethod, m ExternalBankAccountVerificationMethodMatchFuncs[R]) R {
+	if m.Manual == nil || m.MicroDeposit == nil || m.Prenote == nil || m.Unknown == nil {
+		panic("responses: MatchExternalBankAccountVerificationMethod needs a function for every value")
+	}
+	switch v {
+	case ExternalBankAccountVerificationMethodManual:
+		return m.Manual()
+	case ExternalBankAccountVerificationMethodMicroDeposit:
+		return m.MicroDeposit()
+	case ExternalBankAccountVerificationMethodPrenote:
+		return m.Prenote()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ExternalBankAccountVerificationStateMatchFuncs has a function for every value of ExternalBankAccountVerificationState, see MatchExternalBankAccountVerificationState.
+type ExternalBankAccountVerificationStateMatchFuncs[R any] struct {
+	Pending            func() R
+	Enabled            func() R
+	FailedVerification func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ExternalBankAccountVerificationState) R
+}
+
+// MatchExternalBankAccountVerificationState calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchExternalBankAccountVerificationState[R any](v ExternalBankAccountVerificationState, m ExternalBankAccountVerificationStateMatchFuncs[R]) R {
+	if m.Pending == nil || m.Enabled == nil || m.FailedVerification == nil || m.Unknown == nil {
+		panic("responses: MatchExternalBankAccountVerificationState needs a function for every value")
+	}
+	switch v {
+	case ExternalBankAccountVerificationStatePending:
+		return m.Pending()
+	case ExternalBankAccountVerificationStateEnabled:
+		return m.Enabled()
+	case ExternalBankAccountVerificationStateFailedVerification:
+		return m.FailedVerification()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FinancialAccountTypeMatchFuncs has a function for every value of FinancialAccountType, see MatchFinancialAccountType.
+type FinancialAccountTypeMatchFuncs[R any] struct {
+	Issuing   func() R
+	Operating func() R
+	Reserve   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FinancialAccountType) R
+}
+
+// MatchFinancialAccountType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFinancialAccountType[R any](v FinancialAccountType, m FinancialAccountTypeMatchFuncs[R]) R {
+	if m.Issuing == nil || m.Operating == nil || m.Reserve == nil || m.Unknown == nil {
+		panic("responses: MatchFinancialAccountType needs a function for every value")
+	}
+	switch v {
+	case FinancialAccountTypeIssuing:
+		return m.Issuing()
+	case FinancialAccountTypeOperating:
+		return m.Operating()
+	case FinancialAccountTypeReserve:
+		return m.Reserve()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FinancialTransactionCategoryMatchFuncs has a function for every value of FinancialTransactionCategory, see MatchFinancialTransactionCategory.
+type FinancialTransactionCategoryMatchFuncs[R any] struct {
+	ACH      func() R
+	Card     func() R
+	Transfer func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FinancialTransactionCategory) R
+}
+
+// MatchFinancialTransactionCategory calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFinancialTransactionCategory[R any](v FinancialTransactionCategory, m FinancialTransactionCategoryMatchFuncs[R]) R {
+	if m.ACH == nil || m.Card == nil || m.Transfer == nil || m.Unknown == nil {
+		panic("responses: MatchFinancialTransactionCategory needs a function for every value")
+	}
+	switch v {
+	case FinancialTransactionCategoryACH:
+		return m.ACH()
+	case FinancialTransactionCategoryCard:
+		return m.Card()
+	case FinancialTransactionCategoryTransfer:
+		return m.Transfer()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FinancialTransactionEventResultMatchFuncs has a function for every value of FinancialTransactionEventResult, see MatchFinancialTransactionEventResult.
+type FinancialTransactionEventResultMatchFuncs[R any] struct {
+	Approved func() R
+	Declined func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FinancialTransactionEventResult) R
+}
+
+// MatchFinancialTransactionEventResult calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFinancialTransactionEventResult[R any](v FinancialTransactionEventResult, m FinancialTransactionEventResultMatchFuncs[R]) R {
+	if m.Approved == nil || m.Declined == nil || m.Unknown == nil {
+		panic("responses: MatchFinancialTransactionEventResult needs a function for every value")
+	}
+	switch v {
+	case FinancialTransactionEventResultApproved:
+		return m.Approved()
+	case FinancialTransactionEventResultDeclined:
+		return m.Declined()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FinancialTransactionResultMatchFuncs has a function for every value of FinancialTransactionResult, see MatchFinancialTransactionResult.
+type FinancialTransactionResultMatchFuncs[R any] struct {
+	Approved func() R
+	Declined func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FinancialTransactionResult) R
+}
+
+// MatchFinancialTransactionResult calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFinancialTransactionResult[R any](v FinancialTransactionResult, m FinancialTransactionResultMatchFuncs[R]) R {
+	if m.Approved == nil || m.Declined == nil || m.Unknown == nil {
+		panic("responses: MatchFinancialTransactionResult needs a function for every value")
+	}
+	switch v {
+	case FinancialTransactionResultApproved:
+		return m.Approved()
+	case FinancialTransactionResultDeclined:
+		return m.Declined()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FinancialTransactionStatusMatchFuncs has a function for every value of FinancialTransactionStatus, see MatchFinancialTransactionStatus.
+type FinancialTransactionStatusMatchFuncs[R any] struct {
+	Declined func() R
+	Expired  func() R
+	Pending  func() R
+	Settled  func() R
+	Voided   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FinancialTransactionStatus) R
+}
+
+// MatchFinancialTransactionStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFinancialTransactionStatus[R any](v FinancialTransactionStatus, m FinancialTransactionStatusMatchFuncs[R]) R {
+	if m.Declined == nil || m.Expired == nil || m.Pending == nil || m.Settled == nil || m.Voided == nil || m.Unknown == nil {
+		panic("responses: MatchFinancialTransactionStatus needs a function for every value")
+	}
+	switch v {
+	case FinancialTransactionStatusDeclined:
+		return m.Declined()
+	case FinancialTransactionStatusExpired:
+		return m.Expired()
+	case FinancialTransactionStatusPending:
+		return m.Pending()
+	case FinancialTransactionStatusSettled:
+		return m.Settled()
+	case FinancialTransactionStatusVoided:
+		return m.Voided()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FundingSourceStateMatchFuncs has a function for every value of FundingSourceState, see MatchFundingSourceState.
+type FundingSourceStateMatchFuncs[R any] struct {
+	Enabled func() R
+	Pending func() R
+	Deleted func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FundingSourceState) R
+}
+
+// MatchFundingSourceState calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFundingSourceState[R any](v FundingSourceState, m FundingSourceStateMatchFuncs[R]) R {
+	if m.Enabled == nil || m.Pending == nil || m.Deleted == nil || m.Unknown == nil {
+		panic("responses: MatchFundingSourceState needs a function for every value")
+	}
+	switch v {
+	case FundingSourceStateEnabled:
+		return m.Enabled()
+	case FundingSourceStatePending:
+		return m.Pending()
+	case FundingSourceStateDeleted:
+		return m.Deleted()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// FundingSourceTypeMatchFuncs has a function for every value of FundingSourceType, see MatchFundingSourceType.
+type FundingSourceTypeMatchFuncs[R any] struct {
+	DepositoryChecking func() R
+	DepositorySavings  func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(FundingSourceType) R
+}
+
+// MatchFundingSourceType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchFundingSourceType[R any](v FundingSourceType, m FundingSourceTypeMatchFuncs[R]) R {
+	if m.DepositoryChecking == nil || m.DepositorySavings == nil || m.Unknown == nil {
+		panic("responses: MatchFundingSourceType needs a function for every value")
+	}
+	switch v {
+	case FundingSourceTypeDepositoryChecking:
+		return m.DepositoryChecking()
+	case FundingSourceTypeDepositorySavings:
+		return m.DepositorySavings()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// MessageAttemptStatusMatchFuncs has a function for every value of MessageAttemptStatus, see MatchMessageAttemptStatus.
+type MessageAttemptStatusMatchFuncs[R any] struct {
+	Failed  func() R
+	Pending func() R
+	Sending func() R
+	Success func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(MessageAttemptStatus) R
+}
+
+// MatchMessageAttemptStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchMessageAttemptStatus[R any](v MessageAttemptStatus, m MessageAttemptStatusMatchFuncs[R]) R {
+	if m.Failed == nil || m.Pending == nil || m.Sending == nil || m.Success == nil || m.Unknown == nil {
+		panic("responses: MatchMessageAttemptStatus needs a function for every value")
+	}
+	switch v {
+	case MessageAttemptStatusFailed:
+		return m.Failed()
+	case MessageAttemptStatusPending:
+		return m.Pending()
+	case MessageAttemptStatusSending:
+		return m.Sending()
+	case MessageAttemptStatusSuccess:
+		return m.Success()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// SettlementDetailTypeMatchFuncs has a function for every value of SettlementDetailType, see MatchSettlementDetailType.
+type SettlementDetailTypeMatchFuncs[R any] struct {
+	Adjustment     func() R
+	Arbitration    func() R
+	Chargeback     func() R
+	Clearing       func() R
+	Fee            func() R
+	Financial      func() R
+	NonFinancial   func() R
+	Prearbitration func() R
+	Representment  func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(SettlementDetailType) R
+}
+
+// MatchSettlementDetailType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchSettlementDetailType[R any](v SettlementDetailType, m SettlementDetailTypeMatchFuncs[R]) R {
+	if m.Adjustment == nil || m.Arbitration == nil || m.Chargeback == nil || m.Clearing == nil || m.Fee == nil || m.Financial == nil || m.NonFinancial == nil || m.Prearbitration == nil || m.Representment == nil || m.Unknown == nil {
+		panic("responses: MatchSettlementDetailType needs a function for every value")
+	}
+	switch v {
+	case SettlementDetailTypeAdjustment:
+		return m.Adjustment()
+	case SettlementDetailTypeArbitration:
+		return m.Arbitration()
+	case SettlementDetailTypeChargeback:
+		return m.Chargeback()
+	case SettlementDetailTypeClearing:
+		return m.Clearing()
+	case SettlementDetailTypeFee:
+		return m.Fee()
+	case SettlementDetailTypeFinancial:
+		return m.Financial()
+	case SettlementDetailTypeNonFinancial:
+		return m.NonFinancial()
+	case SettlementDetailTypePrearbitration:
+		return m.Prearbitration()
+	case SettlementDetailTypeRepresentment:
+		return m.Representment()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// SettlementNetworkMatchFuncs has a function for every value of SettlementNetwork, see MatchSettlementNetwork.
+type SettlementNetworkMatchFuncs[R any] struct {
+	Interlink                func() R
+	Maestro                  func() R
+	Mastercard               func() R
+	SettlementNetworkUnknown func() R
+	Visa                     func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(SettlementNetwork) R
+}
+
+// MatchSettlementNetwork calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchSettlementNetwork[R any](v SettlementNetwork, m SettlementNetworkMatchFuncs[R]) R {
+	if m.Interlink == nil || m.Maestro == nil || m.Mastercard == nil || m.SettlementNetworkUnknown == nil || m.Visa == nil || m.Unknown == nil {
+		panic("responses: MatchSettlementNetwork needs a function for every value")
+	}
+	switch v {
+	case SettlementNetworkInterlink:
+		return m.Interlink()
+	case SettlementNetworkMaestro:
+		return m.Maestro()
+	case SettlementNetworkMastercard:
+		return m.Mastercard()
+	case SettlementNetworkUnknown:
+		return m.SettlementNetworkUnknown()
+	case SettlementNetworkVisa:
+		return m.Visa()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// SpendLimitDurationMatchFuncs has a function for every value of SpendLimitDuration, see MatchSpendLimitDuration.
+type SpendLimitDurationMatchFuncs[R any] struct {
+	Annually    func() R
+	Forever     func() R
+	Monthly     func() R
+	Transaction func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(SpendLimitDuration) R
+}
+
+// MatchSpendLimitDuration calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchSpendLimitDuration[R any](v SpendLimitDuration, m SpendLimitDurationMatchFuncs[R]) R {
+	if m.Annually == nil || m.Forever == nil || m.Monthly == nil || m.Transaction == nil || m.Unknown == nil {
+		panic("responses: MatchSpendLimitDuration needs a function for every value")
+	}
+	switch v {
+	case SpendLimitDurationAnnually:
+		return m.Annually()
+	case SpendLimitDurationForever:
+		return m.Forever()
+	case SpendLimitDurationMonthly:
+		return m.Monthly()
+	case SpendLimitDurationTransaction:
+		return m.Transaction()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// StatementLineItemCategoryMatchFuncs has a function for every value of StatementLineItemCategory, see MatchStatementLineItemCategory.
+type StatementLineItemCategoryMatchFuncs[R any] struct {
+	ACH                  func() R
+	BalanceOrFunding     func() R
+	Card                 func() R
+	ExternalACH          func() R
+	ExternalCheck        func() R
+	ExternalTransfer     func() R
+	ExternalWire         func() R
+	ManagementAdjustment func() R
+	ManagementDispute    func() R
+	ManagementFee        func() R
+	ManagementReward     func() R
+	Transfer             func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(StatementLineItemCategory) R
+}
+
+// MatchStatementLineItemCategory calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchStatementLineItemCategory[R any](v StatementLineItemCategory, m StatementLineItemCategoryMatchFuncs[R]) R {
+	if m.ACH == nil || m.BalanceOrFunding == nil || m.Card == nil || m.ExternalACH == nil || m.ExternalCheck == nil || m.ExternalTransfer == nil || m.ExternalWire == nil || m.ManagementAdjustment == nil || m.ManagementDispute == nil || m.ManagementFee == nil || m.ManagementReward == nil || m.Transfer == nil || m.Unknown == nil {
+		panic("responses: MatchStatementLineItemCategory needs a function for every value")
+	}
+	switch v {
+	case StatementLineItemCategoryACH:
+		return m.ACH()
+	case StatementLineItemCategoryBalanceOrFunding:
+		return m.BalanceOrFunding()
+	case StatementLineItemCategoryCard:
+		return m.Card()
+	case StatementLineItemCategoryExternalACH:
+		return m.ExternalACH()
+	case StatementLineItemCategoryExternalCheck:
+		return m.ExternalCheck()
+	case StatementLineItemCategoryExternalTransfer:
+		return m.ExternalTransfer()
+	case StatementLineItemCategoryExternalWire:
+		return m.ExternalWire()
+	case StatementLineItemCategoryManagementAdjustment:
+		return m.ManagementAdjustment()
+	case StatementLineItemCategoryManagementDispute:
+		return m.ManagementDispute()
+	case StatementLineItemCategoryManagementFee:
+		return m.ManagementFee()
+	case StatementLineItemCategoryManagementReward:
+		return m.ManagementReward()
+	case StatementLineItemCategoryTransfer:
+		return m.Transfer()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ThreeDSAuthenticationCardExpiryCheckMatchFuncs has a function for every value of ThreeDSAuthenticationCardExpiryCheck, see MatchThreeDSAuthenticationCardExpiryCheck.
+type ThreeDSAuthenticationCardExpiryCheckMatchFuncs[R any] struct {
+	Match      func() R
+	Mismatch   func() R
+	NotPresent func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ThreeDSAuthenticationCardExpiryCheck) R
+}
+
+// MatchThreeDSAuthenticationCardExpiryCheck calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchThreeDSAuthenticationCardExpiryCheck[R any](v ThreeDSAuthenticationCardExpiryCheck, m ThreeDSAuthenticationCardExpiryCheckMatchFuncs[R]) R {
+	if m.Match == nil || m.Mismatch == nil || m.NotPresent == nil || m.Unknown == nil {
+		panic("responses: MatchThreeDSAuthenticationCardExpiryCheck needs a function for every value")
+	}
+	switch v {
+	case ThreeDSAuthenticationCardExpiryCheckMatch:
+		return m.Match()
+	case ThreeDSAuthenticationCardExpiryCheckMismatch:
+		return m.Mismatch()
+	case ThreeDSAuthenticationCardExpiryCheckNotPresent:
+		return m.NotPresent()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ThreeDSAuthenticationChannelMatchFuncs has a function for every value of ThreeDSAuthenticationChannel, see MatchThreeDSAuthenticationChannel.
+type ThreeDSAuthenticationChannelMatchFuncs[R any] struct {
+	AppBased                  func() R
+	Browser                   func() R
+	ThreeDSRequestorInitiated func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ThreeDSAuthenticationChannel) R
+}
+
+// MatchThreeDSAuthenticationChannel calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchThreeDSAuthenticationChannel[R any](v ThreeDSAuthenticationChannel, m ThreeDSAuthenticationChannelMatchFuncs[R]) R {
+	if m.AppBased == nil || m.Browser == nil || m.ThreeDSRequestorInitiated == nil || m.Unknown == nil {
+		panic("responses: MatchThreeDSAuthenticationChannel needs a function for every value")
+	}
+	switch v {
+	case ThreeDSAuthenticationChannelAppBased:
+		return m.AppBased()
+	case ThreeDSAuthenticationChannelBrowser:
+		return m.Browser()
+	case ThreeDSAuthenticationChannelThreeDSRequestorInitiated:
+		return m.ThreeDSRequestorInitiated()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ThreeDSAuthenticationDecisionMadeByMatchFuncs has a function for every value of ThreeDSAuthenticationDecisionMadeBy, see MatchThreeDSAuthenticationDecisionMadeBy.
+type ThreeDSAuthenticationDecisionMadeByMatchFuncs[R any] struct {
+	CustomerEndpoint                           func() R
+	LithicDefault                              func() R
+	LithicRules                                func() R
+	Network                                    func() R
+	ThreeDSAuthenticationDecisionMadeByUnknown func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ThreeDSAuthenticationDecisionMadeBy) R
+}
+
+// MatchThreeDSAuthenticationDecisionMadeBy calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchThreeDSAuthenticationDecisionMadeBy[R any](v ThreeDSAuthenticationDecisionMadeBy, m ThreeDSAuthenticationDecisionMadeByMatchFuncs[R]) R {
+	if m.CustomerEndpoint == nil || m.LithicDefault == nil || m.LithicRules == nil || m.Network == nil || m.ThreeDSAuthenticationDecisionMadeByUnknown == nil || m.Unknown == nil {
+		panic("responses: MatchThreeDSAuthenticationDecisionMadeBy needs a function for every value")
+	}
+	switch v {
+	case ThreeDSAuthenticationDecisionMadeByCustomerEndpoint:
+		return m.CustomerEndpoint()
+	case ThreeDSAuthenticationDecisionMadeByLithicDefault:
+		return m.LithicDefault()
+	case ThreeDSAuthenticationDecisionMadeByLithicRules:
+		return m.LithicRules()
+	case ThreeDSAuthenticationDecisionMadeByNetwork:
+		return m.Network()
+	case ThreeDSAuthenticationDecisionMadeByUnknown:
+		return m.ThreeDSAuthenticationDecisionMadeByUnknown()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ThreeDSAuthenticationMessageCategoryMatchFuncs has a function for every value of ThreeDSAuthenticationMessageCategory, see MatchThreeDSAuthenticationMessageCategory.
+type ThreeDSAuthenticationMessageCategoryMatchFuncs[R any] struct {
+	PaymentAuthentication    func() R
+	NonPaymentAuthentication func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ThreeDSAuthenticationMessageCategory) R
+}
+
+// MatchThreeDSAuthenticationMessageCategory calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchThreeDSAuthenticationMessageCategory[R any](v ThreeDSAuthenticationMessageCategory, m ThreeDSAuthenticationMessageCategoryMatchFuncs[R]) R {
+	if m.PaymentAuthentication == nil || m.NonPaymentAuthentication == nil || m.Unknown == nil {
+		panic("responses: MatchThreeDSAuthenticationMessageCategory needs a function for every value")
+	}
+	switch v {
+	case ThreeDSAuthenticationMessageCategoryPaymentAuthentication:
+		return m.PaymentAuthentication()
+	case ThreeDSAuthenticationMessageCategoryNonPaymentAuthentication:
+		return m.NonPaymentAuthentication()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// ThreeDSAuthenticationResultMatchFuncs has a function for every value of ThreeDSAuthenticationResult, see MatchThreeDSAuthenticationResult.
+type ThreeDSAuthenticationResultMatchFuncs[R any] struct {
+	Decline          func() R
+	Success          func() R
+	PendingChallenge func() R
+	PendingDecision  func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(ThreeDSAuthenticationResult) R
+}
+
+// MatchThreeDSAuthenticationResult calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchThreeDSAuthenticationResult[R any](v ThreeDSAuthenticationResult, m ThreeDSAuthenticationResultMatchFuncs[R]) R {
+	if m.Decline == nil || m.Success == nil || m.PendingChallenge == nil || m.PendingDecision == nil || m.Unknown == nil {
+		panic("responses: MatchThreeDSAuthenticationResult needs a function for every value")
+	}
+	switch v {
+	case ThreeDSAuthenticationResultDecline:
+		return m.Decline()
+	case ThreeDSAuthenticationResultSuccess:
+		return m.Success()
+	case ThreeDSAuthenticationResultPendingChallenge:
+		return m.PendingChallenge()
+	case ThreeDSAuthenticationResultPendingDecision:
+		return m.PendingDecision()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TokenizationChannelMatchFuncs has a function for every value of TokenizationChannel, see MatchTokenizationChannel.
+type TokenizationChannelMatchFuncs[R any] struct {
+	DigitalWallet func() R
+	Merchant      func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TokenizationChannel) R
+}
+
+// MatchTokenizationChannel calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTokenizationChannel[R any](v TokenizationChannel, m TokenizationChannelMatchFuncs[R]) R {
+	if m.DigitalWallet == nil || m.Merchant == nil || m.Unknown == nil {
+		panic("responses: MatchTokenizationChannel needs a function for every value")
+	}
+	switch v {
+	case TokenizationChannelDigitalWallet:
+		return m.DigitalWallet()
+	case TokenizationChannelMerchant:
+		return m.Merchant()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TokenizationEventResultMatchFuncs has a function for every value of TokenizationEventResult, see MatchTokenizationEventResult.
+type TokenizationEventResultMatchFuncs[R any] struct {
+	Approved                        func() R
+	Declined                        func() R
+	NotificationDelivered           func() R
+	RequireAdditionalAuthentication func() R
+	TokenActivated                  func() R
+	TokenCreated                    func() R
+	TokenDeactivated                func() R
+	TokenInactive                   func() R
+	TokenStateUnknown               func() R
+	TokenSuspended                  func() R
+	TokenUpdated                    func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TokenizationEventResult) R
+}
+
+// MatchTokenizationEventResult calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTokenizationEventResult[R any](v TokenizationEventResult, m TokenizationEventResultMatchFuncs[R]) R {
+	if m.Approved == nil || m.Declined == nil || m.NotificationDelivered == nil || m.RequireAdditionalAuthentication == nil || m.TokenActivated == nil || m.TokenCreated == nil || m.TokenDeactivated == nil || m.TokenInactive == nil || m.TokenStateUnknown == nil || m.TokenSuspended == nil || m.TokenUpdated == nil || m.Unknown == nil {
+		panic("responses: MatchTokenizationEventResult needs a function for every value")
+	}
+	switch v {
+	case TokenizationEventResultApproved:
+		return m.Approved()
+	case TokenizationEventResultDeclined:
+		return m.Declined()
+	case TokenizationEventResultNotificationDelivered:
+		return m.NotificationDelivered()
+	case TokenizationEventResultRequireAdditionalAuthentication:
+		return m.RequireAdditionalAuthentication()
+	case TokenizationEventResultTokenActivated:
+		return m.TokenActivated()
+	case TokenizationEventResultTokenCreated:
+		return m.TokenCreated()
+	case TokenizationEventResultTokenDeactivated:
+		return m.TokenDeactivated()
+	case TokenizationEventResultTokenInactive:
+		return m.TokenInactive()
+	case TokenizationEventResultTokenStateUnknown:
+		return m.TokenStateUnknown()
+	case TokenizationEventResultTokenSuspended:
+		return m.TokenSuspended()
+	case TokenizationEventResultTokenUpdated:
+		return m.TokenUpdated()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TokenizationEventTypeMatchFuncs has a function for every value of TokenizationEventType, see MatchTokenizationEventType.
+type TokenizationEventTypeMatchFuncs[R any] struct {
+	Tokenization2Fa              func() R
+	TokenizationAuthorization    func() R
+	TokenizationDecisioning      func() R
+	TokenizationEligibilityCheck func() R
+	TokenizationUpdated          func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TokenizationEventType) R
+}
+
+// MatchTokenizationEventType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTokenizationEventType[R any](v TokenizationEventType, m TokenizationEventTypeMatchFuncs[R]) R {
+	if m.Tokenization2Fa == nil || m.TokenizationAuthorization == nil || m.TokenizationDecisioning == nil || m.TokenizationEligibilityCheck == nil || m.TokenizationUpdated == nil || m.Unknown == nil {
+		panic("responses: MatchTokenizationEventType needs a function for every value")
+	}
+	switch v {
+	case TokenizationEventTypeTokenization2Fa:
+		return m.Tokenization2Fa()
+	case TokenizationEventTypeTokenizationAuthorization:
+		return m.TokenizationAuthorization()
+	case TokenizationEventTypeTokenizationDecisioning:
+		return m.TokenizationDecisioning()
+	case TokenizationEventTypeTokenizationEligibilityCheck:
+		return m.TokenizationEligibilityCheck()
+	case TokenizationEventTypeTokenizationUpdated:
+		return m.TokenizationUpdated()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TokenizationStatusMatchFuncs has a function for every value of TokenizationStatus, see MatchTokenizationStatus.
+type TokenizationStatusMatchFuncs[R any] struct {
+	Active                    func() R
+	Deactivated               func() R
+	Inactive                  func() R
+	Paused                    func() R
+	Pending2Fa                func() R
+	PendingActivation         func() R
+	TokenizationStatusUnknown func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TokenizationStatus) R
+}
+
+// MatchTokenizationStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTokenizationStatus[R any](v TokenizationStatus, m TokenizationStatusMatchFuncs[R]) R {
+	if m.Active == nil || m.Deactivated == nil || m.Inactive == nil || m.Paused == nil || m.Pending2Fa == nil || m.PendingActivation == nil || m.TokenizationStatusUnknown == nil || m.Unknown == nil {
+		panic("responses: MatchTokenizationStatus needs a function for every value")
+	}
+	switch v {
+	case TokenizationStatusActive:
+		return m.Active()
+	case TokenizationStatusDeactivated:
+		return m.Deactivated()
+	case TokenizationStatusInactive:
+		return m.Inactive()
+	case TokenizationStatusPaused:
+		return m.Paused()
+	case TokenizationStatusPending2Fa:
+		return m.Pending2Fa()
+	case TokenizationStatusPendingActivation:
+		return m.PendingActivation()
+	case TokenizationStatusUnknown:
+		return m.TokenizationStatusUnknown()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TokenizationTokenRequestorNameMatchFuncs has a function for every value of TokenizationTokenRequestorName, see MatchTokenizationTokenRequestorName.
+type TokenizationTokenRequestorNameMatchFuncs[R any] struct {
+	AmazonOne                             func() R
+	AndroidPay                            func() R
+	ApplePay                              func() R
+	Facebook                              func() R
+	FitbitPay                             func() R
+	GarminPay                             func() R
+	MicrosoftPay                          func() R
+	Netflix                               func() R
+	SamsungPay                            func() R
+	TokenizationTokenRequestorNameUnknown func() R
+	VisaCheckout                          func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TokenizationTokenRequestorName) R
+}
+
+// MatchTokenizationTokenRequestorName calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTokenizationTokenRequestorName[R any](v TokenizationTokenRequestorName, m TokenizationTokenRequestorNameMatchFuncs[R]) R {
+	if m.AmazonOne == nil || m.AndroidPay == nil || m.ApplePay == nil || m.Facebook == nil || m.FitbitPay == nil || m.GarminPay == nil || m.MicrosoftPay == nil || m.Netflix == nil || m.SamsungPay == nil || m.TokenizationTokenRequestorNameUnknown == nil || m.VisaCheckout == nil || m.Unknown == nil {
+		panic("responses: MatchTokenizationTokenRequestorName needs a function for every value")
+	}
+	switch v {
+	case TokenizationTokenRequestorNameAmazonOne:
+		return m.AmazonOne()
+	case TokenizationTokenRequestorNameAndroidPay:
+		return m.AndroidPay()
+	case TokenizationTokenRequestorNameApplePay:
+		return m.ApplePay()
+	case TokenizationTokenRequestorNameFacebook:
+		return m.Facebook()
+	case TokenizationTokenRequestorNameFitbitPay:
+		return m.FitbitPay()
+	case TokenizationTokenRequestorNameGarminPay:
+		return m.GarminPay()
+	case TokenizationTokenRequestorNameMicrosoftPay:
+		return m.MicrosoftPay()
+	case TokenizationTokenRequestorNameNetflix:
+		return m.Netflix()
+	case TokenizationTokenRequestorNameSamsungPay:
+		return m.SamsungPay()
+	case TokenizationTokenRequestorNameUnknown:
+		return m.TokenizationTokenRequestorNameUnknown()
+	case TokenizationTokenRequestorNameVisaCheckout:
+		return m.VisaCheckout()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TransactionEventResultMatchFuncs has a function for every value of TransactionEventResult, see MatchTransactionEventResult.
+type TransactionEventResultMatchFuncs[R any] struct {
+	AccountStateTransaction func() R
+	Approved                func() R
+	BankConnectionError     func() R
+	BankNotVerified         func() R
+	CardClosed              func() R
+	CardPaused              func() R
+	FraudAdvice             func() R
+	GlobalTransactionLimit  func() R
+	GlobalWeeklyLimit       func() R
+	GlobalMonthlyLimit      func() R
+	InactiveAccount         func() R
+	IncorrectPin            func() R
+	InvalidCardDetails      func() R
+	InsufficientFunds       func() R
+	MerchantBlacklist       func() R
+	SingleUseRecharged      func() R
+	SwitchInoperativeAdvice func() R
+	UnauthorizedMerchant    func() R
+	UnknownHostTimeout      func() R
+	UserTransactionLimit    func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TransactionEventResult) R
+}
+
+// MatchTransactionEventResult calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTransactionEventResult[R any](v TransactionEventResult, m TransactionEventResultMatchFuncs[R]) R {
+	if m.AccountStateTransaction == nil || m.Approved == nil || m.BankConnectionError == nil || m.BankNotVerified == nil || m.CardClosed == nil || m.CardPaused == nil || m.FraudAdvice == nil || m.GlobalTransactionLimit == nil || m.GlobalWeeklyLimit == nil || m.GlobalMonthlyLimit == nil || m.InactiveAccount == nil || m.IncorrectPin == nil || m.InvalidCardDetails == nil || m.InsufficientFunds == nil || m.MerchantBlacklist == nil || m.SingleUseRecharged == nil || m.SwitchInoperativeAdvice == nil || m.UnauthorizedMerchant == nil || m.UnknownHostTimeout == nil || m.UserTransactionLimit == nil || m.Unknown == nil {
+		panic("responses: MatchTransactionEventResult needs a function for every value")
+	}
+	switch v {
+	case TransactionEventResultAccountStateTransaction:
+		return m.AccountStateTransaction()
+	case TransactionEventResultApproved:
+		return m.Approved()
+	case TransactionEventResultBankConnectionError:
+		return m.BankConnectionError()
+	case TransactionEventResultBankNotVerified:
+		return m.BankNotVerified()
+	case TransactionEventResultCardClosed:
+		return m.CardClosed()
+	case TransactionEventResultCardPaused:
+		return m.CardPaused()
+	case TransactionEventResultFraudAdvice:
+		return m.FraudAdvice()
+	case TransactionEventResultGlobalTransactionLimit:
+		return m.GlobalTransactionLimit()
+	case TransactionEventResultGlobalWeeklyLimit:
+		return m.GlobalWeeklyLimit()
+	case TransactionEventResultGlobalMonthlyLimit:
+		return m.GlobalMonthlyLimit()
+	case TransactionEventResultInactiveAccount:
+		return m.InactiveAccount()
+	case TransactionEventResultIncorrectPin:
+		return m.IncorrectPin()
+	case TransactionEventResultInvalidCardDetails:
+		return m.InvalidCardDetails()
+	case TransactionEventResultInsufficientFunds:
+		return m.InsufficientFunds()
+	case TransactionEventResultMerchantBlacklist:
+		return m.MerchantBlacklist()
+	case TransactionEventResultSingleUseRecharged:
+		return m.SingleUseRecharged()
+	case TransactionEventResultSwitchInoperativeAdvice:
+		return m.SwitchInoperativeAdvice()
+	case TransactionEventResultUnauthorizedMerchant:
+		return m.UnauthorizedMerchant()
+	case TransactionEventResultUnknownHostTimeout:
+		return m.UnknownHostTimeout()
+	case TransactionEventResultUserTransactionLimit:
+		return m.UserTransactionLimit()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TransactionEventTypeMatchFuncs has a function for every value of TransactionEventType, see MatchTransactionEventType.
+type TransactionEventTypeMatchFuncs[R any] struct {
+	Authorization                func() R
+	AuthorizationAdvice          func() R
+	AuthorizationExpiry          func() R
+	AuthorizationReversal        func() R
+	BalanceInquiry               func() R
+	Clearing                     func() R
+	CorrectionDebit              func() R
+	CorrectionCredit             func() R
+	CreditAuthorization          func() R
+	CreditAuthorizationAdvice    func() R
+	FinancialAuthorization       func() R
+	FinancialCreditAuthorization func() R
+	FinancialAdvice              func() R
+	FinancialCreditAdvice        func() R
+	Return                       func() R
+	ReturnReversal               func() R
+	Void                         func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TransactionEventType) R
+}
+
+// MatchTransactionEventType calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTransactionEventType[R any](v TransactionEventType, m TransactionEventTypeMatchFuncs[R]) R {
+	if m.Authorization == nil || m.AuthorizationAdvice == nil || m.AuthorizationExpiry == nil || m.AuthorizationReversal == nil || m.BalanceInquiry == nil || m.Clearing == nil || m.CorrectionDebit == nil || m.CorrectionCredit == nil || m.CreditAuthorization == nil || m.CreditAuthorizationAdvice == nil || m.FinancialAuthorization == nil || m.FinancialCreditAuthorization == nil || m.FinancialAdvice == nil || m.FinancialCreditAdvice == nil || m.Return == nil || m.ReturnReversal == nil || m.Void == nil || m.Unknown == nil {
+		panic("responses: MatchTransactionEventType needs a function for every value")
+	}
+	switch v {
+	case TransactionEventTypeAuthorization:
+		return m.Authorization()
+	case TransactionEventTypeAuthorizationAdvice:
+		return m.AuthorizationAdvice()
+	case TransactionEventTypeAuthorizationExpiry:
+		return m.AuthorizationExpiry()
+	case TransactionEventTypeAuthorizationReversal:
+		return m.AuthorizationReversal()
+	case TransactionEventTypeBalanceInquiry:
+		return m.BalanceInquiry()
+	case TransactionEventTypeClearing:
+		return m.Clearing()
+	case TransactionEventTypeCorrectionDebit:
+		return m.CorrectionDebit()
+	case TransactionEventTypeCorrectionCredit:
+		return m.CorrectionCredit()
+	case TransactionEventTypeCreditAuthorization:
+		return m.CreditAuthorization()
+	case TransactionEventTypeCreditAuthorizationAdvice:
+		return m.CreditAuthorizationAdvice()
+	case TransactionEventTypeFinancialAuthorization:
+		return m.FinancialAuthorization()
+	case TransactionEventTypeFinancialCreditAuthorization:
+		return m.FinancialCreditAuthorization()
+	case TransactionEventTypeFinancialAdvice:
+		return m.FinancialAdvice()
+	case TransactionEventTypeFinancialCreditAdvice:
+		return m.FinancialCreditAdvice()
+	case TransactionEventTypeReturn:
+		return m.Return()
+	case TransactionEventTypeReturnReversal:
+		return m.ReturnReversal()
+	case TransactionEventTypeVoid:
+		return m.Void()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TransactionNetworkMatchFuncs has a function for every value of TransactionNetwork, see MatchTransactionNetwork.
+type TransactionNetworkMatchFuncs[R any] struct {
+	Interlink                 func() R
+	Maestro                   func() R
+	Mastercard                func() R
+	Visa                      func() R
+	TransactionNetworkUnknown func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TransactionNetwork) R
+}
+
+// MatchTransactionNetwork calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTransactionNetwork[R any](v TransactionNetwork, m TransactionNetworkMatchFuncs[R]) R {
+	if m.Interlink == nil || m.Maestro == nil || m.Mastercard == nil || m.Visa == nil || m.TransactionNetworkUnknown == nil || m.Unknown == nil {
+		panic("responses: MatchTransactionNetwork needs a function for every value")
+	}
+	switch v {
+	case TransactionNetworkInterlink:
+		return m.Interlink()
+	case TransactionNetworkMaestro:
+		return m.Maestro()
+	case TransactionNetworkMastercard:
+		return m.Mastercard()
+	case TransactionNetworkVisa:
+		return m.Visa()
+	case TransactionNetworkUnknown:
+		return m.TransactionNetworkUnknown()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TransactionResultMatchFuncs has a function for every value of TransactionResult, see MatchTransactionResult.
+type TransactionResultMatchFuncs[R any] struct {
+	AccountStateTransaction func() R
+	Approved                func() R
+	BankConnectionError     func() R
+	BankNotVerified         func() R
+	CardClosed              func() R
+	CardPaused              func() R
+	FraudAdvice             func() R
+	GlobalTransactionLimit  func() R
+	GlobalWeeklyLimit       func() R
+	GlobalMonthlyLimit      func() R
+	InactiveAccount         func() R
+	IncorrectPin            func() R
+	InvalidCardDetails      func() R
+	InsufficientFunds       func() R
+	MerchantBlacklist       func() R
+	SingleUseRecharged      func() R
+	SwitchInoperativeAdvice func() R
+	UnauthorizedMerchant    func() R
+	UnknownHostTimeout      func() R
+	UserTransactionLimit    func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TransactionResult) R
+}
+
+// MatchTransactionResult calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTransactionResult[R any](v TransactionResult, m TransactionResultMatchFuncs[R]) R {
+	if m.AccountStateTransaction == nil || m.Approved == nil || m.BankConnectionError == nil || m.BankNotVerified == nil || m.CardClosed == nil || m.CardPaused == nil || m.FraudAdvice == nil || m.GlobalTransactionLimit == nil || m.GlobalWeeklyLimit == nil || m.GlobalMonthlyLimit == nil || m.InactiveAccount == nil || m.IncorrectPin == nil || m.InvalidCardDetails == nil || m.InsufficientFunds == nil || m.MerchantBlacklist == nil || m.SingleUseRecharged == nil || m.SwitchInoperativeAdvice == nil || m.UnauthorizedMerchant == nil || m.UnknownHostTimeout == nil || m.UserTransactionLimit == nil || m.Unknown == nil {
+		panic("responses: MatchTransactionResult needs a function for every value")
+	}
+	switch v {
+	case TransactionResultAccountStateTransaction:
+		return m.AccountStateTransaction()
+	case TransactionResultApproved:
+		return m.Approved()
+	case TransactionResultBankConnectionError:
+		return m.BankConnectionError()
+	case TransactionResultBankNotVerified:
+		return m.BankNotVerified()
+	case TransactionResultCardClosed:
+		return m.CardClosed()
+	case TransactionResultCardPaused:
+		return m.CardPaused()
+	case TransactionResultFraudAdvice:
+		return m.FraudAdvice()
+	case TransactionResultGlobalTransactionLimit:
+		return m.GlobalTransactionLimit()
+	case TransactionResultGlobalWeeklyLimit:
+		return m.GlobalWeeklyLimit()
+	case TransactionResultGlobalMonthlyLimit:
+		return m.GlobalMonthlyLimit()
+	case TransactionResultInactiveAccount:
+		return m.InactiveAccount()
+	case TransactionResultIncorrectPin:
+		return m.IncorrectPin()
+	case TransactionResultInvalidCardDetails:
+		return m.InvalidCardDetails()
+	case TransactionResultInsufficientFunds:
+		return m.InsufficientFunds()
+	case TransactionResultMerchantBlacklist:
+		return m.MerchantBlacklist()
+	case TransactionResultSingleUseRecharged:
+		return m.SingleUseRecharged()
+	case TransactionResultSwitchInoperativeAdvice:
+		return m.SwitchInoperativeAdvice()
+	case TransactionResultUnauthorizedMerchant:
+		return m.UnauthorizedMerchant()
+	case TransactionResultUnknownHostTimeout:
+		return m.UnknownHostTimeout()
+	case TransactionResultUserTransactionLimit:
+		return m.UserTransactionLimit()
+	default:
+		return m.Unknown(v)
+	}
+}
+
+// TransactionStatusMatchFuncs has a function for every value of TransactionStatus, see MatchTransactionStatus.
+type TransactionStatusMatchFuncs[R any] struct {
+	Bounced  func() R
+	Declined func() R
+	Expired  func() R
+	Pending  func() R
+	Settled  func() R
+	Settling func() R
+	Voided   func() R
+	// Unknown is called with the values that this version of the SDK does not know.
+	Unknown func(TransactionStatus) R
+}
+
+// MatchTransactionStatus calls the function of m for v. It panics if any function of m is
+// nil, so that a value added by a new version of the SDK cannot go unhandled.
+func MatchTransactionStatus[R any](v TransactionStatus, m TransactionStatusMatchFuncs[R]) R {
+	if m.Bounced == nil || m.Declined == nil || m.Expired == nil || m.Pending == nil || m.Settled == nil || m.Settling == nil || m.Voided == nil || m.Unknown == nil {
+		panic("responses: MatchTransactionStatus needs a function for every value")
+	}
+	switch v {
+	case TransactionStatusBounced:
+		return m.Bounced()
+	case TransactionStatusDeclined:
+		return m.Declined()
+	case TransactionStatusExpired:
+		return m.Expired()
+	case TransactionStatusPending:
+		return m.Pending()
+	case TransactionStatusSettled:
+		return m.Settled()
+	case TransactionStatusSettling:
+		return m.Settling()
+	case TransactionStatusVoided:
+		return m.Voided()
+	default:
+		return m.Unknown(v)
+	}
+}
@@ -0,0 +1,27 @@
+package responses
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	describe := SpendLimitDurationMatchFuncs[string]{
+		Annually:    func() string { return "per year" },
+		Forever:     func() string { return "ever" },
+		Monthly:     func() string { return "per month" },
+		Transaction: func() string { return "per transaction" },
+		Unknown:     func(v SpendLimitDuration) string { return "unknown " + string(v) },
+	}
+	if s := MatchSpendLimitDuration(SpendLimitDurationMonthly, describe); s != "per month" {
+		t.Fatalf("expected the function of the value to be called, got %q", s)
+	}
+	if s := MatchSpendLimitDuration("WEEKLY", describe); s != "unknown WEEKLY" {
+		t.Fatalf("expected Unknown to be called, got %q", s)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a missing function to panic")
+		}
+	}()
+	describe.Forever = nil
+	MatchSpendLimitDuration(SpendLimitDurationMonthly, describe)
+}
@@ -0,0 +1,222 @@
+package responses
+
+import (
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/pagination"
+)
+
+// SettlementReport summarizes the network settlement of a program for a report
+// date. Amounts are in the smallest unit of the currency, such as cents for
+// USD.
+type SettlementReport struct {
+	// Date of when the report was first generated.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// 3-digit alphabetic ISO 4217 code for the currency of the report.
+	Currency string `json:"currency,required"`
+	// Settlement totals by network and institution.
+	Details []SettlementSummaryDetails `json:"details,required"`
+	// The total gross amount of disputes settlements.
+	DisputesGrossAmount int64 `json:"disputes_gross_amount,required"`
+	// The total amount of interchange.
+	InterchangeGrossAmount int64 `json:"interchange_gross_amount,required"`
+	// Total amount of gross other fees outside of interchange.
+	OtherFeesGrossAmount int64 `json:"other_fees_gross_amount,required"`
+	// Date of when the report was generated, as `YYYY-MM-DD`.
+	ReportDate string `json:"report_date,required" format:"date"`
+	// The total net amount of cash moved. (net value of settled_gross_amount,
+	// interchange, fees).
+	SettledNetAmount int64 `json:"settled_net_amount,required"`
+	// The total amount of settlement impacting transactions (excluding
+	// interchange, fees, and disputes).
+	TransactionsGrossAmount int64 `json:"transactions_gross_amount,required"`
+	// Date of when the report was last updated.
+	Updated time.Time `json:"updated,required" format:"date-time"`
+	JSON    SettlementReportJSON
+}
+
+type SettlementReportJSON struct {
+	Created                 pjson.Metadata
+	Currency                pjson.Metadata
+	Details                 pjson.Metadata
+	DisputesGrossAmount     pjson.Metadata
+	InterchangeGrossAmount  pjson.Metadata
+	OtherFeesGrossAmount    pjson.Metadata
+	ReportDate              pjson.Metadata
+	SettledNetAmount        pjson.Metadata
+	TransactionsGrossAmount pjson.Metadata
+	Updated                 pjson.Metadata
+	Raw                     []byte
+	Extras                  map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into SettlementReport using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *SettlementReport) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type SettlementSummaryDetails struct {
+	// 3-digit alphabetic ISO 4217 code.
+	Currency string `json:"currency"`
+	// The total gross amount of disputes settlements.
+	DisputesGrossAmount int64 `json:"disputes_gross_amount"`
+	// The most granular ID the network settles with (e.g., ICA for Mastercard,
+	// FTSRE for Visa).
+	Institution string `json:"institution"`
+	// The total amount of interchange.
+	InterchangeGrossAmount int64 `json:"interchange_gross_amount"`
+	// Card network where the transaction took place.
+	Network SettlementNetwork `json:"network"`
+	// Total amount of gross other fees outside of interchange.
+	OtherFeesGrossAmount int64 `json:"other_fees_gross_amount"`
+	// The total net amount of cash moved. (net value of settled_gross_amount,
+	// interchange, fees).
+	SettledNetAmount int64 `json:"settled_net_amount"`
+	// The total amount of settlement impacting transactions (excluding
+	// interchange, fees, and disputes).
+	TransactionsGrossAmount int64 `json:"transactions_gross_amount"`
+	JSON                    SettlementSummaryDetailsJSON
+}
+
+type SettlementSummaryDetailsJSON struct {
+	Currency                pjson.Metadata
+	DisputesGrossAmount     pjson.Metadata
+	Institution             pjson.Metadata
+	InterchangeGrossAmount  pjson.Metadata
+	Network                 pjson.Metadata
+	OtherFeesGrossAmount    pjson.Metadata
+	SettledNetAmount        pjson.Metadata
+	TransactionsGrossAmount pjson.Metadata
+	Raw                     []byte
+	Extras                  map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into SettlementSummaryDetails
+// using the internal pjson library. Unrecognized fields are stored in the
+// `jsonFields` property.
+func (r *SettlementSummaryDetails) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type SettlementNetwork string
+
+const (
+	SettlementNetworkInterlink  SettlementNetwork = "INTERLINK"
+	SettlementNetworkMaestro    SettlementNetwork = "MAESTRO"
+	SettlementNetworkMastercard SettlementNetwork = "MASTERCARD"
+	SettlementNetworkUnknown    SettlementNetwork = "UNKNOWN"
+	SettlementNetworkVisa       SettlementNetwork = "VISA"
+)
+
+type SettlementDetail struct {
+	// Globally unique identifier denoting the Settlement Detail.
+	Token string `json:"token,required" format:"uuid"`
+	// The most granular ID the network settles with (e.g., ICA for Mastercard,
+	// FTSRE for Visa).
+	AccountToken string `json:"account_token,required" format:"uuid"`
+	// Globally unique identifier denoting the card program that the associated
+	// Transaction occurred on.
+	CardProgramToken string `json:"card_program_token,required" format:"uuid"`
+	// Globally unique identifier denoting the card that the associated
+	// Transaction occurred on.
+	CardToken string `json:"card_token,required" format:"uuid"`
+	// Date and time when the transaction first occurred. UTC time zone.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// Three-digit alphabetic ISO 4217 code.
+	Currency string `json:"currency,required"`
+	// The total gross amount of disputes settlements.
+	DisputesGrossAmount int64 `json:"disputes_gross_amount,required"`
+	// Globally unique identifiers denoting the Events associated with this
+	// settlement.
+	EventTokens []string `json:"event_tokens,required"`
+	// The most granular ID the network settles with (e.g., ICA for Mastercard,
+	// FTSRE for Visa).
+	Institution string `json:"institution,required"`
+	// The total amount of interchange in six-digit extended precision.
+	InterchangeFeeExtendedPrecision int64 `json:"interchange_fee_extended_precision,required"`
+	// The total amount of interchange.
+	InterchangeGrossAmount int64 `json:"interchange_gross_amount,required"`
+	// Card network where the transaction took place.
+	Network SettlementNetwork `json:"network,required"`
+	// Total amount of gross other fees outside of interchange.
+	OtherFeesGrossAmount int64 `json:"other_fees_gross_amount,required"`
+	// Date of when the report was generated, as `YYYY-MM-DD`.
+	ReportDate string `json:"report_date,required" format:"date"`
+	// Date of when money movement is triggered for the transaction, as
+	// `YYYY-MM-DD`.
+	SettlementDate string `json:"settlement_date,required" format:"date"`
+	// Globally unique identifier denoting the associated Transaction object.
+	TransactionToken string `json:"transaction_token,required" format:"uuid"`
+	// The total amount of settlement impacting transactions (excluding
+	// interchange, fees, and disputes).
+	TransactionsGrossAmount int64 `json:"transactions_gross_amount,required"`
+	// The type of settlement record.
+	Type SettlementDetailType `json:"type,required"`
+	// Date and time when the transaction last updated. UTC time zone.
+	Updated time.Time `json:"updated,required" format:"date-time"`
+	JSON    SettlementDetailJSON
+}
+
+type SettlementDetailJSON struct {
+	Token                           pjson.Metadata
+	AccountToken                    pjson.Metadata
+	CardProgramToken                pjson.Metadata
+	CardToken                       pjson.Metadata
+	Created                         pjson.Metadata
+	Currency                        pjson.Metadata
+	DisputesGrossAmount             pjson.Metadata
+	EventTokens                     pjson.Metadata
+	Institution                     pjson.Metadata
+	InterchangeFeeExtendedPrecision pjson.Metadata
+	InterchangeGrossAmount          pjson.Metadata
+	Network                         pjson.Metadata
+	OtherFeesGrossAmount            pjson.Metadata
+	ReportDate                      pjson.Metadata
+	SettlementDate                  pjson.Metadata
+	TransactionToken                pjson.Metadata
+	TransactionsGrossAmount         pjson.Metadata
+	Type                            pjson.Metadata
+	Updated                         pjson.Metadata
+	Raw                             []byte
+	Extras                          map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into SettlementDetail using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *SettlementDetail) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type SettlementDetailType string
+
+const (
+	SettlementDetailTypeAdjustment     SettlementDetailType = "ADJUSTMENT"
+	SettlementDetailTypeArbitration    SettlementDetailType = "ARBITRATION"
+	SettlementDetailTypeChargeback     SettlementDetailType = "CHARGEBACK"
+	SettlementDetailTypeClearing       SettlementDetailType = "CLEARING"
+	SettlementDetailTypeFee            SettlementDetailType = "FEE"
+	SettlementDetailTypeFinancial      SettlementDetailType = "FINANCIAL"
+	SettlementDetailTypeNonFinancial   SettlementDetailType = "NON-FINANCIAL"
+	SettlementDetailTypePrearbitration SettlementDetailType = "PREARBITRATION"
+	SettlementDetailTypeRepresentment  SettlementDetailType = "REPRESENTMENT"
+)
+
+type SettlementDetailsCursorPage struct {
+	*pagination.CursorPage[SettlementDetail]
+}
+
+func (r *SettlementDetailsCursorPage) SettlementDetail() *SettlementDetail {
+	return r.Current()
+}
+
+func (r *SettlementDetailsCursorPage) NextPage() (*SettlementDetailsCursorPage, error) {
+	if page, err := r.CursorPage.NextPage(); err != nil {
+		return nil, err
+	} else {
+		return &SettlementDetailsCursorPage{page}, nil
+	}
+}
@@ -94,3 +94,43 @@ type ShippingAddressJSON struct {
 func (r *ShippingAddress) UnmarshalJSON(data []byte) (err error) {
 	return pjson.UnmarshalRoot(data, r)
 }
+
+// Cardholder is the snippet of account holder information that is embedded in
+// card and transaction payloads for programs that have it enabled. It is empty
+// otherwise, which can be checked with `JSON.Cardholder.IsMissing()` on the
+// enclosing object.
+type Cardholder struct {
+	// Globally unique identifier for the account holder.
+	AccountHolderToken string `json:"account_holder_token" format:"uuid"`
+	// The cardholder's first name.
+	FirstName string `json:"first_name"`
+	// The cardholder's last name.
+	LastName string `json:"last_name"`
+	// Last four digits of the cardholder's government-issued ID.
+	IDLastFour string `json:"id_last_four"`
+	JSON       CardholderJSON
+}
+
+type CardholderJSON struct {
+	AccountHolderToken pjson.Metadata
+	FirstName          pjson.Metadata
+	LastName           pjson.Metadata
+	IDLastFour         pjson.Metadata
+	Raw                []byte
+	Extras             map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into Cardholder using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *Cardholder) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// Name returns the full name of the cardholder.
+func (r *Cardholder) Name() string {
+	if r.FirstName == "" || r.LastName == "" {
+		return r.FirstName + r.LastName
+	}
+	return r.FirstName + " " + r.LastName
+}
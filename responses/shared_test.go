@@ -0,0 +1,40 @@
+package responses
+
+import (
+	"testing"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+)
+
+func TestCardholderSnippet(t *testing.T) {
+	card := Card{}
+	err := pjson.Unmarshal([]byte(`{"token":"card_token","cardholder":{"account_holder_token":"holder_token","first_name":"Jane","last_name":"Doe","id_last_four":"1234"}}`), &card)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if card.Cardholder.Name() != "Jane Doe" || card.Cardholder.IDLastFour != "1234" || len(card.JSON.Extras) != 0 {
+		t.Fatalf("expected a typed cardholder, got %+v", card.Cardholder)
+	}
+
+	transaction := Transaction{}
+	if err := pjson.Unmarshal([]byte(`{"token":"transaction_token"}`), &transaction); err != nil {
+		t.Fatal(err)
+	}
+	if !transaction.JSON.Cardholder.IsMissing() {
+		t.Fatalf("expected the cardholder to be missing")
+	}
+}
+
+func TestIsSimulated(t *testing.T) {
+	card := Card{Memo: "groceries [simulated]"}
+	if !card.IsSimulated() || card.IsSimulated("[test]") {
+		t.Fatalf("expected the default marker only to match %q", card.Memo)
+	}
+	transfer := BookTransfer{Events: []BookTransferEvent{{Memo: "rent"}, {Memo: "rent [test]"}}}
+	if !transfer.IsSimulated("[test]") || transfer.IsSimulated() {
+		t.Fatal("expected the marker of an event to mark the transfer")
+	}
+	if (&EventSubscription{Description: "hooks"}).IsSimulated() {
+		t.Fatal("expected an unmarked subscription not to be simulated")
+	}
+}
@@ -0,0 +1,53 @@
+package responses
+
+import (
+	"strings"
+
+	"github.com/lithic-com/lithic-go/core"
+)
+
+// isSimulated reports whether s contains any of markers, or core.SimulatedMarker
+// if none are given.
+func isSimulated(s string, markers []string) bool {
+	if len(markers) == 0 {
+		markers = []string{core.SimulatedMarker}
+	}
+	for _, marker := range markers {
+		if marker != "" && strings.Contains(s, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSimulated reports whether the memo of the card carries one of markers, or
+// the default marker of options.WithSimulatedMarker if none are given, that is
+// whether the card was created in the sandbox.
+func (r *Card) IsSimulated(markers ...string) bool {
+	return isSimulated(r.Memo, markers)
+}
+
+// IsSimulated reports whether the memo of any event of the transfer carries one
+// of markers, or the default marker of options.WithSimulatedMarker if none are
+// given.
+func (r *BookTransfer) IsSimulated(markers ...string) bool {
+	for i := range r.Events {
+		if r.Events[i].IsSimulated(markers...) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSimulated reports whether the memo of the event carries one of markers, or
+// the default marker of options.WithSimulatedMarker if none are given.
+func (r *BookTransferEvent) IsSimulated(markers ...string) bool {
+	return isSimulated(r.Memo, markers)
+}
+
+// IsSimulated reports whether the description of the subscription carries one
+// of markers, or the default marker of options.WithSimulatedMarker if none are
+// given.
+func (r *EventSubscription) IsSimulated(markers ...string) bool {
+	return isSimulated(r.Description, markers)
+}
@@ -0,0 +1,250 @@
+package responses
+
+import (
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/pagination"
+)
+
+type Statement struct {
+	// Globally unique identifier for a statement
+	Token string `json:"token,required"`
+	// Globally unique identifier for a financial account
+	FinancialAccountToken string `json:"financial_account_token,required" format:"uuid"`
+	// Globally unique identifier for a credit product
+	CreditProductToken string `json:"credit_product_token,required"`
+	// Date when the billing period began
+	StatementStartDate time.Time `json:"statement_start_date,required" format:"date"`
+	// Date when the billing period ended
+	StatementEndDate time.Time `json:"statement_end_date,required" format:"date"`
+	// Date when the next billing period will end
+	NextStatementEndDate time.Time `json:"next_statement_end_date,nullable" format:"date"`
+	// Date when the payment is due
+	PaymentDueDate time.Time `json:"payment_due_date,required" format:"date"`
+	// Date when the next payment is due
+	NextPaymentDueDate time.Time `json:"next_payment_due_date,nullable" format:"date"`
+	// Number of days in the billing cycle
+	DaysInBillingCycle int64 `json:"days_in_billing_cycle,required"`
+	// This is the maximum credit balance extended by the lender in cents
+	CreditLimit int64 `json:"credit_limit,required"`
+	// Amount of credit available to spend in cents
+	AvailableCredit int64 `json:"available_credit,required"`
+	// Balance at the start of the billing period
+	StartingBalance int64 `json:"starting_balance,required"`
+	// Balance at the end of the billing period
+	EndingBalance int64 `json:"ending_balance,required"`
+	// The amount due and the amount past due at the end of the billing period
+	AmountDue StatementAmountDue `json:"amount_due,required"`
+	// Totals of the billing period
+	PeriodTotals StatementTotals `json:"period_totals,required"`
+	// Totals of the year to date
+	YtdTotals StatementTotals `json:"ytd_totals,required"`
+	// Timestamp of when the statement was created
+	Created time.Time `json:"created,required" format:"date-time"`
+	// Timestamp of when the statement was updated
+	Updated time.Time `json:"updated,required" format:"date-time"`
+	JSON    StatementJSON
+}
+
+type StatementJSON struct {
+	Token                 pjson.Metadata
+	FinancialAccountToken pjson.Metadata
+	CreditProductToken    pjson.Metadata
+	StatementStartDate    pjson.Metadata
+	StatementEndDate      pjson.Metadata
+	NextStatementEndDate  pjson.Metadata
+	PaymentDueDate        pjson.Metadata
+	NextPaymentDueDate    pjson.Metadata
+	DaysInBillingCycle    pjson.Metadata
+	CreditLimit           pjson.Metadata
+	AvailableCredit       pjson.Metadata
+	StartingBalance       pjson.Metadata
+	EndingBalance         pjson.Metadata
+	AmountDue             pjson.Metadata
+	PeriodTotals          pjson.Metadata
+	YtdTotals             pjson.Metadata
+	Created               pjson.Metadata
+	Updated               pjson.Metadata
+	Raw                   []byte
+	Extras                map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into Statement using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *Statement) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// Covers reports whether the given date falls within the billing period of the
+// statement, which includes both its start and end dates.
+func (r *Statement) Covers(date time.Time) bool {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return !day.Before(r.StatementStartDate) && !day.After(r.StatementEndDate)
+}
+
+type StatementAmountDue struct {
+	// Payment due at the end of the billing period in cents. Negative amount
+	// indicates something is owed. If the amount owed is positive (e.g., there was
+	// a net credit), then payment should be returned to the cardholder via ACH.
+	Amount int64 `json:"amount,required"`
+	// Amount past due for statement in cents
+	PastDue int64 `json:"past_due,required"`
+	JSON    StatementAmountDueJSON
+}
+
+type StatementAmountDueJSON struct {
+	Amount  pjson.Metadata
+	PastDue pjson.Metadata
+	Raw     []byte
+	Extras  map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into StatementAmountDue using
+// the internal pjson library. Unrecognized fields are stored in the
+// `jsonFields` property.
+func (r *StatementAmountDue) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type StatementTotals struct {
+	// Opening balance transferred from previous account in cents
+	BalanceTransfers int64 `json:"balance_transfers,required"`
+	// ATM and cashback transactions in cents
+	CashAdvances int64 `json:"cash_advances,required"`
+	// Volume of credit management operation transactions less any balance
+	// transfers in cents
+	Credits int64 `json:"credits,required"`
+	// Volume of debit management operation transactions less any interest in
+	// cents
+	Fees int64 `json:"fees,required"`
+	// Interest accrued in cents
+	Interest int64 `json:"interest,required"`
+	// Any funds transfers which affective the balance in cents
+	Payments int64 `json:"payments,required"`
+	// Net card transaction volume less any cash advances in cents
+	Purchases int64 `json:"purchases,required"`
+	JSON      StatementTotalsJSON
+}
+
+type StatementTotalsJSON struct {
+	BalanceTransfers pjson.Metadata
+	CashAdvances     pjson.Metadata
+	Credits          pjson.Metadata
+	Fees             pjson.Metadata
+	Interest         pjson.Metadata
+	Payments         pjson.Metadata
+	Purchases        pjson.Metadata
+	Raw              []byte
+	Extras           map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into StatementTotals using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *StatementTotals) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type StatementLineItem struct {
+	// Globally unique identifier for a Statement Line Item
+	Token string `json:"token,required"`
+	// Globally unique identifier for a financial account
+	FinancialAccountToken string `json:"financial_account_token,required" format:"uuid"`
+	// Globally unique identifier for a card
+	CardToken string `json:"card_token" format:"uuid"`
+	// Globally unique identifier for a financial transaction
+	FinancialTransactionToken string `json:"financial_transaction_token,required" format:"uuid"`
+	// Globally unique identifier for a financial transaction event
+	FinancialTransactionEventToken string `json:"financial_transaction_event_token,required" format:"uuid"`
+	// Transaction amount in cents
+	Amount int64 `json:"amount,required"`
+	// Category of the line item
+	Category StatementLineItemCategory `json:"category,required"`
+	// 3-digit alphabetic ISO 4217 code for the settling currency of the
+	// transaction
+	Currency string `json:"currency,required"`
+	// A description of the line item, which may be useful to display to users
+	Descriptor string `json:"descriptor"`
+	// Date that the transaction effected the account balance
+	EffectiveDate time.Time `json:"effective_date,required" format:"date"`
+	// The type of the financial event, such as `CLEARING` or `PAYMENT`
+	EventType string `json:"event_type,required"`
+	// Timestamp of when the line item was generated
+	Created time.Time `json:"created,required" format:"date-time"`
+	JSON    StatementLineItemJSON
+}
+
+type StatementLineItemJSON struct {
+	Token                          pjson.Metadata
+	FinancialAccountToken          pjson.Metadata
+	CardToken                      pjson.Metadata
+	FinancialTransactionToken      pjson.Metadata
+	FinancialTransactionEventToken pjson.Metadata
+	Amount                         pjson.Metadata
+	Category                       pjson.Metadata
+	Currency                       pjson.Metadata
+	Descriptor                     pjson.Metadata
+	EffectiveDate                  pjson.Metadata
+	EventType                      pjson.Metadata
+	Created                        pjson.Metadata
+	Raw                            []byte
+	Extras                         map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into StatementLineItem using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *StatementLineItem) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type StatementLineItemCategory string
+
+const (
+	StatementLineItemCategoryACH                  StatementLineItemCategory = "ACH"
+	StatementLineItemCategoryBalanceOrFunding     StatementLineItemCategory = "BALANCE_OR_FUNDING"
+	StatementLineItemCategoryCard                 StatementLineItemCategory = "CARD"
+	StatementLineItemCategoryExternalACH          StatementLineItemCategory = "EXTERNAL_ACH"
+	StatementLineItemCategoryExternalCheck        StatementLineItemCategory = "EXTERNAL_CHECK"
+	StatementLineItemCategoryExternalTransfer     StatementLineItemCategory = "EXTERNAL_TRANSFER"
+	StatementLineItemCategoryExternalWire         StatementLineItemCategory = "EXTERNAL_WIRE"
+	StatementLineItemCategoryManagementAdjustment StatementLineItemCategory = "MANAGEMENT_ADJUSTMENT"
+	StatementLineItemCategoryManagementDispute    StatementLineItemCategory = "MANAGEMENT_DISPUTE"
+	StatementLineItemCategoryManagementFee        StatementLineItemCategory = "MANAGEMENT_FEE"
+	StatementLineItemCategoryManagementReward     StatementLineItemCategory = "MANAGEMENT_REWARD"
+	StatementLineItemCategoryTransfer             StatementLineItemCategory = "TRANSFER"
+)
+
+type StatementsCursorPage struct {
+	*pagination.CursorPage[Statement]
+}
+
+func (r *StatementsCursorPage) Statement() *Statement {
+	return r.Current()
+}
+
+func (r *StatementsCursorPage) NextPage() (*StatementsCursorPage, error) {
+	if page, err := r.CursorPage.NextPage(); err != nil {
+		return nil, err
+	} else {
+		return &StatementsCursorPage{page}, nil
+	}
+}
+
+type StatementLineItemsCursorPage struct {
+	*pagination.CursorPage[StatementLineItem]
+}
+
+func (r *StatementLineItemsCursorPage) StatementLineItem() *StatementLineItem {
+	return r.Current()
+}
+
+func (r *StatementLineItemsCursorPage) NextPage() (*StatementLineItemsCursorPage, error) {
+	if page, err := r.CursorPage.NextPage(); err != nil {
+		return nil, err
+	} else {
+		return &StatementLineItemsCursorPage{page}, nil
+	}
+}
@@ -0,0 +1,229 @@
+package responses
+
+import (
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+)
+
+type ThreeDSAuthentication struct {
+	// Globally unique identifier for the 3DS authentication.
+	Token string `json:"token,required" format:"uuid"`
+	// Outcome of the authentication:
+	//
+	//   - `DECLINE` - The authentication was declined.
+	//   - `SUCCESS` - The authentication succeeded.
+	//   - `PENDING_CHALLENGE` - The cardholder has been challenged and has not
+	//     responded yet.
+	//   - `PENDING_DECISION` - A decision has not been made yet.
+	AuthenticationResult ThreeDSAuthenticationResult `json:"authentication_result,required"`
+	// Whether the expiry date provided by the cardholder matched Lithic's record
+	// of the card's expiry.
+	CardExpiryCheck ThreeDSAuthenticationCardExpiryCheck `json:"card_expiry_check,required"`
+	// Globally unique identifier for the card on which the 3DS authentication has
+	// occurred.
+	CardToken string `json:"card_token,required" format:"uuid"`
+	// Channel in which the authentication occurs.
+	Channel ThreeDSAuthenticationChannel `json:"channel,required"`
+	// Date and time when the authentication was created in Lithic's system.
+	Created time.Time `json:"created,required" format:"date-time"`
+	// Entity that made the authentication decision.
+	DecisionMadeBy ThreeDSAuthenticationDecisionMadeBy `json:"decision_made_by,required,nullable"`
+	// Whether the authentication is for a payment or for another purpose, such as
+	// adding the card to a merchant's wallet.
+	MessageCategory ThreeDSAuthenticationMessageCategory `json:"message_category,required"`
+	Merchant        ThreeDSAuthenticationMerchant        `json:"merchant,required"`
+	// The transaction that is being authenticated, which is absent for non-payment
+	// authentications.
+	Transaction ThreeDSAuthenticationTransaction `json:"transaction,nullable"`
+	JSON        ThreeDSAuthenticationJSON
+}
+
+type ThreeDSAuthenticationJSON struct {
+	Token                pjson.Metadata
+	AuthenticationResult pjson.Metadata
+	CardExpiryCheck      pjson.Metadata
+	CardToken            pjson.Metadata
+	Channel              pjson.Metadata
+	Created              pjson.Metadata
+	DecisionMadeBy       pjson.Metadata
+	MessageCategory      pjson.Metadata
+	Merchant             pjson.Metadata
+	Transaction          pjson.Metadata
+	Raw                  []byte
+	Extras               map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into ThreeDSAuthentication using
+// the internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *ThreeDSAuthentication) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type ThreeDSAuthenticationResult string
+
+const (
+	ThreeDSAuthenticationResultDecline          ThreeDSAuthenticationResult = "DECLINE"
+	ThreeDSAuthenticationResultSuccess          ThreeDSAuthenticationResult = "SUCCESS"
+	ThreeDSAuthenticationResultPendingChallenge ThreeDSAuthenticationResult = "PENDING_CHALLENGE"
+	ThreeDSAuthenticationResultPendingDecision  ThreeDSAuthenticationResult = "PENDING_DECISION"
+)
+
+type ThreeDSAuthenticationCardExpiryCheck string
+
+const (
+	ThreeDSAuthenticationCardExpiryCheckMatch      ThreeDSAuthenticationCardExpiryCheck = "MATCH"
+	ThreeDSAuthenticationCardExpiryCheckMismatch   ThreeDSAuthenticationCardExpiryCheck = "MISMATCH"
+	ThreeDSAuthenticationCardExpiryCheckNotPresent ThreeDSAuthenticationCardExpiryCheck = "NOT_PRESENT"
+)
+
+type ThreeDSAuthenticationChannel string
+
+const (
+	ThreeDSAuthenticationChannelAppBased                  ThreeDSAuthenticationChannel = "APP_BASED"
+	ThreeDSAuthenticationChannelBrowser                   ThreeDSAuthenticationChannel = "BROWSER"
+	ThreeDSAuthenticationChannelThreeDSRequestorInitiated ThreeDSAuthenticationChannel = "THREE_DS_REQUESTOR_INITIATED"
+)
+
+type ThreeDSAuthenticationDecisionMadeBy string
+
+const (
+	ThreeDSAuthenticationDecisionMadeByCustomerEndpoint ThreeDSAuthenticationDecisionMadeBy = "CUSTOMER_ENDPOINT"
+	ThreeDSAuthenticationDecisionMadeByLithicDefault    ThreeDSAuthenticationDecisionMadeBy = "LITHIC_DEFAULT"
+	ThreeDSAuthenticationDecisionMadeByLithicRules      ThreeDSAuthenticationDecisionMadeBy = "LITHIC_RULES"
+	ThreeDSAuthenticationDecisionMadeByNetwork          ThreeDSAuthenticationDecisionMadeBy = "NETWORK"
+	ThreeDSAuthenticationDecisionMadeByUnknown          ThreeDSAuthenticationDecisionMadeBy = "UNKNOWN"
+)
+
+type ThreeDSAuthenticationMessageCategory string
+
+const (
+	ThreeDSAuthenticationMessageCategoryPaymentAuthentication    ThreeDSAuthenticationMessageCategory = "PAYMENT_AUTHENTICATION"
+	ThreeDSAuthenticationMessageCategoryNonPaymentAuthentication ThreeDSAuthenticationMessageCategory = "NON_PAYMENT_AUTHENTICATION"
+)
+
+type ThreeDSAuthenticationMerchant struct {
+	// Merchant identifier as assigned by the acquirer.
+	ID string `json:"id,required"`
+	// Country code of the merchant requesting 3DS authentication.
+	Country string `json:"country,required"`
+	// Merchant category code of the merchant requesting 3DS authentication.
+	Mcc string `json:"mcc,required"`
+	// Name of the merchant.
+	Name string `json:"name,required"`
+	JSON ThreeDSAuthenticationMerchantJSON
+}
+
+type ThreeDSAuthenticationMerchantJSON struct {
+	ID      pjson.Metadata
+	Country pjson.Metadata
+	Mcc     pjson.Metadata
+	Name    pjson.Metadata
+	Raw     []byte
+	Extras  map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into
+// ThreeDSAuthenticationMerchant using the internal pjson library. Unrecognized
+// fields are stored in the `jsonFields` property.
+func (r *ThreeDSAuthenticationMerchant) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type ThreeDSAuthenticationTransaction struct {
+	// Amount of the purchase in minor units of currency with all punctuation
+	// removed.
+	Amount int64 `json:"amount,required"`
+	// Currency of the purchase, as a 3-digit alphabetic ISO 4217 code.
+	Currency string `json:"currency,required"`
+	// Minor units of currency, as specified in ISO 4217.
+	CurrencyExponent int64 `json:"currency_exponent,required"`
+	// Date and time when the authentication was generated by the merchant.
+	DateTime time.Time `json:"date_time,required" format:"date-time"`
+	JSON     ThreeDSAuthenticationTransactionJSON
+}
+
+type ThreeDSAuthenticationTransactionJSON struct {
+	Amount           pjson.Metadata
+	Currency         pjson.Metadata
+	CurrencyExponent pjson.Metadata
+	DateTime         pjson.Metadata
+	Raw              []byte
+	Extras           map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into
+// ThreeDSAuthenticationTransaction using the internal pjson library.
+// Unrecognized fields are stored in the `jsonFields` property.
+func (r *ThreeDSAuthenticationTransaction) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+// ThreeDSChallenge is sent to the program when a cardholder has to complete an
+// out-of-band challenge, such as confirming a purchase in the program's app.
+type ThreeDSChallenge struct {
+	// The authentication that the challenge is for.
+	AuthenticationObject ThreeDSAuthentication   `json:"authentication_object,required"`
+	Challenge            ThreeDSChallengeDetails `json:"challenge,required"`
+	JSON                 ThreeDSChallengeJSON
+}
+
+type ThreeDSChallengeJSON struct {
+	AuthenticationObject pjson.Metadata
+	Challenge            pjson.Metadata
+	Raw                  []byte
+	Extras               map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into ThreeDSChallenge using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *ThreeDSChallenge) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type ThreeDSChallengeDetails struct {
+	// How the cardholder is challenged, such as `OUT_OF_BAND`.
+	ChallengeMethodType string `json:"challenge_method_type,required"`
+	// Date and time when the challenge was started.
+	StartTime time.Time `json:"start_time,required" format:"date-time"`
+	// Date and time after which a response to the challenge is no longer
+	// accepted.
+	ExpiryTime time.Time `json:"expiry_time,required" format:"date-time"`
+	JSON       ThreeDSChallengeDetailsJSON
+}
+
+type ThreeDSChallengeDetailsJSON struct {
+	ChallengeMethodType pjson.Metadata
+	StartTime           pjson.Metadata
+	ExpiryTime          pjson.Metadata
+	Raw                 []byte
+	Extras              map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into ThreeDSChallengeDetails
+// using the internal pjson library. Unrecognized fields are stored in the
+// `jsonFields` property.
+func (r *ThreeDSChallengeDetails) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type ThreeDSDecisioningSecret struct {
+	// The 3DS Decisioning HMAC secret
+	Secret string `json:"secret"`
+	JSON   ThreeDSDecisioningSecretJSON
+}
+
+type ThreeDSDecisioningSecretJSON struct {
+	Secret pjson.Metadata
+	Raw    []byte
+	Extras map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into ThreeDSDecisioningSecret
+// using the internal pjson library. Unrecognized fields are stored in the
+// `jsonFields` property.
+func (r *ThreeDSDecisioningSecret) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
@@ -0,0 +1,165 @@
+package responses
+
+import (
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/pagination"
+)
+
+type Tokenization struct {
+	// Globally unique identifier for a Tokenization
+	Token string `json:"token,required" format:"uuid"`
+	// The account token associated with the card being tokenized.
+	AccountToken string `json:"account_token,required" format:"uuid"`
+	// The card token associated with the card being tokenized.
+	CardToken string `json:"card_token,required" format:"uuid"`
+	// Date and time when the tokenization first occurred. UTC time zone.
+	CreatedAt time.Time `json:"created_at,required" format:"date-time"`
+	// Specifies the digital card art displayed in the user’s digital wallet after
+	// tokenization. This will be null if the tokenization was created without an
+	// associated digital card art.
+	DigitalCardArtToken string `json:"digital_card_art_token,nullable" format:"uuid"`
+	// A list of events related to the tokenization.
+	Events []TokenizationEvent `json:"events"`
+	// The status of the tokenization request
+	Status TokenizationStatus `json:"status,required"`
+	// The entity that requested the tokenization. Represents a Digital Wallet or
+	// merchant.
+	TokenRequestorName TokenizationTokenRequestorName `json:"token_requestor_name,required"`
+	// The network's unique reference for the tokenization.
+	TokenUniqueReference string `json:"token_unique_reference,required"`
+	// The channel through which the tokenization was made.
+	TokenizationChannel TokenizationChannel `json:"tokenization_channel,required"`
+	// Latest date and time when the tokenization was updated. UTC time zone.
+	UpdatedAt time.Time `json:"updated_at,required" format:"date-time"`
+	JSON      TokenizationJSON
+}
+
+type TokenizationJSON struct {
+	Token                pjson.Metadata
+	AccountToken         pjson.Metadata
+	CardToken            pjson.Metadata
+	CreatedAt            pjson.Metadata
+	DigitalCardArtToken  pjson.Metadata
+	Events               pjson.Metadata
+	Status               pjson.Metadata
+	TokenRequestorName   pjson.Metadata
+	TokenUniqueReference pjson.Metadata
+	TokenizationChannel  pjson.Metadata
+	UpdatedAt            pjson.Metadata
+	Raw                  []byte
+	Extras               map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into Tokenization using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *Tokenization) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type TokenizationStatus string
+
+const (
+	TokenizationStatusActive            TokenizationStatus = "ACTIVE"
+	TokenizationStatusDeactivated       TokenizationStatus = "DEACTIVATED"
+	TokenizationStatusInactive          TokenizationStatus = "INACTIVE"
+	TokenizationStatusPaused            TokenizationStatus = "PAUSED"
+	TokenizationStatusPending2Fa        TokenizationStatus = "PENDING_2FA"
+	TokenizationStatusPendingActivation TokenizationStatus = "PENDING_ACTIVATION"
+	TokenizationStatusUnknown           TokenizationStatus = "UNKNOWN"
+)
+
+type TokenizationTokenRequestorName string
+
+const (
+	TokenizationTokenRequestorNameAmazonOne    TokenizationTokenRequestorName = "AMAZON_ONE"
+	TokenizationTokenRequestorNameAndroidPay   TokenizationTokenRequestorName = "ANDROID_PAY"
+	TokenizationTokenRequestorNameApplePay     TokenizationTokenRequestorName = "APPLE_PAY"
+	TokenizationTokenRequestorNameFacebook     TokenizationTokenRequestorName = "FACEBOOK"
+	TokenizationTokenRequestorNameFitbitPay    TokenizationTokenRequestorName = "FITBIT_PAY"
+	TokenizationTokenRequestorNameGarminPay    TokenizationTokenRequestorName = "GARMIN_PAY"
+	TokenizationTokenRequestorNameMicrosoftPay TokenizationTokenRequestorName = "MICROSOFT_PAY"
+	TokenizationTokenRequestorNameNetflix      TokenizationTokenRequestorName = "NETFLIX"
+	TokenizationTokenRequestorNameSamsungPay   TokenizationTokenRequestorName = "SAMSUNG_PAY"
+	TokenizationTokenRequestorNameUnknown      TokenizationTokenRequestorName = "UNKNOWN"
+	TokenizationTokenRequestorNameVisaCheckout TokenizationTokenRequestorName = "VISA_CHECKOUT"
+)
+
+type TokenizationChannel string
+
+const (
+	TokenizationChannelDigitalWallet TokenizationChannel = "DIGITAL_WALLET"
+	TokenizationChannelMerchant      TokenizationChannel = "MERCHANT"
+)
+
+type TokenizationEvent struct {
+	// Globally unique identifier for a Tokenization Event
+	Token string `json:"token" format:"uuid"`
+	// Date and time when the tokenization event first occurred. UTC time zone.
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+	// Enum representing the result of the tokenization event
+	Result TokenizationEventResult `json:"result"`
+	// Enum representing the type of tokenization event that occurred
+	Type TokenizationEventType `json:"type"`
+	JSON TokenizationEventJSON
+}
+
+type TokenizationEventJSON struct {
+	Token     pjson.Metadata
+	CreatedAt pjson.Metadata
+	Result    pjson.Metadata
+	Type      pjson.Metadata
+	Raw       []byte
+	Extras    map[string]pjson.Metadata
+}
+
+// UnmarshalJSON deserializes the provided bytes into TokenizationEvent using the
+// internal pjson library. Unrecognized fields are stored in the `jsonFields`
+// property.
+func (r *TokenizationEvent) UnmarshalJSON(data []byte) (err error) {
+	return pjson.UnmarshalRoot(data, r)
+}
+
+type TokenizationEventResult string
+
+const (
+	TokenizationEventResultApproved                        TokenizationEventResult = "APPROVED"
+	TokenizationEventResultDeclined                        TokenizationEventResult = "DECLINED"
+	TokenizationEventResultNotificationDelivered           TokenizationEventResult = "NOTIFICATION_DELIVERED"
+	TokenizationEventResultRequireAdditionalAuthentication TokenizationEventResult = "REQUIRE_ADDITIONAL_AUTHENTICATION"
+	TokenizationEventResultTokenActivated                  TokenizationEventResult = "TOKEN_ACTIVATED"
+	TokenizationEventResultTokenCreated                    TokenizationEventResult = "TOKEN_CREATED"
+	TokenizationEventResultTokenDeactivated                TokenizationEventResult = "TOKEN_DEACTIVATED"
+	TokenizationEventResultTokenInactive                   TokenizationEventResult = "TOKEN_INACTIVE"
+	TokenizationEventResultTokenStateUnknown               TokenizationEventResult = "TOKEN_STATE_UNKNOWN"
+	TokenizationEventResultTokenSuspended                  TokenizationEventResult = "TOKEN_SUSPENDED"
+	TokenizationEventResultTokenUpdated                    TokenizationEventResult = "TOKEN_UPDATED"
+)
+
+type TokenizationEventType string
+
+const (
+	TokenizationEventTypeTokenization2Fa              TokenizationEventType = "TOKENIZATION_2FA"
+	TokenizationEventTypeTokenizationAuthorization    TokenizationEventType = "TOKENIZATION_AUTHORIZATION"
+	TokenizationEventTypeTokenizationDecisioning      TokenizationEventType = "TOKENIZATION_DECISIONING"
+	TokenizationEventTypeTokenizationEligibilityCheck TokenizationEventType = "TOKENIZATION_ELIGIBILITY_CHECK"
+	TokenizationEventTypeTokenizationUpdated          TokenizationEventType = "TOKENIZATION_UPDATED"
+)
+
+type TokenizationsCursorPage struct {
+	*pagination.CursorPage[Tokenization]
+}
+
+func (r *TokenizationsCursorPage) Tokenization() *Tokenization {
+	return r.Current()
+}
+
+func (r *TokenizationsCursorPage) NextPage() (*TokenizationsCursorPage, error) {
+	if page, err := r.CursorPage.NextPage(); err != nil {
+		return nil, err
+	} else {
+		return &TokenizationsCursorPage{page}, nil
+	}
+}
@@ -60,7 +60,10 @@ type Transaction struct {
 	Status TransactionStatus `json:"status"`
 	// Globally unique identifier.
 	Token string `json:"token" format:"uuid"`
-	JSON  TransactionJSON
+	// The account holder of the card, if cardholder details are embedded in
+	// transaction payloads for the program.
+	Cardholder Cardholder `json:"cardholder"`
+	JSON       TransactionJSON
 }
 
 type TransactionJSON struct {
@@ -81,6 +84,7 @@ type TransactionJSON struct {
 	SettledAmount               pjson.Metadata
 	Status                      pjson.Metadata
 	Token                       pjson.Metadata
+	Cardholder                  pjson.Metadata
 	Raw                         []byte
 	Extras                      map[string]pjson.Metadata
 }
@@ -293,6 +297,10 @@ type TransactionEvent struct {
 	//     additional clearing.
 	//   - `FINANCIAL_CREDIT_AUTHORIZATION` - A request from a merchant to refund or
 	//     credit funds without additional clearing.
+	//   - `FINANCIAL_ADVICE` - A financial authorization was approved on your behalf
+	//     by the network.
+	//   - `FINANCIAL_CREDIT_ADVICE` - A financial credit authorization was approved on
+	//     your behalf by the network.
 	//   - `RETURN` - A refund has been processed on the transaction.
 	//   - `RETURN_REVERSAL` - A refund has been reversed (e.g., when a merchant reverses
 	//     an incorrect refund).
@@ -357,6 +365,8 @@ const (
 	TransactionEventTypeCreditAuthorizationAdvice    TransactionEventType = "CREDIT_AUTHORIZATION_ADVICE"
 	TransactionEventTypeFinancialAuthorization       TransactionEventType = "FINANCIAL_AUTHORIZATION"
 	TransactionEventTypeFinancialCreditAuthorization TransactionEventType = "FINANCIAL_CREDIT_AUTHORIZATION"
+	TransactionEventTypeFinancialAdvice              TransactionEventType = "FINANCIAL_ADVICE"
+	TransactionEventTypeFinancialCreditAdvice        TransactionEventType = "FINANCIAL_CREDIT_ADVICE"
 	TransactionEventTypeReturn                       TransactionEventType = "RETURN"
 	TransactionEventTypeReturnReversal               TransactionEventType = "RETURN_REVERSAL"
 	TransactionEventTypeVoid                         TransactionEventType = "VOID"
@@ -0,0 +1,78 @@
+package responses
+
+// IsAdvice reports whether events of the type are advice messages. An advice
+// notifies the program of an authorization that was already decided on its
+// behalf, for example by the card network during stand-in processing, rather
+// than asking for one.
+func (r TransactionEventType) IsAdvice() bool {
+	switch r {
+	case TransactionEventTypeAuthorizationAdvice, TransactionEventTypeCreditAuthorizationAdvice,
+		TransactionEventTypeFinancialAdvice, TransactionEventTypeFinancialCreditAdvice:
+		return true
+	}
+	return false
+}
+
+// AuthorizationAdvice is an `AUTHORIZATION_ADVICE` or
+// `CREDIT_AUTHORIZATION_ADVICE` event. Its amount is held until the
+// transaction clears, like the amount of an authorization, but it must not be
+// counted as another authorization of the transaction: it either stands in for
+// an authorization that the program never saw, or adjusts one that it did.
+type AuthorizationAdvice struct {
+	TransactionEvent
+}
+
+// Credit reports whether the advice is for a refund or credit to the card.
+func (r AuthorizationAdvice) Credit() bool {
+	return r.Type == TransactionEventTypeCreditAuthorizationAdvice
+}
+
+// FinancialAdvice is a `FINANCIAL_ADVICE` or `FINANCIAL_CREDIT_ADVICE` event.
+// Like a financial authorization, its amount settles without a clearing.
+type FinancialAdvice struct {
+	TransactionEvent
+}
+
+// Credit reports whether the advice is for a refund or credit to the card.
+func (r FinancialAdvice) Credit() bool {
+	return r.Type == TransactionEventTypeFinancialCreditAdvice
+}
+
+// Authorizations returns the events of the transaction that asked for an
+// authorization, in order, excluding advices.
+func (r *Transaction) Authorizations() []TransactionEvent {
+	var events []TransactionEvent
+	for _, event := range r.Events {
+		switch event.Type {
+		case TransactionEventTypeAuthorization, TransactionEventTypeCreditAuthorization,
+			TransactionEventTypeFinancialAuthorization, TransactionEventTypeFinancialCreditAuthorization:
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// AuthorizationAdvices returns the authorization advices of the transaction, in
+// order.
+func (r *Transaction) AuthorizationAdvices() []AuthorizationAdvice {
+	var advices []AuthorizationAdvice
+	for _, event := range r.Events {
+		switch event.Type {
+		case TransactionEventTypeAuthorizationAdvice, TransactionEventTypeCreditAuthorizationAdvice:
+			advices = append(advices, AuthorizationAdvice{event})
+		}
+	}
+	return advices
+}
+
+// FinancialAdvices returns the financial advices of the transaction, in order.
+func (r *Transaction) FinancialAdvices() []FinancialAdvice {
+	var advices []FinancialAdvice
+	for _, event := range r.Events {
+		switch event.Type {
+		case TransactionEventTypeFinancialAdvice, TransactionEventTypeFinancialCreditAdvice:
+			advices = append(advices, FinancialAdvice{event})
+		}
+	}
+	return advices
+}
@@ -0,0 +1,32 @@
+package responses
+
+import "testing"
+
+func TestTransactionAdvices(t *testing.T) {
+	tx := Transaction{Events: []TransactionEvent{
+		{Token: "1", Type: TransactionEventTypeAuthorization, Amount: 1000},
+		{Token: "2", Type: TransactionEventTypeAuthorizationAdvice, Amount: -200},
+		{Token: "3", Type: TransactionEventTypeCreditAuthorizationAdvice, Amount: 300},
+		{Token: "4", Type: TransactionEventTypeFinancialAdvice, Amount: 400},
+		{Token: "5", Type: TransactionEventTypeClearing, Amount: 800},
+		{Token: "6", Type: TransactionEventTypeFinancialCreditAdvice, Amount: 100},
+	}}
+
+	if auths := tx.Authorizations(); len(auths) != 1 || auths[0].Token != "1" {
+		t.Fatalf("unexpected authorizations %v", auths)
+	}
+	advices := tx.AuthorizationAdvices()
+	if len(advices) != 2 || advices[0].Token != "2" || advices[0].Credit() || !advices[1].Credit() {
+		t.Fatalf("unexpected authorization advices %v", advices)
+	}
+	financial := tx.FinancialAdvices()
+	if len(financial) != 2 || financial[0].Amount != 400 || financial[0].Credit() || !financial[1].Credit() {
+		t.Fatalf("unexpected financial advices %v", financial)
+	}
+	for _, event := range tx.Events {
+		want := event.Token != "1" && event.Token != "5"
+		if event.Type.IsAdvice() != want {
+			t.Fatalf("expected IsAdvice of %s to be %v", event.Type, want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package lithic
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// Select returns the fields of a decoded response, such as a responses.Card,
+// at the given JSON paths, so that only the data a client needs is forwarded to
+// it. Paths are dot separated keys as they are in the API, such as
+// "funding.last_four". A path that crosses a list selects the rest of the path
+// from every element, for example "data.token" on a responses.CardListResponse. Paths that
+// are not present in the response are left out.
+func Select(resp interface{}, paths ...string) (map[string]interface{}, error) {
+	data, err := responses.Marshal(resp, responses.KeyStyleSnakeCase)
+	if err != nil {
+		return nil, err
+	}
+	var src interface{}
+	if err := json.Unmarshal(data, &src); err != nil {
+		return nil, err
+	}
+	if _, ok := src.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("lithic: cannot select fields of %T", resp)
+	}
+	res := map[string]interface{}{}
+	for _, path := range paths {
+		if selected, ok := selectPath(src, strings.Split(path, ".")); ok {
+			res = merge(res, selected).(map[string]interface{})
+		}
+	}
+	return res, nil
+}
+
+// selectPath returns a copy of src trimmed to path, and whether path is
+// present in src.
+func selectPath(src interface{}, path []string) (interface{}, bool) {
+	if len(path) == 0 {
+		return src, true
+	}
+	switch src := src.(type) {
+	case map[string]interface{}:
+		value, ok := src[path[0]]
+		if !ok {
+			return nil, false
+		}
+		selected, ok := selectPath(value, path[1:])
+		if !ok {
+			return nil, false
+		}
+		return map[string]interface{}{path[0]: selected}, true
+	case []interface{}:
+		elements := make([]interface{}, len(src))
+		found := false
+		for i, element := range src {
+			if selected, ok := selectPath(element, path); ok {
+				elements[i], found = selected, true
+			}
+		}
+		return elements, found
+	}
+	return nil, false
+}
+
+// merge combines two selections of the same response.
+func merge(a, b interface{}) interface{} {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		if b, ok := b.(map[string]interface{}); ok {
+			for key, value := range b {
+				if existing, ok := a[key]; ok {
+					a[key] = merge(existing, value)
+				} else {
+					a[key] = value
+				}
+			}
+			return a
+		}
+	case []interface{}:
+		if b, ok := b.([]interface{}); ok && len(a) == len(b) {
+			for i := range a {
+				if a[i] == nil {
+					a[i] = b[i]
+				} else if b[i] != nil {
+					a[i] = merge(a[i], b[i])
+				}
+			}
+			return a
+		}
+	}
+	return b
+}
@@ -0,0 +1,46 @@
+package lithic
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestSelect(t *testing.T) {
+	page := &responses.CardListResponse{}
+	err := json.Unmarshal([]byte(`{"data":[`+
+		`{"token":"a","last_four":"1111","pan":"4111111111111111","funding":{"token":"f","last_four":"9999"}},`+
+		`{"token":"b","last_four":"2222","pan":"4222222222222222"}`+
+		`],"page":1,"total_entries":2,"total_pages":1}`), page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := Select(page, "data.token", "data.funding.last_four", "total_entries", "missing.path")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(res)
+	// Fields that are missing from the response are selected with their empty
+	// value, like they are decoded.
+	want := `{"data":[{"funding":{"last_four":"9999"},"token":"a"},{"funding":{"last_four":""},"token":"b"}],"total_entries":2}`
+	if string(data) != want {
+		t.Fatalf("expected %s, got %s", want, data)
+	}
+}
+
+func TestSelectCard(t *testing.T) {
+	card := responses.Card{Token: "a", LastFour: "1111", Pan: "4111111111111111"}
+	res, err := Select(&card, "token", "last_four")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res, map[string]interface{}{"token": "a", "last_four": "1111"}) {
+		t.Fatalf("unexpected selection %v", res)
+	}
+	if _, err := Select([]responses.Card{card}, "token"); err == nil {
+		t.Fatal("expected an error for a list")
+	}
+}
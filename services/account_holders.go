@@ -153,3 +153,40 @@ func (r *AccountHolderService) UploadDocument(ctx context.Context, account_holde
 	err = options.ExecuteNewRequest(ctx, "POST", path, body, &res, opts...)
 	return
 }
+
+// UploadDocumentImage sends an image of a document, as a multipart form, to the
+// upload URL of one of its required uploads. The upload URL is pre-signed, so
+// the API key is not sent along with the image. Whether the image was accepted
+// is reported asynchronously in the status of the upload, see GetDocument.
+func (r *AccountHolderService) UploadDocumentImage(ctx context.Context, upload *responses.AccountHolderDocumentRequiredDocumentUploads, body *requests.AccountHolderUploadDocumentImageParams, opts ...options.RequestOption) (err error) {
+	opts = append(r.Options[:], opts...)
+	opts = append([]options.RequestOption{options.WithHeader("Accept", "")}, opts...)
+	opts = append(opts, options.WithHeaderDel("Authorization"), options.WithHeaderDel("Idempotency-Token"))
+	err = options.ExecuteNewRequest(ctx, "POST", upload.UploadURL, body, nil, opts...)
+	return
+}
+
+// SubmitDocument creates a document upload of the given type, sends the image
+// for each of its pending required uploads from images, keyed by image type,
+// and returns the document with the status of its uploads. For example, a
+// driver's license needs both a front and a back image.
+func (r *AccountHolderService) SubmitDocument(ctx context.Context, account_holder_token string, body *requests.AccountHolderUploadDocumentParams, images map[responses.AccountHolderDocumentRequiredDocumentUploadsImageType]*requests.AccountHolderUploadDocumentImageParams, opts ...options.RequestOption) (res *responses.AccountHolderDocument, err error) {
+	document, err := r.UploadDocument(ctx, account_holder_token, body, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range document.RequiredDocumentUploads {
+		upload := &document.RequiredDocumentUploads[i]
+		if upload.Status != responses.AccountHolderDocumentRequiredDocumentUploadsStatusPending {
+			continue
+		}
+		image, ok := images[upload.ImageType]
+		if !ok {
+			return document, fmt.Errorf("lithic: no %s image for document %s", upload.ImageType, document.Token)
+		}
+		if err = r.UploadDocumentImage(ctx, upload, image, opts...); err != nil {
+			return document, err
+		}
+	}
+	return r.GetDocument(ctx, document.Token, &requests.AccountHoldersGetDocumentParams{AccountHolderToken: account_holder_token}, opts...)
+}
@@ -56,3 +56,15 @@ func (r *AccountService) List(ctx context.Context, query *requests.AccountListPa
 	}
 	return res, res.Fire()
 }
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *AccountService) ListStream(ctx context.Context, query *requests.AccountListParams, opts ...options.RequestOption) (<-chan responses.Account, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.Account], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/pagination"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type AggregateBalanceService struct {
+	Options []options.RequestOption
+}
+
+func NewAggregateBalanceService(opts ...options.RequestOption) (r *AggregateBalanceService) {
+	r = &AggregateBalanceService{}
+	r.Options = opts
+	return
+}
+
+// Get the aggregated balance across all end-user accounts by financial account
+// type
+func (r *AggregateBalanceService) List(ctx context.Context, query *requests.AggregateBalanceListParams, opts ...options.RequestOption) (res *responses.AggregateBalancesCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := "aggregate_balances"
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.AggregateBalancesCursorPage{
+		CursorPage: &pagination.CursorPage[responses.AggregateBalance]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *AggregateBalanceService) ListStream(ctx context.Context, query *requests.AggregateBalanceListParams, opts ...options.RequestOption) (<-chan responses.AggregateBalance, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.AggregateBalance], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
@@ -22,7 +22,7 @@ func NewAuthRuleService(opts ...options.RequestOption) (r *AuthRuleService) {
 
 // Creates an authorization rule (Auth Rule) and applies it at the program,
 // account, or card level.
-func (r *AuthRuleService) New(ctx context.Context, body *requests.AuthRuleRequest, opts ...options.RequestOption) (res *responses.AuthRuleCreateResponse, err error) {
+func (r *AuthRuleService) New(ctx context.Context, body *requests.AuthRuleNewParams, opts ...options.RequestOption) (res *responses.AuthRuleCreateResponse, err error) {
 	opts = append(r.Options[:], opts...)
 	path := "auth_rules"
 	err = options.ExecuteNewRequest(ctx, "POST", path, body, &res, opts...)
@@ -64,6 +64,18 @@ func (r *AuthRuleService) List(ctx context.Context, query *requests.AuthRuleList
 	return res, res.Fire()
 }
 
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *AuthRuleService) ListStream(ctx context.Context, query *requests.AuthRuleListParams, opts ...options.RequestOption) (<-chan responses.AuthRule, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.AuthRule], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
+
 // Applies an existing authorization rule (Auth Rule) to an program, account, or
 // card level.
 func (r *AuthRuleService) Apply(ctx context.Context, auth_rule_token string, body *requests.AuthRuleApplyParams, opts ...options.RequestOption) (res *responses.AuthRuleApplyResponse, err error) {
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/pagination"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type BalanceService struct {
+	Options []options.RequestOption
+}
+
+func NewBalanceService(opts ...options.RequestOption) (r *BalanceService) {
+	r = &BalanceService{}
+	r.Options = opts
+	return
+}
+
+// Get the balances for a program or a given end-user account
+func (r *BalanceService) List(ctx context.Context, query *requests.BalanceListParams, opts ...options.RequestOption) (res *responses.BalancesCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := "balances"
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.BalancesCursorPage{
+		CursorPage: &pagination.CursorPage[responses.Balance]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *BalanceService) ListStream(ctx context.Context, query *requests.BalanceListParams, opts ...options.RequestOption) (<-chan responses.Balance, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.Balance], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/pagination"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type BookTransferService struct {
+	Options []options.RequestOption
+}
+
+func NewBookTransferService(opts ...options.RequestOption) (r *BookTransferService) {
+	r = &BookTransferService{}
+	r.Options = opts
+	return
+}
+
+// Book transfer funds between two financial accounts or between a financial
+// account and card.
+func (r *BookTransferService) New(ctx context.Context, body *requests.BookTransferNewParams, opts ...options.RequestOption) (res *responses.BookTransfer, err error) {
+	opts = append(r.Options[:], opts...)
+	path := "book_transfers"
+	err = options.ExecuteNewRequest(ctx, "POST", path, body, &res, opts...)
+	return
+}
+
+// Get book transfer by token.
+func (r *BookTransferService) Get(ctx context.Context, book_transfer_token string, opts ...options.RequestOption) (res *responses.BookTransfer, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("book_transfers/%s", book_transfer_token)
+	err = options.ExecuteNewRequest(ctx, "GET", path, nil, &res, opts...)
+	return
+}
+
+// List book transfers.
+func (r *BookTransferService) List(ctx context.Context, query *requests.BookTransferListParams, opts ...options.RequestOption) (res *responses.BookTransfersCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := "book_transfers"
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.BookTransfersCursorPage{
+		CursorPage: &pagination.CursorPage[responses.BookTransfer]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *BookTransferService) ListStream(ctx context.Context, query *requests.BookTransferListParams, opts ...options.RequestOption) (<-chan responses.BookTransfer, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.BookTransfer], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
+
+// Reverse a book transfer.
+func (r *BookTransferService) Reverse(ctx context.Context, book_transfer_token string, body *requests.BookTransferReverseParams, opts ...options.RequestOption) (res *responses.BookTransfer, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("book_transfers/%s/reverse", book_transfer_token)
+	err = options.ExecuteNewRequest(ctx, "POST", path, body, &res, opts...)
+	return
+}
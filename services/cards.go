@@ -5,9 +5,11 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/url"
 
+	"github.com/lithic-com/lithic-go/fields"
 	"github.com/lithic-com/lithic-go/options"
 	"github.com/lithic-com/lithic-go/pagination"
 	"github.com/lithic-com/lithic-go/requests"
@@ -61,6 +63,9 @@ func (r *CardService) List(ctx context.Context, query *requests.CardListParams,
 	if err != nil {
 		return
 	}
+	if err = query.ValidateTimeRange(cfg.MaxListTimeRange); err != nil {
+		return
+	}
 	res = &responses.CardsPage{
 		Page: &pagination.Page[responses.Card]{
 			Config:  *cfg,
@@ -70,6 +75,18 @@ func (r *CardService) List(ctx context.Context, query *requests.CardListParams,
 	return res, res.Fire()
 }
 
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *CardService) ListStream(ctx context.Context, query *requests.CardListParams, opts ...options.RequestOption) (<-chan responses.Card, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.Card], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
+
 // Handling full card PANs and CVV codes requires that you comply with the Payment
 // Card Industry Data Security Standards (PCI DSS). Some clients choose to reduce
 // their compliance obligations by leveraging our embedded card UI solution
@@ -106,30 +123,24 @@ func (r *CardService) Embed(ctx context.Context, query *requests.CardEmbedParams
 	return
 }
 
+// GetEmbedHTML signs the embed request with the client's API key and returns the
+// HTML document of the embedded card UI. See GetEmbedURL.
 func (r *CardService) GetEmbedHTML(ctx context.Context, body *requests.EmbedRequestParams, opts ...options.RequestOption) (res []byte, err error) {
-	opts = append(r.Options, opts...)
-	buf, err := body.MarshalJSON()
+	opts = append(r.Options[:], opts...)
+	cfg, err := options.NewRequestConfig(ctx, "GET", "embed/card", nil, &res, opts...)
 	if err != nil {
 		return nil, err
 	}
-	cfg, err := options.NewRequestConfig(ctx, "GET", "embed/card", nil, &res, opts...)
+	err = signEmbedRequest(cfg, body)
 	if err != nil {
 		return nil, err
 	}
-	mac := hmac.New(sha256.New, []byte(cfg.APIKey))
-	mac.Write(buf)
-	sign := mac.Sum(nil)
-	err = cfg.Apply(
-		options.WithHeader("Accept", "text/html"),
-		options.WithQuery("hmac", base64.StdEncoding.EncodeToString(sign)),
-		options.WithQuery("embed_request", base64.StdEncoding.EncodeToString(buf)),
-	)
+	err = cfg.Apply(options.WithHeader("Accept", "text/html"))
 	if err != nil {
 		return nil, err
 	}
 	err = cfg.Execute()
 	return
-
 }
 
 // Handling full card PANs and CVV codes requires that you comply with the Payment
@@ -160,27 +171,38 @@ func (r *CardService) GetEmbedHTML(ctx context.Context, body *requests.EmbedRequ
 // the whole iframe) on the server or make an ajax call from your front end code,
 // but **do not ever embed your API key into front end code, as doing so introduces
 // a serious security vulnerability**.
+//
+// GetEmbedURL serializes the embed request, signs it with the client's API key
+// and returns the absolute URL of the embedded card UI.
 func (r *CardService) GetEmbedURL(ctx context.Context, body *requests.EmbedRequestParams, opts ...options.RequestOption) (res *url.URL, err error) {
-	buf, err := body.MarshalJSON()
+	opts = append(r.Options[:], opts...)
+	cfg, err := options.NewRequestConfig(ctx, "GET", "embed/card", nil, &res, opts...)
 	if err != nil {
 		return nil, err
 	}
-	cfg, err := options.NewRequestConfig(ctx, "GET", "embed/card", nil, &res, opts...)
+	err = signEmbedRequest(cfg, body)
 	if err != nil {
 		return nil, err
 	}
+	return cfg.URL()
+}
+
+// signEmbedRequest adds the base64 encoded embed request and its HMAC, computed
+// with the API key of the request, to the query of the request.
+func signEmbedRequest(cfg *options.RequestConfig, body *requests.EmbedRequestParams) error {
+	if cfg.APIKey == "" {
+		return errors.New("an API key is required to sign embed requests")
+	}
+	buf, err := body.MarshalJSON()
+	if err != nil {
+		return err
+	}
 	mac := hmac.New(sha256.New, []byte(cfg.APIKey))
 	mac.Write(buf)
-	sign := mac.Sum(nil)
-	err = cfg.Apply(
-		options.WithQuery("hmac", base64.StdEncoding.EncodeToString(sign)),
+	return cfg.Apply(
+		options.WithQuery("hmac", base64.StdEncoding.EncodeToString(mac.Sum(nil))),
 		options.WithQuery("embed_request", base64.StdEncoding.EncodeToString(buf)),
 	)
-	if err != nil {
-		return nil, err
-	}
-	return cfg.Request.URL, nil
-
 }
 
 // Allow your cardholders to directly add payment cards to the device's digital
@@ -205,3 +227,18 @@ func (r *CardService) Reissue(ctx context.Context, card_token string, body *requ
 	err = options.ExecuteNewRequest(ctx, "POST", path, body, &res, opts...)
 	return
 }
+
+// Get the token of the card with the given PAN. The PAN is redacted from the
+// request that middlewares see, see options.WithRedactedFields.
+func (r *CardService) SearchByPAN(ctx context.Context, pan string, opts ...options.RequestOption) (token string, err error) {
+	opts = append(r.Options[:], opts...)
+	opts = append(opts, options.WithRedactedFields("pan"))
+	path := "cards/search_by_pan"
+	body := &requests.CardSearchByPANParams{Pan: fields.F(pan)}
+	var res *responses.Card
+	err = options.ExecuteNewRequest(ctx, "POST", path, body, &res, opts...)
+	if err != nil {
+		return "", err
+	}
+	return res.Token, nil
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// The number of cards that BulkCreate creates at once by default.
+const DefaultBulkConcurrency = 8
+
+// The delay before the first retry of a card in BulkCreate by default. It
+// doubles with every retry.
+const DefaultBulkRetryDelay = time.Second
+
+// BulkOptions configures BulkCreate.
+type BulkOptions struct {
+	// The number of cards created at once. Defaults to DefaultBulkConcurrency.
+	Concurrency int
+	// The number of times the creation of a card is retried after a transient
+	// failure, such as a server error or a rate limit, in addition to the
+	// retries of the client. Every attempt for a card is sent with the same
+	// Idempotency-Token, so that a retry cannot create a second card.
+	Retries int
+	// The delay before the first retry of a card. Defaults to
+	// DefaultBulkRetryDelay.
+	RetryDelay time.Duration
+	// If Progress is not nil, it is called after each card is created or has
+	// failed. Calls are not concurrent.
+	Progress func(progress BulkProgress)
+}
+
+// BulkProgress counts the cards of a BulkCreate that have been processed.
+type BulkProgress struct {
+	Total   int
+	Created int
+	Failed  int
+}
+
+// Done returns the number of cards that have been processed.
+func (r BulkProgress) Done() int {
+	return r.Created + r.Failed
+}
+
+// CardBulkResult is the outcome of creating a single card with BulkCreate.
+type CardBulkResult struct {
+	// The index of the card in the params given to BulkCreate.
+	Index int
+	// The card that was created, if any.
+	Card *responses.Card
+	// The number of attempts that were made.
+	Attempts int
+	Err      error
+}
+
+// BulkCreate creates a card for each of params with bounded concurrency, and
+// reports the outcome for every card in the same order as params. Cards that
+// fail are reported without stopping the others, and cards that have not been
+// started when ctx is cancelled are reported with the error of ctx.
+func (r *CardService) BulkCreate(ctx context.Context, params []requests.CardNewParams, bulk BulkOptions, opts ...options.RequestOption) (res []CardBulkResult) {
+	concurrency := bulk.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+	res = make([]CardBulkResult, len(params))
+	progress := BulkProgress{Total: len(params)}
+	mu := sync.Mutex{}
+	report := func(result *CardBulkResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		if result.Err != nil {
+			progress.Failed += 1
+		} else {
+			progress.Created += 1
+		}
+		if bulk.Progress != nil {
+			bulk.Progress(progress)
+		}
+	}
+
+	// Service methods append to Options, which must not have spare capacity
+	// when they are called concurrently.
+	service := &CardService{Options: r.Options[:len(r.Options):len(r.Options)]}
+	sem := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+	for i := range params {
+		res[i].Index = i
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			res[i].Err = ctx.Err()
+			report(&res[i])
+			continue
+		}
+		wg.Add(1)
+		go func(result *CardBulkResult, body *requests.CardNewParams) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			result.Card, result.Attempts, result.Err = service.createWithRetries(ctx, body, bulk, opts)
+			report(result)
+		}(&res[i], &params[i])
+	}
+	wg.Wait()
+	return res
+}
+
+func (r *CardService) createWithRetries(ctx context.Context, body *requests.CardNewParams, bulk BulkOptions, opts []options.RequestOption) (card *responses.Card, attempts int, err error) {
+	delay := bulk.RetryDelay
+	if delay <= 0 {
+		delay = DefaultBulkRetryDelay
+	}
+	opts = append(opts[:len(opts):len(opts)], options.WithIdempotencyKey("stainless-go-"+uuid.New().String()))
+	for {
+		attempts += 1
+		card, err = r.New(ctx, body, opts...)
+		if err == nil || attempts > bulk.Retries || !options.IsTransient(err) {
+			return card, attempts, err
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, attempts, err
+		}
+		delay *= 2
+	}
+}
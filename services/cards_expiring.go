@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// ErrNotReissuable is reported by ReissueAll for cards that are not physical, as
+// only physical cards can be reissued.
+var ErrNotReissuable = errors.New("only physical cards can be reissued")
+
+// ExpiringWithin lists the cards that have not been closed and expire within
+// window from now, in the order they are listed by the API. Cards that have
+// already expired are not included. query may be used to narrow the cards that
+// are considered, for example to a single account.
+func (r *CardService) ExpiringWithin(ctx context.Context, window time.Duration, query *requests.CardListParams, opts ...options.RequestOption) (res []responses.Card, err error) {
+	page, err := r.List(ctx, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	iter := page.Iterator()
+	for iter.Next(ctx) {
+		card := iter.Current()
+		if card.State == responses.CardStateClosed {
+			continue
+		}
+		expires, ok := card.ExpiresAt()
+		if ok && expires.After(now) && !expires.After(now.Add(window)) {
+			res = append(res, *card)
+		}
+	}
+	return res, iter.Err()
+}
+
+// CardReissueResult is the outcome of reissuing a single card with ReissueAll.
+type CardReissueResult struct {
+	Card responses.Card
+	// The card returned by the API, if it was reissued.
+	Reissued *responses.Card
+	Err      error
+}
+
+// ReissueAll reissues each of the given cards in turn, for example those
+// returned by ExpiringWithin, and reports the outcome for every card. Cards that
+// are not physical are reported with ErrNotReissuable. params is called for
+// every card to provide overrides such as an updated shipping address, and may be
+// nil or return nil to reissue the card as is.
+func (r *CardService) ReissueAll(ctx context.Context, cards []responses.Card, params func(card responses.Card) *requests.CardReissueParams, opts ...options.RequestOption) (res []CardReissueResult) {
+	res = make([]CardReissueResult, len(cards))
+	for i, card := range cards {
+		res[i].Card = card
+		if card.Type != responses.CardTypePhysical {
+			res[i].Err = ErrNotReissuable
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			res[i].Err = err
+			continue
+		}
+		body := &requests.CardReissueParams{}
+		if params != nil {
+			if p := params(card); p != nil {
+				body = p
+			}
+		}
+		res[i].Reissued, res[i].Err = r.Reissue(ctx, card.Token, body, opts...)
+	}
+	return res
+}
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// The number of cards that ImportCSV creates at once by default.
+const DefaultImportConcurrency = 4
+
+// ColumnMapping maps the columns of a CSV file, by header name, to the fields of
+// CardNewParams. Fields whose column is left empty are not set.
+type ColumnMapping struct {
+	Type                string
+	AccountToken        string
+	CardProgramToken    string
+	DigitalCardArtToken string
+	ExpMonth            string
+	ExpYear             string
+	FundingToken        string
+	Memo                string
+	ProductID           string
+	SpendLimit          string
+	SpendLimitDuration  string
+	State               string
+
+	// The number of cards created at once. Defaults to DefaultImportConcurrency.
+	Concurrency int
+}
+
+// CardImportStatus is the outcome of importing a single row.
+type CardImportStatus string
+
+const (
+	// The row was valid and the card was created.
+	CardImportStatusCreated CardImportStatus = "CREATED"
+	// The row failed validation and no card was created.
+	CardImportStatusInvalid CardImportStatus = "INVALID"
+	// The row was valid but the API failed to create the card.
+	CardImportStatusFailed CardImportStatus = "FAILED"
+)
+
+// CardImportRow is the result of importing a single row of the CSV file.
+type CardImportRow struct {
+	// The line of the row in the CSV file, starting at 2 for the first row after
+	// the header.
+	Line   int
+	Record []string
+	Status CardImportStatus
+	// The card that was created, if any.
+	Card *responses.Card
+	Err  error
+}
+
+// CardImportResult is the result of ImportCSV, with one row per row of the
+// imported file.
+type CardImportResult struct {
+	Header []string
+	Rows   []CardImportRow
+}
+
+// Count returns the number of rows with the given status.
+func (r *CardImportResult) Count(status CardImportStatus) int {
+	count := 0
+	for _, row := range r.Rows {
+		if row.Status == status {
+			count += 1
+		}
+	}
+	return count
+}
+
+// WriteCSV writes the imported file back to w with the `import_status`,
+// `card_token` and `import_error` columns appended to every row.
+func (r *CardImportResult) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	header := append(append([]string{}, r.Header...), "import_status", "card_token", "import_error")
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, row := range r.Rows {
+		token, message := "", ""
+		if row.Card != nil {
+			token = row.Card.Token
+		}
+		if row.Err != nil {
+			message = row.Err.Error()
+		}
+		record := append(append([]string{}, row.Record...), string(row.Status), token, message)
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ImportCSV creates a card for every row of a CSV file whose first row is a
+// header. Every row is validated before any card is created, and rows that fail
+// validation are reported without being sent. Valid rows are created with
+// bounded concurrency. An error is only returned if the file itself cannot be
+// read; the outcome of each row is reported in the result.
+func (r *CardService) ImportCSV(ctx context.Context, in io.Reader, mapping ColumnMapping, opts ...options.RequestOption) (res *CardImportResult, err error) {
+	reader := csv.NewReader(in)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("lithic: reading card import CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, errors.New("lithic: card import CSV has no header")
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	if mapping.Type == "" {
+		return nil, errors.New("lithic: card import mapping has no type column")
+	}
+	for _, name := range mapping.columns() {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("lithic: card import CSV has no %q column", name)
+		}
+	}
+
+	res = &CardImportResult{Header: records[0], Rows: make([]CardImportRow, len(records)-1)}
+	params := make([]*requests.CardNewParams, len(records)-1)
+	for i, record := range records[1:] {
+		res.Rows[i] = CardImportRow{Line: i + 2, Record: record}
+		params[i], err = mapping.params(record, columns)
+		if err != nil {
+			res.Rows[i].Status = CardImportStatusInvalid
+			res.Rows[i].Err = err
+		}
+	}
+
+	concurrency := mapping.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultImportConcurrency
+	}
+	var valid []requests.CardNewParams
+	var rows []*CardImportRow
+	for i := range res.Rows {
+		if params[i] != nil {
+			valid = append(valid, *params[i])
+			rows = append(rows, &res.Rows[i])
+		}
+	}
+	for _, result := range r.BulkCreate(ctx, valid, BulkOptions{Concurrency: concurrency}, opts...) {
+		row := rows[result.Index]
+		if result.Err != nil {
+			row.Status = CardImportStatusFailed
+			row.Err = result.Err
+			continue
+		}
+		row.Status = CardImportStatusCreated
+		row.Card = result.Card
+	}
+	return res, nil
+}
+
+func (m ColumnMapping) columns() []string {
+	names := []string{}
+	for _, name := range []string{m.Type, m.AccountToken, m.CardProgramToken, m.DigitalCardArtToken, m.ExpMonth, m.ExpYear, m.FundingToken, m.Memo, m.ProductID, m.SpendLimit, m.SpendLimitDuration, m.State} {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (m ColumnMapping) params(record []string, columns map[string]int) (*requests.CardNewParams, error) {
+	value := func(column string) string {
+		if column == "" {
+			return ""
+		}
+		if i := columns[column]; i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	params := &requests.CardNewParams{}
+
+	switch cardType := requests.CardNewParamsType(strings.ToUpper(value(m.Type))); cardType {
+	case requests.CardNewParamsTypeVirtual, requests.CardNewParamsTypePhysical, requests.CardNewParamsTypeMerchantLocked, requests.CardNewParamsTypeSingleUse:
+		params.Type = fields.F(cardType)
+	case "":
+		return nil, fmt.Errorf("%s is required", m.Type)
+	default:
+		return nil, fmt.Errorf("%s %q is not a card type", m.Type, value(m.Type))
+	}
+
+	for _, column := range []struct {
+		name  string
+		field *fields.Field[string]
+	}{
+		{m.AccountToken, &params.AccountToken},
+		{m.CardProgramToken, &params.CardProgramToken},
+		{m.DigitalCardArtToken, &params.DigitalCardArtToken},
+		{m.FundingToken, &params.FundingToken},
+		{m.Memo, &params.Memo},
+		{m.ProductID, &params.ProductID},
+	} {
+		if v := value(column.name); v != "" {
+			*column.field = fields.F(v)
+		}
+	}
+
+	if v := value(m.ExpMonth); v != "" {
+		if month, err := strconv.Atoi(v); err != nil || len(v) != 2 || month < 1 || month > 12 {
+			return nil, fmt.Errorf("%s %q is not a two digit month", m.ExpMonth, v)
+		}
+		params.ExpMonth = fields.F(v)
+	}
+	if v := value(m.ExpYear); v != "" {
+		if _, err := strconv.Atoi(v); err != nil || len(v) != 4 {
+			return nil, fmt.Errorf("%s %q is not a four digit year", m.ExpYear, v)
+		}
+		params.ExpYear = fields.F(v)
+	}
+	if v := value(m.SpendLimit); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("%s %q is not an amount in cents", m.SpendLimit, v)
+		}
+		params.SpendLimit = fields.F(limit)
+	}
+	if v := value(m.SpendLimitDuration); v != "" {
+		switch duration := requests.SpendLimitDuration(strings.ToUpper(v)); duration {
+		case requests.SpendLimitDurationAnnually, requests.SpendLimitDurationForever, requests.SpendLimitDurationMonthly, requests.SpendLimitDurationTransaction:
+			params.SpendLimitDuration = fields.F(duration)
+		default:
+			return nil, fmt.Errorf("%s %q is not a spend limit duration", m.SpendLimitDuration, v)
+		}
+	}
+	if v := value(m.State); v != "" {
+		switch state := requests.CardNewParamsState(strings.ToUpper(v)); state {
+		case requests.CardNewParamsStateOpen, requests.CardNewParamsStatePaused:
+			params.State = fields.F(state)
+		default:
+			return nil, fmt.Errorf("%s %q is not a card state", m.State, v)
+		}
+	}
+	return params, nil
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// ErrInvalidProvisionParams is returned, wrapped, by ProvisionApplePay and
+// ProvisionGooglePay when a field that the wallet requires is missing or
+// malformed. The request is not sent.
+var ErrInvalidProvisionParams = errors.New("invalid provision params")
+
+// ProvisionApplePay provisions a card into Apple Pay, with the certificate,
+// nonce and nonce signature provided by the device's wallet, and decodes the
+// payload that is passed to PassKit. The certificate is Apple's public leaf
+// certificate, base64 encoded in PEM format with the headers and trailers
+// omitted, and the nonce and nonce signature are base64 encoded.
+func (r *CardService) ProvisionApplePay(ctx context.Context, card_token string, certificate string, nonce string, nonceSignature string, opts ...options.RequestOption) (res *responses.ApplePayProvisioningPayload, err error) {
+	if card_token == "" {
+		return nil, fmt.Errorf("%w: card_token is required", ErrInvalidProvisionParams)
+	}
+	for _, field := range []struct{ name, value string }{{"certificate", certificate}, {"nonce", nonce}, {"nonce_signature", nonceSignature}} {
+		if field.value == "" {
+			return nil, fmt.Errorf("%w: %s is required for Apple Pay", ErrInvalidProvisionParams, field.name)
+		}
+		if _, err := base64.StdEncoding.DecodeString(field.value); err != nil {
+			return nil, fmt.Errorf("%w: %s is not base64 encoded", ErrInvalidProvisionParams, field.name)
+		}
+	}
+	provision, err := r.Provision(ctx, card_token, &requests.CardProvisionParams{
+		DigitalWallet:  fields.F(requests.CardProvisionParamsDigitalWalletApplePay),
+		Certificate:    fields.F(certificate),
+		Nonce:          fields.F(nonce),
+		NonceSignature: fields.F(nonceSignature),
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return provision.ApplePay()
+}
+
+// ProvisionGooglePay provisions a card into Google Pay and returns the Opaque
+// Payment Card that is passed to the Google Pay Push Provisioning API. Google
+// Pay does not take any of the Apple Pay fields of CardProvisionParams.
+func (r *CardService) ProvisionGooglePay(ctx context.Context, card_token string, opts ...options.RequestOption) (res *responses.GooglePayProvisioningPayload, err error) {
+	if card_token == "" {
+		return nil, fmt.Errorf("%w: card_token is required", ErrInvalidProvisionParams)
+	}
+	provision, err := r.Provision(ctx, card_token, &requests.CardProvisionParams{
+		DigitalWallet: fields.F(requests.CardProvisionParamsDigitalWalletGooglePay),
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return provision.GooglePay()
+}
@@ -1,12 +1,9 @@
 package services
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
-	"mime/multipart"
-	"net/http"
 
 	"github.com/lithic-com/lithic-go/options"
 	"github.com/lithic-com/lithic-go/pagination"
@@ -65,6 +62,18 @@ func (r *DisputeService) List(ctx context.Context, query *requests.DisputeListPa
 	return res, res.Fire()
 }
 
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *DisputeService) ListStream(ctx context.Context, query *requests.DisputeListParams, opts ...options.RequestOption) (<-chan responses.Dispute, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.Dispute], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
+
 // Withdraw dispute.
 func (r *DisputeService) Delete(ctx context.Context, dispute_token string, opts ...options.RequestOption) (res *responses.Dispute, err error) {
 	opts = append(r.Options[:], opts...)
@@ -119,30 +128,26 @@ func (r *DisputeService) GetEvidence(ctx context.Context, evidence_token string,
 	return
 }
 
-func (r *DisputeService) UploadEvidence(ctx context.Context, disputeToken string, file io.Reader, opts ...options.RequestOption) (err error) {
-	payload, err := r.InitiateEvidenceUpload(ctx, disputeToken, opts...)
-	if err != nil {
-		return err
-	}
-
-	body := bytes.NewBuffer(nil)
-	writer := multipart.NewWriter(body)
-	defer writer.Close()
-	name := "anonymous_file"
-	if nameable, ok := file.(interface{ Name() string }); ok {
-		name = nameable.Name()
-	}
-	part, err := writer.CreateFormFile("file", name)
-	if err != nil {
-		return err
-	}
-	io.Copy(part, file)
+// UploadEvidence initiates an evidence upload for the dispute and uploads the
+// contents of file, which must be a `jpg`, `png` or `pdf` file, to the returned
+// upload URL. The upload URL is pre-signed, so the API key is not sent along
+// with the file. Use UploadEvidenceWithResponse to get the token of the
+// evidence.
+func (r *DisputeService) UploadEvidence(ctx context.Context, dispute_token string, file io.Reader, opts ...options.RequestOption) (err error) {
+	_, err = r.UploadEvidenceWithResponse(ctx, dispute_token, file, opts...)
+	return
+}
 
-	req, err := http.NewRequestWithContext(ctx, "PUT", payload.UploadURL, body)
+// UploadEvidenceWithResponse is like UploadEvidence, but also returns the
+// initiated evidence upload.
+func (r *DisputeService) UploadEvidenceWithResponse(ctx context.Context, dispute_token string, file io.Reader, opts ...options.RequestOption) (res *responses.DisputeInitiateEvidenceUploadResponse, err error) {
+	res, err = r.InitiateEvidenceUpload(ctx, dispute_token, opts...)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = http.DefaultClient.Do(req)
+	opts = append(r.Options[:], opts...)
+	opts = append([]options.RequestOption{options.WithHeader("Accept", "")}, opts...)
+	opts = append(opts, options.WithHeaderDel("Authorization"), options.WithHeaderDel("Idempotency-Token"))
+	err = options.ExecuteNewRequest(ctx, "PUT", res.UploadURL, file, nil, opts...)
 	return
-
 }
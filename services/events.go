@@ -46,3 +46,32 @@ func (r *EventService) List(ctx context.Context, query *requests.EventListParams
 	}
 	return res, res.Fire()
 }
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *EventService) ListStream(ctx context.Context, query *requests.EventListParams, opts ...options.RequestOption) (<-chan responses.Event, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.Event], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
+
+// List all the message attempts for a given event.
+func (r *EventService) ListAttempts(ctx context.Context, event_token string, query *requests.EventListAttemptsParams, opts ...options.RequestOption) (res *responses.MessageAttemptsCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := fmt.Sprintf("events/%s/attempts", event_token)
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.MessageAttemptsCursorPage{
+		CursorPage: &pagination.CursorPage[responses.MessageAttempt]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
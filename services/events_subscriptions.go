@@ -14,6 +14,10 @@ type EventsSubscriptionService struct {
 	Options []options.RequestOption
 }
 
+// EventSubscriptionService is the service that manages webhook subscriptions,
+// available on the client as Events.Subscriptions.
+type EventSubscriptionService = EventsSubscriptionService
+
 func NewEventsSubscriptionService(opts ...options.RequestOption) (r *EventsSubscriptionService) {
 	r = &EventsSubscriptionService{}
 	r.Options = opts
@@ -61,6 +65,18 @@ func (r *EventsSubscriptionService) List(ctx context.Context, query *requests.Su
 	return res, res.Fire()
 }
 
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *EventsSubscriptionService) ListStream(ctx context.Context, query *requests.SubscriptionListParams, opts ...options.RequestOption) (<-chan responses.EventSubscription, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.EventSubscription], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
+
 // Delete an event subscription.
 func (r *EventsSubscriptionService) Delete(ctx context.Context, event_subscription_token string, opts ...options.RequestOption) (err error) {
 	opts = append(r.Options[:], opts...)
@@ -106,3 +122,20 @@ func (r *EventsSubscriptionService) RotateSecret(ctx context.Context, event_subs
 	err = options.ExecuteNewRequest(ctx, "POST", path, nil, nil, opts...)
 	return
 }
+
+// List all the message attempts for a given event subscription.
+func (r *EventsSubscriptionService) ListAttempts(ctx context.Context, event_subscription_token string, query *requests.SubscriptionListAttemptsParams, opts ...options.RequestOption) (res *responses.MessageAttemptsCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := fmt.Sprintf("event_subscriptions/%s/attempts", event_subscription_token)
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.MessageAttemptsCursorPage{
+		CursorPage: &pagination.CursorPage[responses.MessageAttempt]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/pagination"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type ExternalBankAccountService struct {
+	Options       []options.RequestOption
+	MicroDeposits *ExternalBankAccountMicroDepositService
+}
+
+func NewExternalBankAccountService(opts ...options.RequestOption) (r *ExternalBankAccountService) {
+	r = &ExternalBankAccountService{}
+	r.Options = opts
+	r.MicroDeposits = NewExternalBankAccountMicroDepositService(opts...)
+	return
+}
+
+// Creates an external bank account within a program or Lithic account. Accounts
+// created with the `MICRO_DEPOSIT` verification method stay `PENDING` until
+// their micro-deposits are confirmed with MicroDeposits.New.
+func (r *ExternalBankAccountService) New(ctx context.Context, body *requests.ExternalBankAccountNewParams, opts ...options.RequestOption) (res *responses.ExternalBankAccount, err error) {
+	opts = append(r.Options[:], opts...)
+	path := "external_bank_accounts"
+	err = options.ExecuteNewRequest(ctx, "POST", path, body, &res, opts...)
+	return
+}
+
+// Get the external bank account by token.
+func (r *ExternalBankAccountService) Get(ctx context.Context, external_bank_account_token string, opts ...options.RequestOption) (res *responses.ExternalBankAccount, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("external_bank_accounts/%s", external_bank_account_token)
+	err = options.ExecuteNewRequest(ctx, "GET", path, nil, &res, opts...)
+	return
+}
+
+// Update the external bank account by token.
+func (r *ExternalBankAccountService) Update(ctx context.Context, external_bank_account_token string, body *requests.ExternalBankAccountUpdateParams, opts ...options.RequestOption) (res *responses.ExternalBankAccount, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("external_bank_accounts/%s", external_bank_account_token)
+	err = options.ExecuteNewRequest(ctx, "PATCH", path, body, &res, opts...)
+	return
+}
+
+// List all the external bank accounts for the provided search criteria.
+func (r *ExternalBankAccountService) List(ctx context.Context, query *requests.ExternalBankAccountListParams, opts ...options.RequestOption) (res *responses.ExternalBankAccountsCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := "external_bank_accounts"
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.ExternalBankAccountsCursorPage{
+		CursorPage: &pagination.CursorPage[responses.ExternalBankAccount]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *ExternalBankAccountService) ListStream(ctx context.Context, query *requests.ExternalBankAccountListParams, opts ...options.RequestOption) (<-chan responses.ExternalBankAccount, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.ExternalBankAccount], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
+
+// Send new micro-deposits to an external bank account whose verification
+// failed, which resets its verification state to `PENDING`.
+func (r *ExternalBankAccountService) RetryMicroDeposits(ctx context.Context, external_bank_account_token string, body *requests.ExternalBankAccountRetryMicroDepositsParams, opts ...options.RequestOption) (res *responses.ExternalBankAccount, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("external_bank_accounts/%s/retry_micro_deposits", external_bank_account_token)
+	err = options.ExecuteNewRequest(ctx, "POST", path, body, &res, opts...)
+	return
+}
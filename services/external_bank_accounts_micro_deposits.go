@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type ExternalBankAccountMicroDepositService struct {
+	Options []options.RequestOption
+}
+
+func NewExternalBankAccountMicroDepositService(opts ...options.RequestOption) (r *ExternalBankAccountMicroDepositService) {
+	r = &ExternalBankAccountMicroDepositService{}
+	r.Options = opts
+	return
+}
+
+// Verify an external bank account by confirming the amounts of the two
+// micro-deposits sent to it. The account is `ENABLED` if the amounts match, and
+// `FAILED_VERIFICATION` once too many attempts have been made, in which case
+// the micro-deposits can be sent again with
+// ExternalBankAccountService.RetryMicroDeposits.
+func (r *ExternalBankAccountMicroDepositService) New(ctx context.Context, external_bank_account_token string, body *requests.ExternalBankAccountMicroDepositNewParams, opts ...options.RequestOption) (res *responses.ExternalBankAccount, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("external_bank_accounts/%s/micro_deposits", external_bank_account_token)
+	err = options.ExecuteNewRequest(ctx, "POST", path, body, &res, opts...)
+	return
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/pagination"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type FinancialAccountService struct {
+	Options               []options.RequestOption
+	Balances              *FinancialAccountsBalanceService
+	FinancialTransactions *FinancialAccountsFinancialTransactionService
+	Statements            *FinancialAccountsStatementService
+}
+
+func NewFinancialAccountService(opts ...options.RequestOption) (r *FinancialAccountService) {
+	r = &FinancialAccountService{}
+	r.Options = opts
+	r.Balances = NewFinancialAccountsBalanceService(opts...)
+	r.FinancialTransactions = NewFinancialAccountsFinancialTransactionService(opts...)
+	r.Statements = NewFinancialAccountsStatementService(opts...)
+	return
+}
+
+// Retrieve information on your financial accounts including routing and account
+// number.
+func (r *FinancialAccountService) List(ctx context.Context, query *requests.FinancialAccountListParams, opts ...options.RequestOption) (res *responses.FinancialAccountsCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := "financial_accounts"
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.FinancialAccountsCursorPage{
+		CursorPage: &pagination.CursorPage[responses.FinancialAccount]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *FinancialAccountService) ListStream(ctx context.Context, query *requests.FinancialAccountListParams, opts ...options.RequestOption) (<-chan responses.FinancialAccount, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.FinancialAccount], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/pagination"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type FinancialAccountsBalanceService struct {
+	Options []options.RequestOption
+}
+
+func NewFinancialAccountsBalanceService(opts ...options.RequestOption) (r *FinancialAccountsBalanceService) {
+	r = &FinancialAccountsBalanceService{}
+	r.Options = opts
+	return
+}
+
+// Get the balances for a given financial account.
+func (r *FinancialAccountsBalanceService) List(ctx context.Context, financial_account_token string, query *requests.FinancialAccountBalanceListParams, opts ...options.RequestOption) (res *responses.BalancesCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := fmt.Sprintf("financial_accounts/%s/balances", financial_account_token)
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.BalancesCursorPage{
+		CursorPage: &pagination.CursorPage[responses.Balance]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *FinancialAccountsBalanceService) ListStream(ctx context.Context, financial_account_token string, query *requests.FinancialAccountBalanceListParams, opts ...options.RequestOption) (<-chan responses.Balance, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.Balance], error) {
+		page, err := r.List(ctx, financial_account_token, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/pagination"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type FinancialAccountsFinancialTransactionService struct {
+	Options []options.RequestOption
+}
+
+func NewFinancialAccountsFinancialTransactionService(opts ...options.RequestOption) (r *FinancialAccountsFinancialTransactionService) {
+	r = &FinancialAccountsFinancialTransactionService{}
+	r.Options = opts
+	return
+}
+
+// Get the financial transaction for the provided token.
+func (r *FinancialAccountsFinancialTransactionService) Get(ctx context.Context, financial_account_token string, financial_transaction_token string, opts ...options.RequestOption) (res *responses.FinancialTransaction, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("financial_accounts/%s/financial_transactions/%s", financial_account_token, financial_transaction_token)
+	err = options.ExecuteNewRequest(ctx, "GET", path, nil, &res, opts...)
+	return
+}
+
+// List the financial transactions for a given financial account.
+func (r *FinancialAccountsFinancialTransactionService) List(ctx context.Context, financial_account_token string, query *requests.FinancialTransactionListParams, opts ...options.RequestOption) (res *responses.FinancialTransactionsCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := fmt.Sprintf("financial_accounts/%s/financial_transactions", financial_account_token)
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.FinancialTransactionsCursorPage{
+		CursorPage: &pagination.CursorPage[responses.FinancialTransaction]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *FinancialAccountsFinancialTransactionService) ListStream(ctx context.Context, financial_account_token string, query *requests.FinancialTransactionListParams, opts ...options.RequestOption) (<-chan responses.FinancialTransaction, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.FinancialTransaction], error) {
+		page, err := r.List(ctx, financial_account_token, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/pagination"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type FinancialAccountsStatementService struct {
+	Options   []options.RequestOption
+	LineItems *FinancialAccountsStatementsLineItemService
+}
+
+func NewFinancialAccountsStatementService(opts ...options.RequestOption) (r *FinancialAccountsStatementService) {
+	r = &FinancialAccountsStatementService{}
+	r.Options = opts
+	r.LineItems = NewFinancialAccountsStatementsLineItemService(opts...)
+	return
+}
+
+// Get a specific statement for a given financial account.
+func (r *FinancialAccountsStatementService) Get(ctx context.Context, financial_account_token string, statement_token string, opts ...options.RequestOption) (res *responses.Statement, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("financial_accounts/%s/statements/%s", financial_account_token, statement_token)
+	err = options.ExecuteNewRequest(ctx, "GET", path, nil, &res, opts...)
+	return
+}
+
+// List the statements for a given financial account.
+func (r *FinancialAccountsStatementService) List(ctx context.Context, financial_account_token string, query *requests.StatementListParams, opts ...options.RequestOption) (res *responses.StatementsCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := fmt.Sprintf("financial_accounts/%s/statements", financial_account_token)
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.StatementsCursorPage{
+		CursorPage: &pagination.CursorPage[responses.Statement]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *FinancialAccountsStatementService) ListStream(ctx context.Context, financial_account_token string, query *requests.StatementListParams, opts ...options.RequestOption) (<-chan responses.Statement, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.Statement], error) {
+		page, err := r.List(ctx, financial_account_token, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
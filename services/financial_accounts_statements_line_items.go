@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/pagination"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type FinancialAccountsStatementsLineItemService struct {
+	Options []options.RequestOption
+}
+
+func NewFinancialAccountsStatementsLineItemService(opts ...options.RequestOption) (r *FinancialAccountsStatementsLineItemService) {
+	r = &FinancialAccountsStatementsLineItemService{}
+	r.Options = opts
+	return
+}
+
+// List the line items for a given statement within a given financial account.
+func (r *FinancialAccountsStatementsLineItemService) List(ctx context.Context, financial_account_token string, statement_token string, query *requests.StatementLineItemListParams, opts ...options.RequestOption) (res *responses.StatementLineItemsCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := fmt.Sprintf("financial_accounts/%s/statements/%s/line_items", financial_account_token, statement_token)
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.StatementLineItemsCursorPage{
+		CursorPage: &pagination.CursorPage[responses.StatementLineItem]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *FinancialAccountsStatementsLineItemService) ListStream(ctx context.Context, financial_account_token string, statement_token string, query *requests.StatementLineItemListParams, opts ...options.RequestOption) (<-chan responses.StatementLineItem, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.StatementLineItem], error) {
+		page, err := r.List(ctx, financial_account_token, statement_token, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
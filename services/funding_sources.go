@@ -57,6 +57,18 @@ func (r *FundingSourceService) List(ctx context.Context, query *requests.Funding
 	return res, res.Fire()
 }
 
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *FundingSourceService) ListStream(ctx context.Context, query *requests.FundingSourceListParams, opts ...options.RequestOption) (<-chan responses.FundingSource, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.FundingSource], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
+
 // Verify a bank account as a funding source by providing received micro-deposit
 // amounts.
 func (r *FundingSourceService) Verify(ctx context.Context, funding_source_token string, body *requests.FundingSourceVerifyParams, opts ...options.RequestOption) (res *responses.FundingSource, err error) {
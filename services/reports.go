@@ -0,0 +1,17 @@
+package services
+
+import (
+	"github.com/lithic-com/lithic-go/options"
+)
+
+type ReportService struct {
+	Options    []options.RequestOption
+	Settlement *ReportSettlementService
+}
+
+func NewReportService(opts ...options.RequestOption) (r *ReportService) {
+	r = &ReportService{}
+	r.Options = opts
+	r.Settlement = NewReportSettlementService(opts...)
+	return
+}
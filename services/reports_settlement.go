@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/pagination"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type ReportSettlementService struct {
+	Options []options.RequestOption
+}
+
+func NewReportSettlementService(opts ...options.RequestOption) (r *ReportSettlementService) {
+	r = &ReportSettlementService{}
+	r.Options = opts
+	return
+}
+
+// Get the settlement report summary for a specified report date. Only the
+// date of report_date is used.
+func (r *ReportSettlementService) Summary(ctx context.Context, report_date time.Time, opts ...options.RequestOption) (res *responses.SettlementReport, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("reports/settlement/summary/%s", report_date.Format("2006-01-02"))
+	err = options.ExecuteNewRequest(ctx, "GET", path, nil, &res, opts...)
+	return
+}
+
+// List details of the settlement report for a specified report date. Only the
+// date of report_date is used.
+func (r *ReportSettlementService) ListDetails(ctx context.Context, report_date time.Time, query *requests.ReportSettlementListDetailsParams, opts ...options.RequestOption) (res *responses.SettlementDetailsCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := fmt.Sprintf("reports/settlement/details/%s", report_date.Format("2006-01-02"))
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.SettlementDetailsCursorPage{
+		CursorPage: &pagination.CursorPage[responses.SettlementDetail]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
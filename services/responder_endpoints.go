@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// DefaultResponderEndpointPollInterval is the interval at which
+// WaitUntilEnrolled checks the status of an endpoint by default.
+const DefaultResponderEndpointPollInterval = 2 * time.Second
+
+type ResponderEndpointService struct {
+	Options []options.RequestOption
+}
+
+func NewResponderEndpointService(opts ...options.RequestOption) (r *ResponderEndpointService) {
+	r = &ResponderEndpointService{}
+	r.Options = opts
+	return
+}
+
+// Enroll a responder endpoint, such as an ASA or 3DS decisioning URL. It
+// replaces the endpoint of the same type that was enrolled before, if any.
+func (r *ResponderEndpointService) New(ctx context.Context, body *requests.ResponderEndpointNewParams, opts ...options.RequestOption) (res *responses.ResponderEndpointNewResponse, err error) {
+	opts = append(r.Options[:], opts...)
+	path := "responder_endpoints"
+	err = options.ExecuteNewRequest(ctx, "POST", path, body, &res, opts...)
+	return
+}
+
+// Disenroll a responder endpoint.
+func (r *ResponderEndpointService) Delete(ctx context.Context, query *requests.ResponderEndpointDeleteParams, opts ...options.RequestOption) (err error) {
+	opts = append(r.Options[:], opts...)
+	opts = append([]options.RequestOption{options.WithHeader("Accept", "")}, opts...)
+	path := "responder_endpoints"
+	err = options.ExecuteNewRequest(ctx, "DELETE", path, query, nil, opts...)
+	return
+}
+
+// Check the status of a responder endpoint.
+func (r *ResponderEndpointService) CheckStatus(ctx context.Context, query *requests.ResponderEndpointCheckStatusParams, opts ...options.RequestOption) (res *responses.ResponderEndpointStatus, err error) {
+	opts = append(r.Options[:], opts...)
+	path := "responder_endpoints"
+	err = options.ExecuteNewRequest(ctx, "GET", path, query, &res, opts...)
+	return
+}
+
+// WaitUntilEnrolled checks the status of the responder endpoint of the given
+// type every interval, DefaultResponderEndpointPollInterval if it is 0, until
+// it is enrolled with url, or with any URL if url is empty. It returns the
+// last status along with the context's error if ctx is done first, or with the
+// error of a status check that fails.
+func (r *ResponderEndpointService) WaitUntilEnrolled(ctx context.Context, endpointType requests.ResponderEndpointType, url string, interval time.Duration, opts ...options.RequestOption) (res *responses.ResponderEndpointStatus, err error) {
+	if interval <= 0 {
+		interval = DefaultResponderEndpointPollInterval
+	}
+	query := &requests.ResponderEndpointCheckStatusParams{Type: fields.F(endpointType)}
+	for {
+		status, err := r.CheckStatus(ctx, query, opts...)
+		if err != nil {
+			return res, err
+		}
+		res = status
+		if res.Enrolled && (url == "" || res.URL == url) {
+			return res, nil
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return res, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type ThreeDSService struct {
+	Options []options.RequestOption
+}
+
+func NewThreeDSService(opts ...options.RequestOption) (r *ThreeDSService) {
+	r = &ThreeDSService{}
+	r.Options = opts
+	return
+}
+
+// Get a 3DS authentication by its token.
+func (r *ThreeDSService) GetAuthentication(ctx context.Context, three_ds_authentication_token string, opts ...options.RequestOption) (res *responses.ThreeDSAuthentication, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("three_ds_authentication/%s", three_ds_authentication_token)
+	err = options.ExecuteNewRequest(ctx, "GET", path, nil, &res, opts...)
+	return
+}
+
+// Submit the cardholder's response to a 3DS challenge, once the cardholder has
+// approved or declined it, for example in the program's app.
+func (r *ThreeDSService) ChallengeResponse(ctx context.Context, body *requests.ThreeDSChallengeResponseParams, opts ...options.RequestOption) (err error) {
+	opts = append(r.Options[:], opts...)
+	opts = append([]options.RequestOption{options.WithHeader("Accept", "")}, opts...)
+	path := "three_ds_decisioning/challenge_response"
+	err = options.ExecuteNewRequest(ctx, "POST", path, body, nil, opts...)
+	return
+}
+
+// Retrieve the 3DS Decisioning HMAC secret key. If one does not exist for your
+// program yet, calling this endpoint will create one for you. The headers of the
+// 3DS challenge requests will contain a signature which you can use to verify
+// that they originate from Lithic.
+func (r *ThreeDSService) GetDecisioningSecret(ctx context.Context, opts ...options.RequestOption) (res *responses.ThreeDSDecisioningSecret, err error) {
+	opts = append(r.Options[:], opts...)
+	path := "three_ds_decisioning/secret"
+	err = options.ExecuteNewRequest(ctx, "GET", path, nil, &res, opts...)
+	return
+}
+
+// Generate a new 3DS Decisioning HMAC secret key. The old secret key will be
+// deactivated 24 hours after a successful request to this endpoint.
+func (r *ThreeDSService) RotateDecisioningSecret(ctx context.Context, opts ...options.RequestOption) (err error) {
+	opts = append(r.Options[:], opts...)
+	opts = append([]options.RequestOption{options.WithHeader("Accept", "")}, opts...)
+	path := "three_ds_decisioning/secret/rotate"
+	err = options.ExecuteNewRequest(ctx, "POST", path, nil, nil, opts...)
+	return
+}
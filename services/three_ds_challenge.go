@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// ThreeDSChallengeFunc is called with a 3DS challenge that the cardholder has to
+// complete. It returns the cardholder's response if it is known right away, or
+// an empty response if the cardholder is asked asynchronously, in which case the
+// response must be submitted with ThreeDSService.ChallengeResponse before the
+// challenge expires.
+type ThreeDSChallengeFunc func(ctx context.Context, challenge *responses.ThreeDSChallenge) (requests.ThreeDSChallengeResponseParamsChallengeResponse, error)
+
+// ThreeDSChallengeHandler is an http.Handler that receives 3DS challenges,
+// verifies that they were sent by Lithic and passes them to OnChallenge. A
+// response returned by OnChallenge is submitted through Service.
+type ThreeDSChallengeHandler struct {
+	Service *ThreeDSService
+	// The 3DS Decisioning secret, see ThreeDSService.GetDecisioningSecret.
+	// Requests are not verified if it is empty.
+	Secret      string
+	OnChallenge ThreeDSChallengeFunc
+	// Called when a challenge is rejected or its response fails to be submitted.
+	OnError func(challenge *responses.ThreeDSChallenge, err error)
+}
+
+func NewThreeDSChallengeHandler(service *ThreeDSService, secret string, onChallenge ThreeDSChallengeFunc) *ThreeDSChallengeHandler {
+	return &ThreeDSChallengeHandler{Service: service, Secret: secret, OnChallenge: onChallenge}
+}
+
+func (h *ThreeDSChallengeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.fail(w, nil, http.StatusBadRequest, err)
+		return
+	}
+	if h.Secret != "" {
+		if err := (&WebhookService{}).VerifySignature(payload, r.Header, h.Secret, time.Now()); err != nil {
+			h.fail(w, nil, http.StatusUnauthorized, err)
+			return
+		}
+	}
+	challenge := &responses.ThreeDSChallenge{}
+	if err := json.Unmarshal(payload, challenge); err != nil {
+		h.fail(w, nil, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := h.OnChallenge(r.Context(), challenge)
+	if err == nil && result != "" {
+		err = h.Service.ChallengeResponse(r.Context(), &requests.ThreeDSChallengeResponseParams{
+			Token:             fields.F(challenge.AuthenticationObject.Token),
+			ChallengeResponse: fields.F(result),
+		})
+	}
+	if err != nil {
+		// Lithic retries challenges that are not acknowledged.
+		h.fail(w, challenge, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *ThreeDSChallengeHandler) fail(w http.ResponseWriter, challenge *responses.ThreeDSChallenge, status int, err error) {
+	if h.OnError != nil {
+		h.OnError(challenge, err)
+	}
+	w.WriteHeader(status)
+}
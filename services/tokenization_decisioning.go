@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type TokenizationDecisioningService struct {
+	Options []options.RequestOption
+}
+
+func NewTokenizationDecisioningService(opts ...options.RequestOption) (r *TokenizationDecisioningService) {
+	r = &TokenizationDecisioningService{}
+	r.Options = opts
+	return
+}
+
+// Retrieve the Tokenization Decisioning secret key. If one does not exist your
+// program yet, calling this endpoint will create one for you. The headers of the
+// Tokenization Decisioning request will contain a hmac signature which you can use
+// to verify requests originate from Lithic. See
+// [this page](https://docs.lithic.com/docs/events-api#verifying-webhooks) for more
+// detail about verifying Tokenization Decisioning requests.
+func (r *TokenizationDecisioningService) GetSecret(ctx context.Context, opts ...options.RequestOption) (res *responses.TokenizationSecret, err error) {
+	opts = append(r.Options[:], opts...)
+	path := "tokenization_decisioning/secret"
+	err = options.ExecuteNewRequest(ctx, "GET", path, nil, &res, opts...)
+	return
+}
+
+// Generate a new Tokenization Decisioning secret key. The old Tokenization
+// Decisioning secret key will be deactivated 24 hours after a successful request
+// to this endpoint.
+func (r *TokenizationDecisioningService) RotateSecret(ctx context.Context, opts ...options.RequestOption) (res *responses.TokenizationDecisioningRotateSecretResponse, err error) {
+	opts = append(r.Options[:], opts...)
+	path := "tokenization_decisioning/secret/rotate"
+	err = options.ExecuteNewRequest(ctx, "POST", path, nil, &res, opts...)
+	return
+}
+
+// TokenizationSecretOverlap is how long the previous Tokenization Decisioning
+// secret is still accepted after a rotation, matching how long Lithic keeps the
+// old secret active.
+const TokenizationSecretOverlap = 24 * time.Hour
+
+// TokenizationSecretCache keeps the Tokenization Decisioning secret in memory so
+// that verifying a request does not require a round trip to the API. After a
+// rotation the previous secret is still accepted for TokenizationSecretOverlap,
+// as Lithic keeps signing with it until it is deactivated.
+type TokenizationSecretCache struct {
+	Service *TokenizationDecisioningService
+	// How long a fetched secret is used before it is fetched again. A TTL of 0
+	// keeps the secret until it is rotated through the cache.
+	TTL time.Duration
+
+	mu       sync.Mutex
+	secret   string
+	previous WebhookSecret
+	fetched  time.Time
+}
+
+func NewTokenizationSecretCache(service *TokenizationDecisioningService, ttl time.Duration) *TokenizationSecretCache {
+	return &TokenizationSecretCache{Service: service, TTL: ttl}
+}
+
+// Secret returns the cached secret, fetching it if it has not been fetched yet or
+// has expired.
+func (c *TokenizationSecretCache) Secret(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.secret != "" && (c.TTL == 0 || time.Since(c.fetched) < c.TTL) {
+		return c.secret, nil
+	}
+	res, err := c.Service.GetSecret(ctx)
+	if err != nil {
+		return "", err
+	}
+	if c.secret != "" && c.secret != res.Secret {
+		c.retire(c.secret)
+	}
+	c.secret = res.Secret
+	c.fetched = time.Now()
+	return c.secret, nil
+}
+
+// Rotate rotates the secret and caches the new one.
+func (c *TokenizationSecretCache) Rotate(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	res, err := c.Service.RotateSecret(ctx)
+	if err != nil {
+		return "", err
+	}
+	if c.secret != "" {
+		c.retire(c.secret)
+	}
+	c.secret = res.Secret
+	c.fetched = time.Now()
+	return c.secret, nil
+}
+
+// retire keeps secret as the previous secret until TokenizationSecretOverlap
+// has passed. The caller must hold c.mu.
+func (c *TokenizationSecretCache) retire(secret string) {
+	c.previous = WebhookSecret{Secret: secret, ExpiresAt: time.Now().Add(TokenizationSecretOverlap)}
+}
+
+// VerifySignature validates that a Tokenization Decisioning request was sent by
+// Lithic, using the cached secret or, until it expires, the one it replaced. See
+// WebhookService.VerifySignatureWithSecrets.
+func (c *TokenizationSecretCache) VerifySignature(ctx context.Context, payload []byte, headers http.Header, now time.Time) error {
+	secret, err := c.Secret(ctx)
+	if err != nil {
+		return err
+	}
+	secrets := []WebhookSecret{{Secret: secret}}
+	c.mu.Lock()
+	if c.previous.Secret != "" {
+		secrets = append(secrets, c.previous)
+	}
+	c.mu.Unlock()
+	return (&WebhookService{}).VerifySignatureWithSecrets(payload, headers, secrets, now)
+}
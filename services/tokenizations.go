@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/pagination"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+type TokenizationService struct {
+	Options []options.RequestOption
+}
+
+func NewTokenizationService(opts ...options.RequestOption) (r *TokenizationService) {
+	r = &TokenizationService{}
+	r.Options = opts
+	return
+}
+
+// Get tokenization
+func (r *TokenizationService) Get(ctx context.Context, tokenization_token string, opts ...options.RequestOption) (res *responses.Tokenization, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("tokenizations/%s", tokenization_token)
+	err = options.ExecuteNewRequest(ctx, "GET", path, nil, &res, opts...)
+	return
+}
+
+// List card tokenizations
+func (r *TokenizationService) List(ctx context.Context, query *requests.TokenizationListParams, opts ...options.RequestOption) (res *responses.TokenizationsCursorPage, err error) {
+	opts = append(r.Options, opts...)
+	path := "tokenizations"
+	cfg, err := options.NewRequestConfig(ctx, "GET", path, query, nil, opts...)
+	if err != nil {
+		return
+	}
+	res = &responses.TokenizationsCursorPage{
+		CursorPage: &pagination.CursorPage[responses.Tokenization]{
+			Config:  *cfg,
+			Options: opts,
+		},
+	}
+	return res, res.Fire()
+}
+
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *TokenizationService) ListStream(ctx context.Context, query *requests.TokenizationListParams, opts ...options.RequestOption) (<-chan responses.Tokenization, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.Tokenization], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
+
+// Activate a tokenization that is pending activation or inactive, allowing it
+// to be used for transactions.
+func (r *TokenizationService) Activate(ctx context.Context, tokenization_token string, opts ...options.RequestOption) (err error) {
+	opts = append(r.Options[:], opts...)
+	opts = append([]options.RequestOption{options.WithHeader("Accept", "")}, opts...)
+	path := fmt.Sprintf("tokenizations/%s/activate", tokenization_token)
+	err = options.ExecuteNewRequest(ctx, "POST", path, nil, nil, opts...)
+	return
+}
+
+// Deactivate a tokenization. Deactivation is permanent: the tokenization can no
+// longer be used and the cardholder has to provision the card again.
+func (r *TokenizationService) Deactivate(ctx context.Context, tokenization_token string, opts ...options.RequestOption) (err error) {
+	opts = append(r.Options[:], opts...)
+	opts = append([]options.RequestOption{options.WithHeader("Accept", "")}, opts...)
+	path := fmt.Sprintf("tokenizations/%s/deactivate", tokenization_token)
+	err = options.ExecuteNewRequest(ctx, "POST", path, nil, nil, opts...)
+	return
+}
+
+// Pause an active tokenization, temporarily preventing it from being used for
+// transactions until it is resumed.
+func (r *TokenizationService) Pause(ctx context.Context, tokenization_token string, opts ...options.RequestOption) (err error) {
+	opts = append(r.Options[:], opts...)
+	opts = append([]options.RequestOption{options.WithHeader("Accept", "")}, opts...)
+	path := fmt.Sprintf("tokenizations/%s/pause", tokenization_token)
+	err = options.ExecuteNewRequest(ctx, "POST", path, nil, nil, opts...)
+	return
+}
+
+// Resume a paused tokenization.
+func (r *TokenizationService) Resume(ctx context.Context, tokenization_token string, opts ...options.RequestOption) (err error) {
+	opts = append(r.Options[:], opts...)
+	opts = append([]options.RequestOption{options.WithHeader("Accept", "")}, opts...)
+	path := fmt.Sprintf("tokenizations/%s/unpause", tokenization_token)
+	err = options.ExecuteNewRequest(ctx, "POST", path, nil, nil, opts...)
+	return
+}
+
+// Update the digital card art that is displayed in the cardholder's digital
+// wallet for a tokenization.
+func (r *TokenizationService) UpdateDigitalCardArt(ctx context.Context, tokenization_token string, body *requests.TokenizationUpdateDigitalCardArtParams, opts ...options.RequestOption) (res *responses.Tokenization, err error) {
+	opts = append(r.Options[:], opts...)
+	path := fmt.Sprintf("tokenizations/%s/update_digital_card_art", tokenization_token)
+	err = options.ExecuteNewRequest(ctx, "POST", path, body, &res, opts...)
+	return
+}
@@ -36,6 +36,9 @@ func (r *TransactionService) List(ctx context.Context, query *requests.Transacti
 	if err != nil {
 		return
 	}
+	if err = query.ValidateTimeRange(cfg.MaxListTimeRange); err != nil {
+		return
+	}
 	res = &responses.TransactionsPage{
 		Page: &pagination.Page[responses.Transaction]{
 			Config:  *cfg,
@@ -45,6 +48,18 @@ func (r *TransactionService) List(ctx context.Context, query *requests.Transacti
 	return res, res.Fire()
 }
 
+// ListStream is like List, but fetches the pages in the background and streams
+// their items, see pagination.Stream.
+func (r *TransactionService) ListStream(ctx context.Context, query *requests.TransactionListParams, opts ...options.RequestOption) (<-chan responses.Transaction, <-chan error) {
+	return pagination.Stream(ctx, func() (*pagination.Iterator[responses.Transaction], error) {
+		page, err := r.List(ctx, query, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return page.Iterator(), nil
+	})
+}
+
 // Simulates an authorization request from the payment network as if it came from a
 // merchant acquirer. If you're configured for ASA, simulating auths requires your
 // ASA client to be set up properly (respond with a valid JSON to the ASA request).
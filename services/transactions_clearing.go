@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// CaptureAmountError is returned by PartialCapture.Clear for an amount that is not
+// positive or exceeds the amount that remains capturable.
+type CaptureAmountError struct {
+	Amount    int64
+	Remaining int64
+}
+
+func (e *CaptureAmountError) Error() string {
+	return fmt.Sprintf("cannot clear %d cents of an authorization with %d cents remaining", e.Amount, e.Remaining)
+}
+
+// PartialCapture simulates multiple partial clearings against a single
+// authorization, as merchants such as hotels and fuel stations do, keeping track
+// of the amount that remains capturable. It is safe for concurrent use, but
+// clearings are sent one at a time.
+type PartialCapture struct {
+	service    *TransactionService
+	token      string
+	authorized int64
+	opts       []options.RequestOption
+
+	mu       sync.Mutex
+	captured int64
+}
+
+// NewPartialCapture fetches the authorization with the given transaction token,
+// as returned by SimulateAuthorization, and starts a PartialCapture against it.
+// Any amount that has already been settled counts as captured.
+func (r *TransactionService) NewPartialCapture(ctx context.Context, transaction_token string, opts ...options.RequestOption) (res *PartialCapture, err error) {
+	tx, err := r.Get(ctx, transaction_token, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &PartialCapture{
+		service:    r,
+		token:      transaction_token,
+		authorized: tx.AuthorizationAmount,
+		captured:   tx.SettledAmount,
+		opts:       opts,
+	}, nil
+}
+
+// Authorized returns the amount (in cents) of the authorization.
+func (c *PartialCapture) Authorized() int64 {
+	return c.authorized
+}
+
+// Captured returns the amount (in cents) that has been cleared so far.
+func (c *PartialCapture) Captured() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.captured
+}
+
+// Remaining returns the amount (in cents) that can still be cleared.
+func (c *PartialCapture) Remaining() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.authorized - c.captured
+}
+
+// Clear clears amount (in cents) of the authorization. Amounts that are not
+// positive or exceed the remaining amount fail with a *CaptureAmountError
+// without sending a request. The amount is always sent, as the API only
+// captures the full amount by default before any amount has been cleared.
+func (c *PartialCapture) Clear(ctx context.Context, amount int64, opts ...options.RequestOption) (res *responses.TransactionSimulateClearingResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if remaining := c.authorized - c.captured; amount <= 0 || amount > remaining {
+		return nil, &CaptureAmountError{Amount: amount, Remaining: remaining}
+	}
+	res, err = c.service.SimulateClearing(ctx, &requests.TransactionSimulateClearingParams{
+		Token:  fields.F(c.token),
+		Amount: fields.F(amount),
+	}, append(c.opts[:len(c.opts):len(c.opts)], opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	c.captured += amount
+	return res, nil
+}
+
+// ClearAll clears each of amounts in turn, stopping at the first clearing that
+// fails. It returns the responses of the clearings that succeeded.
+func (c *PartialCapture) ClearAll(ctx context.Context, amounts []int64, opts ...options.RequestOption) (res []*responses.TransactionSimulateClearingResponse, err error) {
+	for _, amount := range amounts {
+		cleared, err := c.Clear(ctx, amount, opts...)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, cleared)
+	}
+	return res, nil
+}
@@ -24,13 +24,49 @@ func NewWebhookService(opts ...options.RequestOption) (r *WebhookService) {
 	return
 }
 
+// WebhookSecret is a secret that webhooks may be signed with, until it
+// expires. The zero ExpiresAt never expires.
+type WebhookSecret struct {
+	Secret    string
+	ExpiresAt time.Time
+}
+
+// Expired reports whether the secret has expired at now.
+func (s WebhookSecret) Expired(now time.Time) bool {
+	return !s.ExpiresAt.IsZero() && !now.Before(s.ExpiresAt)
+}
+
 // Validates whether or not the webhook payload was sent by Lithic.
 //
 // An error will be raised if the webhook payload was not sent by Lithic.
 func (r *WebhookService) VerifySignature(payload []byte, headers http.Header, secret string, now time.Time) (err error) {
-	whsecret, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, "whsec_"))
-	if err != nil {
-		return fmt.Errorf("invalid webhook secret: %s", err)
+	return r.VerifySignatureWithSecrets(payload, headers, []WebhookSecret{{Secret: secret}}, now)
+}
+
+// Validates whether or not the webhook payload was sent by Lithic, signed with
+// any of the secrets that have not expired at now. While the secret of an event
+// subscription is rotated, give both the new secret and the old one, expiring
+// at the end of the changeover window, so that no webhook is rejected.
+//
+// An error will be raised if the webhook payload was not sent by Lithic.
+func (r *WebhookService) VerifySignatureWithSecrets(payload []byte, headers http.Header, secrets []WebhookSecret, now time.Time) (err error) {
+	keys := make([][]byte, 0, len(secrets))
+	for _, secret := range secrets {
+		if secret.Expired(now) {
+			continue
+		}
+		whsecret, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret.Secret, "whsec_"))
+		if err != nil {
+			return fmt.Errorf("invalid webhook secret: %s", err)
+		}
+		// An empty key would accept signatures that anyone can compute.
+		if len(whsecret) == 0 {
+			continue
+		}
+		keys = append(keys, whsecret)
+	}
+	if len(keys) == 0 {
+		return errors.New("no webhook secret to verify the signature with, all are empty or have expired")
 	}
 
 	id := headers.Get("webhook-id")
@@ -51,21 +87,15 @@ func (r *WebhookService) VerifySignature(payload []byte, headers http.Header, se
 		return fmt.Errorf("invalid signature headers: %s", err)
 	}
 
-	if timestamp < now.UnixMilli()-300 {
+	// The timestamp is in seconds, and is tolerated 5 minutes either way.
+	if timestamp < now.Unix()-300 {
 		return errors.New("webhook timestamp too old")
 	}
-	if timestamp > now.UnixMilli()+300 {
+	if timestamp > now.Unix()+300 {
 		return errors.New("webhook timestamp too new")
 	}
 
-	mac := hmac.New(sha256.New, whsecret)
-	mac.Write([]byte(id))
-	mac.Write([]byte("."))
-	mac.Write([]byte(unixtime))
-	mac.Write([]byte("."))
-	mac.Write(payload)
-	expected := mac.Sum(nil)
-
+	signatures := [][]byte{}
 	for _, part := range sign {
 		parts := strings.Split(part, ",")
 		if len(parts) != 2 {
@@ -78,8 +108,21 @@ func (r *WebhookService) VerifySignature(payload []byte, headers http.Header, se
 		if err != nil {
 			continue
 		}
-		if hmac.Equal(signature, expected) {
-			return nil
+		signatures = append(signatures, signature)
+	}
+
+	for _, key := range keys {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(id))
+		mac.Write([]byte("."))
+		mac.Write([]byte(unixtime))
+		mac.Write([]byte("."))
+		mac.Write(payload)
+		expected := mac.Sum(nil)
+		for _, signature := range signatures {
+			if hmac.Equal(signature, expected) {
+				return nil
+			}
 		}
 	}
 
@@ -0,0 +1,70 @@
+package lithic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lithic-com/lithic-go/options"
+)
+
+// Closer is implemented by components that run in the background, such as event
+// streamers or rate limiters, and need to drain before the process exits.
+// Shutdown must stop accepting new work and return once pending work is done or
+// ctx is done, whichever comes first.
+type Closer interface {
+	Shutdown(ctx context.Context) error
+}
+
+// ShutdownError collects the errors returned by the components that failed to
+// shut down cleanly.
+type ShutdownError struct {
+	Errors []error
+}
+
+func (e *ShutdownError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("lithic: %d components failed to shut down: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+func (e *ShutdownError) Unwrap() []error {
+	return e.Errors
+}
+
+// RegisterCloser adds a background component to be shut down along with the
+// client.
+func (r *Lithic) RegisterCloser(closer Closer) {
+	r.closersMu.Lock()
+	defer r.closersMu.Unlock()
+	r.closers = append(r.closers, closer)
+}
+
+// Shutdown shuts down every registered component in the reverse order of
+// registration and then closes the idle connections of the client's
+// http.Client. Every component is given the chance to shut down even if an
+// earlier one fails, and the errors are returned as a *ShutdownError.
+func (r *Lithic) Shutdown(ctx context.Context) error {
+	r.closersMu.Lock()
+	closers := r.closers
+	r.closers = nil
+	r.closersMu.Unlock()
+
+	var errs []error
+	for i := len(closers) - 1; i >= 0; i-- {
+		if err := closers[i].Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if cfg, err := options.NewRequestConfig(ctx, "GET", "", nil, nil, r.Options...); err == nil && cfg.HTTPClient != nil {
+		cfg.HTTPClient.CloseIdleConnections()
+	}
+
+	if len(errs) > 0 {
+		return &ShutdownError{Errors: errs}
+	}
+	return nil
+}
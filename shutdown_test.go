@@ -0,0 +1,39 @@
+package lithic
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type testCloser struct {
+	name  string
+	err   error
+	order *[]string
+}
+
+func (c testCloser) Shutdown(ctx context.Context) error {
+	*c.order = append(*c.order, c.name)
+	return c.err
+}
+
+func TestShutdownAggregatesClosers(t *testing.T) {
+	client := NewLithic()
+	order := []string{}
+	failure := errors.New("refill still running")
+	client.RegisterCloser(testCloser{"streamer", nil, &order})
+	client.RegisterCloser(testCloser{"limiter", failure, &order})
+	client.RegisterCloser(testCloser{"pool", nil, &order})
+
+	err := client.Shutdown(context.Background())
+	var shutdownErr *ShutdownError
+	if !errors.As(err, &shutdownErr) || len(shutdownErr.Errors) != 1 || shutdownErr.Errors[0] != failure {
+		t.Fatalf("expected the limiter failure, got %v", err)
+	}
+	if len(order) != 3 || order[0] != "pool" || order[2] != "streamer" {
+		t.Fatalf("expected closers in reverse order, got %v", order)
+	}
+	if err := client.Shutdown(context.Background()); err != nil {
+		t.Fatalf("expected a second shutdown to be a no-op, got %v", err)
+	}
+}
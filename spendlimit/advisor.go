@@ -0,0 +1,108 @@
+package spendlimit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+// ConflictKind identifies a check that a card's spend limit failed.
+type ConflictKind string
+
+const (
+	// The card's spend limit is 0, which means that the card has no limit of its
+	// own rather than that it cannot spend. Pause the card to stop it from
+	// spending.
+	ConflictNoCardLimit ConflictKind = "NO_CARD_LIMIT"
+	// The card's per-transaction limit exceeds the account's daily limit, so
+	// transactions between the two are declined.
+	ConflictExceedsAccountDaily ConflictKind = "EXCEEDS_ACCOUNT_DAILY"
+	// The card's monthly limit exceeds the account's monthly limit, so it can
+	// never be reached.
+	ConflictExceedsAccountMonthly ConflictKind = "EXCEEDS_ACCOUNT_MONTHLY"
+	// The card's limit exceeds the account's lifetime limit, so it can never be
+	// reached.
+	ConflictExceedsAccountLifetime ConflictKind = "EXCEEDS_ACCOUNT_LIFETIME"
+)
+
+// Conflict is a card spend limit that does not behave the way it reads, given
+// the semantics of the limit and the limits of the card's account.
+type Conflict struct {
+	CardToken string
+	Kind      ConflictKind
+	// The card's spend limit and the account limit that it conflicts with, if
+	// any, in cents.
+	CardLimit    int64
+	AccountLimit int64
+}
+
+func (c Conflict) String() string {
+	switch c.Kind {
+	case ConflictNoCardLimit:
+		return fmt.Sprintf("card %s: spend limit 0 means no card limit, not a blocked card", c.CardToken)
+	case ConflictExceedsAccountDaily:
+		return fmt.Sprintf("card %s: per-transaction limit %d exceeds the account daily limit %d", c.CardToken, c.CardLimit, c.AccountLimit)
+	case ConflictExceedsAccountMonthly:
+		return fmt.Sprintf("card %s: monthly limit %d exceeds the account monthly limit %d", c.CardToken, c.CardLimit, c.AccountLimit)
+	case ConflictExceedsAccountLifetime:
+		return fmt.Sprintf("card %s: limit %d exceeds the account lifetime limit %d", c.CardToken, c.CardLimit, c.AccountLimit)
+	}
+	return fmt.Sprintf("card %s: %s", c.CardToken, c.Kind)
+}
+
+// Check reports the conflicts between the spend limits of cards and the limits
+// of their account. Closed cards are skipped. An account limit of 0 is treated
+// as not set, as it is for the lifetime limit.
+func Check(account responses.Account, cards ...responses.Card) (conflicts []Conflict) {
+	limits := account.SpendLimit
+	for _, card := range cards {
+		if card.State == responses.CardStateClosed {
+			continue
+		}
+		conflict := Conflict{CardToken: card.Token, CardLimit: card.SpendLimit}
+		if card.SpendLimit == 0 {
+			conflict.Kind = ConflictNoCardLimit
+			conflicts = append(conflicts, conflict)
+			continue
+		}
+		switch {
+		case card.SpendLimitDuration == responses.SpendLimitDurationTransaction && limits.Daily != 0 && card.SpendLimit > limits.Daily:
+			conflict.Kind, conflict.AccountLimit = ConflictExceedsAccountDaily, limits.Daily
+		case card.SpendLimitDuration == responses.SpendLimitDurationMonthly && limits.Monthly != 0 && card.SpendLimit > limits.Monthly:
+			conflict.Kind, conflict.AccountLimit = ConflictExceedsAccountMonthly, limits.Monthly
+		case limits.Lifetime != 0 && card.SpendLimit > limits.Lifetime:
+			conflict.Kind, conflict.AccountLimit = ConflictExceedsAccountLifetime, limits.Lifetime
+		default:
+			continue
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	return conflicts
+}
+
+// Advise fetches an account and its cards and reports the conflicts between
+// their spend limits, see Check.
+func Advise(ctx context.Context, accounts *services.AccountService, cards *services.CardService, accountToken string, opts ...options.RequestOption) ([]Conflict, error) {
+	account, err := accounts.Get(ctx, accountToken, opts...)
+	if err != nil {
+		return nil, err
+	}
+	page, err := cards.List(ctx, &requests.CardListParams{AccountToken: fields.F(accountToken)}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var list []responses.Card
+	iter := page.Iterator()
+	for iter.Next(ctx) {
+		list = append(list, *iter.Current())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return Check(*account, list...), nil
+}
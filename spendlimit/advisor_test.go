@@ -0,0 +1,76 @@
+package spendlimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/responses"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+func card(token string, limit int64, duration responses.SpendLimitDuration) responses.Card {
+	return responses.Card{Token: token, SpendLimit: limit, SpendLimitDuration: duration, State: responses.CardStateOpen}
+}
+
+func TestCheck(t *testing.T) {
+	account := responses.Account{SpendLimit: responses.AccountSpendLimit{Daily: 1000, Monthly: 5000, Lifetime: 20000}}
+	closed := card("closed", 0, responses.SpendLimitDurationForever)
+	closed.State = responses.CardStateClosed
+
+	conflicts := Check(account,
+		card("ok", 500, responses.SpendLimitDurationTransaction),
+		card("zero", 0, responses.SpendLimitDurationMonthly),
+		card("daily", 1500, responses.SpendLimitDurationTransaction),
+		card("monthly", 6000, responses.SpendLimitDurationMonthly),
+		card("annual", 25000, responses.SpendLimitDurationAnnually),
+		card("forever", 20000, responses.SpendLimitDurationForever),
+		closed,
+	)
+	expected := []Conflict{
+		{CardToken: "zero", Kind: ConflictNoCardLimit},
+		{CardToken: "daily", Kind: ConflictExceedsAccountDaily, CardLimit: 1500, AccountLimit: 1000},
+		{CardToken: "monthly", Kind: ConflictExceedsAccountMonthly, CardLimit: 6000, AccountLimit: 5000},
+		{CardToken: "annual", Kind: ConflictExceedsAccountLifetime, CardLimit: 25000, AccountLimit: 20000},
+	}
+	if !reflect.DeepEqual(conflicts, expected) {
+		t.Fatalf("expected %v, got %v", expected, conflicts)
+	}
+}
+
+func TestCheckUnsetAccountLimits(t *testing.T) {
+	conflicts := Check(responses.Account{}, card("big", 1000000, responses.SpendLimitDurationTransaction))
+	if len(conflicts) != 0 {
+		t.Fatalf("expected unset account limits to be ignored, got %v", conflicts)
+	}
+}
+
+func TestAdvise(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/accounts/acct":
+			w.Write([]byte(`{"token":"acct","spend_limit":{"daily":1000,"monthly":5000,"lifetime":0}}`))
+		case "/cards":
+			if r.URL.Query().Get("account_token") != "acct" {
+				t.Errorf("expected the cards of the account, got %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"page":1,"total_pages":1,"data":[{"token":"c1","spend_limit":2000,"spend_limit_duration":"TRANSACTION","state":"OPEN"}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	opts := []options.RequestOption{options.WithBaseURL(server.URL)}
+
+	conflicts, err := Advise(context.Background(), services.NewAccountService(opts...), services.NewCardService(opts...), "acct")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conflicts) != 1 || conflicts[0].Kind != ConflictExceedsAccountDaily || conflicts[0].String() != "card c1: per-transaction limit 2000 exceeds the account daily limit 1000" {
+		t.Fatalf("unexpected conflicts %v", conflicts)
+	}
+}
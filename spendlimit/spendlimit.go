@@ -0,0 +1,511 @@
+// Package spendlimit adjusts card spend limits on a schedule, such as raising
+// the limit of a travel card for the length of a trip and restoring it after,
+// and checks card spend limits against the limits of their account.
+package spendlimit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+// Schedule reports when a change should next be applied. Next returns the zero
+// time once the schedule has no further occurrences.
+type Schedule interface {
+	Next(after time.Time) time.Time
+}
+
+type once time.Time
+
+// At returns a Schedule that occurs a single time, at t.
+func At(t time.Time) Schedule {
+	return once(t)
+}
+
+func (s once) Next(after time.Time) time.Time {
+	if time.Time(s).After(after) {
+		return time.Time(s)
+	}
+	return time.Time{}
+}
+
+type daily struct {
+	hour, minute int
+	weekdays     []time.Weekday
+	loc          *time.Location
+}
+
+// Daily returns a Schedule that occurs every day at hour:minute in loc. If any
+// weekdays are given, it only occurs on those days.
+func Daily(hour, minute int, loc *time.Location, weekdays ...time.Weekday) Schedule {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return daily{hour, minute, weekdays, loc}
+}
+
+func (s daily) Next(after time.Time) time.Time {
+	after = after.In(s.loc)
+	next := time.Date(after.Year(), after.Month(), after.Day(), s.hour, s.minute, 0, 0, s.loc)
+	for i := 0; i < 8; i++ {
+		if next.After(after) && s.matches(next.Weekday()) {
+			return next
+		}
+		next = next.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+func (s daily) matches(day time.Weekday) bool {
+	if len(s.weekdays) == 0 {
+		return true
+	}
+	for _, d := range s.weekdays {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Change sets the spend limit of a card each time its schedule occurs.
+type Change struct {
+	// Name identifies the change in the audit trail and in idempotency keys, and
+	// must be unique within a Scheduler.
+	Name      string
+	CardToken string
+	Schedule  Schedule
+	// Fields that are not present are left unchanged on the card.
+	SpendLimit         fields.Field[int64]
+	SpendLimitDuration fields.Field[requests.SpendLimitDuration]
+}
+
+// Window raises the spend limit of a card between Start and End. The limit the
+// card had when the window started is restored when it ends.
+type Window struct {
+	Name               string
+	CardToken          string
+	Start              time.Time
+	End                time.Time
+	SpendLimit         int64
+	SpendLimitDuration fields.Field[requests.SpendLimitDuration]
+}
+
+// Entry records a single attempt to apply a change.
+type Entry struct {
+	Name      string
+	CardToken string
+	// Time the change was scheduled for, which may be earlier than the time it
+	// was applied at.
+	ScheduledAt time.Time
+	AppliedAt   time.Time
+	// Idempotency token the update was sent with. It is derived from the name and
+	// the scheduled time, so that retrying an occurrence cannot apply it twice.
+	IdempotencyToken           string
+	PreviousSpendLimit         int64
+	PreviousSpendLimitDuration requests.SpendLimitDuration
+	SpendLimit                 int64
+	SpendLimitDuration         requests.SpendLimitDuration
+	Err                        error
+}
+
+func (e Entry) String() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: card %s failed: %s", e.Name, e.CardToken, e.Err)
+	}
+	return fmt.Sprintf("%s: card %s spend limit %d %s -> %d %s", e.Name, e.CardToken, e.PreviousSpendLimit, e.PreviousSpendLimitDuration, e.SpendLimit, e.SpendLimitDuration)
+}
+
+// AuditLog stores the entries of a Scheduler. Implementations must be safe for
+// concurrent use.
+type AuditLog interface {
+	Record(ctx context.Context, entry Entry) error
+}
+
+// MemoryAuditLog is an in-process AuditLog.
+type MemoryAuditLog struct {
+	mu      sync.Mutex
+	entries []Entry
+}
+
+func NewMemoryAuditLog() *MemoryAuditLog {
+	return &MemoryAuditLog{}
+}
+
+func (l *MemoryAuditLog) Record(ctx context.Context, entry Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+// Entries returns a copy of the recorded entries, oldest first.
+func (l *MemoryAuditLog) Entries() []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Entry(nil), l.entries...)
+}
+
+type window struct {
+	captured bool
+	limit    int64
+	duration requests.SpendLimitDuration
+}
+
+type job struct {
+	change  Change
+	next    time.Time
+	seq     int
+	window  *window
+	restore bool
+	// The occurrence that a failed restore is being retried for, and the number
+	// of attempts that failed.
+	retrying time.Time
+	attempts int
+}
+
+const (
+	// restoreBackoff is the delay before the first retry of a failed restore,
+	// doubled for every further attempt up to maxRestoreBackoff.
+	restoreBackoff    = time.Minute
+	maxRestoreBackoff = time.Hour
+)
+
+// retry schedules the next attempt of a failed restore, with the same
+// idempotency token. It must be called with mu held.
+func (j *job) retry(now time.Time, scheduledAt time.Time) {
+	if j.retrying.IsZero() {
+		j.retrying = scheduledAt
+	}
+	delay := restoreBackoff
+	for i := 0; i < j.attempts && delay < maxRestoreBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxRestoreBackoff {
+		delay = maxRestoreBackoff
+	}
+	j.attempts += 1
+	j.next = now.Add(delay)
+}
+
+// Scheduler applies changes to card spend limits as they come due. Call Start
+// to apply them in the background, or RunDue to apply them from an existing
+// job runner. A Scheduler can be registered with Lithic.RegisterCloser.
+type Scheduler struct {
+	// Service used to read and update cards.
+	Cards *services.CardService
+	// Log receives an entry for every change that is applied or fails. Defaults
+	// to a MemoryAuditLog.
+	Log AuditLog
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+	// Options applied to every request made by the scheduler.
+	Options []options.RequestOption
+
+	runMu   sync.Mutex
+	mu      sync.Mutex
+	jobs    []*job
+	seq     int
+	names   map[string]bool
+	wake    chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+	running bool
+}
+
+func NewScheduler(cards *services.CardService, opts ...options.RequestOption) *Scheduler {
+	return &Scheduler{Cards: cards, Log: NewMemoryAuditLog(), Options: opts}
+}
+
+func (s *Scheduler) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// Add schedules a change, starting with its first occurrence after now.
+func (s *Scheduler) Add(change Change) error {
+	if change.Schedule == nil {
+		return fmt.Errorf("spendlimit: change %q has no schedule", change.Name)
+	}
+	next := change.Schedule.Next(s.now())
+	if at, ok := change.Schedule.(once); ok {
+		// One-off changes that are already due are applied on the next run
+		// rather than dropped.
+		next = time.Time(at)
+	}
+	return s.add(&job{change: change, next: next})
+}
+
+// AddWindow schedules the raise and restore of a Window. Windows that have
+// already started are raised on the next run.
+func (s *Scheduler) AddWindow(w Window) error {
+	if !w.End.After(w.Start) {
+		return fmt.Errorf("spendlimit: window %q ends before it starts", w.Name)
+	}
+	state := &window{}
+	raise := &job{
+		change: Change{Name: w.Name + "/raise", CardToken: w.CardToken, SpendLimit: fields.F(w.SpendLimit), SpendLimitDuration: w.SpendLimitDuration},
+		next:   w.Start,
+		window: state,
+	}
+	restore := &job{
+		change:  Change{Name: w.Name + "/restore", CardToken: w.CardToken},
+		next:    w.End,
+		window:  state,
+		restore: true,
+	}
+	return s.add(raise, restore)
+}
+
+func (s *Scheduler) add(jobs ...*job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.names == nil {
+		s.names = map[string]bool{}
+	}
+	for _, j := range jobs {
+		if s.names[j.change.Name] {
+			return fmt.Errorf("spendlimit: a change named %q is already scheduled", j.change.Name)
+		}
+	}
+	for _, j := range jobs {
+		s.names[j.change.Name] = true
+		s.seq += 1
+		j.seq = s.seq
+		s.jobs = append(s.jobs, j)
+	}
+	s.signal()
+	return nil
+}
+
+// Next returns the time the next change is due, or the zero time if there is
+// nothing left to apply.
+func (s *Scheduler) Next() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sort()
+	if len(s.jobs) == 0 {
+		return time.Time{}
+	}
+	return s.jobs[0].next
+}
+
+// sort orders the pending jobs by due time and drops finished ones. It must be
+// called with mu held.
+func (s *Scheduler) sort() {
+	pending := s.jobs[:0]
+	for _, j := range s.jobs {
+		if !j.next.IsZero() {
+			pending = append(pending, j)
+		}
+	}
+	s.jobs = pending
+	sort.Slice(s.jobs, func(i, j int) bool {
+		if s.jobs[i].next.Equal(s.jobs[j].next) {
+			return s.jobs[i].seq < s.jobs[j].seq
+		}
+		return s.jobs[i].next.Before(s.jobs[j].next)
+	})
+}
+
+// RunError is returned by RunDue when one or more changes could not be applied
+// or recorded.
+type RunError struct {
+	Errors []error
+}
+
+func (e *RunError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return "spendlimit: " + strings.Join(msgs, "; ")
+}
+
+func (e *RunError) Unwrap() []error {
+	return e.Errors
+}
+
+// RunDue applies every change that is due, in the order they were scheduled
+// for, and returns a *RunError holding the errors of the changes that failed.
+// Failed changes are not retried until their next occurrence, except for the
+// restore of a raised Window, which is retried with backoff until it succeeds
+// so that the card does not keep the raised limit.
+func (s *Scheduler) RunDue(ctx context.Context) error {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+	now := s.now()
+	s.mu.Lock()
+	s.sort()
+	var due []*job
+	for _, j := range s.jobs {
+		if j.next.After(now) {
+			break
+		}
+		due = append(due, j)
+	}
+	s.mu.Unlock()
+
+	var errs []error
+	for _, j := range due {
+		entry := s.apply(ctx, j)
+		if s.Log != nil {
+			if err := s.Log.Record(ctx, entry); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if entry.Err != nil {
+			errs = append(errs, entry.Err)
+		}
+		s.mu.Lock()
+		switch {
+		case entry.Err != nil && j.restore && j.window.captured:
+			j.retry(now, entry.ScheduledAt)
+		case j.change.Schedule != nil:
+			j.next = j.change.Schedule.Next(now)
+		default:
+			j.next = time.Time{}
+		}
+		s.mu.Unlock()
+	}
+	if len(errs) > 0 {
+		return &RunError{Errors: errs}
+	}
+	return nil
+}
+
+func (s *Scheduler) apply(ctx context.Context, j *job) (entry Entry) {
+	scheduledAt := j.next
+	if !j.retrying.IsZero() {
+		scheduledAt = j.retrying
+	}
+	entry = Entry{
+		Name:             j.change.Name,
+		CardToken:        j.change.CardToken,
+		ScheduledAt:      scheduledAt,
+		IdempotencyToken: fmt.Sprintf("spendlimit-%s-%d", j.change.Name, scheduledAt.Unix()),
+	}
+	defer func() { entry.AppliedAt = s.now() }()
+
+	card, err := s.Cards.Get(ctx, j.change.CardToken, s.Options...)
+	if err != nil {
+		entry.Err = err
+		return
+	}
+	entry.PreviousSpendLimit = card.SpendLimit
+	entry.PreviousSpendLimitDuration = requests.SpendLimitDuration(card.SpendLimitDuration)
+
+	params := &requests.CardUpdateParams{SpendLimit: j.change.SpendLimit, SpendLimitDuration: j.change.SpendLimitDuration}
+	if j.window != nil && j.restore {
+		if !j.window.captured {
+			entry.Err = fmt.Errorf("spendlimit: %s has no limit to restore because the window was never raised", j.change.Name)
+			return
+		}
+		params.SpendLimit = fields.F(j.window.limit)
+		params.SpendLimitDuration = fields.F(j.window.duration)
+	}
+
+	opts := append(s.Options[:len(s.Options):len(s.Options)], options.WithIdempotencyKey(entry.IdempotencyToken))
+	card, err = s.Cards.Update(ctx, j.change.CardToken, params, opts...)
+	if err != nil {
+		entry.Err = err
+		return
+	}
+	if j.window != nil && !j.restore {
+		j.window.captured = true
+		j.window.limit = entry.PreviousSpendLimit
+		j.window.duration = entry.PreviousSpendLimitDuration
+	}
+	entry.SpendLimit = card.SpendLimit
+	entry.SpendLimitDuration = requests.SpendLimitDuration(card.SpendLimitDuration)
+	return
+}
+
+// Start applies changes in the background as they come due, until Shutdown is
+// called. Errors are only reported through the audit log.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		return
+	}
+	s.running = true
+	s.wake = make(chan struct{}, 1)
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.run(s.wake, s.stop, s.done)
+}
+
+func (s *Scheduler) run(wake, stop, done chan struct{}) {
+	defer close(done)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop
+		cancel()
+	}()
+	for {
+		s.RunDue(ctx)
+		var timer *time.Timer
+		var fire <-chan time.Time
+		if next := s.Next(); !next.IsZero() {
+			timer = time.NewTimer(next.Sub(s.now()))
+			fire = timer.C
+		}
+		select {
+		case <-stop:
+		case <-wake:
+		case <-fire:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		select {
+		case <-stop:
+			return
+		default:
+		}
+	}
+}
+
+// signal wakes the background loop so that it picks up newly added changes. It
+// must be called with mu held.
+func (s *Scheduler) signal() {
+	if !s.running {
+		return
+	}
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Shutdown stops the background loop started by Start, cancelling any update
+// in flight, and waits for it to exit or for ctx to be done.
+func (s *Scheduler) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return nil
+	}
+	s.running = false
+	close(s.stop)
+	done := s.done
+	s.mu.Unlock()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,155 @@
+package spendlimit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+type cardServer struct {
+	mu          sync.Mutex
+	limit       int64
+	duration    string
+	idempotency []string
+	// The number of updates to fail with a server error.
+	failures int
+}
+
+func (c *cardServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if r.Method == "PATCH" && c.failures > 0 {
+		c.failures -= 1
+		c.idempotency = append(c.idempotency, r.Header.Get("Idempotency-Token"))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if r.Method == "PATCH" {
+		var body struct {
+			SpendLimit         *int64  `json:"spend_limit"`
+			SpendLimitDuration *string `json:"spend_limit_duration"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.SpendLimit != nil {
+			c.limit = *body.SpendLimit
+		}
+		if body.SpendLimitDuration != nil {
+			c.duration = *body.SpendLimitDuration
+		}
+		c.idempotency = append(c.idempotency, r.Header.Get("Idempotency-Token"))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{"token": "card_token", "spend_limit": c.limit, "spend_limit_duration": c.duration})
+}
+
+func newScheduler(t *testing.T, server *cardServer, now *time.Time) *Scheduler {
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+	s := NewScheduler(services.NewCardService(options.WithBaseURL(ts.URL), options.WithMaxRetries(0)))
+	s.Now = func() time.Time { return *now }
+	return s
+}
+
+func TestWindow(t *testing.T) {
+	server := &cardServer{limit: 1000, duration: "MONTHLY"}
+	now := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	s := newScheduler(t, server, &now)
+
+	err := s.AddWindow(Window{Name: "trip", CardToken: "card_token", Start: now.Add(time.Hour), End: now.Add(48 * time.Hour), SpendLimit: 500000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RunDue(context.Background()); err != nil || server.limit != 1000 {
+		t.Fatalf("expected nothing to be due yet, got %v and limit %d", err, server.limit)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if err := s.RunDue(context.Background()); err != nil || server.limit != 500000 {
+		t.Fatalf("expected the window to raise the limit, got %v and limit %d", err, server.limit)
+	}
+	server.limit = 2000 // changed in the meantime, should still be restored
+
+	now = now.Add(48 * time.Hour)
+	if err := s.RunDue(context.Background()); err != nil || server.limit != 1000 || server.duration != "MONTHLY" {
+		t.Fatalf("expected the window to restore the limit, got %v and limit %d %s", err, server.limit, server.duration)
+	}
+	if !s.Next().IsZero() {
+		t.Fatalf("expected no changes left, next at %s", s.Next())
+	}
+
+	entries := s.Log.(*MemoryAuditLog).Entries()
+	if len(entries) != 2 || entries[0].PreviousSpendLimit != 1000 || entries[0].SpendLimit != 500000 || entries[1].PreviousSpendLimit != 2000 {
+		t.Fatalf("unexpected audit trail %v", entries)
+	}
+	if server.idempotency[0] != entries[0].IdempotencyToken || server.idempotency[0] == server.idempotency[1] {
+		t.Fatalf("expected each occurrence to be sent with its own idempotency token, got %v", server.idempotency)
+	}
+}
+
+func TestWindowRestoreRetried(t *testing.T) {
+	server := &cardServer{limit: 1000, duration: "MONTHLY"}
+	now := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	s := newScheduler(t, server, &now)
+	if err := s.AddWindow(Window{Name: "trip", CardToken: "card_token", Start: now, End: now.Add(time.Hour), SpendLimit: 500000}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RunDue(context.Background()); err != nil || server.limit != 500000 {
+		t.Fatalf("expected the window to raise the limit, got %v and limit %d", err, server.limit)
+	}
+
+	now = now.Add(time.Hour)
+	server.failures = 1
+	if err := s.RunDue(context.Background()); err == nil || server.limit != 500000 {
+		t.Fatalf("expected the restore to fail, got %v and limit %d", err, server.limit)
+	}
+	if next := s.Next(); !next.Equal(now.Add(restoreBackoff)) {
+		t.Fatalf("expected the restore to be retried after %s, next at %s", restoreBackoff, next)
+	}
+
+	now = now.Add(restoreBackoff)
+	if err := s.RunDue(context.Background()); err != nil || server.limit != 1000 {
+		t.Fatalf("expected the retry to restore the limit, got %v and limit %d", err, server.limit)
+	}
+	if !s.Next().IsZero() {
+		t.Fatalf("expected no changes left, next at %s", s.Next())
+	}
+	if n := len(server.idempotency); n != 3 || server.idempotency[1] != server.idempotency[2] {
+		t.Fatalf("expected the retry to reuse the idempotency token, got %v", server.idempotency)
+	}
+}
+
+func TestDaily(t *testing.T) {
+	schedule := Daily(9, 0, time.UTC, time.Monday, time.Friday)
+	// Wednesday
+	next := schedule.Next(time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC))
+	if !next.Equal(time.Date(2023, 3, 3, 9, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected Friday at 9:00, got %s", next)
+	}
+}
+
+func TestStartShutdown(t *testing.T) {
+	server := &cardServer{limit: 1000}
+	now := time.Now()
+	s := newScheduler(t, server, &now)
+	s.Start()
+	if err := s.Add(Change{Name: "raise", CardToken: "card_token", Schedule: At(now)}); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for len(s.Log.(*MemoryAuditLog).Entries()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := s.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Log.(*MemoryAuditLog).Entries()) != 1 {
+		t.Fatalf("expected the due change to be applied in the background")
+	}
+}
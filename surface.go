@@ -0,0 +1,262 @@
+package lithic
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/core/query"
+)
+
+// Version returns the version of this SDK.
+func Version() string {
+	return core.PackageVersion
+}
+
+// Endpoint describes an API endpoint bound by this version of the SDK.
+type Endpoint struct {
+	// Path of the service on the client, such as "Cards" or
+	// "FinancialAccounts.Balances".
+	Service string
+	// Name of the method on the service, such as "List".
+	Method     string
+	HTTPMethod string
+	// Path of the endpoint relative to the base URL, with path parameters in
+	// braces, such as "cards/{card_token}".
+	Path   string
+	Params []Param
+}
+
+func (e Endpoint) String() string {
+	return fmt.Sprintf("%s %s (%s.%s)", e.HTTPMethod, e.Path, e.Service, e.Method)
+}
+
+// Param describes a parameter accepted by an endpoint.
+type Param struct {
+	Name string
+	// Where the parameter is sent: "path", "query" or "body".
+	In       string
+	Type     string
+	Required bool
+}
+
+// APISurface returns the endpoints bound by this version of the SDK, along with
+// the parameters they support, so that the availability of an endpoint can be
+// checked before relying on it.
+func APISurface() []Endpoint {
+	client := reflect.TypeOf(Lithic{})
+	res := make([]Endpoint, len(endpoints))
+	for i, e := range endpoints {
+		res[i] = e
+		for _, segment := range strings.Split(e.Path, "/") {
+			if strings.HasPrefix(segment, "{") {
+				res[i].Params = append(res[i].Params, Param{Name: strings.Trim(segment, "{}"), In: "path", Type: "string", Required: true})
+			}
+		}
+		method, ok := serviceMethod(client, e.Service, e.Method)
+		if !ok {
+			continue
+		}
+		for j := 0; j < method.Type.NumIn(); j++ {
+			if params := method.Type.In(j); params.Kind() == reflect.Pointer && params.Elem().Kind() == reflect.Struct {
+				res[i].Params = append(res[i].Params, paramsOf(params)...)
+			}
+		}
+	}
+	return res
+}
+
+// FindEndpoint returns the bound endpoint with the given HTTP method and path,
+// for example "POST" and "cards/{card_token}/reissue".
+func FindEndpoint(httpMethod string, path string) (Endpoint, bool) {
+	for _, e := range APISurface() {
+		if e.HTTPMethod == httpMethod && e.Path == path {
+			return e, true
+		}
+	}
+	return Endpoint{}, false
+}
+
+func serviceMethod(client reflect.Type, service string, name string) (reflect.Method, bool) {
+	t := client
+	for _, field := range strings.Split(service, ".") {
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		f, ok := t.FieldByName(field)
+		if !ok {
+			return reflect.Method{}, false
+		}
+		t = f.Type
+	}
+	return t.MethodByName(name)
+}
+
+var queryer = reflect.TypeOf((*query.Queryer)(nil)).Elem()
+
+func paramsOf(t reflect.Type) (params []Param) {
+	in := "body"
+	if t.Implements(queryer) {
+		in = "query"
+	}
+	t = t.Elem()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup("json")
+		if in == "query" {
+			tag, ok = field.Tag.Lookup("query")
+		}
+		if !ok {
+			// Variants of a union, such as the KYC and KYB workflows of
+			// AccountHolderNewParams. Other untagged fields hold path parameters.
+			if field.IsExported() && field.Type.Kind() == reflect.Pointer {
+				params = append(params, Param{Name: field.Name, In: in, Type: typeName(field.Type.Elem())})
+			}
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			continue
+		}
+		params = append(params, Param{Name: name, In: in, Type: typeName(field.Type), Required: opts == "required"})
+	}
+	return params
+}
+
+// typeName returns the name of the type of a parameter, unwrapping
+// fields.Field.
+func typeName(t reflect.Type) string {
+	if t.Kind() == reflect.Struct && strings.HasPrefix(t.Name(), "Field[") {
+		if value, ok := t.FieldByName("Value"); ok {
+			t = value.Type
+		}
+	}
+	return t.String()
+}
+
+// endpoints lists the API endpoints that the services of the client are bound
+// to. Methods that are built on top of other endpoints, such as
+// CardService.GetEmbedURL, are not listed.
+var endpoints = []Endpoint{
+	{Service: "Accounts", Method: "Get", HTTPMethod: "GET", Path: "accounts/{account_token}"},
+	{Service: "Accounts", Method: "Update", HTTPMethod: "PATCH", Path: "accounts/{account_token}"},
+	{Service: "Accounts", Method: "List", HTTPMethod: "GET", Path: "accounts"},
+	{Service: "Accounts", Method: "ListStream", HTTPMethod: "GET", Path: "accounts"},
+	{Service: "AccountHolders", Method: "New", HTTPMethod: "POST", Path: "account_holders"},
+	{Service: "AccountHolders", Method: "Get", HTTPMethod: "GET", Path: "account_holders/{account_holder_token}"},
+	{Service: "AccountHolders", Method: "Update", HTTPMethod: "PATCH", Path: "account_holders/{account_holder_token}"},
+	{Service: "AccountHolders", Method: "NewWebhook", HTTPMethod: "POST", Path: "webhooks/account_holders"},
+	{Service: "AccountHolders", Method: "ListDocuments", HTTPMethod: "GET", Path: "account_holders/{account_holder_token}/documents"},
+	{Service: "AccountHolders", Method: "Resubmit", HTTPMethod: "POST", Path: "account_holders/{account_holder_token}/resubmit"},
+	{Service: "AccountHolders", Method: "GetDocument", HTTPMethod: "GET", Path: "account_holders/{account_holder_token}/documents/{document_token}"},
+	{Service: "AccountHolders", Method: "UploadDocument", HTTPMethod: "POST", Path: "account_holders/{account_holder_token}/documents"},
+	{Service: "AggregateBalances", Method: "List", HTTPMethod: "GET", Path: "aggregate_balances"},
+	{Service: "AggregateBalances", Method: "ListStream", HTTPMethod: "GET", Path: "aggregate_balances"},
+	{Service: "AuthRules", Method: "New", HTTPMethod: "POST", Path: "auth_rules"},
+	{Service: "AuthRules", Method: "Get", HTTPMethod: "GET", Path: "auth_rules/{auth_rule_token}"},
+	{Service: "AuthRules", Method: "Update", HTTPMethod: "PUT", Path: "auth_rules/{auth_rule_token}"},
+	{Service: "AuthRules", Method: "List", HTTPMethod: "GET", Path: "auth_rules"},
+	{Service: "AuthRules", Method: "ListStream", HTTPMethod: "GET", Path: "auth_rules"},
+	{Service: "AuthRules", Method: "Apply", HTTPMethod: "POST", Path: "auth_rules/{auth_rule_token}/apply"},
+	{Service: "AuthRules", Method: "Remove", HTTPMethod: "DELETE", Path: "auth_rules/remove"},
+	{Service: "AuthStreamEnrollment", Method: "Get", HTTPMethod: "GET", Path: "auth_stream"},
+	{Service: "AuthStreamEnrollment", Method: "Disenroll", HTTPMethod: "DELETE", Path: "auth_stream"},
+	{Service: "AuthStreamEnrollment", Method: "Enroll", HTTPMethod: "POST", Path: "auth_stream"},
+	{Service: "AuthStreamEnrollment", Method: "GetSecret", HTTPMethod: "GET", Path: "auth_stream/secret"},
+	{Service: "AuthStreamEnrollment", Method: "RotateSecret", HTTPMethod: "POST", Path: "auth_stream/secret/rotate"},
+	{Service: "Balances", Method: "List", HTTPMethod: "GET", Path: "balances"},
+	{Service: "Balances", Method: "ListStream", HTTPMethod: "GET", Path: "balances"},
+	{Service: "BookTransfers", Method: "New", HTTPMethod: "POST", Path: "book_transfers"},
+	{Service: "BookTransfers", Method: "Get", HTTPMethod: "GET", Path: "book_transfers/{book_transfer_token}"},
+	{Service: "BookTransfers", Method: "List", HTTPMethod: "GET", Path: "book_transfers"},
+	{Service: "BookTransfers", Method: "ListStream", HTTPMethod: "GET", Path: "book_transfers"},
+	{Service: "BookTransfers", Method: "Reverse", HTTPMethod: "POST", Path: "book_transfers/{book_transfer_token}/reverse"},
+	{Service: "Cards", Method: "New", HTTPMethod: "POST", Path: "cards"},
+	{Service: "Cards", Method: "Get", HTTPMethod: "GET", Path: "cards/{card_token}"},
+	{Service: "Cards", Method: "Update", HTTPMethod: "PATCH", Path: "cards/{card_token}"},
+	{Service: "Cards", Method: "List", HTTPMethod: "GET", Path: "cards"},
+	{Service: "Cards", Method: "ListStream", HTTPMethod: "GET", Path: "cards"},
+	{Service: "Cards", Method: "Embed", HTTPMethod: "GET", Path: "embed/card"},
+	{Service: "Cards", Method: "Provision", HTTPMethod: "POST", Path: "cards/{card_token}/provision"},
+	{Service: "Cards", Method: "Reissue", HTTPMethod: "POST", Path: "cards/{card_token}/reissue"},
+	{Service: "Cards", Method: "SearchByPAN", HTTPMethod: "POST", Path: "cards/search_by_pan"},
+	{Service: "Disputes", Method: "New", HTTPMethod: "POST", Path: "disputes"},
+	{Service: "Disputes", Method: "Get", HTTPMethod: "GET", Path: "disputes/{dispute_token}"},
+	{Service: "Disputes", Method: "Update", HTTPMethod: "PATCH", Path: "disputes/{dispute_token}"},
+	{Service: "Disputes", Method: "List", HTTPMethod: "GET", Path: "disputes"},
+	{Service: "Disputes", Method: "ListStream", HTTPMethod: "GET", Path: "disputes"},
+	{Service: "Disputes", Method: "Delete", HTTPMethod: "DELETE", Path: "disputes/{dispute_token}"},
+	{Service: "Disputes", Method: "DeleteEvidence", HTTPMethod: "DELETE", Path: "disputes/{dispute_token}/evidences/{evidence_token}"},
+	{Service: "Disputes", Method: "InitiateEvidenceUpload", HTTPMethod: "POST", Path: "disputes/{dispute_token}/evidences"},
+	{Service: "Disputes", Method: "ListEvidences", HTTPMethod: "GET", Path: "disputes/{dispute_token}/evidences"},
+	{Service: "Disputes", Method: "GetEvidence", HTTPMethod: "GET", Path: "disputes/{dispute_token}/evidences/{evidence_token}"},
+	{Service: "Events", Method: "Get", HTTPMethod: "GET", Path: "events/{event_token}"},
+	{Service: "Events", Method: "List", HTTPMethod: "GET", Path: "events"},
+	{Service: "Events", Method: "ListStream", HTTPMethod: "GET", Path: "events"},
+	{Service: "Events", Method: "ListAttempts", HTTPMethod: "GET", Path: "events/{event_token}/attempts"},
+	{Service: "Events.Subscriptions", Method: "New", HTTPMethod: "POST", Path: "event_subscriptions"},
+	{Service: "Events.Subscriptions", Method: "Get", HTTPMethod: "GET", Path: "event_subscriptions/{event_subscription_token}"},
+	{Service: "Events.Subscriptions", Method: "Update", HTTPMethod: "PATCH", Path: "event_subscriptions/{event_subscription_token}"},
+	{Service: "Events.Subscriptions", Method: "List", HTTPMethod: "GET", Path: "event_subscriptions"},
+	{Service: "Events.Subscriptions", Method: "ListStream", HTTPMethod: "GET", Path: "event_subscriptions"},
+	{Service: "Events.Subscriptions", Method: "Delete", HTTPMethod: "DELETE", Path: "event_subscriptions/{event_subscription_token}"},
+	{Service: "Events.Subscriptions", Method: "Recover", HTTPMethod: "POST", Path: "event_subscriptions/{event_subscription_token}/recover"},
+	{Service: "Events.Subscriptions", Method: "ReplayMissing", HTTPMethod: "POST", Path: "event_subscriptions/{event_subscription_token}/replay_missing"},
+	{Service: "Events.Subscriptions", Method: "GetSecret", HTTPMethod: "GET", Path: "event_subscriptions/{event_subscription_token}/secret"},
+	{Service: "Events.Subscriptions", Method: "RotateSecret", HTTPMethod: "POST", Path: "event_subscriptions/{event_subscription_token}/secret/rotate"},
+	{Service: "Events.Subscriptions", Method: "ListAttempts", HTTPMethod: "GET", Path: "event_subscriptions/{event_subscription_token}/attempts"},
+	{Service: "ExternalBankAccounts", Method: "New", HTTPMethod: "POST", Path: "external_bank_accounts"},
+	{Service: "ExternalBankAccounts", Method: "Get", HTTPMethod: "GET", Path: "external_bank_accounts/{external_bank_account_token}"},
+	{Service: "ExternalBankAccounts", Method: "Update", HTTPMethod: "PATCH", Path: "external_bank_accounts/{external_bank_account_token}"},
+	{Service: "ExternalBankAccounts", Method: "List", HTTPMethod: "GET", Path: "external_bank_accounts"},
+	{Service: "ExternalBankAccounts", Method: "ListStream", HTTPMethod: "GET", Path: "external_bank_accounts"},
+	{Service: "ExternalBankAccounts", Method: "RetryMicroDeposits", HTTPMethod: "POST", Path: "external_bank_accounts/{external_bank_account_token}/retry_micro_deposits"},
+	{Service: "ExternalBankAccounts.MicroDeposits", Method: "New", HTTPMethod: "POST", Path: "external_bank_accounts/{external_bank_account_token}/micro_deposits"},
+	{Service: "FinancialAccounts", Method: "List", HTTPMethod: "GET", Path: "financial_accounts"},
+	{Service: "FinancialAccounts", Method: "ListStream", HTTPMethod: "GET", Path: "financial_accounts"},
+	{Service: "FinancialAccounts.Balances", Method: "List", HTTPMethod: "GET", Path: "financial_accounts/{financial_account_token}/balances"},
+	{Service: "FinancialAccounts.Balances", Method: "ListStream", HTTPMethod: "GET", Path: "financial_accounts/{financial_account_token}/balances"},
+	{Service: "FinancialAccounts.FinancialTransactions", Method: "Get", HTTPMethod: "GET", Path: "financial_accounts/{financial_account_token}/financial_transactions/{financial_transaction_token}"},
+	{Service: "FinancialAccounts.FinancialTransactions", Method: "List", HTTPMethod: "GET", Path: "financial_accounts/{financial_account_token}/financial_transactions"},
+	{Service: "FinancialAccounts.FinancialTransactions", Method: "ListStream", HTTPMethod: "GET", Path: "financial_accounts/{financial_account_token}/financial_transactions"},
+	{Service: "FinancialAccounts.Statements", Method: "Get", HTTPMethod: "GET", Path: "financial_accounts/{financial_account_token}/statements/{statement_token}"},
+	{Service: "FinancialAccounts.Statements", Method: "List", HTTPMethod: "GET", Path: "financial_accounts/{financial_account_token}/statements"},
+	{Service: "FinancialAccounts.Statements", Method: "ListStream", HTTPMethod: "GET", Path: "financial_accounts/{financial_account_token}/statements"},
+	{Service: "FinancialAccounts.Statements.LineItems", Method: "List", HTTPMethod: "GET", Path: "financial_accounts/{financial_account_token}/statements/{statement_token}/line_items"},
+	{Service: "FinancialAccounts.Statements.LineItems", Method: "ListStream", HTTPMethod: "GET", Path: "financial_accounts/{financial_account_token}/statements/{statement_token}/line_items"},
+	{Service: "FundingSources", Method: "New", HTTPMethod: "POST", Path: "funding_sources"},
+	{Service: "FundingSources", Method: "Update", HTTPMethod: "PATCH", Path: "funding_sources/{funding_source_token}"},
+	{Service: "FundingSources", Method: "List", HTTPMethod: "GET", Path: "funding_sources"},
+	{Service: "FundingSources", Method: "ListStream", HTTPMethod: "GET", Path: "funding_sources"},
+	{Service: "FundingSources", Method: "Verify", HTTPMethod: "POST", Path: "funding_sources/{funding_source_token}/verify"},
+	{Service: "Reports.Settlement", Method: "Summary", HTTPMethod: "GET", Path: "reports/settlement/summary/{report_date}"},
+	{Service: "Reports.Settlement", Method: "ListDetails", HTTPMethod: "GET", Path: "reports/settlement/details/{report_date}"},
+	{Service: "ResponderEndpoints", Method: "New", HTTPMethod: "POST", Path: "responder_endpoints"},
+	{Service: "ResponderEndpoints", Method: "Delete", HTTPMethod: "DELETE", Path: "responder_endpoints"},
+	{Service: "ResponderEndpoints", Method: "CheckStatus", HTTPMethod: "GET", Path: "responder_endpoints"},
+	{Service: "ThreeDS", Method: "GetAuthentication", HTTPMethod: "GET", Path: "three_ds_authentication/{three_ds_authentication_token}"},
+	{Service: "ThreeDS", Method: "ChallengeResponse", HTTPMethod: "POST", Path: "three_ds_decisioning/challenge_response"},
+	{Service: "ThreeDS", Method: "GetDecisioningSecret", HTTPMethod: "GET", Path: "three_ds_decisioning/secret"},
+	{Service: "ThreeDS", Method: "RotateDecisioningSecret", HTTPMethod: "POST", Path: "three_ds_decisioning/secret/rotate"},
+	{Service: "TokenizationDecisioning", Method: "GetSecret", HTTPMethod: "GET", Path: "tokenization_decisioning/secret"},
+	{Service: "TokenizationDecisioning", Method: "RotateSecret", HTTPMethod: "POST", Path: "tokenization_decisioning/secret/rotate"},
+	{Service: "Tokenizations", Method: "Get", HTTPMethod: "GET", Path: "tokenizations/{tokenization_token}"},
+	{Service: "Tokenizations", Method: "List", HTTPMethod: "GET", Path: "tokenizations"},
+	{Service: "Tokenizations", Method: "ListStream", HTTPMethod: "GET", Path: "tokenizations"},
+	{Service: "Tokenizations", Method: "Activate", HTTPMethod: "POST", Path: "tokenizations/{tokenization_token}/activate"},
+	{Service: "Tokenizations", Method: "Deactivate", HTTPMethod: "POST", Path: "tokenizations/{tokenization_token}/deactivate"},
+	{Service: "Tokenizations", Method: "Pause", HTTPMethod: "POST", Path: "tokenizations/{tokenization_token}/pause"},
+	{Service: "Tokenizations", Method: "Resume", HTTPMethod: "POST", Path: "tokenizations/{tokenization_token}/unpause"},
+	{Service: "Tokenizations", Method: "UpdateDigitalCardArt", HTTPMethod: "POST", Path: "tokenizations/{tokenization_token}/update_digital_card_art"},
+	{Service: "Transactions", Method: "Get", HTTPMethod: "GET", Path: "transactions/{transaction_token}"},
+	{Service: "Transactions", Method: "List", HTTPMethod: "GET", Path: "transactions"},
+	{Service: "Transactions", Method: "ListStream", HTTPMethod: "GET", Path: "transactions"},
+	{Service: "Transactions", Method: "SimulateAuthorization", HTTPMethod: "POST", Path: "simulate/authorize"},
+	{Service: "Transactions", Method: "SimulateAuthorizationAdvice", HTTPMethod: "POST", Path: "simulate/authorization_advice"},
+	{Service: "Transactions", Method: "SimulateClearing", HTTPMethod: "POST", Path: "simulate/clearing"},
+	{Service: "Transactions", Method: "SimulateCreditAuthorization", HTTPMethod: "POST", Path: "simulate/credit_authorization_advice"},
+	{Service: "Transactions", Method: "SimulateReturn", HTTPMethod: "POST", Path: "simulate/return"},
+	{Service: "Transactions", Method: "SimulateReturnReversal", HTTPMethod: "POST", Path: "simulate/return_reversal"},
+	{Service: "Transactions", Method: "SimulateVoid", HTTPMethod: "POST", Path: "simulate/void"},
+}
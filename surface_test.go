@@ -0,0 +1,82 @@
+package lithic
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAPISurface(t *testing.T) {
+	e, ok := FindEndpoint("POST", "cards/{card_token}/reissue")
+	if !ok {
+		t.Fatal("expected the reissue endpoint to be bound")
+	}
+	want := []Param{
+		{Name: "card_token", In: "path", Type: "string", Required: true},
+		{Name: "shipping_address", In: "body", Type: "requests.ShippingAddress"},
+		{Name: "shipping_method", In: "body", Type: "requests.CardReissueParamsShippingMethod"},
+		{Name: "product_id", In: "body", Type: "string"},
+	}
+	if !reflect.DeepEqual(e.Params, want) {
+		t.Fatalf("unexpected params %+v", e.Params)
+	}
+
+	e, _ = FindEndpoint("GET", "transactions")
+	if len(e.Params) == 0 || e.Params[0].In != "query" {
+		t.Fatalf("expected query params, got %+v", e.Params)
+	}
+}
+
+// helpers are service methods that are built on top of other endpoints.
+var helpers = map[string]bool{
+	"AccountHolders.SubmitDocument":        true,
+	"AccountHolders.UploadDocumentImage":   true,
+	"Cards.GetEmbedHTML":                   true,
+	"Cards.GetEmbedURL":                    true,
+	"Cards.ImportCSV":                      true,
+	"Cards.BulkCreate":                     true,
+	"Cards.ExpiringWithin":                 true,
+	"Cards.ReissueAll":                     true,
+	"Cards.ProvisionApplePay":              true,
+	"Cards.ProvisionGooglePay":             true,
+	"Disputes.UploadEvidence":              true,
+	"Disputes.UploadEvidenceWithResponse":  true,
+	"ResponderEndpoints.WaitUntilEnrolled": true,
+	"Transactions.NewPartialCapture":       true,
+}
+
+// TestAPISurfaceIsComplete checks that every method of every service is either
+// listed in the API surface or a helper.
+func TestAPISurfaceIsComplete(t *testing.T) {
+	listed := map[string]bool{}
+	for _, e := range APISurface() {
+		if _, ok := serviceMethod(reflect.TypeOf(Lithic{}), e.Service, e.Method); !ok {
+			t.Errorf("%s.%s does not exist", e.Service, e.Method)
+		}
+		listed[e.Service+"."+e.Method] = true
+	}
+
+	ctx := reflect.TypeOf((*context.Context)(nil)).Elem()
+	var walk func(prefix string, service reflect.Type)
+	walk = func(prefix string, service reflect.Type) {
+		for i := 0; i < service.NumMethod(); i++ {
+			m := service.Method(i)
+			name := prefix + "." + m.Name
+			if m.Type.NumIn() > 1 && m.Type.In(1) == ctx && !listed[name] && !helpers[name] {
+				t.Errorf("%s is not listed in the API surface", name)
+			}
+		}
+		for i := 0; i < service.Elem().NumField(); i++ {
+			if f := service.Elem().Field(i); f.Type.Kind() == reflect.Pointer && strings.HasSuffix(f.Type.Elem().Name(), "Service") {
+				walk(prefix+"."+f.Name, f.Type)
+			}
+		}
+	}
+	client := reflect.TypeOf(Lithic{})
+	for i := 0; i < client.NumField(); i++ {
+		if f := client.Field(i); f.Type.Kind() == reflect.Pointer && strings.HasSuffix(f.Type.Elem().Name(), "Service") {
+			walk(f.Name, f.Type)
+		}
+	}
+}
@@ -3,7 +3,12 @@ package services
 import (
 	"context"
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"net/http/httputil"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/lithic-com/lithic-go"
@@ -11,8 +16,35 @@ import (
 	"github.com/lithic-com/lithic-go/fields"
 	"github.com/lithic-com/lithic-go/options"
 	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
 )
 
+func TestAccountHoldersNewKYC(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.AccountHolders.New(context.TODO(), &requests.AccountHolderNewParams{KYC: &requests.KYC{Workflow: fields.F(requests.KYCWorkflowKYCAdvanced), TosTimestamp: fields.F("2018-05-29T21:16:05Z"), Individual: fields.F(requests.Individual{Address: fields.F(requests.Address{Address1: fields.F("123 Old Forest Way"), Address2: fields.F("string"), City: fields.F("Omaha"), Country: fields.F("USA"), PostalCode: fields.F("68022"), State: fields.F("NE")}), Dob: fields.F("1991-03-08 08:00:00"), Email: fields.F("tom@middle-earth.com"), FirstName: fields.F("Tom"), GovernmentID: fields.F("111-23-1412"), LastName: fields.F("Bombadil"), PhoneNumber: fields.F("+12124007676")})}})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestAccountHoldersNewKYCExempt(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.AccountHolders.New(context.TODO(), &requests.AccountHolderNewParams{KYCExempt: &requests.KYCExempt{Workflow: fields.F(requests.KYCExemptWorkflowKYCExempt), KYCExemptionType: fields.F(requests.KYCExemptKYCExemptionTypeAuthorizedUser), FirstName: fields.F("Tom"), LastName: fields.F("Bombadil"), Email: fields.F("tom@middle-earth.com"), PhoneNumber: fields.F("+12124007676")}})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
 func TestAccountHoldersGet(t *testing.T) {
 	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
 	_, err := c.AccountHolders.Get(
@@ -125,3 +157,62 @@ func TestAccountHoldersUploadDocument(t *testing.T) {
 		t.Fatalf("err should be nil: %s", err.Error())
 	}
 }
+
+func TestAccountHoldersSubmitDocument(t *testing.T) {
+	var mu sync.Mutex
+	uploaded := map[string]string{}
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/account_holders/ah/documents":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"document_type":"EIN_LETTER","entity_token":"ent"}` {
+				t.Errorf("unexpected body %s", body)
+			}
+			w.Write([]byte(`{"token":"doc","account_holder_token":"ah","entity_token":"ent","document_type":"EIN_LETTER","required_document_uploads":[{"image_type":"front","status":"PENDING","upload_url":"` + server.URL + `/upload/front"}]}`))
+		case r.Method == "POST" && strings.HasPrefix(r.URL.Path, "/upload/"):
+			if r.Header.Get("Authorization") != "" {
+				t.Error("expected the API key not to be sent to the upload URL")
+			}
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			contents, _ := io.ReadAll(file)
+			mu.Lock()
+			uploaded[strings.TrimPrefix(r.URL.Path, "/upload/")] = header.Filename + ":" + string(contents)
+			mu.Unlock()
+		case r.Method == "GET" && r.URL.Path == "/account_holders/ah/documents/doc":
+			w.Write([]byte(`{"token":"doc","document_type":"EIN_LETTER","required_document_uploads":[{"image_type":"front","status":"UPLOADED"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+
+	document, err := c.AccountHolders.SubmitDocument(
+		context.TODO(),
+		"ah",
+		&requests.AccountHolderUploadDocumentParams{DocumentType: fields.F(requests.AccountHolderUploadDocumentParamsDocumentTypeEinLetter), EntityToken: fields.F("ent")},
+		map[responses.AccountHolderDocumentRequiredDocumentUploadsImageType]*requests.AccountHolderUploadDocumentImageParams{
+			responses.AccountHolderDocumentRequiredDocumentUploadsImageTypeFront: {Filename: fields.F("ein.png"), Image: strings.NewReader("png bytes")},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uploaded["front"] != "ein.png:png bytes" {
+		t.Fatalf("unexpected uploads %v", uploaded)
+	}
+	if document.RequiredDocumentUploads[0].Status != responses.AccountHolderDocumentRequiredDocumentUploadsStatusUploaded {
+		t.Fatalf("expected the status after the upload, got %+v", document.RequiredDocumentUploads)
+	}
+
+	_, err = c.AccountHolders.SubmitDocument(context.TODO(), "ah", &requests.AccountHolderUploadDocumentParams{DocumentType: fields.F(requests.AccountHolderUploadDocumentParamsDocumentTypeEinLetter), EntityToken: fields.F("ent")}, nil)
+	if err == nil || !strings.Contains(err.Error(), "no front image") {
+		t.Fatalf("expected a missing image error, got %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http/httputil"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+)
+
+func TestBalancesListWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.Balances.List(context.TODO(), &requests.BalanceListParams{AccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), BalanceDate: fields.F(time.Now()), FinancialAccountType: fields.F(requests.BalanceListParamsFinancialAccountTypeIssuing)})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestFinancialAccountsBalancesListWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.FinancialAccounts.Balances.List(
+		context.TODO(),
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+		&requests.FinancialAccountBalanceListParams{BalanceDate: fields.F(time.Now()), LastTransactionEventToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e")},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestAggregateBalancesListWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.AggregateBalances.List(context.TODO(), &requests.AggregateBalanceListParams{FinancialAccountType: fields.F(requests.AggregateBalanceListParamsFinancialAccountTypeIssuing)})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestBookTransfersNewWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.BookTransfers.New(context.TODO(), &requests.BookTransferNewParams{Amount: fields.F(int64(1)), Category: fields.F(requests.BookTransferCategoryAdjustment), FromFinancialAccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), ToFinancialAccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), Subtype: fields.F("string"), Type: fields.F(requests.BookTransferTypeAtmWithdrawal), Token: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), Memo: fields.F("string")})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestBookTransfersGet(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.BookTransfers.Get(context.TODO(), "182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e")
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestBookTransfersListWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.BookTransfers.List(context.TODO(), &requests.BookTransferListParams{AccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), BusinessAccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), Category: fields.F(requests.BookTransferCategoryBalanceOrFunding), FinancialAccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), Result: fields.F(requests.BookTransferListParamsResultApproved), Status: fields.F(requests.BookTransferListParamsStatusDeclined), Begin: fields.F(time.Now()), End: fields.F(time.Now()), PageSize: fields.F(int64(1)), StartingAfter: fields.F("string"), EndingBefore: fields.F("string")})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestBookTransfersReverseWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.BookTransfers.Reverse(
+		context.TODO(),
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+		&requests.BookTransferReverseParams{Memo: fields.F("string")},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestBookTransfersReverseDecodesStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/book_transfers/bt/reverse" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token":"bt","category":"TRANSFER","result":"APPROVED","status":"REVERSED","events":[{"token":"e1","amount":100,"result":"APPROVED","type":"TRANSFER","detailed_results":["APPROVED"]}]}`))
+	}))
+	defer server.Close()
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+
+	res, err := c.BookTransfers.Reverse(context.TODO(), "bt", &requests.BookTransferReverseParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Category != responses.BookTransferCategoryTransfer || res.Result != responses.BookTransferResultApproved || res.Status != responses.BookTransferStatusReversed {
+		t.Fatalf("unexpected transfer %+v", res)
+	}
+	if len(res.Events) != 1 || res.Events[0].Amount != 100 {
+		t.Fatalf("unexpected events %+v", res.Events)
+	}
+}
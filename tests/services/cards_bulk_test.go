@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+func TestCardsBulkCreate(t *testing.T) {
+	mu := sync.Mutex{}
+	inflight, maxInflight := 0, 0
+	tokens := map[string][]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		params := map[string]string{}
+		json.Unmarshal(body, &params)
+		memo := params["memo"]
+
+		mu.Lock()
+		inflight += 1
+		if inflight > maxInflight {
+			maxInflight = inflight
+		}
+		tokens[memo] = append(tokens[memo], r.Header.Get("Idempotency-Token"))
+		attempt := len(tokens[memo])
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		inflight -= 1
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case memo == "invalid":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message":"invalid"}`))
+		case memo == "flaky" && attempt == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"message":"unavailable"}`))
+		default:
+			fmt.Fprintf(w, `{"token":"card-%s","memo":"%s"}`, memo, memo)
+		}
+	}))
+	defer server.Close()
+
+	memos := []string{"a", "b", "flaky", "c", "invalid", "d", "e"}
+	params := make([]requests.CardNewParams, len(memos))
+	for i, memo := range memos {
+		params[i] = requests.CardNewParams{Type: fields.F(requests.CardNewParamsTypeVirtual), Memo: fields.F(memo)}
+	}
+	var progress []services.BulkProgress
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL), options.WithMaxRetries(0))
+	res := c.Cards.BulkCreate(context.TODO(), params, services.BulkOptions{
+		Concurrency: 2,
+		Retries:     2,
+		RetryDelay:  time.Millisecond,
+		Progress:    func(p services.BulkProgress) { progress = append(progress, p) },
+	})
+
+	if len(res) != len(memos) {
+		t.Fatalf("expected a result per card, got %d", len(res))
+	}
+	for i, result := range res {
+		memo := memos[i]
+		if result.Index != i {
+			t.Errorf("%s: unexpected index %d", memo, result.Index)
+		}
+		if memo == "invalid" {
+			if result.Err == nil || result.Attempts != 1 {
+				t.Errorf("expected the invalid card to fail without retries, got %+v", result)
+			}
+			continue
+		}
+		if result.Err != nil || result.Card.Token != "card-"+memo {
+			t.Errorf("%s: unexpected result %+v", memo, result)
+		}
+	}
+	if res[2].Attempts != 2 || tokens["flaky"][0] != tokens["flaky"][1] {
+		t.Errorf("expected the flaky card to be retried with the same token, got %d attempts with %v", res[2].Attempts, tokens["flaky"])
+	}
+	if maxInflight > 2 {
+		t.Errorf("expected at most 2 cards at once, got %d", maxInflight)
+	}
+	last := progress[len(progress)-1]
+	if len(progress) != len(memos) || last.Done() != len(memos) || last.Created != 6 || last.Failed != 1 || last.Total != len(memos) {
+		t.Errorf("unexpected progress %+v", progress)
+	}
+}
+
+func TestCardsBulkCreateCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:1"))
+	res := c.Cards.BulkCreate(ctx, make([]requests.CardNewParams, 3), services.BulkOptions{Concurrency: 1})
+	for _, result := range res {
+		if result.Err == nil {
+			t.Fatalf("expected the cards to fail, got %+v", result)
+		}
+	}
+}
+
+func TestCardsBulkCreateMiddlewareErrorsNotRetried(t *testing.T) {
+	rejected := errors.New("rejected by middleware")
+	calls := 0
+	reject := func(req *http.Request, next options.MiddlewareNext) (*http.Response, error) {
+		calls += 1
+		return nil, rejected
+	}
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:1"), options.WithMaxRetries(0), options.WithMiddleware(reject))
+	res := c.Cards.BulkCreate(context.TODO(), make([]requests.CardNewParams, 1), services.BulkOptions{Retries: 3, RetryDelay: time.Millisecond})
+	if !errors.Is(res[0].Err, rejected) || res[0].Attempts != 1 || calls != 1 {
+		t.Fatalf("expected the middleware error not to be retried, got %+v after %d calls", res[0], calls)
+	}
+}
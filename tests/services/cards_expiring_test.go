@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+func TestCardsExpiringWithinAndReissueAll(t *testing.T) {
+	now := time.Now().UTC()
+	soon := now.AddDate(0, 1, 0)
+	later := now.AddDate(1, 0, 0)
+	var reissued []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/cards":
+			fmt.Fprintf(w, `{"data":[`+
+				`{"token":"physical","type":"PHYSICAL","state":"OPEN","exp_month":"%02d","exp_year":"%d"},`+
+				`{"token":"virtual","type":"VIRTUAL","state":"OPEN","exp_month":"%02d","exp_year":"%d"},`+
+				`{"token":"closed","type":"PHYSICAL","state":"CLOSED","exp_month":"%02d","exp_year":"%d"},`+
+				`{"token":"later","type":"PHYSICAL","state":"OPEN","exp_month":"%02d","exp_year":"%d"}`+
+				`],"page":1,"total_entries":4,"total_pages":1}`,
+				soon.Month(), soon.Year(), soon.Month(), soon.Year(), soon.Month(), soon.Year(), later.Month(), later.Year())
+		case r.Method == "POST" && r.URL.Path == "/cards/physical/reissue":
+			reissued = append(reissued, r.URL.Path)
+			w.Write([]byte(`{"token":"physical","type":"PHYSICAL","state":"OPEN"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+	cards, err := c.Cards.ExpiringWithin(context.TODO(), 62*24*time.Hour, &requests.CardListParams{})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if len(cards) != 2 || cards[0].Token != "physical" || cards[1].Token != "virtual" {
+		t.Fatalf("expected the open cards expiring soon, got %v", cards)
+	}
+
+	results := c.Cards.ReissueAll(context.TODO(), cards, func(card responses.Card) *requests.CardReissueParams {
+		return &requests.CardReissueParams{ShippingMethod: fields.F(requests.CardReissueParamsShippingMethodExpedited)}
+	})
+	if results[0].Err != nil || results[0].Reissued == nil || len(reissued) != 1 {
+		t.Fatalf("expected the physical card to be reissued, got %+v", results[0])
+	}
+	if !errors.Is(results[1].Err, services.ErrNotReissuable) {
+		t.Fatalf("expected the virtual card to be skipped, got %+v", results[1])
+	}
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+func TestCardsImportCSV(t *testing.T) {
+	var created int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		if body["memo"] == "rejected" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message":"rejected"}`))
+			return
+		}
+		atomic.AddInt32(&created, 1)
+		w.Write([]byte(`{"token":"card-` + body["memo"].(string) + `"}`))
+	}))
+	defer server.Close()
+
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL), options.WithMaxRetries(0))
+	in := strings.NewReader("kind,name,limit\nvirtual,alice,1000\nplastic,bob,1000\nvirtual,rejected,10\nvirtual,carol,\n")
+	res, err := c.Cards.ImportCSV(context.TODO(), in, services.ColumnMapping{Type: "kind", Memo: "name", SpendLimit: "limit"})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if created != 2 || res.Count(services.CardImportStatusCreated) != 2 || res.Count(services.CardImportStatusInvalid) != 1 || res.Count(services.CardImportStatusFailed) != 1 {
+		t.Fatalf("unexpected result %+v", res.Rows)
+	}
+
+	out := bytes.NewBuffer(nil)
+	if err := res.WriteCSV(out); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if lines[0] != "kind,name,limit,import_status,card_token,import_error" || lines[1] != "virtual,alice,1000,CREATED,card-alice," {
+		t.Fatalf("unexpected result CSV\n%s", out.String())
+	}
+	if lines[2] != `plastic,bob,1000,INVALID,,"kind ""plastic"" is not a card type"` {
+		t.Fatalf("unexpected invalid row %s", lines[2])
+	}
+}
+
+func TestCardsImportCSVMissingColumn(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.Cards.ImportCSV(context.TODO(), strings.NewReader("kind\nvirtual\n"), services.ColumnMapping{Type: "kind", Memo: "name"})
+	if err == nil {
+		t.Fatal("expected an error for a missing column")
+	}
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+func provisionServer(t *testing.T, wallet string, payload string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		params := map[string]string{}
+		json.Unmarshal(body, &params)
+		if r.URL.Path != "/cards/card/provision" || params["digital_wallet"] != wallet {
+			t.Errorf("unexpected request %s %s", r.URL.Path, body)
+		}
+		if wallet == "GOOGLE_PAY" && len(params) != 1 {
+			t.Errorf("expected no Apple Pay fields, got %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"provisioning_payload": payload})
+	}))
+}
+
+func TestCardsProvisionApplePay(t *testing.T) {
+	payload := base64.StdEncoding.EncodeToString([]byte(`{"activationData":"YQ==","encryptedPassData":"Yg==","ephemeralPublicKey":"Yw=="}`))
+	server := provisionServer(t, "APPLE_PAY", payload)
+	defer server.Close()
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+
+	res, err := c.Cards.ProvisionApplePay(context.TODO(), "card", "Y2VydA==", "bm9uY2U=", "c2ln")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ActivationData != "YQ==" || res.EncryptedPassData != "Yg==" || res.EphemeralPublicKey != "Yw==" {
+		t.Fatalf("unexpected payload %+v", res)
+	}
+}
+
+func TestCardsProvisionApplePayValidates(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:1"))
+	tests := map[string][4]string{
+		"missing token":       {"", "Y2VydA==", "bm9uY2U=", "c2ln"},
+		"missing certificate": {"card", "", "bm9uY2U=", "c2ln"},
+		"missing nonce":       {"card", "Y2VydA==", "", "c2ln"},
+		"malformed signature": {"card", "Y2VydA==", "bm9uY2U=", "not base64!"},
+	}
+	for name, args := range tests {
+		_, err := c.Cards.ProvisionApplePay(context.TODO(), args[0], args[1], args[2], args[3])
+		if !errors.Is(err, services.ErrInvalidProvisionParams) {
+			t.Errorf("%s: expected ErrInvalidProvisionParams, got %v", name, err)
+		}
+	}
+}
+
+func TestCardsProvisionGooglePay(t *testing.T) {
+	server := provisionServer(t, "GOOGLE_PAY", "b3BhcXVl")
+	defer server.Close()
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+
+	res, err := c.Cards.ProvisionGooglePay(context.TODO(), "card")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.OpaquePaymentCard != "b3BhcXVl" {
+		t.Fatalf("unexpected payload %+v", res)
+	}
+	if _, err := c.Cards.ProvisionGooglePay(context.TODO(), ""); !errors.Is(err, services.ErrInvalidProvisionParams) {
+		t.Fatalf("expected ErrInvalidProvisionParams, got %v", err)
+	}
+}
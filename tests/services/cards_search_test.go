@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+)
+
+const pan = "4111111289144142"
+
+func TestCardsSearchByPAN(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != "POST" || r.URL.Path != "/cards/search_by_pan" || string(body) != `{"pan":"`+pan+`"}` {
+			t.Errorf("unexpected request %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token":"card","last_four":"4142","state":"OPEN"}`))
+	}))
+	defer server.Close()
+
+	var logged []string
+	debug := func(req *http.Request, next options.MiddlewareNext) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		logged = append(logged, string(body))
+		return next(req)
+	}
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL), options.WithMiddleware(debug))
+	token, err := c.Cards.SearchByPAN(context.TODO(), pan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "card" {
+		t.Fatalf("expected the card token, got %q", token)
+	}
+	if len(logged) != 1 || strings.Contains(logged[0], pan) || logged[0] != `{"pan":"[REDACTED]"}` {
+		t.Fatalf("expected the PAN to be redacted, got %q", logged)
+	}
+}
+
+func TestCardSearchByPANParamsString(t *testing.T) {
+	params := requests.CardSearchByPANParams{Pan: fields.F(pan)}
+	if strings.Contains(params.String(), pan) {
+		t.Fatalf("expected the PAN to be redacted, got %s", params)
+	}
+}
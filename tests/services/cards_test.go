@@ -2,8 +2,12 @@ package services
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"net/http/httputil"
+	"net/url"
 	"testing"
 	"time"
 
@@ -112,6 +116,23 @@ func TestCardsGetEmbedURLWithOptionalParams(t *testing.T) {
 	}
 }
 
+func TestCardsGetEmbedURLIsSigned(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	u, err := c.Cards.GetEmbedURL(context.TODO(), &requests.EmbedRequestParams{Token: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e")})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if u.String() != "http://127.0.0.1:4010/embed/card?embed_request=eyJ0b2tlbiI6IjE4MmJkNWU1LTZlMWEtNGZlNC1hNzk5LWFhNmQ5YTZhYjI2ZSJ9&hmac=" + url.QueryEscape(embedHMAC("APIKey", `{"token":"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"}`)) {
+		t.Fatalf("unexpected embed URL %s", u)
+	}
+}
+
+func embedHMAC(key, payload string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(payload))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
 func TestCardsProvisionWithOptionalParams(t *testing.T) {
 	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
 	_, err := c.Cards.Provision(
@@ -1,8 +1,12 @@
 package services
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"net/http/httputil"
 	"testing"
 	"time"
@@ -157,5 +161,37 @@ func TestDisputesGetEvidence(t *testing.T) {
 }
 
 func TestDisputesUploadEvidence(t *testing.T) {
-	t.Skip("TODO")
+	var uploaded []byte
+	var authorization string
+	server := httptest.NewServer(nil)
+	defer server.Close()
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/disputes/dispute_token/evidences":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"upload_url":"` + server.URL + `/upload"}`))
+		case r.Method == "PUT" && r.URL.Path == "/upload":
+			uploaded, _ = io.ReadAll(r.Body)
+			authorization = r.Header.Get("Authorization")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+	err := c.Disputes.UploadEvidence(context.TODO(), "dispute_token", bytes.NewBufferString("%PDF-1.4 evidence"))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if string(uploaded) != "%PDF-1.4 evidence" {
+		t.Fatalf("expected the file to be uploaded as is, got %q", uploaded)
+	}
+	if authorization != "" {
+		t.Fatalf("expected the API key not to be sent to the upload URL")
+	}
+
+	res, err := c.Disputes.UploadEvidenceWithResponse(context.TODO(), "dispute_token", bytes.NewBufferString("%PDF-1.4 evidence"))
+	if err != nil || res.UploadURL != server.URL+"/upload" {
+		t.Fatalf("expected the initiated upload, got %+v %v", res, err)
+	}
 }
@@ -42,3 +42,20 @@ func TestEventsListWithOptionalParams(t *testing.T) {
 		t.Fatalf("err should be nil: %s", err.Error())
 	}
 }
+
+func TestEventsListAttemptsWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.Events.ListAttempts(
+		context.TODO(),
+		"string",
+		&requests.EventListAttemptsParams{Begin: fields.F(time.Now()), End: fields.F(time.Now()), PageSize: fields.F(int64(1)), StartingAfter: fields.F("string"), EndingBefore: fields.F("string"), Status: fields.F(requests.EventListAttemptsParamsStatusFailed)},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"testing"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestExternalBankAccountsNewWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.ExternalBankAccounts.New(context.TODO(), &requests.ExternalBankAccountNewParams{VerificationMethod: fields.F(requests.ExternalBankAccountVerificationMethodMicroDeposit), OwnerType: fields.F(requests.ExternalBankAccountOwnerTypeIndividual), Owner: fields.F("x"), Type: fields.F(requests.ExternalBankAccountTypeChecking), AccountNumber: fields.F("12345678901234567"), RoutingNumber: fields.F("123456789"), Country: fields.F("USA"), Currency: fields.F("USD"), FinancialAccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), AccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), Address: fields.F(requests.Address{Address1: fields.F("123 Old Forest Way"), Address2: fields.F("string"), City: fields.F("Omaha"), Country: fields.F("USA"), PostalCode: fields.F("68022"), State: fields.F("NE")}), CompanyID: fields.F("x"), Dob: fields.F("2019-12-27"), DoingBusinessAs: fields.F("string"), Name: fields.F("x"), UserDefinedID: fields.F("string")})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestExternalBankAccountsGet(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.ExternalBankAccounts.Get(context.TODO(), "182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e")
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestExternalBankAccountsUpdateWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.ExternalBankAccounts.Update(
+		context.TODO(),
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+		&requests.ExternalBankAccountUpdateParams{Owner: fields.F("x"), OwnerType: fields.F(requests.ExternalBankAccountOwnerTypeBusiness), CompanyID: fields.F("x"), Dob: fields.F("2019-12-27"), DoingBusinessAs: fields.F("string"), Name: fields.F("x"), UserDefinedID: fields.F("string")},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestExternalBankAccountsListWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.ExternalBankAccounts.List(context.TODO(), &requests.ExternalBankAccountListParams{AccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), AccountTypes: fields.F([]requests.ExternalBankAccountType{requests.ExternalBankAccountTypeChecking}), Countries: fields.F([]string{"string"}), OwnerTypes: fields.F([]requests.ExternalBankAccountOwnerType{requests.ExternalBankAccountOwnerTypeBusiness}), States: fields.F([]requests.ExternalBankAccountListParamsState{requests.ExternalBankAccountListParamsStateEnabled}), VerificationStates: fields.F([]requests.ExternalBankAccountListParamsVerificationState{requests.ExternalBankAccountListParamsVerificationStatePending}), PageSize: fields.F(int64(1)), StartingAfter: fields.F("string"), EndingBefore: fields.F("string")})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestExternalBankAccountsRetryMicroDepositsWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.ExternalBankAccounts.RetryMicroDeposits(
+		context.TODO(),
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+		&requests.ExternalBankAccountRetryMicroDepositsParams{FinancialAccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e")},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestExternalBankAccountsMicroDepositsNew(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.ExternalBankAccounts.MicroDeposits.New(
+		context.TODO(),
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+		&requests.ExternalBankAccountMicroDepositNewParams{MicroDeposits: fields.F([]int64{int64(0), int64(0)})},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestExternalBankAccountsMicroDepositVerification(t *testing.T) {
+	state := responses.ExternalBankAccountVerificationStatePending
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch r.URL.Path {
+		case "/external_bank_accounts/eba/micro_deposits":
+			params := struct {
+				MicroDeposits []int64 `json:"micro_deposits"`
+			}{}
+			json.Unmarshal(body, &params)
+			attempts++
+			if len(params.MicroDeposits) == 2 && params.MicroDeposits[0] == 12 && params.MicroDeposits[1] == 34 {
+				state = responses.ExternalBankAccountVerificationStateEnabled
+			} else if attempts >= 2 {
+				state = responses.ExternalBankAccountVerificationStateFailedVerification
+			}
+		case "/external_bank_accounts/eba/retry_micro_deposits":
+			attempts = 0
+			state = responses.ExternalBankAccountVerificationStatePending
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"token": "eba", "verification_state": state, "verification_attempts": attempts})
+	}))
+	defer server.Close()
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+
+	verify := func(amounts ...int64) responses.ExternalBankAccountVerificationState {
+		res, err := c.ExternalBankAccounts.MicroDeposits.New(context.TODO(), "eba", &requests.ExternalBankAccountMicroDepositNewParams{MicroDeposits: fields.F(amounts)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.VerificationState
+	}
+
+	if got := verify(1, 2); got != responses.ExternalBankAccountVerificationStatePending {
+		t.Fatalf("expected PENDING after a wrong guess, got %s", got)
+	}
+	if got := verify(3, 4); got != responses.ExternalBankAccountVerificationStateFailedVerification {
+		t.Fatalf("expected FAILED_VERIFICATION, got %s", got)
+	}
+	res, err := c.ExternalBankAccounts.RetryMicroDeposits(context.TODO(), "eba", &requests.ExternalBankAccountRetryMicroDepositsParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.VerificationState != responses.ExternalBankAccountVerificationStatePending || res.VerificationAttempts != 0 {
+		t.Fatalf("expected a fresh PENDING verification, got %+v", res)
+	}
+	if got := verify(12, 34); got != responses.ExternalBankAccountVerificationStateEnabled {
+		t.Fatalf("expected ENABLED, got %s", got)
+	}
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestFinancialAccountsListWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.FinancialAccounts.List(context.TODO(), &requests.FinancialAccountListParams{AccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), BusinessAccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), Type: fields.F(requests.FinancialAccountListParamsTypeIssuing)})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestFinancialAccountsFinancialTransactionsGet(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.FinancialAccounts.FinancialTransactions.Get(
+		context.TODO(),
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestFinancialAccountsFinancialTransactionsListWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.FinancialAccounts.FinancialTransactions.List(
+		context.TODO(),
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+		&requests.FinancialTransactionListParams{Begin: fields.F(time.Now()), Category: fields.F(requests.FinancialTransactionListParamsCategoryACH), End: fields.F(time.Now()), EndingBefore: fields.F("string"), Result: fields.F(requests.FinancialTransactionListParamsResultApproved), StartingAfter: fields.F("string"), Status: fields.F(requests.FinancialTransactionListParamsStatusDeclined)},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestFinancialAccountsStatementsGet(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.FinancialAccounts.Statements.Get(
+		context.TODO(),
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+		"string",
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestFinancialAccountsStatementsListWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.FinancialAccounts.Statements.List(
+		context.TODO(),
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+		&requests.StatementListParams{Begin: fields.F(time.Now()), End: fields.F(time.Now()), PageSize: fields.F(int64(1)), StartingAfter: fields.F("string"), EndingBefore: fields.F("string")},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestFinancialAccountsStatementsLineItemsListWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.FinancialAccounts.Statements.LineItems.List(
+		context.TODO(),
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+		"string",
+		&requests.StatementLineItemListParams{PageSize: fields.F(int64(1)), StartingAfter: fields.F("string"), EndingBefore: fields.F("string")},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestFinancialAccountsStatementsDecodeDates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/financial_accounts/fa/statements":
+			if r.URL.Query().Get("begin") != "2024-01-01" {
+				t.Errorf("expected a date query, got %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"data":[{"token":"st","statement_start_date":"2024-01-01","statement_end_date":"2024-01-31","payment_due_date":"2024-02-25","next_statement_end_date":null,"amount_due":{"amount":-1500,"past_due":0},"period_totals":{"purchases":1500}}],"has_more":false}`))
+		case "/financial_accounts/fa/statements/st/line_items":
+			w.Write([]byte(`{"data":[{"token":"li","amount":1500,"category":"CARD","effective_date":"2024-01-15","event_type":"CLEARING"}],"has_more":false}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+
+	page, err := c.FinancialAccounts.Statements.List(context.TODO(), "fa", &requests.StatementListParams{Begin: fields.F(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	statement := page.GetResponse().GetItems()[0]
+	if !statement.StatementEndDate.Equal(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)) || !statement.NextStatementEndDate.IsZero() {
+		t.Fatalf("unexpected period %s - %s", statement.StatementStartDate, statement.StatementEndDate)
+	}
+	if !statement.Covers(time.Date(2024, 1, 31, 23, 0, 0, 0, time.UTC)) || statement.Covers(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatal("expected the period to include its end date only")
+	}
+	if statement.AmountDue.Amount != -1500 || statement.PeriodTotals.Purchases != 1500 {
+		t.Fatalf("unexpected totals %+v %+v", statement.AmountDue, statement.PeriodTotals)
+	}
+
+	items, err := c.FinancialAccounts.Statements.LineItems.List(context.TODO(), "fa", statement.Token, &requests.StatementLineItemListParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	item := items.GetResponse().GetItems()[0]
+	if item.Category != responses.StatementLineItemCategoryCard || !statement.Covers(item.EffectiveDate) {
+		t.Fatalf("unexpected line item %+v", item)
+	}
+}
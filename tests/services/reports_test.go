@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestReportsSettlementSummary(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.Reports.Settlement.Summary(context.TODO(), time.Now())
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestReportsSettlementListDetailsWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.Reports.Settlement.ListDetails(
+		context.TODO(),
+		time.Now(),
+		&requests.ReportSettlementListDetailsParams{PageSize: fields.F(int64(1)), StartingAfter: fields.F("string"), EndingBefore: fields.F("string")},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestReportsSettlementListDetailsPaginates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reports/settlement/details/2023-09-01" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("starting_after") == "" {
+			w.Write([]byte(`{"data":[{"token":"d1","type":"CLEARING","network":"VISA","transactions_gross_amount":1000}],"has_more":true}`))
+		} else {
+			w.Write([]byte(`{"data":[{"token":"d2","type":"NON-FINANCIAL","network":"MASTERCARD"}],"has_more":false}`))
+		}
+	}))
+	defer server.Close()
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+
+	date := time.Date(2023, 9, 1, 23, 0, 0, 0, time.UTC)
+	page, err := c.Reports.Settlement.ListDetails(context.TODO(), date, &requests.ReportSettlementListDetailsParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var details []responses.SettlementDetail
+	iter := page.Iterator()
+	for iter.Next(context.TODO()) {
+		details = append(details, *iter.Current())
+	}
+	if err := iter.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(details) != 2 || details[0].Type != responses.SettlementDetailTypeClearing || details[0].TransactionsGrossAmount != 1000 || details[1].Type != responses.SettlementDetailTypeNonFinancial {
+		t.Fatalf("unexpected details %+v", details)
+	}
+}
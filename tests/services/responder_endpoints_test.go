@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+)
+
+func TestResponderEndpointsNew(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.ResponderEndpoints.New(context.TODO(), &requests.ResponderEndpointNewParams{Type: fields.F(requests.ResponderEndpointTypeAuthStreamAccess), URL: fields.F("https://example.com")})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestResponderEndpointsDelete(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	err := c.ResponderEndpoints.Delete(context.TODO(), &requests.ResponderEndpointDeleteParams{Type: fields.F(requests.ResponderEndpointTypeAuthStreamAccess)})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestResponderEndpointsCheckStatus(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.ResponderEndpoints.CheckStatus(context.TODO(), &requests.ResponderEndpointCheckStatusParams{Type: fields.F(requests.ResponderEndpointTypeAuthStreamAccess)})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestResponderEndpointsWaitUntilEnrolled(t *testing.T) {
+	checks := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("type") != "THREE_DS_DECISIONING" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		checks++
+		w.Header().Set("Content-Type", "application/json")
+		switch checks {
+		case 1:
+			w.Write([]byte(`{"enrolled":false,"url":null}`))
+		case 2:
+			w.Write([]byte(`{"enrolled":true,"url":"https://old.example.com"}`))
+		default:
+			w.Write([]byte(`{"enrolled":true,"url":"https://new.example.com"}`))
+		}
+	}))
+	defer server.Close()
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+
+	res, err := c.ResponderEndpoints.WaitUntilEnrolled(context.TODO(), requests.ResponderEndpointTypeThreeDSDecisioning, "https://new.example.com", time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checks != 3 || res.URL != "https://new.example.com" {
+		t.Fatalf("expected to wait for the new URL, got %+v after %d checks", res, checks)
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+	defer cancel()
+	res, err = c.ResponderEndpoints.WaitUntilEnrolled(ctx, requests.ResponderEndpointTypeThreeDSDecisioning, "https://other.example.com", time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) || res == nil || res.URL != "https://new.example.com" {
+		t.Fatalf("expected the deadline with the last status, got %+v %v", res, err)
+	}
+}
@@ -158,3 +158,20 @@ func TestSubscriptionsRotateSecret(t *testing.T) {
 		t.Fatalf("err should be nil: %s", err.Error())
 	}
 }
+
+func TestSubscriptionsListAttemptsWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.Events.Subscriptions.ListAttempts(
+		context.TODO(),
+		"string",
+		&requests.SubscriptionListAttemptsParams{Begin: fields.F(time.Now()), End: fields.F(time.Now()), PageSize: fields.F(int64(1)), StartingAfter: fields.F("string"), EndingBefore: fields.F("string"), Status: fields.F(requests.SubscriptionListAttemptsParamsStatusFailed)},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
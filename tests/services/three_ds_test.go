@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+func TestThreeDSGetAuthentication(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.ThreeDS.GetAuthentication(context.TODO(), "182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e")
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestThreeDSChallengeResponse(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	err := c.ThreeDS.ChallengeResponse(context.TODO(), &requests.ThreeDSChallengeResponseParams{
+		Token:             fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"),
+		ChallengeResponse: fields.F(requests.ThreeDSChallengeResponseParamsChallengeResponseApprove),
+	})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestThreeDSChallengeHandler(t *testing.T) {
+	var submitted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && r.URL.Path == "/three_ds_decisioning/challenge_response" {
+			body, _ := io.ReadAll(r.Body)
+			submitted = string(body)
+		}
+	}))
+	defer server.Close()
+
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+	secret := "whsec_zlFsbBZ8Xcodlpcu6NDTdSzZRLSdhkst"
+	var challenged *responses.ThreeDSChallenge
+	handler := services.NewThreeDSChallengeHandler(c.ThreeDS, secret, func(ctx context.Context, challenge *responses.ThreeDSChallenge) (requests.ThreeDSChallengeResponseParamsChallengeResponse, error) {
+		challenged = challenge
+		return requests.ThreeDSChallengeResponseParamsChallengeResponseDeclineByCustomer, nil
+	})
+
+	payload := `{"authentication_object":{"token":"auth","card_token":"card","authentication_result":"PENDING_CHALLENGE","channel":"APP_BASED"},"challenge":{"challenge_method_type":"OUT_OF_BAND","expiry_time":"2023-03-01T12:10:00Z"}}`
+	send := func(signature string) int {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		key, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, "whsec_"))
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte("msg_1." + timestamp + "." + payload))
+		if signature == "" {
+			signature = base64.StdEncoding.EncodeToString(mac.Sum(nil))
+		}
+		r := httptest.NewRequest("POST", "/3ds", strings.NewReader(payload))
+		r.Header.Set("webhook-id", "msg_1")
+		r.Header.Set("webhook-timestamp", timestamp)
+		r.Header.Set("webhook-signature", "v1,"+signature)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	if code := send(base64.StdEncoding.EncodeToString([]byte("forged"))); code != http.StatusUnauthorized || challenged != nil {
+		t.Fatalf("expected a forged challenge to be rejected, got %d", code)
+	}
+	if code := send(""); code != http.StatusOK {
+		t.Fatalf("expected the challenge to be acknowledged, got %d", code)
+	}
+	if challenged.AuthenticationObject.AuthenticationResult != responses.ThreeDSAuthenticationResultPendingChallenge || challenged.Challenge.ExpiryTime.IsZero() {
+		t.Fatalf("unexpected challenge %+v", challenged)
+	}
+	if submitted != `{"challenge_response":"DECLINE_BY_CUSTOMER","token":"auth"}` {
+		t.Fatalf("unexpected challenge response %s", submitted)
+	}
+}
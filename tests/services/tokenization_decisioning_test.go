@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+func TestTokenizationDecisioningGetSecret(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.TokenizationDecisioning.GetSecret(context.TODO())
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestTokenizationDecisioningRotateSecret(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.TokenizationDecisioning.RotateSecret(context.TODO())
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestTokenizationSecretCacheVerifiesAcrossRotation(t *testing.T) {
+	fetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/tokenization_decisioning/secret":
+			fetches++
+			w.Write([]byte(`{"secret":"whsec_zlFsbBZ8Xcodlpcu6NDTdSzZRLSdhkst"}`))
+		case "/tokenization_decisioning/secret/rotate":
+			w.Write([]byte(`{"secret":"whsec_bmV3IHNlY3JldCBhZnRlciByb3RhdGlvbg=="}`))
+		}
+	}))
+	defer server.Close()
+
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+	cache := services.NewTokenizationSecretCache(c.TokenizationDecisioning, 0)
+
+	payload := `{"card_token":"sit Lorem ipsum, accusantium repellendus possimus","created_at":"elit. placeat libero architecto molestias, sit","account_token":"elit.","issuer_decision":"magnam, libero esse Lorem ipsum magnam, magnam,","tokenization_attempt_id":"illum dolor repellendus libero esse accusantium","wallet_decisioning_info":{"device_score":"placeat architecto"},"digital_wallet_token_metadata":{"status":"reprehenderit dolor","token_requestor_id":"possimus","payment_account_info":{"account_holder_data":{"phone_number":"libero","email_address":"nobis molestias, veniam culpa! quas elit. quas libero esse architecto placeat"},"pan_unique_reference":"adipisicing odit magnam, odit"}}}`
+	header := http.Header{}
+	header.Add("webhook-id", "msg_2Lh9KRb0pzN4LePd3XiA4v12Axj")
+	header.Add("webhook-timestamp", "1676312382")
+	header.Add("webhook-signature", "v1,Dwa0AHInLL3XFo2sxcHamOQDrJNi7F654S3L6skMAOI=")
+	now := time.Unix(1676312382, 0)
+
+	for i := 0; i < 2; i++ {
+		if err := cache.VerifySignature(context.TODO(), []byte(payload), header, now); err != nil {
+			t.Fatalf("did not expect error %s", err.Error())
+		}
+	}
+	if fetches != 1 {
+		t.Fatalf("expected the secret to be fetched once, got %d", fetches)
+	}
+
+	if _, err := cache.Rotate(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.VerifySignature(context.TODO(), []byte(payload), header, now); err != nil {
+		t.Fatalf("expected the previous secret to be accepted after rotation: %s", err.Error())
+	}
+
+	// Lithic deactivates the previous secret a day after the rotation.
+	later := time.Now().Add(services.TokenizationSecretOverlap + time.Hour)
+	timestamp := strconv.FormatInt(later.Unix(), 10)
+	key, _ := base64.StdEncoding.DecodeString("zlFsbBZ8Xcodlpcu6NDTdSzZRLSdhkst")
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("msg_2Lh9KRb0pzN4LePd3XiA4v12Axj." + timestamp + "." + payload))
+	header.Set("webhook-timestamp", timestamp)
+	header.Set("webhook-signature", "v1,"+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	if err := cache.VerifySignature(context.TODO(), []byte(payload), header, later); err == nil {
+		t.Fatal("expected the previous secret to be rejected once it has expired")
+	}
+}
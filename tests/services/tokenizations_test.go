@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/core"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestTokenizationsGet(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.Tokenizations.Get(context.TODO(), "182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e")
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestTokenizationsListWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.Tokenizations.List(context.TODO(), &requests.TokenizationListParams{AccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), CardToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), Begin: fields.F(time.Now()), End: fields.F(time.Now()), TokenizationChannel: fields.F(requests.TokenizationListParamsTokenizationChannelDigitalWallet), PageSize: fields.F(int64(1)), StartingAfter: fields.F("string"), EndingBefore: fields.F("string")})
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestTokenizationsUpdateDigitalCardArtWithOptionalParams(t *testing.T) {
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
+	_, err := c.Tokenizations.UpdateDigitalCardArt(
+		context.TODO(),
+		"182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e",
+		&requests.TokenizationUpdateDigitalCardArtParams{DigitalCardArtToken: fields.F("5e9483eb-8103-4e16-9794-2106111b2eca")},
+	)
+	if err != nil {
+		var apiError core.APIError
+		if errors.As(err, &apiError) {
+			body, _ := httputil.DumpRequest(apiError.Request(), true)
+			println(string(body))
+		}
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+}
+
+func TestTokenizationsActions(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/tokenizations/tok" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"token":"tok","card_token":"card","status":"PAUSED","tokenization_channel":"DIGITAL_WALLET","token_requestor_name":"APPLE_PAY","events":[{"token":"ev","result":"TOKEN_SUSPENDED","type":"TOKENIZATION_UPDATED"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+
+	for _, action := range []func(context.Context, string, ...options.RequestOption) error{
+		c.Tokenizations.Activate,
+		c.Tokenizations.Deactivate,
+		c.Tokenizations.Pause,
+		c.Tokenizations.Resume,
+	} {
+		if err := action(context.TODO(), "tok"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	res, err := c.Tokenizations.Get(context.TODO(), "tok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != responses.TokenizationStatusPaused || res.TokenizationChannel != responses.TokenizationChannelDigitalWallet || res.TokenRequestorName != responses.TokenizationTokenRequestorNameApplePay {
+		t.Fatalf("unexpected tokenization %+v", res)
+	}
+	if len(res.Events) != 1 || res.Events[0].Result != responses.TokenizationEventResultTokenSuspended {
+		t.Fatalf("unexpected events %+v", res.Events)
+	}
+	expected := "POST /tokenizations/tok/activate,POST /tokenizations/tok/deactivate,POST /tokenizations/tok/pause,POST /tokenizations/tok/unpause,GET /tokenizations/tok"
+	if got := strings.Join(calls, ","); got != expected {
+		t.Fatalf("unexpected calls %s", got)
+	}
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+func TestTransactionsPartialCapture(t *testing.T) {
+	var cleared []int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/transactions/tx":
+			w.Write([]byte(`{"token":"tx","authorization_amount":10000,"settled_amount":1000,"status":"PENDING"}`))
+		case r.Method == "POST" && r.URL.Path == "/simulate/clearing":
+			var body struct {
+				Token  string `json:"token"`
+				Amount int64  `json:"amount"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.Token != "tx" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			cleared = append(cleared, body.Amount)
+			w.Write([]byte(`{"debugging_request_id":"1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL(server.URL))
+	capture, err := c.Transactions.NewPartialCapture(context.TODO(), "tx")
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if capture.Remaining() != 9000 {
+		t.Fatalf("expected 9000 to remain, got %d", capture.Remaining())
+	}
+
+	res, err := capture.ClearAll(context.TODO(), []int64{4000, 3000, 5000})
+	var amountErr *services.CaptureAmountError
+	if !errors.As(err, &amountErr) || amountErr.Remaining != 2000 {
+		t.Fatalf("expected the last clearing to be rejected, got %v", err)
+	}
+	if len(res) != 2 || len(cleared) != 2 || cleared[0] != 4000 || cleared[1] != 3000 {
+		t.Fatalf("expected two clearings to be sent, got %v", cleared)
+	}
+	if capture.Captured() != 8000 || capture.Remaining() != 2000 {
+		t.Fatalf("unexpected captured %d remaining %d", capture.Captured(), capture.Remaining())
+	}
+	if _, err := capture.Clear(context.TODO(), 0); err == nil {
+		t.Fatal("expected a zero amount to be rejected")
+	}
+}
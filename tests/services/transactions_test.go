@@ -32,7 +32,7 @@ func TestTransactionsGet(t *testing.T) {
 
 func TestTransactionsListWithOptionalParams(t *testing.T) {
 	c := lithic.NewLithic(options.WithAPIKey("APIKey"), options.WithBaseURL("http://127.0.0.1:4010"))
-	_, err := c.Transactions.List(context.TODO(), &requests.TransactionListParams{AccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), CardToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), Result: fields.F(requests.TransactionListParamsResultApproved), Begin: fields.F(time.Now()), End: fields.F(time.Now()), Page: fields.F(int64(0)), PageSize: fields.F(int64(1))})
+	_, err := c.Transactions.List(context.TODO(), &requests.TransactionListParams{AccountToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), CardToken: fields.F("182bd5e5-6e1a-4fe4-a799-aa6d9a6ab26e"), Result: fields.F(requests.TransactionListParamsResultApproved), Status: fields.F(requests.TransactionListParamsStatusSettled), Begin: fields.F(time.Now()), End: fields.F(time.Now()), Page: fields.F(int64(0)), PageSize: fields.F(int64(1))})
 	if err != nil {
 		var apiError core.APIError
 		if errors.As(err, &apiError) {
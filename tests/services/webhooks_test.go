@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/lithic-com/lithic-go"
+	"github.com/lithic-com/lithic-go/services"
 )
 
 func TestVerifySignature(t *testing.T) {
@@ -19,8 +20,38 @@ func TestVerifySignature(t *testing.T) {
 	header.Add("webhook-signature", "v1,Dwa0AHInLL3XFo2sxcHamOQDrJNi7F654S3L6skMAOI=")
 
 	client := lithic.NewLithic()
-	err := client.Webhooks.VerifySignature([]byte(payload), header, secret, time.UnixMilli(1676312382))
+	err := client.Webhooks.VerifySignature([]byte(payload), header, secret, time.Unix(1676312382, 0))
 	if err != nil {
 		t.Fatalf("did not expect error %s", err.Error())
 	}
 }
+
+func TestVerifySignatureWithSecrets(t *testing.T) {
+	secret := "whsec_zlFsbBZ8Xcodlpcu6NDTdSzZRLSdhkst"
+	rotated := "whsec_c2VjcmV0X2Zvcl90ZXN0cw=="
+
+	payload := `{"card_token":"sit Lorem ipsum, accusantium repellendus possimus","created_at":"elit. placeat libero architecto molestias, sit","account_token":"elit.","issuer_decision":"magnam, libero esse Lorem ipsum magnam, magnam,","tokenization_attempt_id":"illum dolor repellendus libero esse accusantium","wallet_decisioning_info":{"device_score":"placeat architecto"},"digital_wallet_token_metadata":{"status":"reprehenderit dolor","token_requestor_id":"possimus","payment_account_info":{"account_holder_data":{"phone_number":"libero","email_address":"nobis molestias, veniam culpa! quas elit. quas libero esse architecto placeat"},"pan_unique_reference":"adipisicing odit magnam, odit"}}}`
+
+	header := http.Header{}
+	header.Add("webhook-id", "msg_2Lh9KRb0pzN4LePd3XiA4v12Axj")
+	header.Add("webhook-timestamp", "1676312382")
+	header.Add("webhook-signature", "v1,Dwa0AHInLL3XFo2sxcHamOQDrJNi7F654S3L6skMAOI=")
+
+	client := lithic.NewLithic()
+	now := time.Unix(1676312382, 0)
+	err := client.Webhooks.VerifySignatureWithSecrets([]byte(payload), header, []services.WebhookSecret{
+		{Secret: rotated},
+		{Secret: secret, ExpiresAt: now.Add(time.Hour)},
+	}, now)
+	if err != nil {
+		t.Fatalf("did not expect error %s", err.Error())
+	}
+
+	err = client.Webhooks.VerifySignatureWithSecrets([]byte(payload), header, []services.WebhookSecret{
+		{Secret: rotated},
+		{Secret: secret, ExpiresAt: now},
+	}, now)
+	if err == nil {
+		t.Fatal("expected the expired secret not to be accepted")
+	}
+}
@@ -0,0 +1,48 @@
+package transactions
+
+import "github.com/lithic-com/lithic-go/responses"
+
+// BalanceImpact returns how a transaction event changes the available and the
+// settled balance of the card's account, in cents. Debits are negative.
+//
+// Events that were not approved have no impact. Otherwise:
+//
+//   - AUTHORIZATION and AUTHORIZATION_ADVICE hold funds, reducing the available
+//     balance only.
+//   - AUTHORIZATION_REVERSAL, AUTHORIZATION_EXPIRY and VOID release held funds.
+//   - CLEARING settles held funds, reducing the settled balance. The available
+//     balance already reflects the hold.
+//   - FINANCIAL_AUTHORIZATION and FINANCIAL_ADVICE, and CORRECTION_DEBIT,
+//     reduce both balances, as they settle without a clearing.
+//   - FINANCIAL_CREDIT_AUTHORIZATION, FINANCIAL_CREDIT_ADVICE and
+//     CORRECTION_CREDIT increase both balances.
+//   - RETURN increases both balances and RETURN_REVERSAL reduces them.
+//   - CREDIT_AUTHORIZATION and CREDIT_AUTHORIZATION_ADVICE have no impact
+//     until the credit is settled by a RETURN.
+//   - BALANCE_INQUIRY, and event types that are unknown, have no impact.
+//
+// The sign of the event amount is ignored, except for advices, which can adjust
+// an earlier authorization in either direction: a negative
+// AUTHORIZATION_ADVICE releases funds.
+func BalanceImpact(event responses.TransactionEvent) (available, settled int64) {
+	if event.Result != responses.TransactionEventResultApproved {
+		return 0, 0
+	}
+	amount := event.Amount
+	if amount < 0 && !event.Type.IsAdvice() {
+		amount = -amount
+	}
+	switch event.Type {
+	case responses.TransactionEventTypeAuthorization, responses.TransactionEventTypeAuthorizationAdvice:
+		return -amount, 0
+	case responses.TransactionEventTypeAuthorizationReversal, responses.TransactionEventTypeAuthorizationExpiry, responses.TransactionEventTypeVoid:
+		return amount, 0
+	case responses.TransactionEventTypeClearing:
+		return 0, -amount
+	case responses.TransactionEventTypeFinancialAuthorization, responses.TransactionEventTypeFinancialAdvice, responses.TransactionEventTypeCorrectionDebit, responses.TransactionEventTypeReturnReversal:
+		return -amount, -amount
+	case responses.TransactionEventTypeFinancialCreditAuthorization, responses.TransactionEventTypeFinancialCreditAdvice, responses.TransactionEventTypeCorrectionCredit, responses.TransactionEventTypeReturn:
+		return amount, amount
+	}
+	return 0, 0
+}
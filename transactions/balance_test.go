@@ -0,0 +1,122 @@
+package transactions
+
+import (
+	"testing"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// impacts are the available and settled deltas of an approved event of each
+// type with an amount of 1000.
+var impacts = map[responses.TransactionEventType][2]int64{
+	responses.TransactionEventTypeAuthorization:                {-1000, 0},
+	responses.TransactionEventTypeAuthorizationAdvice:          {-1000, 0},
+	responses.TransactionEventTypeAuthorizationExpiry:          {1000, 0},
+	responses.TransactionEventTypeAuthorizationReversal:        {1000, 0},
+	responses.TransactionEventTypeBalanceInquiry:               {0, 0},
+	responses.TransactionEventTypeClearing:                     {0, -1000},
+	responses.TransactionEventTypeCorrectionDebit:              {-1000, -1000},
+	responses.TransactionEventTypeCorrectionCredit:             {1000, 1000},
+	responses.TransactionEventTypeCreditAuthorization:          {0, 0},
+	responses.TransactionEventTypeCreditAuthorizationAdvice:    {0, 0},
+	responses.TransactionEventTypeFinancialAuthorization:       {-1000, -1000},
+	responses.TransactionEventTypeFinancialCreditAuthorization: {1000, 1000},
+	responses.TransactionEventTypeFinancialAdvice:              {-1000, -1000},
+	responses.TransactionEventTypeFinancialCreditAdvice:        {1000, 1000},
+	responses.TransactionEventTypeReturn:                       {1000, 1000},
+	responses.TransactionEventTypeReturnReversal:               {-1000, -1000},
+	responses.TransactionEventTypeVoid:                         {1000, 0},
+	"UNKNOWN_EVENT":                                            {0, 0},
+}
+
+var declines = []responses.TransactionEventResult{
+	responses.TransactionEventResultAccountStateTransaction,
+	responses.TransactionEventResultBankConnectionError,
+	responses.TransactionEventResultBankNotVerified,
+	responses.TransactionEventResultCardClosed,
+	responses.TransactionEventResultCardPaused,
+	responses.TransactionEventResultFraudAdvice,
+	responses.TransactionEventResultGlobalTransactionLimit,
+	responses.TransactionEventResultGlobalWeeklyLimit,
+	responses.TransactionEventResultGlobalMonthlyLimit,
+	responses.TransactionEventResultInactiveAccount,
+	responses.TransactionEventResultIncorrectPin,
+	responses.TransactionEventResultInvalidCardDetails,
+	responses.TransactionEventResultInsufficientFunds,
+	responses.TransactionEventResultMerchantBlacklist,
+	responses.TransactionEventResultSingleUseRecharged,
+	responses.TransactionEventResultSwitchInoperativeAdvice,
+	responses.TransactionEventResultUnauthorizedMerchant,
+	responses.TransactionEventResultUnknownHostTimeout,
+	responses.TransactionEventResultUserTransactionLimit,
+	"",
+}
+
+func TestBalanceImpactApproved(t *testing.T) {
+	for typ, want := range impacts {
+		for _, amount := range []int64{1000, -1000} {
+			event := responses.TransactionEvent{Type: typ, Result: responses.TransactionEventResultApproved, Amount: amount}
+			available, settled := BalanceImpact(event)
+			if amount < 0 && typ.IsAdvice() {
+				want = [2]int64{-want[0], -want[1]}
+			}
+			if available != want[0] || settled != want[1] {
+				t.Errorf("%s of %d: expected %d/%d, got %d/%d", typ, amount, want[0], want[1], available, settled)
+			}
+		}
+	}
+}
+
+func TestBalanceImpactDeclined(t *testing.T) {
+	for typ := range impacts {
+		for _, result := range declines {
+			available, settled := BalanceImpact(responses.TransactionEvent{Type: typ, Result: result, Amount: 1000})
+			if available != 0 || settled != 0 {
+				t.Errorf("%s %s: expected no impact, got %d/%d", typ, result, available, settled)
+			}
+		}
+	}
+}
+
+func TestBalanceImpactOfLifecycles(t *testing.T) {
+	approved := responses.TransactionEventResultApproved
+	tests := map[string]struct {
+		events             []responses.TransactionEvent
+		available, settled int64
+	}{
+		"cleared": {
+			events: []responses.TransactionEvent{
+				{Type: responses.TransactionEventTypeAuthorization, Result: approved, Amount: 5000},
+				{Type: responses.TransactionEventTypeAuthorization, Result: responses.TransactionEventResultInsufficientFunds, Amount: 2000},
+				{Type: responses.TransactionEventTypeAuthorizationAdvice, Result: approved, Amount: -1000},
+				{Type: responses.TransactionEventTypeClearing, Result: approved, Amount: 4000},
+			},
+			available: -4000,
+			settled:   -4000,
+		},
+		"voided": {
+			events: []responses.TransactionEvent{
+				{Type: responses.TransactionEventTypeAuthorization, Result: approved, Amount: 5000},
+				{Type: responses.TransactionEventTypeVoid, Result: approved, Amount: -5000},
+			},
+		},
+		"refunded": {
+			events: []responses.TransactionEvent{
+				{Type: responses.TransactionEventTypeCreditAuthorization, Result: approved, Amount: -3000},
+				{Type: responses.TransactionEventTypeReturn, Result: approved, Amount: -3000},
+			},
+			available: 3000,
+			settled:   3000,
+		},
+	}
+	for name, test := range tests {
+		var available, settled int64
+		for _, event := range test.events {
+			a, s := BalanceImpact(event)
+			available, settled = available+a, settled+s
+		}
+		if available != test.available || settled != test.settled {
+			t.Errorf("%s: expected %d/%d, got %d/%d", name, test.available, test.settled, available, settled)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package transactions
+
+import "github.com/lithic-com/lithic-go/responses"
+
+// Changes describes how a set of transactions changed between two polls of the
+// transactions list.
+type Changes struct {
+	// Transactions that are only in the current set.
+	Added []responses.Transaction
+	// Transactions that are in both sets but have changed.
+	Updated []Change
+	// Tokens of the transactions that are only in the previous set.
+	Removed []string
+}
+
+// Change describes how a single transaction changed.
+type Change struct {
+	Token string
+	// The number of events added to the transaction since the previous set. It is
+	// negative if events have disappeared.
+	EventsDelta int
+	// The status of the transaction in the previous set, if it changed.
+	PreviousStatus responses.TransactionStatus
+	// The transaction as it is in the current set.
+	Current responses.Transaction
+}
+
+// StatusChanged reports whether the status of the transaction changed.
+func (r Change) StatusChanged() bool {
+	return r.PreviousStatus != "" && r.PreviousStatus != r.Current.Status
+}
+
+// IsEmpty reports whether the two sets of transactions were equivalent.
+func (r Changes) IsEmpty() bool {
+	return len(r.Added) == 0 && len(r.Updated) == 0 && len(r.Removed) == 0
+}
+
+// Diff compares two sets of transactions by token, so that consumers that poll
+// the transactions list can emit change events. A transaction is considered
+// updated when it gained or lost events, or its status or settled amount
+// changed. The order of the current set is kept in Added and Updated, and the
+// order of the previous set in Removed.
+func Diff(previous, current []responses.Transaction) Changes {
+	diff := Changes{}
+	before := make(map[string]*responses.Transaction, len(previous))
+	for i := range previous {
+		before[previous[i].Token] = &previous[i]
+	}
+	seen := make(map[string]bool, len(current))
+	for _, txn := range current {
+		seen[txn.Token] = true
+		prev, ok := before[txn.Token]
+		if !ok {
+			diff.Added = append(diff.Added, txn)
+			continue
+		}
+		delta := len(txn.Events) - len(prev.Events)
+		if delta == 0 && prev.Status == txn.Status && prev.SettledAmount == txn.SettledAmount {
+			continue
+		}
+		change := Change{Token: txn.Token, EventsDelta: delta, Current: txn}
+		if prev.Status != txn.Status {
+			change.PreviousStatus = prev.Status
+		}
+		diff.Updated = append(diff.Updated, change)
+	}
+	for _, txn := range previous {
+		if !seen[txn.Token] {
+			diff.Removed = append(diff.Removed, txn.Token)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,38 @@
+package transactions
+
+import (
+	"testing"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestDiff(t *testing.T) {
+	previous := []responses.Transaction{
+		{Token: "a", Status: responses.TransactionStatusPending, Events: make([]responses.TransactionEvent, 1)},
+		{Token: "b", Status: responses.TransactionStatusPending, Events: make([]responses.TransactionEvent, 1)},
+		{Token: "c", Status: responses.TransactionStatusSettled, Events: make([]responses.TransactionEvent, 2)},
+	}
+	current := []responses.Transaction{
+		{Token: "a", Status: responses.TransactionStatusPending, Events: make([]responses.TransactionEvent, 1)},
+		{Token: "b", Status: responses.TransactionStatusSettled, Events: make([]responses.TransactionEvent, 3)},
+		{Token: "d", Status: responses.TransactionStatusPending, Events: make([]responses.TransactionEvent, 1)},
+	}
+
+	diff := Diff(previous, current)
+	if len(diff.Added) != 1 || diff.Added[0].Token != "d" {
+		t.Fatalf("unexpected added %v", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0] != "c" {
+		t.Fatalf("unexpected removed %v", diff.Removed)
+	}
+	if len(diff.Updated) != 1 {
+		t.Fatalf("unexpected updated %v", diff.Updated)
+	}
+	change := diff.Updated[0]
+	if change.Token != "b" || change.EventsDelta != 2 || !change.StatusChanged() || change.PreviousStatus != responses.TransactionStatusPending {
+		t.Fatalf("unexpected change %+v", change)
+	}
+	if !Diff(current, current).IsEmpty() {
+		t.Fatal("expected no changes between identical sets")
+	}
+}
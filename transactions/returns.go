@@ -0,0 +1,161 @@
+// Package transactions provides reconciliation helpers for transactions, such as
+// pairing returns with the transactions that they refund.
+package transactions
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+// Reasons that a return was matched to an original transaction.
+const (
+	ReasonAcquirerReferenceNumber = "acquirer_reference_number"
+	ReasonMerchantAcceptorID      = "merchant_acceptor_id"
+	ReasonMerchantDescriptor      = "merchant_descriptor"
+	ReasonAmount                  = "amount"
+	ReasonRecent                  = "recent"
+)
+
+// Weights of the reasons in the confidence of a match.
+var weights = map[string]float64{
+	ReasonAcquirerReferenceNumber: 0.6,
+	ReasonMerchantAcceptorID:      0.25,
+	ReasonMerchantDescriptor:      0.15,
+	ReasonAmount:                  0.15,
+	ReasonRecent:                  0.05,
+}
+
+// MinConfidence is the confidence below which a return is left unmatched.
+const MinConfidence = 0.2
+
+// Returns more than this long after the original transaction are not
+// considered recent.
+const recentWindow = 30 * 24 * time.Hour
+
+// Match pairs a return with the transaction that it refunds.
+type Match struct {
+	Return responses.Transaction
+	// The original transaction, or nil if no transaction matched the return
+	// with at least MinConfidence.
+	Original *responses.Transaction
+	// Confidence of the match, from 0 to 1.
+	Confidence float64
+	// The reasons the transactions were matched, such as
+	// ReasonAcquirerReferenceNumber.
+	Reasons []string
+}
+
+// MatchReturns pairs each of returns with the transaction among originals that
+// it most likely refunds, and returns one Match per return in the same order.
+//
+// Candidates must be on the same card, in the same currency and created before
+// the return. They are scored by their network reference, merchant, amount and
+// age. The best scoring pairs are matched first, and an original can be matched
+// to several partial returns as long as they do not add up to more than its
+// amount.
+func MatchReturns(originals, returns []responses.Transaction) []Match {
+	type candidate struct {
+		original, ret int
+		confidence    float64
+		reasons       []string
+		age           time.Duration
+	}
+	var candidates []candidate
+	for r, ret := range returns {
+		for o, original := range originals {
+			confidence, reasons, ok := score(original, ret)
+			if ok && confidence >= MinConfidence {
+				candidates = append(candidates, candidate{o, r, confidence, reasons, ret.Created.Sub(original.Created)})
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].confidence != candidates[j].confidence {
+			return candidates[i].confidence > candidates[j].confidence
+		}
+		return candidates[i].age < candidates[j].age
+	})
+
+	matches := make([]Match, len(returns))
+	for i, ret := range returns {
+		matches[i].Return = ret
+	}
+	refunded := make([]int64, len(originals))
+	for _, c := range candidates {
+		if matches[c.ret].Original != nil {
+			continue
+		}
+		amount := abs(returns[c.ret].Amount)
+		if refunded[c.original]+amount > amountOf(originals[c.original]) {
+			continue
+		}
+		refunded[c.original] += amount
+		matches[c.ret].Original = &originals[c.original]
+		matches[c.ret].Confidence = c.confidence
+		matches[c.ret].Reasons = c.reasons
+	}
+	return matches
+}
+
+// score returns the confidence that ret refunds original, or false if it cannot.
+func score(original, ret responses.Transaction) (float64, []string, bool) {
+	if original.Token == ret.Token || original.CardToken == "" || original.CardToken != ret.CardToken {
+		return 0, nil, false
+	}
+	if original.MerchantCurrency != "" && ret.MerchantCurrency != "" && original.MerchantCurrency != ret.MerchantCurrency {
+		return 0, nil, false
+	}
+	if !original.Created.IsZero() && !ret.Created.IsZero() && ret.Created.Before(original.Created) {
+		return 0, nil, false
+	}
+	if abs(ret.Amount) > amountOf(original) {
+		return 0, nil, false
+	}
+
+	var reasons []string
+	if original.AcquirerReferenceNumber != "" && original.AcquirerReferenceNumber == ret.AcquirerReferenceNumber {
+		reasons = append(reasons, ReasonAcquirerReferenceNumber)
+	}
+	if original.Merchant.AcceptorID != "" && original.Merchant.AcceptorID == ret.Merchant.AcceptorID {
+		reasons = append(reasons, ReasonMerchantAcceptorID)
+	} else if descriptor(original) != "" && descriptor(original) == descriptor(ret) {
+		reasons = append(reasons, ReasonMerchantDescriptor)
+	}
+	if abs(ret.Amount) == amountOf(original) {
+		reasons = append(reasons, ReasonAmount)
+	}
+	if ret.Created.Sub(original.Created) <= recentWindow {
+		reasons = append(reasons, ReasonRecent)
+	}
+
+	confidence := 0.0
+	for _, reason := range reasons {
+		confidence += weights[reason]
+	}
+	if confidence > 1 {
+		confidence = 1
+	}
+	return confidence, reasons, true
+}
+
+// amountOf returns the amount of a transaction that can be refunded.
+func amountOf(tx responses.Transaction) int64 {
+	if tx.SettledAmount != 0 {
+		return abs(tx.SettledAmount)
+	}
+	return abs(tx.Amount)
+}
+
+func descriptor(tx responses.Transaction) string {
+	return strings.ToUpper(strings.Join(strings.Fields(tx.Merchant.Descriptor), " "))
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
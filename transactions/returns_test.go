@@ -0,0 +1,66 @@
+package transactions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestMatchReturns(t *testing.T) {
+	day := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	tx := func(token string, amount int64, created time.Time, arn string, descriptor string) responses.Transaction {
+		return responses.Transaction{
+			Token:                   token,
+			CardToken:               "card",
+			Amount:                  amount,
+			Created:                 created,
+			AcquirerReferenceNumber: arn,
+			Merchant:                responses.Merchant{Descriptor: descriptor},
+		}
+	}
+	originals := []responses.Transaction{
+		tx("hotel", 30000, day, "arn-1", "HOTEL  CALIFORNIA"),
+		tx("coffee", 500, day, "", "COFFEE"),
+		tx("shoes", 8000, day.AddDate(0, 0, 1), "", "SHOES"),
+	}
+	returns := []responses.Transaction{
+		tx("refund-1", -10000, day.AddDate(0, 0, 2), "arn-1", "Hotel California"),
+		tx("refund-2", -20000, day.AddDate(0, 0, 3), "", "hotel california"),
+		tx("refund-3", -500, day.AddDate(0, 0, 2), "", "COFFEE"),
+		tx("refund-4", -8000, day, "", "SHOES"),
+		tx("refund-5", -1, day.AddDate(0, 0, 2), "", "UNKNOWN"),
+	}
+
+	matches := MatchReturns(originals, returns)
+	if len(matches) != len(returns) {
+		t.Fatalf("expected a match per return, got %d", len(matches))
+	}
+	want := []string{"hotel", "hotel", "coffee", "", ""}
+	for i, m := range matches {
+		got := ""
+		if m.Original != nil {
+			got = m.Original.Token
+		}
+		if got != want[i] {
+			t.Errorf("expected %s to match %q, got %q (%v)", m.Return.Token, want[i], got, m.Reasons)
+		}
+	}
+	if matches[0].Confidence <= matches[1].Confidence {
+		t.Errorf("expected the network reference to raise the confidence, got %v and %v", matches[0].Confidence, matches[1].Confidence)
+	}
+	if matches[2].Confidence != weights[ReasonMerchantDescriptor]+weights[ReasonAmount]+weights[ReasonRecent] {
+		t.Errorf("unexpected confidence %v", matches[2].Confidence)
+	}
+}
+
+func TestMatchReturnsDoesNotOverRefund(t *testing.T) {
+	original := responses.Transaction{Token: "a", CardToken: "card", Amount: 1000, Merchant: responses.Merchant{AcceptorID: "m"}}
+	ret := func(token string) responses.Transaction {
+		return responses.Transaction{Token: token, CardToken: "card", Amount: -600, Merchant: responses.Merchant{AcceptorID: "m"}}
+	}
+	matches := MatchReturns([]responses.Transaction{original}, []responses.Transaction{ret("r1"), ret("r2")})
+	if matches[0].Original == nil || matches[1].Original != nil {
+		t.Fatalf("expected only one return to match, got %+v", matches)
+	}
+}
@@ -0,0 +1,261 @@
+// Package velocity pauses cards whose transactions exceed user-defined velocity
+// thresholds, such as more than 10 approved transactions or $1,000 within an
+// hour.
+package velocity
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	pjson "github.com/lithic-com/lithic-go/core/json"
+	"github.com/lithic-com/lithic-go/fields"
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/requests"
+	"github.com/lithic-com/lithic-go/responses"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+// Threshold is breached when the approved transactions of a single card within
+// Window exceed MaxCount transactions or MaxAmount in total. A limit of 0 is not
+// checked.
+type Threshold struct {
+	Name   string
+	Window time.Duration
+	// Maximum number of approved transactions within Window.
+	MaxCount int
+	// Maximum sum of the amounts of approved transactions within Window, in the
+	// smallest unit of the currency.
+	MaxAmount int64
+}
+
+func (t Threshold) String() string {
+	return fmt.Sprintf("%s (%d transactions or %d within %s)", t.Name, t.MaxCount, t.MaxAmount, t.Window)
+}
+
+// Breach describes a card that exceeded a threshold.
+type Breach struct {
+	CardToken string
+	Threshold Threshold
+	// Number and total amount of the approved transactions within the window,
+	// including the transaction that breached the threshold.
+	Count       int
+	Amount      int64
+	Transaction responses.Transaction
+}
+
+func (b Breach) String() string {
+	return fmt.Sprintf("card %s breached %s with %d transactions totalling %d", b.CardToken, b.Threshold, b.Count, b.Amount)
+}
+
+type entry struct {
+	token   string
+	created time.Time
+	amount  int64
+}
+
+// Monitor watches the transactions of cards and pauses a card through
+// CardService.Update the first time it breaches one of the thresholds.
+//
+// Transactions can be passed to Observe as they arrive, for example from
+// transaction webhooks, or be picked up from the transactions fetched through the
+// client by installing Middleware.
+type Monitor struct {
+	// Service used to pause cards.
+	Cards      *services.CardService
+	Thresholds []Threshold
+	// Called before a card is paused. Returning false leaves the card open, for
+	// example to only notify about the breach, or to defer to a human.
+	BeforePause func(ctx context.Context, breach Breach) bool
+	// Called after a card was paused, or pausing it failed.
+	AfterPause func(ctx context.Context, breach Breach, err error)
+
+	now    func() time.Time
+	mu     sync.Mutex
+	seen   map[string][]entry
+	paused map[string]bool
+	// The last time the histories of idle cards were pruned.
+	pruned time.Time
+}
+
+func NewMonitor(cards *services.CardService, thresholds ...Threshold) *Monitor {
+	return &Monitor{Cards: cards, Thresholds: thresholds}
+}
+
+func (m *Monitor) clock() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+	return time.Now()
+}
+
+// Observe records a transaction and pauses its card if that breaches a
+// threshold. Only approved transactions are counted, and observing the same
+// transaction again has no effect. The breach is returned even if the card was
+// not paused; a card that was already paused by the monitor is not paused or
+// reported again until Reset is called.
+func (m *Monitor) Observe(ctx context.Context, tx responses.Transaction) (*Breach, error) {
+	if tx.Result != responses.TransactionResultApproved || tx.CardToken == "" {
+		return nil, nil
+	}
+	breach := m.record(tx)
+	if breach == nil {
+		return nil, nil
+	}
+	if m.BeforePause != nil && !m.BeforePause(ctx, *breach) {
+		return breach, nil
+	}
+	_, err := m.Cards.Update(ctx, breach.CardToken, &requests.CardUpdateParams{State: fields.F(requests.CardUpdateParamsStatePaused)})
+	if err != nil {
+		// Allow the next transaction to try again.
+		m.mu.Lock()
+		delete(m.paused, breach.CardToken)
+		m.mu.Unlock()
+	}
+	if m.AfterPause != nil {
+		m.AfterPause(ctx, *breach, err)
+	}
+	return breach, err
+}
+
+// record adds the transaction to the history of its card and returns the first
+// threshold it breaches, marking the card as paused.
+func (m *Monitor) record(tx responses.Transaction) *Breach {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.seen == nil {
+		m.seen = map[string][]entry{}
+		m.paused = map[string]bool{}
+	}
+	created := tx.Created
+	if created.IsZero() {
+		created = m.clock()
+	}
+
+	history := m.seen[tx.CardToken]
+	for _, e := range history {
+		if e.token == tx.Token {
+			return nil
+		}
+	}
+	history = append(history, entry{tx.Token, created, tx.Amount})
+
+	// Drop the transactions that are outside of every window.
+	var longest time.Duration
+	for _, t := range m.Thresholds {
+		if t.Window > longest {
+			longest = t.Window
+		}
+	}
+	now := m.clock()
+	kept := history[:0]
+	for _, e := range history {
+		if !e.created.Before(now.Add(-longest)) {
+			kept = append(kept, e)
+		}
+	}
+	m.seen[tx.CardToken] = kept
+	if now.Sub(m.pruned) >= longest {
+		m.prune(now.Add(-longest))
+		m.pruned = now
+	}
+
+	if m.paused[tx.CardToken] {
+		return nil
+	}
+	for _, t := range m.Thresholds {
+		count, amount := 0, int64(0)
+		for _, e := range kept {
+			if !e.created.Before(now.Add(-t.Window)) {
+				count += 1
+				amount += e.amount
+			}
+		}
+		if t.MaxCount > 0 && count > t.MaxCount || t.MaxAmount > 0 && amount > t.MaxAmount {
+			m.paused[tx.CardToken] = true
+			return &Breach{CardToken: tx.CardToken, Threshold: t, Count: count, Amount: amount, Transaction: tx}
+		}
+	}
+	return nil
+}
+
+// prune forgets the histories of the cards that have no transaction since
+// cutoff, so that cards that go idle do not accumulate. It must be called with
+// mu held.
+func (m *Monitor) prune(cutoff time.Time) {
+	for card, history := range m.seen {
+		idle := true
+		for _, e := range history {
+			if !e.created.Before(cutoff) {
+				idle = false
+				break
+			}
+		}
+		if idle {
+			delete(m.seen, card)
+		}
+	}
+}
+
+// Reset forgets that a card was paused by the monitor, along with its history,
+// for example after the card has been reviewed and resumed.
+func (m *Monitor) Reset(cardToken string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.seen, cardToken)
+	delete(m.paused, cardToken)
+}
+
+// Middleware returns an options.Middleware that observes the transactions
+// returned by `TransactionService.Get` and `TransactionService.List`. Errors from
+// pausing a card are reported to AfterPause rather than to the caller.
+//
+//	client := lithic.NewLithic()
+//	monitor := velocity.NewMonitor(client.Cards, velocity.Threshold{Window: time.Hour, MaxCount: 10})
+//	page, err := client.Transactions.List(ctx, &requests.TransactionListParams{}, options.WithMiddleware(monitor.Middleware()))
+func (m *Monitor) Middleware() options.Middleware {
+	return func(req *http.Request, next options.MiddlewareNext) (*http.Response, error) {
+		res, err := next(req)
+		if err != nil || req.Method != http.MethodGet || res.StatusCode != http.StatusOK || !isTransactions(req) {
+			return res, err
+		}
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		res.Body = io.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return res, nil
+		}
+		page := struct {
+			Data []responses.Transaction `json:"data"`
+		}{}
+		if pjson.Unmarshal(body, &page) != nil {
+			return res, nil
+		}
+		if page.Data == nil {
+			tx := responses.Transaction{}
+			if pjson.Unmarshal(body, &tx) != nil {
+				return res, nil
+			}
+			page.Data = append(page.Data, tx)
+		}
+		for _, tx := range page.Data {
+			m.Observe(req.Context(), tx)
+		}
+		return res, nil
+	}
+}
+
+func isTransactions(req *http.Request) bool {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		if segment == "transactions" {
+			return len(segments)-i <= 2
+		}
+	}
+	return false
+}
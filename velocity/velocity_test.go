@@ -0,0 +1,115 @@
+package velocity
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go/options"
+	"github.com/lithic-com/lithic-go/responses"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+func newMonitor(t *testing.T, updates *[]string, thresholds ...Threshold) (*Monitor, *httptest.Server) {
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "PATCH":
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			*updates = append(*updates, r.URL.Path+" "+string(body))
+			mu.Unlock()
+			w.Write([]byte(`{"token":"card_token","state":"PAUSED"}`))
+		case r.URL.Path == "/transactions":
+			now := time.Now().UTC().Format(time.RFC3339)
+			fmt.Fprintf(w, `{"data":[{"token":"a","card_token":"card_token","amount":600,"result":"APPROVED","created":%q},{"token":"b","card_token":"card_token","amount":600,"result":"APPROVED","created":%q}],"has_more":false}`, now, now)
+		}
+	}))
+	t.Cleanup(server.Close)
+	cards := services.NewCardService(options.WithBaseURL(server.URL), options.WithMaxRetries(0))
+	return NewMonitor(cards, thresholds...), server
+}
+
+func TestObservePausesOnce(t *testing.T) {
+	var updates []string
+	monitor, _ := newMonitor(t, &updates, Threshold{Name: "hourly", Window: time.Hour, MaxCount: 2})
+	notified := 0
+	monitor.BeforePause = func(ctx context.Context, breach Breach) bool {
+		notified += 1
+		return true
+	}
+
+	now := time.Now()
+	for i, result := range []responses.TransactionResult{"APPROVED", "DECLINED", "APPROVED", "APPROVED", "APPROVED"} {
+		tx := responses.Transaction{Token: fmt.Sprint(i), CardToken: "card_token", Result: result, Created: now}
+		breach, err := monitor.Observe(context.Background(), tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (i == 3) != (breach != nil) {
+			t.Fatalf("expected only the fourth transaction to breach the threshold, got %v at %d", breach, i)
+		}
+	}
+	if notified != 1 || len(updates) != 1 || updates[0] != `/cards/card_token {"state":"PAUSED"}` {
+		t.Fatalf("expected the card to be paused once, got %d notifications and %v", notified, updates)
+	}
+}
+
+func TestBeforePauseVeto(t *testing.T) {
+	var updates []string
+	monitor, _ := newMonitor(t, &updates, Threshold{Window: time.Hour, MaxAmount: 1000})
+	monitor.BeforePause = func(ctx context.Context, breach Breach) bool { return false }
+
+	breach, err := monitor.Observe(context.Background(), responses.Transaction{Token: "a", CardToken: "card_token", Amount: 1500, Result: "APPROVED"})
+	if err != nil || breach == nil || breach.Amount != 1500 {
+		t.Fatalf("expected a breach, got %v and %v", breach, err)
+	}
+	if len(updates) != 0 {
+		t.Fatalf("expected the card not to be paused, got %v", updates)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var updates []string
+	monitor, server := newMonitor(t, &updates, Threshold{Window: time.Hour, MaxAmount: 1000})
+	transactions := services.NewTransactionService(options.WithBaseURL(server.URL), options.WithMaxRetries(0), options.WithMiddleware(monitor.Middleware()))
+
+	page, err := transactions.List(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.GetResponse().GetItems()) != 2 {
+		t.Fatalf("expected the response to be passed through")
+	}
+	if len(updates) != 1 {
+		t.Fatalf("expected the card to be paused, got %v", updates)
+	}
+}
+
+func TestIdleCardsArePruned(t *testing.T) {
+	var updates []string
+	monitor, _ := newMonitor(t, &updates, Threshold{Window: time.Hour, MaxCount: 10})
+	now := time.Now()
+	monitor.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		tx := responses.Transaction{Token: fmt.Sprint(i), CardToken: fmt.Sprint("card_", i), Result: "APPROVED", Created: now}
+		if _, err := monitor.Observe(context.Background(), tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now = now.Add(2 * time.Hour)
+	tx := responses.Transaction{Token: "3", CardToken: "card_3", Result: "APPROVED", Created: now}
+	if _, err := monitor.Observe(context.Background(), tx); err != nil {
+		t.Fatal(err)
+	}
+	if len(monitor.seen) != 1 || monitor.seen["card_3"] == nil {
+		t.Fatalf("expected only the active card to be kept, got %v", monitor.seen)
+	}
+}
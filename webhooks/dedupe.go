@@ -0,0 +1,94 @@
+package webhooks
+
+import (
+	"context"
+	"time"
+
+	"github.com/lithic-com/lithic-go/dedupe"
+)
+
+// DedupeStore records the tokens of the events that a Handler handles, so
+// that an event that is delivered more than once is handled once within the
+// retention window of the store. Implementations must be safe for concurrent
+// use, and shared by every instance of a service for exactly-once handling
+// across them.
+type DedupeStore interface {
+	// Claim records token and reports whether it was not recorded before, in
+	// which case the event is handled. Claims are atomic: when an event is
+	// delivered concurrently, only one delivery claims it.
+	Claim(ctx context.Context, token string) (bool, error)
+	// Release forgets token after its event failed to be handled, so that its
+	// next delivery is handled.
+	Release(ctx context.Context, token string) error
+}
+
+// MemoryDedupeStore is a DedupeStore that records tokens in memory, for a
+// single instance of a service.
+type MemoryDedupeStore struct {
+	filter *dedupe.Filter
+}
+
+// NewMemoryDedupeStore returns a store that records at most maxKeys tokens,
+// each for ttl. Non-positive values are replaced by dedupe.DefaultMaxKeys and
+// dedupe.DefaultTTL.
+func NewMemoryDedupeStore(maxKeys int, ttl time.Duration) *MemoryDedupeStore {
+	return &MemoryDedupeStore{filter: dedupe.New(maxKeys, ttl)}
+}
+
+func (s *MemoryDedupeStore) Claim(ctx context.Context, token string) (bool, error) {
+	return !s.filter.Seen(token), nil
+}
+
+func (s *MemoryDedupeStore) Release(ctx context.Context, token string) error {
+	s.filter.Forget(token)
+	return nil
+}
+
+// RedisClient is the subset of a Redis client that RedisDedupeStore uses. The
+// client of github.com/redis/go-redis is adapted with:
+//
+//	type redisClient struct{ *redis.Client }
+//
+//	func (c redisClient) SetNX(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
+//		return c.Client.SetNX(ctx, key, value, ttl).Result()
+//	}
+//
+//	func (c redisClient) Del(ctx context.Context, keys ...string) error {
+//		return c.Client.Del(ctx, keys...).Err()
+//	}
+type RedisClient interface {
+	// SetNX sets key to value with an expiry of ttl, if key is not set, and
+	// reports whether it was set, like the SET key value NX PX command.
+	SetNX(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error)
+	Del(ctx context.Context, keys ...string) error
+}
+
+// RedisDedupeStore is a DedupeStore that records tokens in Redis, or any store
+// with the semantics of RedisClient, so that they are shared by every instance
+// of a service.
+type RedisDedupeStore struct {
+	Client RedisClient
+	// Prefixes the keys of the tokens. Defaults to "lithic:webhooks:".
+	Prefix string
+	// The time for which tokens are recorded. Defaults to dedupe.DefaultTTL.
+	TTL time.Duration
+}
+
+func (s *RedisDedupeStore) key(token string) string {
+	if s.Prefix == "" {
+		return "lithic:webhooks:" + token
+	}
+	return s.Prefix + token
+}
+
+func (s *RedisDedupeStore) Claim(ctx context.Context, token string) (bool, error) {
+	ttl := s.TTL
+	if ttl <= 0 {
+		ttl = dedupe.DefaultTTL
+	}
+	return s.Client.SetNX(ctx, s.key(token), time.Now().Unix(), ttl)
+}
+
+func (s *RedisDedupeStore) Release(ctx context.Context, token string) error {
+	return s.Client.Del(ctx, s.key(token))
+}
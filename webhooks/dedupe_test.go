@@ -0,0 +1,101 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go/responses"
+)
+
+func TestHandlerDedupe(t *testing.T) {
+	calls := 0
+	h := NewHandler(secret, Handlers{
+		OnCardCreated: func(ctx context.Context, event *responses.Event, card *responses.CardCreatedEvent) error {
+			calls++
+			if calls == 1 {
+				return errors.New("unavailable")
+			}
+			return nil
+		},
+	})
+	h.Dedupe = NewMemoryDedupeStore(10, time.Hour)
+	body := `{"token":"evt_1","event_type":"card.created","payload":{"card_token":"card"}}`
+
+	if code, res := post(t, h, "msg_1", body, true); code != http.StatusInternalServerError {
+		t.Fatalf("expected the handler to fail, got %d %+v", code, res)
+	}
+	if code, res := post(t, h, "msg_1", body, true); code != http.StatusOK || res.Status != "handled" {
+		t.Fatalf("expected the retry of a failed event to be handled, got %d %+v", code, res)
+	}
+	if code, res := post(t, h, "msg_1", body, true); code != http.StatusOK || res.Status != "duplicate" || res.EventToken != "evt_1" {
+		t.Fatalf("expected the redelivered event to be a duplicate, got %d %+v", code, res)
+	}
+	// Forged webhooks are rejected before they can claim a token.
+	if code, _ := post(t, h, "msg_2", `{"token":"evt_2","event_type":"card.created","payload":{}}`, false); code != http.StatusUnauthorized {
+		t.Fatalf("expected a forged webhook to be rejected, got %d", code)
+	}
+	if code, res := post(t, h, "msg_2", `{"token":"evt_2","event_type":"card.created","payload":{}}`, true); res.Status != "handled" {
+		t.Fatalf("expected the event to be handled, got %d %+v", code, res)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+}
+
+type fakeRedis struct {
+	mu   sync.Mutex
+	keys map[string]time.Duration
+	err  error
+}
+
+func (r *fakeRedis) SetNX(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return false, r.err
+	}
+	if _, ok := r.keys[key]; ok {
+		return false, nil
+	}
+	r.keys[key] = ttl
+	return true, nil
+}
+
+func (r *fakeRedis) Del(ctx context.Context, keys ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, key := range keys {
+		delete(r.keys, key)
+	}
+	return nil
+}
+
+func TestRedisDedupeStore(t *testing.T) {
+	client := &fakeRedis{keys: map[string]time.Duration{}}
+	store := &RedisDedupeStore{Client: client}
+	ctx := context.Background()
+	if claimed, err := store.Claim(ctx, "evt_1"); !claimed || err != nil {
+		t.Fatalf("expected the token to be claimed, got %v %v", claimed, err)
+	}
+	if claimed, _ := store.Claim(ctx, "evt_1"); claimed {
+		t.Fatal("expected the token to be claimed once")
+	}
+	if ttl := client.keys["lithic:webhooks:evt_1"]; ttl <= 0 {
+		t.Fatalf("expected the key to expire, got %v", client.keys)
+	}
+	store.Release(ctx, "evt_1")
+	if claimed, _ := store.Claim(ctx, "evt_1"); !claimed {
+		t.Fatal("expected a released token to be claimed again")
+	}
+
+	client.err = errors.New("connection refused")
+	h := NewHandler(secret, Handlers{})
+	h.Dedupe = store
+	if code, res := post(t, h, "msg_1", `{"token":"evt_2","event_type":"card.created","payload":{}}`, true); code != http.StatusInternalServerError || res.Error.Type != ErrorTypeDedupeFailed {
+		t.Fatalf("expected the webhook to be retried when the store fails, got %d %+v", code, res)
+	}
+}
@@ -0,0 +1,325 @@
+// Package webhooks serves the webhooks of Lithic event subscriptions. A
+// Handler verifies the signature of every webhook, decodes its payload into the
+// struct of its event type and calls the handler registered for it:
+//
+//	http.Handle("/webhooks", webhooks.NewHandler(secret, webhooks.Handlers{
+//		OnTransactionUpdated: func(ctx context.Context, event *responses.Event, tx *responses.Transaction) error {
+//			return ledger.Record(ctx, tx)
+//		},
+//		OnCardCreated: func(ctx context.Context, event *responses.Event, card *responses.CardCreatedEvent) error {
+//			return provision(ctx, card.CardToken)
+//		},
+//	}))
+//
+// The handler replies 200 once a webhook was handled or ignored, 4xx to
+// webhooks that are not valid, which retrying cannot fix, and 500 when a
+// handler failed or panicked, so that Lithic retries the webhook.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/lithic-com/lithic-go/events"
+	"github.com/lithic-com/lithic-go/responses"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+// MaxBodySize is the size above which webhooks are rejected.
+const MaxBodySize = 1 << 20
+
+// The types of error responses.
+const (
+	ErrorTypeMethodNotAllowed = "method_not_allowed"
+	ErrorTypeBodyTooLarge     = "body_too_large"
+	ErrorTypeInvalidSignature = "invalid_signature"
+	ErrorTypeInvalidPayload   = "invalid_payload"
+	ErrorTypeHandlerFailed    = "handler_failed"
+	ErrorTypeHandlerPanicked  = "handler_panicked"
+	ErrorTypeDedupeFailed     = "dedupe_failed"
+)
+
+// Func handles the events of a type, whose payloads decode into a *T.
+type Func[T any] func(ctx context.Context, event *responses.Event, payload *T) error
+
+// Handlers are the functions that handle each event type. Events of a type
+// without a handler are passed to OnEvent, or acknowledged and ignored.
+type Handlers struct {
+	OnAccountHolderCreated                             Func[responses.AccountHolderCreatedEvent]
+	OnAccountHolderUpdated                             Func[responses.AccountHolderUpdatedEvent]
+	OnAccountHolderVerification                        Func[responses.AccountHolderVerificationEvent]
+	OnBalanceUpdated                                   Func[responses.BalanceUpdatedEvent]
+	OnCardCreated                                      Func[responses.CardCreatedEvent]
+	OnCardRenewed                                      Func[responses.CardRenewedEvent]
+	OnCardShipped                                      Func[responses.CardShippedEvent]
+	OnTransactionUpdated                               Func[responses.Transaction]
+	OnDigitalWalletTokenizationApprovalRequest         Func[responses.DigitalWalletTokenizationApprovalRequestEvent]
+	OnDigitalWalletTokenizationResult                  Func[responses.DigitalWalletTokenizationResultEvent]
+	OnDigitalWalletTokenizationTwoFactorAuthentication Func[responses.DigitalWalletTokenizationTwoFactorAuthenticationCodeEvent]
+	OnDisputeUpdated                                   Func[responses.Dispute]
+	OnDisputeEvidenceUploadFailed                      Func[responses.DisputeEvidence]
+	OnExternalBankAccountCreated                       Func[responses.ExternalBankAccount]
+	OnExternalBankAccountUpdated                       Func[responses.ExternalBankAccount]
+	OnFinancialAccountCreated                          Func[responses.FinancialAccount]
+	OnSettlementReportUpdated                          Func[responses.SettlementReport]
+	OnStatementsCreated                                Func[responses.Statement]
+	OnThreeDSAuthenticationCreated                     Func[responses.ThreeDSAuthentication]
+	// OnEvent is called for the events that have no handler above, with their
+	// decoded payload, or nil if their type is unknown.
+	OnEvent events.HandlerFunc
+}
+
+func (h Handlers) dispatcher() *events.Dispatcher {
+	d := events.NewDispatcher()
+	on(d, responses.EventEventTypeAccountHolderCreated, h.OnAccountHolderCreated)
+	on(d, responses.EventEventTypeAccountHolderUpdated, h.OnAccountHolderUpdated)
+	on(d, responses.EventEventTypeAccountHolderVerification, h.OnAccountHolderVerification)
+	on(d, responses.EventEventTypeBalanceUpdated, h.OnBalanceUpdated)
+	on(d, responses.EventEventTypeCardCreated, h.OnCardCreated)
+	on(d, responses.EventEventTypeCardRenewed, h.OnCardRenewed)
+	on(d, responses.EventEventTypeCardShipped, h.OnCardShipped)
+	on(d, responses.EventEventTypeCardTransactionUpdated, h.OnTransactionUpdated)
+	on(d, responses.EventEventTypeDigitalWalletTokenizationApprovalRequest, h.OnDigitalWalletTokenizationApprovalRequest)
+	on(d, responses.EventEventTypeDigitalWalletTokenizationResult, h.OnDigitalWalletTokenizationResult)
+	on(d, responses.EventEventTypeDigitalWalletTokenizationTwoFactorAuthenticationCode, h.OnDigitalWalletTokenizationTwoFactorAuthentication)
+	on(d, responses.EventEventTypeDisputeUpdated, h.OnDisputeUpdated)
+	on(d, responses.EventEventTypeDisputeEvidenceUploadFailed, h.OnDisputeEvidenceUploadFailed)
+	on(d, responses.EventEventTypeExternalBankAccountCreated, h.OnExternalBankAccountCreated)
+	on(d, responses.EventEventTypeExternalBankAccountUpdated, h.OnExternalBankAccountUpdated)
+	on(d, responses.EventEventTypeFinancialAccountCreated, h.OnFinancialAccountCreated)
+	on(d, responses.EventEventTypeSettlementReportUpdated, h.OnSettlementReportUpdated)
+	on(d, responses.EventEventTypeStatementsCreated, h.OnStatementsCreated)
+	on(d, responses.EventEventTypeThreeDSAuthenticationCreated, h.OnThreeDSAuthenticationCreated)
+	d.Unhandled = h.OnEvent
+	return d
+}
+
+func on[T any](d *events.Dispatcher, eventType responses.EventEventType, fn Func[T]) {
+	if fn != nil {
+		events.Handle(d, eventType, fn)
+	}
+}
+
+// Handler is an http.Handler that serves webhooks, see the package
+// documentation. A Handler that is not created by NewHandler has no handlers,
+// and acknowledges the webhooks it verifies without handling them.
+type Handler struct {
+	// The secret of the event subscription that webhooks are signed with, as
+	// returned by EventsSubscriptionService.GetSecret.
+	Secret string
+	// Other secrets that webhooks are accepted with until they expire, such as
+	// the previous secret while the secret is rotated, see RotateSecret.
+	Secrets []services.WebhookSecret
+	// Called when a webhook is rejected or fails to be handled, if it is not
+	// nil.
+	OnError func(r *http.Request, err error)
+	// If Dedupe is not nil, events whose tokens it already recorded are
+	// acknowledged with the status "duplicate" without being handled, and the
+	// tokens of events that fail to be handled are released so that their
+	// retries are handled.
+	Dedupe DedupeStore
+
+	mu         sync.RWMutex
+	dispatcher *events.Dispatcher
+	verifier   *services.WebhookService
+	now        func() time.Time
+}
+
+func NewHandler(secret string, handlers Handlers) *Handler {
+	return &Handler{Secret: secret, dispatcher: handlers.dispatcher(), verifier: services.NewWebhookService(), now: time.Now}
+}
+
+// RotateSecret makes secret the secret that webhooks are signed with, and keeps
+// accepting the previous one for overlap, so that webhooks signed while Lithic
+// switches to the new secret are not rejected. Expired secrets are dropped. It is
+// safe to call while the handler serves webhooks, unlike setting Secret and
+// Secrets directly.
+func (h *Handler) RotateSecret(secret string, overlap time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := h.clock()
+	secrets := []services.WebhookSecret{}
+	for _, s := range h.Secrets {
+		if !s.Expired(now) {
+			secrets = append(secrets, s)
+		}
+	}
+	if h.Secret != "" && h.Secret != secret && overlap > 0 {
+		secrets = append(secrets, services.WebhookSecret{Secret: h.Secret, ExpiresAt: now.Add(overlap)})
+	}
+	h.Secret = secret
+	h.Secrets = secrets
+}
+
+func (h *Handler) clock() time.Time {
+	if h.now != nil {
+		return h.now()
+	}
+	return time.Now()
+}
+
+// secrets returns the secrets that webhooks are verified with. Empty secrets
+// are left out, and webhooks are rejected if none remain.
+func (h *Handler) secrets() []services.WebhookSecret {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	secrets := make([]services.WebhookSecret, 0, len(h.Secrets)+1)
+	for _, secret := range append([]services.WebhookSecret{{Secret: h.Secret}}, h.Secrets...) {
+		if secret.Secret != "" {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
+// Response is the JSON body of the replies of a Handler.
+type Response struct {
+	// "handled", "ignored" or "duplicate" for webhooks that were acknowledged.
+	Status string `json:"status,omitempty"`
+	// The token of the event.
+	EventToken string         `json:"event_token,omitempty"`
+	Error      *ResponseError `json:"error,omitempty"`
+}
+
+type ResponseError struct {
+	// One of the ErrorType constants.
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.fail(w, r, http.StatusMethodNotAllowed, ErrorTypeMethodNotAllowed, fmt.Errorf("webhooks: method %s is not allowed", r.Method))
+		return
+	}
+	payload, err := io.ReadAll(io.LimitReader(r.Body, MaxBodySize+1))
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, ErrorTypeInvalidPayload, err)
+		return
+	}
+	if len(payload) > MaxBodySize {
+		h.fail(w, r, http.StatusRequestEntityTooLarge, ErrorTypeBodyTooLarge, fmt.Errorf("webhooks: body is larger than %d bytes", MaxBodySize))
+		return
+	}
+	if err := h.verifier.VerifySignatureWithSecrets(payload, r.Header, h.secrets(), h.clock()); err != nil {
+		h.fail(w, r, http.StatusUnauthorized, ErrorTypeInvalidSignature, err)
+		return
+	}
+	event, err := Parse(payload, r.Header)
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, ErrorTypeInvalidPayload, err)
+		return
+	}
+
+	if h.Dedupe != nil && event.Token != "" {
+		claimed, err := h.Dedupe.Claim(r.Context(), event.Token)
+		if err != nil {
+			h.fail(w, r, http.StatusInternalServerError, ErrorTypeDedupeFailed, fmt.Errorf("webhooks: cannot claim event %s: %w", event.Token, err))
+			return
+		}
+		if !claimed {
+			h.reply(w, http.StatusOK, Response{Status: "duplicate", EventToken: event.Token})
+			return
+		}
+	}
+
+	handled, err := h.dispatch(r.Context(), event)
+	if err != nil && h.Dedupe != nil && event.Token != "" {
+		if releaseErr := h.Dedupe.Release(r.Context(), event.Token); releaseErr != nil && h.OnError != nil {
+			h.OnError(r, fmt.Errorf("webhooks: cannot release event %s: %w", event.Token, releaseErr))
+		}
+	}
+	switch {
+	case errors.Is(err, errInvalidPayload):
+		h.fail(w, r, http.StatusBadRequest, ErrorTypeInvalidPayload, err)
+	case errors.Is(err, errPanicked):
+		h.fail(w, r, http.StatusInternalServerError, ErrorTypeHandlerPanicked, err)
+	case err != nil:
+		h.fail(w, r, http.StatusInternalServerError, ErrorTypeHandlerFailed, err)
+	case !handled:
+		h.reply(w, http.StatusOK, Response{Status: "ignored", EventToken: event.Token})
+	default:
+		h.reply(w, http.StatusOK, Response{Status: "handled", EventToken: event.Token})
+	}
+}
+
+var (
+	errInvalidPayload = errors.New("webhooks: invalid payload")
+	errPanicked       = errors.New("webhooks: handler panicked")
+)
+
+// dispatch decodes the payload of event and dispatches it, and reports whether
+// a handler was called for it.
+func (h *Handler) dispatch(ctx context.Context, event *responses.Event) (handled bool, err error) {
+	if h.dispatcher == nil || !h.dispatcher.Handles(event.EventType) {
+		return false, nil
+	}
+	payload, err := events.Decode(event)
+	var unknown *events.UnknownEventTypeError
+	if err != nil && !errors.As(err, &unknown) {
+		return false, fmt.Errorf("%w: %s", errInvalidPayload, err)
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%w: %v\n%s", errPanicked, recovered, debug.Stack())
+		}
+	}()
+	return true, h.dispatcher.DispatchPayload(ctx, event, payload)
+}
+
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, status int, errorType string, err error) {
+	if h.OnError != nil {
+		h.OnError(r, err)
+	}
+	message := err.Error()
+	if errorType == ErrorTypeHandlerPanicked {
+		// The stack trace is only given to OnError.
+		message = errPanicked.Error()
+	}
+	h.reply(w, status, Response{Error: &ResponseError{Type: errorType, Message: message}})
+}
+
+func (h *Handler) reply(w http.ResponseWriter, status int, res Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Parse decodes the body of a webhook into an event. Bodies that are events,
+// with a payload, are decoded as such. Other bodies are the payload itself,
+// with an event_type field, and the event token is taken from the webhook-id
+// header.
+func Parse(body []byte, header http.Header) (*responses.Event, error) {
+	var envelope struct {
+		Token     string          `json:"token"`
+		EventType string          `json:"event_type"`
+		Payload   json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("webhooks: invalid body: %w", err)
+	}
+	if envelope.EventType == "" {
+		return nil, errors.New("webhooks: body has no event_type")
+	}
+	if len(envelope.Payload) == 0 || bytes.Equal(envelope.Payload, []byte("null")) {
+		data, err := json.Marshal(map[string]interface{}{"token": header.Get("webhook-id"), "event_type": envelope.EventType, "payload": json.RawMessage(body)})
+		if err != nil {
+			return nil, err
+		}
+		body = data
+	}
+	event := &responses.Event{}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, fmt.Errorf("webhooks: invalid body: %w", err)
+	}
+	return event, nil
+}
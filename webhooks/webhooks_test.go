@@ -0,0 +1,209 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lithic-com/lithic-go/responses"
+	"github.com/lithic-com/lithic-go/services"
+)
+
+const secret = "whsec_c2VjcmV0X2Zvcl90ZXN0cw=="
+
+func post(t *testing.T, h http.Handler, id string, body string, sign bool) (int, Response) {
+	t.Helper()
+	r := httptest.NewRequest("POST", "/webhooks", strings.NewReader(body))
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	r.Header.Set("webhook-id", id)
+	r.Header.Set("webhook-timestamp", timestamp)
+	signature := []byte("forged")
+	if sign {
+		key, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, "whsec_"))
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(id + "." + timestamp + "." + body))
+		signature = mac.Sum(nil)
+	}
+	r.Header.Set("webhook-signature", "v1,"+base64.StdEncoding.EncodeToString(signature))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	var res Response
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatalf("expected a JSON response, got %q", w.Body)
+	}
+	return w.Code, res
+}
+
+func TestHandler(t *testing.T) {
+	var transactions []string
+	var cards []string
+	h := NewHandler(secret, Handlers{
+		OnTransactionUpdated: func(ctx context.Context, event *responses.Event, tx *responses.Transaction) error {
+			transactions = append(transactions, event.Token+":"+tx.Token)
+			return nil
+		},
+		OnCardCreated: func(ctx context.Context, event *responses.Event, card *responses.CardCreatedEvent) error {
+			cards = append(cards, event.Token+":"+card.CardToken)
+			return nil
+		},
+	})
+
+	code, res := post(t, h, "msg_1", `{"token":"evt_1","event_type":"card_transaction.updated","payload":{"token":"tx"}}`, true)
+	if code != http.StatusOK || res.Status != "handled" || res.EventToken != "evt_1" {
+		t.Fatalf("expected the event to be handled, got %d %+v", code, res)
+	}
+	// Webhooks whose body is the payload itself take their token from the
+	// webhook-id header.
+	code, res = post(t, h, "msg_2", `{"event_type":"card.created","card_token":"card"}`, true)
+	if code != http.StatusOK || res.Status != "handled" || res.EventToken != "msg_2" {
+		t.Fatalf("expected the payload to be handled, got %d %+v", code, res)
+	}
+	code, res = post(t, h, "msg_3", `{"event_type":"dispute.updated","payload":{"token":"dispute"}}`, true)
+	if code != http.StatusOK || res.Status != "ignored" {
+		t.Fatalf("expected an event without handler to be ignored, got %d %+v", code, res)
+	}
+	code, res = post(t, h, "msg_4", `{"event_type":"card.exploded","payload":{}}`, true)
+	if code != http.StatusOK || res.Status != "ignored" {
+		t.Fatalf("expected an unknown event to be ignored, got %d %+v", code, res)
+	}
+
+	if strings.Join(transactions, ",") != "evt_1:tx" || strings.Join(cards, ",") != "msg_2:card" {
+		t.Fatalf("unexpected handled events %v %v", transactions, cards)
+	}
+}
+
+func TestHandlerRejects(t *testing.T) {
+	var reported []error
+	h := NewHandler(secret, Handlers{
+		OnTransactionUpdated: func(ctx context.Context, event *responses.Event, tx *responses.Transaction) error {
+			return nil
+		},
+	})
+	h.OnError = func(r *http.Request, err error) { reported = append(reported, err) }
+
+	code, res := post(t, h, "msg_1", `{"event_type":"card_transaction.updated","payload":{}}`, false)
+	if code != http.StatusUnauthorized || res.Error == nil || res.Error.Type != ErrorTypeInvalidSignature {
+		t.Fatalf("expected a forged webhook to be rejected, got %d %+v", code, res)
+	}
+	code, res = post(t, h, "msg_2", `{"payload":{}}`, true)
+	if code != http.StatusBadRequest || res.Error.Type != ErrorTypeInvalidPayload {
+		t.Fatalf("expected a webhook without event type to be rejected, got %d %+v", code, res)
+	}
+	code, res = post(t, h, "msg_3", `{"event_type":`, true)
+	if code != http.StatusBadRequest || res.Error.Type != ErrorTypeInvalidPayload {
+		t.Fatalf("expected a body that is not JSON to be rejected, got %d %+v", code, res)
+	}
+	r := httptest.NewRequest("GET", "/webhooks", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusMethodNotAllowed || w.Header().Get("Allow") != "POST" {
+		t.Fatalf("expected GET to be rejected, got %d", w.Code)
+	}
+	if len(reported) != 4 {
+		t.Fatalf("expected every rejection to be reported, got %v", reported)
+	}
+}
+
+func TestRotateSecret(t *testing.T) {
+	h := NewHandler(secret, Handlers{})
+	now := time.Now()
+	h.now = func() time.Time { return now }
+
+	// Webhooks signed with the previous secret are accepted until the overlap
+	// ends.
+	h.RotateSecret("whsec_bmV3X3NlY3JldA==", time.Hour)
+	if code, res := post(t, h, "msg_1", `{"event_type":"card.created","payload":{}}`, true); code != http.StatusOK {
+		t.Fatalf("expected the previous secret to be accepted, got %d %+v", code, res)
+	}
+	now = now.Add(2 * time.Hour)
+	h.RotateSecret("whsec_bmV3ZXJfc2VjcmV0", 0)
+	if len(h.Secrets) != 0 {
+		t.Fatalf("expected the expired secret to be dropped, got %+v", h.Secrets)
+	}
+	now = time.Now()
+	if code, res := post(t, h, "msg_2", `{"event_type":"card.created","payload":{}}`, true); code != http.StatusUnauthorized {
+		t.Fatalf("expected the previous secret to be rejected, got %d %+v", code, res)
+	}
+}
+
+func TestHandlerLiteral(t *testing.T) {
+	h := &Handler{Secret: secret}
+	code, res := post(t, h, "msg_1", `{"event_type":"card.created","payload":{}}`, true)
+	if code != http.StatusOK || res.Status != "ignored" {
+		t.Fatalf("expected the webhook to be acknowledged, got %d %+v", code, res)
+	}
+	if code, res := post(t, h, "msg_2", `{"event_type":"card.created","payload":{}}`, false); code != http.StatusUnauthorized {
+		t.Fatalf("expected an unsigned webhook to be rejected, got %d %+v", code, res)
+	}
+}
+
+func TestHandlerRejectsEmptySecrets(t *testing.T) {
+	body := `{"event_type":"card.created","payload":{}}`
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, nil)
+	mac.Write([]byte("msg_1." + timestamp + "." + body))
+	signature := "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	empty := NewHandler("", Handlers{})
+	prefixOnly := NewHandler("", Handlers{})
+	prefixOnly.Secrets = []services.WebhookSecret{{Secret: "whsec_"}}
+	for name, h := range map[string]*Handler{"empty": empty, "prefix only": prefixOnly} {
+		r := httptest.NewRequest("POST", "/webhooks", strings.NewReader(body))
+		r.Header.Set("webhook-id", "msg_1")
+		r.Header.Set("webhook-timestamp", timestamp)
+		r.Header.Set("webhook-signature", signature)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != http.StatusUnauthorized {
+			t.Fatalf("%s: expected a webhook signed with an empty key to be rejected, got %d", name, w.Code)
+		}
+	}
+}
+
+func TestHandlerFailures(t *testing.T) {
+	var reported error
+	h := NewHandler(secret, Handlers{
+		OnCardCreated: func(ctx context.Context, event *responses.Event, card *responses.CardCreatedEvent) error {
+			return errors.New("database unavailable")
+		},
+		OnCardShipped: func(ctx context.Context, event *responses.Event, card *responses.CardShippedEvent) error {
+			panic("nil map")
+		},
+	})
+	h.OnError = func(r *http.Request, err error) { reported = err }
+
+	code, res := post(t, h, "msg_1", `{"event_type":"card.created","card_token":"card"}`, true)
+	if code != http.StatusInternalServerError || res.Error.Type != ErrorTypeHandlerFailed || res.Error.Message != "database unavailable" {
+		t.Fatalf("expected a failed handler to be retried, got %d %+v", code, res)
+	}
+	code, res = post(t, h, "msg_2", `{"event_type":"card.shipped","card_token":"card"}`, true)
+	if code != http.StatusInternalServerError || res.Error.Type != ErrorTypeHandlerPanicked || strings.Contains(res.Error.Message, "goroutine") {
+		t.Fatalf("expected a panic to be recovered without leaking the stack, got %d %+v", code, res)
+	}
+	if !strings.Contains(reported.Error(), "nil map") || !strings.Contains(reported.Error(), "goroutine") {
+		t.Fatalf("expected the panic and its stack to be reported, got %v", reported)
+	}
+}
+
+func TestParse(t *testing.T) {
+	event, err := Parse([]byte(`{"event_type":"card.created","card_token":"card"}`), http.Header{"Webhook-Id": {"msg_1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Token != "msg_1" || event.EventType != responses.EventEventTypeCardCreated || !bytes.Contains(event.JSON.Payload.Raw(), []byte(`"card_token":"card"`)) {
+		t.Fatalf("unexpected event %+v", event)
+	}
+	if _, err := Parse([]byte(`not json`), nil); err == nil {
+		t.Fatal("expected an error")
+	}
+}